@@ -0,0 +1,381 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/apikey"
+	"backend/ent/apikeyusage"
+	"backend/swagdto"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// Header yang dipakai integrator untuk mengirim API key
+	headerAPIKey = "X-API-Key"
+	// Key di echo.Context untuk *ent.APIKey yang sedang dipakai
+	ctxKeyAPIKey = "apiKey"
+
+	apiKeyPrefix     = "capt_"
+	apiKeyScopeRead  = "read" // Semua route GET
+	apiKeyScopeAll   = "*"    // Semua route
+	apiKeyUsageRoute = "/api-keys/me"
+)
+
+// apiKeyCredentialRoutes adalah route partner yang menerima API key sebagai pengganti sesi login
+// (lihat 'requireAuthOrAPIKey'). Scope "read" tidak cukup: key harus punya scope route tersebut atau "*".
+var apiKeyCredentialRoutes = map[string]bool{
+	"GET /events/:id/attendees/export": true,
+}
+
+func apiKeyDay(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+// apiKeyAllows mengecek apakah scopes key mengizinkan 'method' ke route 'path' (pola route Echo).
+func apiKeyAllows(scopes []string, method, path string) bool {
+	if path == apiKeyUsageRoute {
+		return true
+	}
+	for _, s := range scopes {
+		switch {
+		case s == apiKeyScopeAll:
+			return true
+		case s == apiKeyScopeRead && method == http.MethodGet:
+			return true
+		case s == method+" "+path:
+			return true
+		}
+	}
+	return false
+}
+
+func validAPIKeyScope(s string) bool {
+	if s == apiKeyScopeRead || s == apiKeyScopeAll {
+		return true
+	}
+	method, path, ok := strings.Cut(s, " ")
+	if !ok || !strings.HasPrefix(path, "/") {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// apiKeyMiddleware memvalidasi header 'X-API-Key' (jika ada), lalu menerapkan
+// scope, batas per menit, dan kuota harian key tersebut.
+// Request tanpa API key diteruskan apa adanya (batas per route lihat 'rateLimit').
+func (h *Handler) apiKeyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		raw := c.Request().Header.Get(headerAPIKey)
+		if raw == "" {
+			return next(c)
+		}
+		ctx := c.Request().Context()
+		now := time.Now()
+
+		// 1. Cari key (hanya yang belum dicabut)
+		key, err := h.DB.APIKey.Query().
+			Where(apikey.KeyHashEQ(hashSessionToken(raw)), apikey.RevokedAtIsNil()).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return c.JSON(http.StatusUnauthorized, APIResponse{Error: "API key tidak valid atau sudah dicabut"})
+			}
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+
+		// 2. Scope
+		if !apiKeyAllows(key.Scopes, c.Request().Method, c.Path()) {
+			return c.JSON(http.StatusForbidden, APIResponse{Error: "API key tidak punya akses ke route ini"})
+		}
+
+		// 3. Batas per menit (token bucket yang sama dengan 'rateLimit')
+		bucket := "apikey|" + strconv.Itoa(key.ID)
+		ok, retryAfter := rateLimitStore.take([]rateLimitCheck{{
+			key:  bucket,
+			rule: rateLimitRule{Limit: key.RatePerMinute, Per: time.Minute},
+		}}, now)
+		header := c.Response().Header()
+		header.Set("X-RateLimit-Limit", strconv.Itoa(key.RatePerMinute))
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(rateLimitStore.remaining(bucket)))
+		if !ok {
+			header.Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			return c.JSON(http.StatusTooManyRequests, APIResponse{Error: "Rate limit API key terlampaui"})
+		}
+
+		// 4. Kuota harian
+		used, err := h.incrementAPIKeyUsage(ctx, key.ID, apiKeyDay(now))
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		header.Set("X-Quota-Limit", strconv.Itoa(key.DailyQuota))
+		header.Set("X-Quota-Remaining", strconv.Itoa(max(key.DailyQuota-used, 0)))
+		if used > key.DailyQuota {
+			return c.JSON(http.StatusTooManyRequests, APIResponse{Error: "Kuota harian API key habis"})
+		}
+
+		if err := key.Update().AddTotalRequests(1).SetLastUsedAt(now).Exec(ctx); err != nil {
+			log.Printf("Gagal update pemakaian API key %d: %v", key.ID, err)
+		}
+
+		c.Set(ctxKeyAPIKey, key)
+		return next(c)
+	}
+}
+
+// requireAuthOrAPIKey seperti 'requireAuth', tetapi juga menerima API key (divalidasi 'apiKeyMiddleware')
+// yang punya 'owner_address' dan scope eksplisit untuk route partner ini. Request lalu diotorisasi
+// sebagai owner key, sehingga 'requireEventPermission' tetap berlaku.
+func (h *Handler) requireAuthOrAPIKey(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key, ok := c.Get(ctxKeyAPIKey).(*ent.APIKey)
+		if !ok {
+			return h.requireAuth(next)(c)
+		}
+		route := c.Request().Method + " " + c.Path()
+		if !apiKeyCredentialRoutes[route] || key.OwnerAddress == "" || !apiKeyGrants(key.Scopes, route) {
+			return c.JSON(http.StatusForbidden, APIResponse{Error: "API key tidak berlaku sebagai kredensial untuk route ini"})
+		}
+		c.Set(ctxKeyAddress, key.OwnerAddress)
+		return next(c)
+	}
+}
+
+// apiKeyGrants mengecek scope eksplisit "METHOD /path" atau "*" (tanpa "read").
+func apiKeyGrants(scopes []string, route string) bool {
+	for _, s := range scopes {
+		if s == apiKeyScopeAll || s == route {
+			return true
+		}
+	}
+	return false
+}
+
+// incrementAPIKeyUsage menambah counter harian dan mengembalikan nilai barunya.
+func (h *Handler) incrementAPIKeyUsage(ctx context.Context, keyID int, day string) (int, error) {
+	increment := func() (int, error) {
+		return h.DB.APIKeyUsage.Update().
+			Where(apikeyusage.KeyIDEQ(keyID), apikeyusage.DayEQ(day)).
+			AddCount(1).
+			Save(ctx)
+	}
+
+	n, err := increment()
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		// Baris hari ini belum ada. Jika request lain membuatnya bersamaan
+		// (constraint error), cukup increment ulang.
+		_, err = h.DB.APIKeyUsage.Create().SetKeyID(keyID).SetDay(day).SetCount(1).Save(ctx)
+		if err == nil {
+			return 1, nil
+		}
+		if !ent.IsConstraintError(err) {
+			return 0, err
+		}
+		if _, err := increment(); err != nil {
+			return 0, err
+		}
+	}
+
+	usage, err := h.DB.APIKeyUsage.Query().
+		Where(apikeyusage.KeyIDEQ(keyID), apikeyusage.DayEQ(day)).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return usage.Count, nil
+}
+
+func apiKeyResponse(k *ent.APIKey, usedToday int) *swagdto.APIKeyResponse {
+	return &swagdto.APIKeyResponse{
+		ID:            k.ID,
+		Name:          k.Name,
+		Prefix:        k.Prefix,
+		Scopes:        k.Scopes,
+		OwnerAddress:  k.OwnerAddress,
+		RatePerMinute: k.RatePerMinute,
+		DailyQuota:    k.DailyQuota,
+		UsedToday:     usedToday,
+		TotalRequests: k.TotalRequests,
+		CreatedBy:     k.CreatedBy,
+		CreatedAt:     k.CreatedAt,
+		LastUsedAt:    k.LastUsedAt,
+		RevokedAt:     k.RevokedAt,
+	}
+}
+
+// @Summary     Buat API Key (Admin)
+// @Description Membuat API key untuk partner. Kunci mentah hanya dikembalikan SEKALI di respon ini.
+// @Description Scope: "read" (semua GET), "*" (semua route), atau route spesifik "METHOD /path" (misal: "GET /events/:id").
+// @Description Route partner (misal: "GET /events/:id/attendees/export") hanya bisa diakses dengan key yang punya
+// @Description 'ownerAddress' dan scope route tersebut (atau "*"); izin event dicek sebagai owner.
+// @Tags        API Keys
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       body body     CreateAPIKeyRequest true "Nama, scope, dan batas pemakaian"
+// @Success     201 {object} APIResponse{data=swagdto.CreateAPIKeyResponse} "API key dibuat"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     403 {object} APIResponse "Bukan admin"
+// @Router      /admin/api-keys [post]
+func (h *Handler) createAPIKey(c echo.Context) error {
+	req := new(CreateAPIKeyRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.Name == "" || len(req.Scopes) == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "name dan scopes adalah field wajib"})
+	}
+	for _, s := range req.Scopes {
+		if !validAPIKeyScope(s) {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "Scope tidak valid: " + s})
+		}
+	}
+	if req.OwnerAddress != "" {
+		req.OwnerAddress = normalizeAddress(req.OwnerAddress)
+	}
+	if req.RatePerMinute < 0 || req.DailyQuota < 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "ratePerMinute dan dailyQuota tidak boleh negatif"})
+	}
+
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	raw := apiKeyPrefix + hex.EncodeToString(b)
+
+	create := h.DB.APIKey.Create().
+		SetName(req.Name).
+		SetPrefix(raw[:len(apiKeyPrefix)+6]).
+		SetKeyHash(hashSessionToken(raw)).
+		SetScopes(req.Scopes).
+		SetOwnerAddress(req.OwnerAddress).
+		SetCreatedBy(sessionAddress(c))
+	// 0 = pakai default dari skema
+	if req.RatePerMinute > 0 {
+		create.SetRatePerMinute(req.RatePerMinute)
+	}
+	if req.DailyQuota > 0 {
+		create.SetDailyQuota(req.DailyQuota)
+	}
+	key, err := create.Save(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, APIResponse{Data: &swagdto.CreateAPIKeyResponse{
+		APIKeyResponse: *apiKeyResponse(key, 0),
+		Key:            raw,
+	}})
+}
+
+// @Summary     Daftar API Key (Admin)
+// @Description Menampilkan semua API key beserta pemakaian hari ini dan total request.
+// @Tags        API Keys
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse{data=[]swagdto.APIKeyResponse} "Daftar API key"
+// @Failure     403 {object} APIResponse "Bukan admin"
+// @Router      /admin/api-keys [get]
+func (h *Handler) getAPIKeys(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	keys, err := h.DB.APIKey.Query().
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	usages, err := h.DB.APIKeyUsage.Query().
+		Where(apikeyusage.DayEQ(apiKeyDay(time.Now()))).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	usedToday := make(map[int]int, len(usages))
+	for _, u := range usages {
+		usedToday[u.KeyID] = u.Count
+	}
+
+	responses := make([]*swagdto.APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		responses = append(responses, apiKeyResponse(k, usedToday[k.ID]))
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses})
+}
+
+// @Summary     Cabut API Key (Admin)
+// @Description Mencabut API key. Request berikutnya dengan key ini akan ditolak (401).
+// @Tags        API Keys
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "API Key ID"
+// @Success     200 {object} APIResponse{data=swagdto.APIKeyResponse} "API key dicabut"
+// @Failure     403 {object} APIResponse "Bukan admin"
+// @Failure     404 {object} APIResponse "API key tidak ditemukan"
+// @Router      /admin/api-keys/{id} [delete]
+func (h *Handler) revokeAPIKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid API Key ID"})
+	}
+
+	key, err := h.DB.APIKey.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "API key not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if key.RevokedAt == nil {
+		if key, err = key.Update().SetRevokedAt(time.Now()).Save(ctx); err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: apiKeyResponse(key, 0)})
+}
+
+// @Summary     Pemakaian API Key
+// @Description Menampilkan batas dan pemakaian API key yang dikirim di header 'X-API-Key'.
+// @Tags        API Keys
+// @Produce     json
+// @Security    APIKeyAuth
+// @Success     200 {object} APIResponse{data=swagdto.APIKeyResponse} "Pemakaian API key"
+// @Failure     401 {object} APIResponse "API key tidak valid"
+// @Router      /api-keys/me [get]
+func (h *Handler) getAPIKeyUsage(c echo.Context) error {
+	key, ok := c.Get(ctxKeyAPIKey).(*ent.APIKey)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, APIResponse{Error: "Header X-API-Key wajib diisi"})
+	}
+
+	usage, err := h.DB.APIKeyUsage.Query().
+		Where(apikeyusage.KeyIDEQ(key.ID), apikeyusage.DayEQ(apiKeyDay(time.Now()))).
+		Only(c.Request().Context())
+	if err != nil && !ent.IsNotFound(err) {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	usedToday := 0
+	if usage != nil {
+		usedToday = usage.Count
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: apiKeyResponse(key, usedToday)})
+}
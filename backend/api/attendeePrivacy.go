@@ -0,0 +1,264 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/event"
+	"backend/ent/eventpass"
+	"backend/ent/eventstaff"
+	"backend/ent/predicate"
+	"backend/ent/user"
+	"backend/swagdto"
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Privasi daftar peserta.
+//
+// Setiap event punya 'attendee_visibility' (public | attendees | host) dan setiap user bisa memilih
+// 'hide_from_attendee_lists'. Aturannya (dipakai oleh /events/:id, /event-passes, serta
+// edge 'event_passes' di /users dan /profiles):
+//   - Pengunjung anonim hanya mendapat jumlah peserta.
+//   - Host/staff event dan admin platform melihat semua peserta.
+//   - User selalu melihat entry miliknya sendiri.
+//   - Selain itu, peserta yang tidak memilih disembunyikan tampil jika visibility 'public',
+//     atau 'attendees' dan viewer juga peserta event tsb.
+//
+// Deprecated: client lama yang belum login masih boleh memakai '?viewer=' di /events/:id dan
+// 'owner_address' di /event-passes, tetapi hanya untuk data yang memang publik (visibility 'public'
+// dan peserta tidak memilih disembunyikan). Respon tsb diberi header 'Deprecation: true'.
+
+// attendeeAccess adalah tingkat akses viewer ke daftar peserta sebuah event.
+type attendeeAccess int
+
+const (
+	attendeeAccessNone   attendeeAccess = iota // Hanya jumlah (dan entry milik viewer sendiri)
+	attendeeAccessPublic                       // Peserta yang tidak memilih disembunyikan
+	attendeeAccessFull                         // Semua peserta (host/staff/admin)
+)
+
+// viewerAddress mengembalikan alamat user yang login, atau "" untuk pengunjung anonim.
+// Dipakai di route publik (tanpa 'requireAuth') yang hasilnya bergantung pada viewer.
+func (h *Handler) viewerAddress(c echo.Context) string {
+	if address := sessionAddress(c); address != "" {
+		return address
+	}
+	address, _ := h.lookupSession(c)
+	return address
+}
+
+// eventAttendeeAccess menentukan akses 'viewer' ke daftar peserta event 'ev'.
+func (h *Handler) eventAttendeeAccess(ctx context.Context, ev *ent.Event, viewer string) (attendeeAccess, error) {
+	if viewer == "" {
+		return attendeeAccessNone, nil
+	}
+
+	role, err := h.eventRole(ctx, ev.EventID, viewer)
+	if err != nil {
+		return attendeeAccessNone, err
+	}
+	if role != "" {
+		return attendeeAccessFull, nil
+	}
+
+	switch ev.AttendeeVisibility {
+	case event.AttendeeVisibilityPublic:
+		return attendeeAccessPublic, nil
+	case event.AttendeeVisibilityAttendees:
+		registered, err := h.DB.Attendance.Query().
+			Where(
+				attendance.HasEventWith(event.IDEQ(ev.ID)),
+				attendance.HasUserWith(user.AddressEQ(viewer)),
+			).
+			Exist(ctx)
+		if err != nil || !registered {
+			return attendeeAccessNone, err
+		}
+		return attendeeAccessPublic, nil
+	}
+	return attendeeAccessNone, nil
+}
+
+// attendeeVisible mengecek apakah peserta 'u' boleh ditampilkan ke 'viewer'.
+func attendeeVisible(access attendeeAccess, viewer string, u *ent.User) bool {
+	switch {
+	case u == nil:
+		return false
+	case access == attendeeAccessFull, viewer != "" && u.Address == viewer:
+		return true
+	case access == attendeeAccessPublic:
+		return !u.HideFromAttendeeLists
+	}
+	return false
+}
+
+// visibleEventPassPredicate membatasi /event-passes ke pass yang pemiliknya boleh dilihat 'viewer'
+// (aturan yang sama dengan 'attendeeVisible', dalam bentuk SQL). 'viewer' tidak boleh kosong.
+// Mengembalikan nil jika viewer adalah admin platform (tanpa batasan).
+func (h *Handler) visibleEventPassPredicate(ctx context.Context, viewer string) (predicate.EventPass, error) {
+	if isPlatformAdmin(viewer) {
+		return nil, nil
+	}
+
+	staffEventIDs, err := h.DB.EventStaff.Query().
+		Where(eventstaff.AddressEQ(viewer)).
+		Select(eventstaff.FieldEventID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	managed := []predicate.Event{event.HasHostWith(user.AddressEQ(viewer))}
+	if len(staffEventIDs) > 0 {
+		ids := make([]uint64, len(staffEventIDs))
+		for i, id := range staffEventIDs {
+			ids[i] = uint64(id)
+		}
+		managed = append(managed, event.EventIDIn(ids...))
+	}
+
+	return eventpass.Or(
+		eventpass.HasOwnerWith(user.AddressEQ(viewer)),
+		eventpass.HasEventWith(event.Or(managed...)),
+		eventpass.And(
+			eventpass.HasOwnerWith(user.HideFromAttendeeLists(false)),
+			eventpass.HasEventWith(event.Or(
+				event.AttendeeVisibilityEQ(event.AttendeeVisibilityPublic),
+				event.And(
+					event.AttendeeVisibilityEQ(event.AttendeeVisibilityAttendees),
+					event.HasAttendancesWith(attendance.HasUserWith(user.AddressEQ(viewer))),
+				),
+			)),
+		),
+	), nil
+}
+
+// withVisibleEventPasses memuat edge 'event_passes' user, dibatasi ke pass yang boleh dilihat 'viewer'.
+// Pengunjung anonim tidak mendapat edge ini sama sekali.
+func (h *Handler) withVisibleEventPasses(ctx context.Context, query *ent.UserQuery, viewer string) (*ent.UserQuery, error) {
+	if viewer == "" {
+		return query, nil
+	}
+	visible, err := h.visibleEventPassPredicate(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if visible == nil {
+		return query.WithEventPasses(), nil
+	}
+	return query.WithEventPasses(func(q *ent.EventPassQuery) { q.Where(visible) }), nil
+}
+
+// publicEventPassPredicate membatasi ke pass yang boleh dilihat siapa saja: event dengan visibility
+// 'public' dan pemilik yang tidak memilih disembunyikan. Hanya untuk jalur deprecated client anonim.
+func publicEventPassPredicate() predicate.EventPass {
+	return eventpass.And(
+		eventpass.HasOwnerWith(user.HideFromAttendeeLists(false)),
+		eventpass.HasEventWith(event.AttendeeVisibilityEQ(event.AttendeeVisibilityPublic)),
+	)
+}
+
+// @Summary     Atur Privasi Daftar Peserta Event (Host)
+// @Description Menentukan siapa yang boleh melihat daftar peserta di /events/{id} dan /event-passes:
+// @Description 'public' (semua user yang login), 'attendees' (hanya peserta), atau 'host' (hanya host/staff).
+// @Description Pengunjung anonim selalu hanya mendapat jumlah peserta.
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id   path     int                       true "Event ID (On-Chain ID)"
+// @Param       body body     UpdateEventPrivacyRequest true "Pengaturan privasi"
+// @Success     200 {object} APIResponse{data=swagdto.EventPrivacyResponse} "Pengaturan tersimpan"
+// @Failure     400 {object} APIResponse "attendeeVisibility tidak valid"
+// @Failure     403 {object} APIResponse "Bukan host event ini"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Router      /events/{id}/privacy [put]
+func (h *Handler) updateEventPrivacy(c echo.Context) error {
+	ctx := c.Request().Context()
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	req := new(UpdateEventPrivacyRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	visibility := event.AttendeeVisibility(req.AttendeeVisibility)
+	if err := event.AttendeeVisibilityValidator(visibility); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "attendeeVisibility harus public, attendees, atau host"})
+	}
+
+	n, err := h.DB.Event.Update().
+		Where(event.EventIDEQ(eventID)).
+		SetAttendeeVisibility(visibility).
+		Save(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if n == 0 {
+		return c.JSON(http.StatusNotFound, APIResponse{Error: "Event not found"})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.EventPrivacyResponse{
+		EventID:            eventID,
+		AttendeeVisibility: string(visibility),
+	}})
+}
+
+// @Summary     Ambil Pengaturan Privasi Saya
+// @Description Menampilkan apakah user disembunyikan dari daftar peserta publik.
+// @Tags        Profiles
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse{data=swagdto.UserPrivacyResponse} "Pengaturan privasi"
+// @Failure     404 {object} APIResponse "User belum punya profil"
+// @Router      /users/me/privacy [get]
+func (h *Handler) getMyPrivacy(c echo.Context) error {
+	u, err := h.DB.User.Query().Where(user.AddressEQ(sessionAddress(c))).Only(c.Request().Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "User not found. Please setup profile first."})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.UserPrivacyResponse{
+		HideFromAttendeeLists: u.HideFromAttendeeLists,
+	}})
+}
+
+// @Summary     Ubah Pengaturan Privasi Saya
+// @Description 'hideFromAttendeeLists' = true: user tidak ditampilkan di daftar peserta event maupun /event-passes
+// @Description untuk user lain (host/staff event tetap bisa melihat, jumlah peserta tetap dihitung).
+// @Tags        Profiles
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       body body     UpdatePrivacyRequest true "Pengaturan privasi"
+// @Success     200 {object} APIResponse{data=swagdto.UserPrivacyResponse} "Pengaturan tersimpan"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     404 {object} APIResponse "User belum punya profil"
+// @Router      /users/me/privacy [put]
+func (h *Handler) updateMyPrivacy(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := new(UpdatePrivacyRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.HideFromAttendeeLists == nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "hideFromAttendeeLists wajib diisi"})
+	}
+
+	n, err := h.DB.User.Update().
+		Where(user.AddressEQ(sessionAddress(c))).
+		SetHideFromAttendeeLists(*req.HideFromAttendeeLists).
+		Save(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if n == 0 {
+		return c.JSON(http.StatusNotFound, APIResponse{Error: "User not found. Please setup profile first."})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.UserPrivacyResponse{
+		HideFromAttendeeLists: *req.HideFromAttendeeLists,
+	}})
+}
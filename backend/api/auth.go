@@ -0,0 +1,240 @@
+package main
+
+import (
+	"backend/ent/authnonce"
+	"backend/ent/session"
+	"backend/swagdto"
+	"backend/utils"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/onflow/flow-go-sdk"
+	flowhttp "github.com/onflow/flow-go-sdk/access/http"
+)
+
+const (
+	// Nonce harus dipakai login dalam waktu ini
+	authNonceTTL = 5 * time.Minute
+	// Masa berlaku token sesi
+	sessionTTL = 7 * 24 * time.Hour
+	// Key di echo.Context untuk alamat user yang sudah login
+	ctxKeyAddress = "address"
+)
+
+// fclAppIdentifier harus sama dengan 'appIdentifier' di konfigurasi FCL frontend.
+func fclAppIdentifier() string {
+	if v := os.Getenv("FCL_APP_IDENTIFIER"); v != "" {
+		return v
+	}
+	return "Capt.today"
+}
+
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeAddress menyamakan format alamat dengan yang disimpan indexer ("0x" + 16 hex).
+func normalizeAddress(address string) string {
+	return flow.HexToAddress(address).HexWithPrefix()
+}
+
+// parseFlowAddress memvalidasi alamat Flow (hex, maks. 8 byte, boleh tanpa '0x')
+// dan mengembalikannya dalam bentuk normal (lihat 'normalizeAddress').
+func parseFlowAddress(address string) (string, bool) {
+	raw := strings.TrimPrefix(strings.TrimSpace(address), "0x")
+	if raw == "" || len(raw) > 2*flow.AddressLength {
+		return "", false
+	}
+	if _, err := hex.DecodeString(strings.Repeat("0", len(raw)%2) + raw); err != nil {
+		return "", false
+	}
+	return normalizeAddress(raw), true
+}
+
+// sessionAddress mengembalikan alamat user yang sudah login (di-set oleh 'requireAuth').
+func sessionAddress(c echo.Context) string {
+	address, _ := c.Get(ctxKeyAddress).(string)
+	return address
+}
+
+// requireAuth adalah middleware yang mewajibkan header 'Authorization: Bearer <token>'.
+// Alamat user disimpan di context dan diambil dengan 'sessionAddress(c)'.
+func (h *Handler) requireAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		address, ok := h.lookupSession(c)
+		if !ok {
+			return c.JSON(http.StatusUnauthorized, APIResponse{Error: "Unauthorized. Please login with your wallet."})
+		}
+		c.Set(ctxKeyAddress, address)
+		return next(c)
+	}
+}
+
+func (h *Handler) lookupSession(c echo.Context) (string, bool) {
+	token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+	if !ok || token == "" {
+		return "", false
+	}
+
+	s, err := h.DB.Session.Query().
+		Where(
+			session.TokenHashEQ(hashSessionToken(token)),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Only(c.Request().Context())
+	if err != nil {
+		return "", false
+	}
+	return s.Address, true
+}
+
+// @Summary     Ambil Nonce Login
+// @Description Langkah 1 login: ambil nonce untuk FCL account-proof ('fcl.config().put("fcl.accountProof.resolver", ...)').
+// @Tags        Auth
+// @Produce     json
+// @Success     200 {object} APIResponse{data=swagdto.AuthNonceResponse} "Nonce"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /auth/nonce [get]
+func (h *Handler) getAuthNonce(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// FCL mensyaratkan nonce minimal 32 byte (64 karakter hex)
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	nonce := hex.EncodeToString(b)
+	expiresAt := time.Now().Add(authNonceTTL)
+
+	if _, err := h.DB.AuthNonce.Create().
+		SetNonce(nonce).
+		SetExpiresAt(expiresAt).
+		Save(ctx); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// Bersihkan nonce kedaluwarsa (tidak kritis)
+	if _, err := h.DB.AuthNonce.Delete().Where(authnonce.ExpiresAtLT(time.Now())).Exec(ctx); err != nil {
+		log.Printf("Gagal membersihkan nonce lama: %v", err)
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.AuthNonceResponse{
+		AppIdentifier: fclAppIdentifier(),
+		Nonce:         nonce,
+		ExpiresAt:     expiresAt,
+	}})
+}
+
+// @Summary     Login dengan Wallet (FCL Account-Proof)
+// @Description Langkah 2 login: kirim account-proof dari FCL ('currentUser.services' tipe 'account-proof').
+// @Description Signature diverifikasi terhadap key on-chain akun (via access node). Jika valid, token sesi dikembalikan.
+// @Description Gunakan token sebagai header 'Authorization: Bearer <token>'.
+// @Tags        Auth
+// @Accept      json
+// @Produce     json
+// @Param       body body     LoginRequest true "Account-proof dari FCL"
+// @Success     200 {object} APIResponse{data=swagdto.SessionResponse} "Login sukses"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     401 {object} APIResponse "Nonce / signature tidak valid"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /auth/login [post]
+func (h *Handler) login(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := new(LoginRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.Address == "" || req.Nonce == "" || len(req.Signatures) == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "address, nonce, dan signatures adalah field wajib"})
+	}
+
+	// 1. Nonce harus pernah kita buat, belum kedaluwarsa, dan sekali pakai.
+	// Hapus dulu: jika 0 baris terhapus, nonce tidak valid (atau sudah dipakai).
+	deleted, err := h.DB.AuthNonce.Delete().
+		Where(
+			authnonce.NonceEQ(req.Nonce),
+			authnonce.ExpiresAtGT(time.Now()),
+		).
+		Exec(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if deleted == 0 {
+		return c.JSON(http.StatusUnauthorized, APIResponse{Error: "Nonce tidak valid atau sudah kedaluwarsa"})
+	}
+
+	// 2. Verifikasi signature terhadap key on-chain
+	flowClient, err := flowhttp.NewClient(flowhttp.TestnetHost)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: "gagal membuat flow client: " + err.Error()})
+	}
+	if err := utils.VerifyAccountProof(ctx, flowClient, req.Address, fclAppIdentifier(), req.Nonce, req.Signatures); err != nil {
+		log.Printf("Account-proof %s ditolak: %v", req.Address, err)
+		return c.JSON(http.StatusUnauthorized, APIResponse{Error: err.Error()})
+	}
+
+	// 3. Buat sesi. Hanya hash token yang disimpan.
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	token := hex.EncodeToString(b)
+	address := normalizeAddress(req.Address)
+	expiresAt := time.Now().Add(sessionTTL)
+
+	if _, err := h.DB.Session.Create().
+		SetTokenHash(hashSessionToken(token)).
+		SetAddress(address).
+		SetExpiresAt(expiresAt).
+		Save(ctx); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.SessionResponse{
+		Token:     token,
+		Address:   address,
+		ExpiresAt: expiresAt,
+	}})
+}
+
+// @Summary     Logout
+// @Description Menghapus sesi yang sedang dipakai.
+// @Tags        Auth
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse "Logout sukses"
+// @Router      /auth/logout [post]
+func (h *Handler) logout(c echo.Context) error {
+	token, _ := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+	if _, err := h.DB.Session.Delete().
+		Where(session.TokenHashEQ(hashSessionToken(token))).
+		Exec(c.Request().Context()); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: map[string]string{"message": "Logged out"}})
+}
+
+// @Summary     Sesi Saat Ini
+// @Description Mengembalikan alamat wallet dari sesi yang sedang dipakai, dan apakah user adalah admin platform.
+// @Tags        Auth
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse "Alamat user"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Router      /auth/me [get]
+func (h *Handler) getMe(c echo.Context) error {
+	address := sessionAddress(c)
+	return c.JSON(http.StatusOK, APIResponse{Data: map[string]interface{}{
+		"address": address,
+		"isAdmin": isPlatformAdmin(address),
+	}})
+}
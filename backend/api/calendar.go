@@ -0,0 +1,255 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/calendartoken"
+	"backend/ent/event"
+	"backend/ent/user"
+	"backend/swagdto"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Feed iCalendar (RFC 5545) untuk event:
+//   - GET /events/:id.ics                          -> satu event (publik)
+//   - GET /users/:address/calendar.ics?token=...   -> semua event yang diikuti user (butuh token rahasia)
+//
+// Waktu ditulis dalam UTC (akhiran 'Z') sehingga aplikasi kalender menampilkannya sesuai zona waktu
+// masing-masing. UID stabil per event ('event-<eventID>@capt.today') agar update tidak menjadi duplikat.
+
+const (
+	icalUIDDomain   = "capt.today"
+	icalProdID      = "-//Capt.today//Events//ID"
+	icalTimeLayout  = "20060102T150405Z"
+	icalContentType = "text/calendar; charset=utf-8"
+	// Saran interval refresh untuk aplikasi kalender yang berlangganan
+	icalRefreshInterval = "PT1H"
+)
+
+// icalEscape meng-escape teks sesuai RFC 5545 (backslash, titik koma, koma, baris baru).
+func icalEscape(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, ";", "\\;")
+	s = strings.ReplaceAll(s, ",", "\\,")
+	s = strings.ReplaceAll(s, "\r\n", "\\n")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+	return s
+}
+
+// icalWriter menulis baris iCalendar dengan CRLF dan melipat baris > 75 oktet.
+type icalWriter struct {
+	b strings.Builder
+}
+
+func (w *icalWriter) line(name, value string) {
+	l := name + ":" + value
+	for len(l) > 75 {
+		// Jangan memotong di tengah karakter UTF-8
+		cut := 75
+		for cut > 0 && l[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.b.WriteString(l[:cut] + "\r\n")
+		l = " " + l[cut:]
+	}
+	w.b.WriteString(l + "\r\n")
+}
+
+// begin membuka VCALENDAR beserta nama kalender.
+func (w *icalWriter) begin(name string) {
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", icalProdID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.line("X-WR-CALNAME", icalEscape(name))
+	w.line("REFRESH-INTERVAL;VALUE=DURATION", icalRefreshInterval)
+	w.line("X-PUBLISHED-TTL", icalRefreshInterval)
+}
+
+// event menulis satu VEVENT. 'onlineURL' dipakai untuk event online (link join/meeting).
+func (w *icalWriter) event(ev *ent.Event, onlineURL string, now time.Time) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", fmt.Sprintf("event-%d@%s", ev.EventID, icalUIDDomain))
+	w.line("DTSTAMP", now.UTC().Format(icalTimeLayout))
+	w.line("DTSTART", ev.StartDate.UTC().Format(icalTimeLayout))
+	w.line("DTEND", ev.EndDate.UTC().Format(icalTimeLayout))
+	w.line("SUMMARY", icalEscape(ev.Name))
+
+	description := ev.Description
+	if ev.EventType == 0 {
+		// Event online: lokasi berupa link
+		w.line("LOCATION", icalEscape(onlineURL))
+		w.line("URL", onlineURL)
+		description = strings.TrimSpace(description + "\n\nJoin: " + onlineURL)
+	} else {
+		w.line("LOCATION", icalEscape(ev.Location))
+		w.line("GEO", fmt.Sprintf("%f;%f", ev.Lat, ev.Long))
+	}
+	w.line("DESCRIPTION", icalEscape(description))
+	// SEQUENCE naik setiap host mengubah event, agar aplikasi kalender memperbarui salinannya
+	w.line("SEQUENCE", strconv.Itoa(ev.Revision))
+	if ev.CancelledAt != nil {
+		w.line("STATUS", "CANCELLED")
+	} else {
+		w.line("STATUS", "CONFIRMED")
+	}
+	w.line("END", "VEVENT")
+}
+
+func (w *icalWriter) end() string {
+	w.line("END", "VCALENDAR")
+	return w.b.String()
+}
+
+// @Summary     Unduh Event sebagai iCalendar
+// @Description Menghasilkan file .ics (RFC 5545) untuk satu event, bisa langsung diimpor ke aplikasi kalender.
+// @Tags        Events
+// @Produce     text/calendar
+// @Param       id   path     int  true  "Event ID (On-Chain ID)"
+// @Success     200 {string} string "File iCalendar"
+// @Failure     400 {object} APIResponse "Invalid Event ID format"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /events/{id}.ics [get]
+func (h *Handler) getEventICS(c echo.Context, idStr string) error {
+	ctx := c.Request().Context()
+
+	eventID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+	}
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Event not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	w := &icalWriter{}
+	w.begin(ev.Name)
+	w.event(ev, ev.Location, time.Now())
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="event-%d.ics"`, eventID))
+	return c.Blob(http.StatusOK, icalContentType, []byte(w.end()))
+}
+
+// @Summary     Feed Kalender User (iCalendar)
+// @Description Feed .ics berisi semua event yang di-register user. Bisa di-subscribe di Google Calendar, Apple Calendar, dll.
+// @Description Membutuhkan token rahasia dari POST /users/me/calendar-token. Event online memakai link join milik user.
+// @Tags        Profiles
+// @Produce     text/calendar
+// @Param       address path     string true "Alamat user (misal: 0x...)"
+// @Param       token   query    string true "Token kalender"
+// @Success     200 {string} string "Feed iCalendar"
+// @Failure     401 {object} APIResponse "Token tidak valid"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /users/{address}/calendar.ics [get]
+func (h *Handler) getUserCalendar(c echo.Context) error {
+	ctx := c.Request().Context()
+	address := normalizeAddress(c.Param("address"))
+
+	// 1. Verifikasi token (dibandingkan dalam bentuk hash)
+	ct, err := h.DB.CalendarToken.Query().Where(calendartoken.AddressEQ(address)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	token := c.QueryParam("token")
+	if ct == nil || token == "" ||
+		subtle.ConstantTimeCompare([]byte(ct.TokenHash), []byte(hashSessionToken(token))) != 1 {
+		return c.JSON(http.StatusUnauthorized, APIResponse{Error: "Token kalender tidak valid"})
+	}
+
+	// 2. Ambil event yang di-register user
+	events, err := h.DB.Event.Query().
+		Where(event.HasAttendancesWith(attendance.HasUserWith(user.AddressEQ(address)))).
+		Order(ent.Asc(event.FieldStartDate)).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 3. Tulis feed
+	now := time.Now()
+	w := &icalWriter{}
+	w.begin("Capt.today - " + address)
+	for _, ev := range events {
+		onlineURL := ev.Location
+		if ev.EventType == 0 {
+			onlineURL = h.calendarJoinURL(ctx, ev, address)
+		}
+		w.event(ev, onlineURL, now)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, max-age=300")
+	return c.Blob(http.StatusOK, icalContentType, []byte(w.end()))
+}
+
+// calendarJoinURL mengembalikan link join milik user (agar kehadiran tetap tercatat),
+// atau URL meeting langsung jika link gagal dibuat.
+func (h *Handler) calendarJoinURL(ctx context.Context, ev *ent.Event, address string) string {
+	link, err := h.getOrCreateJoinLink(ctx, ev.EventID, address)
+	if err != nil {
+		log.Printf("Gagal membuat join link kalender event %d: %v", ev.EventID, err)
+		return ev.Location
+	}
+	return publicURL("/join/" + link.Token)
+}
+
+// @Summary     Buat Token Kalender
+// @Description Membuat (atau mengganti) token rahasia untuk feed kalender user. Token lama otomatis tidak berlaku.
+// @Description Token hanya ditampilkan sekali, simpan URL-nya untuk di-subscribe.
+// @Tags        Profiles
+// @Produce     json
+// @Security    BearerAuth
+// @Success     201 {object} APIResponse{data=swagdto.CalendarTokenResponse} "Token kalender"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /users/me/calendar-token [post]
+func (h *Handler) createCalendarToken(c echo.Context) error {
+	ctx := c.Request().Context()
+	address := sessionAddress(c)
+
+	token, err := newNonce()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// Satu token per user: ganti yang lama jika ada
+	updated, err := h.DB.CalendarToken.Update().
+		Where(calendartoken.AddressEQ(address)).
+		SetTokenHash(hashSessionToken(token)).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err == nil && updated == 0 {
+		_, err = h.DB.CalendarToken.Create().
+			SetAddress(address).
+			SetTokenHash(hashSessionToken(token)).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Request paralel sudah membuat token; timpa dengan token ini
+			err = h.DB.CalendarToken.Update().
+				Where(calendartoken.AddressEQ(address)).
+				SetTokenHash(hashSessionToken(token)).
+				Exec(ctx)
+		}
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, APIResponse{Data: &swagdto.CalendarTokenResponse{
+		Token: token,
+		URL:   publicURL(fmt.Sprintf("/users/%s/calendar.ics?token=%s", address, token)),
+	}})
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestICalEscape(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Meetup Flow", "Meetup Flow"},
+		{"Senayan, Jakarta", `Senayan\, Jakarta`},
+		{"a;b", `a\;b`},
+		{`C:\path`, `C:\\path`},
+		{"baris 1\nbaris 2", `baris 1\nbaris 2`},
+		{"baris 1\r\nbaris 2", `baris 1\nbaris 2`},
+		// Backslash di-escape lebih dulu agar escape lain tidak ter-escape dua kali
+		{`\,`, `\\\,`},
+	}
+	for _, tt := range tests {
+		if got := icalEscape(tt.in); got != tt.want {
+			t.Errorf("icalEscape(%q) = %q, ingin %q", tt.in, got, tt.want)
+		}
+		// Import membaca balik persis teks aslinya (CRLF menjadi LF)
+		if got, want := icalUnescape(icalEscape(tt.in)), strings.ReplaceAll(tt.in, "\r\n", "\n"); got != want {
+			t.Errorf("icalUnescape(icalEscape(%q)) = %q, ingin %q", tt.in, got, want)
+		}
+	}
+}
+
+func TestICalWriterLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		prop  string
+		value string
+		want  []string // Baris fisik (tanpa CRLF); nil = hanya cek batas panjang
+	}{
+		{
+			name:  "pendek",
+			prop:  "SUMMARY",
+			value: "Meetup",
+			want:  []string{"SUMMARY:Meetup"},
+		},
+		{
+			name:  "tepat 75 oktet tidak dilipat",
+			prop:  "SUMMARY",
+			value: strings.Repeat("a", 75-len("SUMMARY:")),
+			want:  []string{"SUMMARY:" + strings.Repeat("a", 75-len("SUMMARY:"))},
+		},
+		{
+			name:  "76 oktet dilipat",
+			prop:  "SUMMARY",
+			value: strings.Repeat("a", 76-len("SUMMARY:")),
+			want:  []string{"SUMMARY:" + strings.Repeat("a", 75-len("SUMMARY:")), " a"},
+		},
+		{
+			name:  "panjang dilipat beberapa kali",
+			prop:  "DESCRIPTION",
+			value: strings.Repeat("0123456789", 20),
+		},
+		{
+			name:  "karakter multi-byte tidak terpotong",
+			prop:  "SUMMARY",
+			value: strings.Repeat("a", 66) + strings.Repeat("é", 10) + "🎉 selesai",
+		},
+		{
+			name:  "hanya multi-byte",
+			prop:  "LOCATION",
+			value: strings.Repeat("日本", 40),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w icalWriter
+			w.line(tt.prop, tt.value)
+			out := w.b.String()
+
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("output tidak diakhiri CRLF: %q", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			for i, l := range lines {
+				if len(l) > 75 {
+					t.Errorf("baris %d panjangnya %d oktet (maks. 75): %q", i, len(l), l)
+				}
+				if !utf8.ValidString(l) {
+					t.Errorf("baris %d memotong karakter UTF-8: %q", i, l)
+				}
+				if i > 0 && !strings.HasPrefix(l, " ") {
+					t.Errorf("baris lanjutan %d tidak diawali spasi: %q", i, l)
+				}
+			}
+			if tt.want != nil && strings.Join(lines, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("baris = %q, ingin %q", lines, tt.want)
+			}
+
+			// Unfold (dipakai import .ics) mengembalikan baris aslinya
+			if got := icalUnfold([]byte(out))[0]; got != tt.prop+":"+tt.value {
+				t.Errorf("icalUnfold = %q, ingin %q", got, tt.prop+":"+tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,385 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/checkinintent"
+	"backend/ent/event"
+	"backend/ent/kioskdevice"
+	"backend/ent/user"
+	"backend/swagdto"
+	"backend/transactions"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// Berapa lama worker menunggu sebelum mengecek antrian lagi
+	checkInQueueInterval = 10 * time.Second
+	// Jumlah intent yang diambil per putaran worker
+	checkInQueueBatchSize = 20
+	// Setelah gagal sebanyak ini, intent ditandai 'failed' (scan ulang di kiosk mengantrikannya lagi)
+	checkInQueueMaxAttempts = 5
+	// Jeda sebelum percobaan ulang: 30 detik, lalu berlipat dua (maks. 'checkInQueueMaxBackoff')
+	checkInQueueBaseBackoff = 30 * time.Second
+	checkInQueueMaxBackoff  = 15 * time.Minute
+	// Intent 'processing' yang tidak berubah selama ini dianggap ditinggal worker yang mati
+	// (jauh di atas waktu tunggu seal transaksi check-in)
+	checkInIntentStaleAfter = 10 * time.Minute
+	// Toleransi jam kiosk yang lebih cepat dari server
+	checkInQueueClockSkew = 5 * time.Minute
+)
+
+// @Summary     Antrikan Check-in dari Kiosk (Offline)
+// @Description Menerima satu atau banyak 'check-in intent' yang ditandatangani kiosk (HMAC-SHA256 per device).
+// @Description Kiosk didaftarkan host/staff lewat POST /events/{id}/kiosks dan hanya berlaku untuk event tersebut.
+// @Description Intent disimpan di database dan diproses oleh worker di background, sehingga kiosk tetap bisa
+// @Description men-scan walaupun chain sedang tidak bisa diakses. Intent ganda (event + user sama) diabaikan,
+// @Description kecuali intent sebelumnya 'failed': scan ulang mengantrikannya lagi (status 'requeued').
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Param       body body     QueueCheckInRequest true "Daftar intent dari kiosk"
+// @Success     202 {object} APIResponse{data=[]swagdto.QueuedCheckInResult} "Intent diterima"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /event/check-in/queue [post]
+func (h *Handler) queueCheckIns(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := new(QueueCheckInRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if len(req.Intents) == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "intents tidak boleh kosong"})
+	}
+
+	verifier := h.newKioskVerifier()
+	results := make([]*swagdto.QueuedCheckInResult, 0, len(req.Intents))
+	for _, in := range req.Intents {
+		result := &swagdto.QueuedCheckInResult{EventID: in.EventID, UserAddress: in.UserAddress}
+		results = append(results, result)
+
+		// 1. Validasi & verifikasi kiosk (terikat ke event, signature, izin pendaftar)
+		eventID, err := strconv.ParseUint(in.EventID, 10, 64)
+		if err != nil || in.UserAddress == "" || in.DeviceID == "" {
+			result.Status = "invalid"
+			result.Error = "eventID, userAddress, dan deviceID adalah field wajib"
+			continue
+		}
+		userAddress, ok := parseFlowAddress(in.UserAddress)
+		if !ok {
+			result.Status = "invalid"
+			result.Error = "userAddress bukan alamat Flow yang valid"
+			continue
+		}
+		result.UserAddress = userAddress
+		if in.DeviceID == joinLinkDeviceID {
+			// ID ini dipakai intent dari join link (lihat joinLink.go)
+			result.Status = "invalid"
+			result.Error = fmt.Sprintf("device '%s' tidak terdaftar", in.DeviceID)
+			continue
+		}
+		if err := verifier.verify(ctx, in, eventID, userAddress); err != nil {
+			if !errors.Is(err, errKioskRejected) {
+				return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+			}
+			result.Status = "invalid"
+			result.Error = err.Error()
+			continue
+		}
+		if cancelled, err := h.eventCancelled(ctx, eventID); err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		} else if cancelled {
+			result.Status = "invalid"
+			result.Error = errEventCancelled.Error()
+			continue
+		}
+		scannedAt := time.Unix(in.ScannedAt, 0)
+		if scannedAt.After(time.Now().Add(checkInQueueClockSkew)) {
+			result.Status = "invalid"
+			result.Error = "scannedAt berada di masa depan"
+			continue
+		}
+
+		// 2. Simpan. Unique index (event_id, user_address) menangani duplikat.
+		_, err = h.DB.CheckInIntent.Create().
+			SetEventID(eventID).
+			SetUserAddress(userAddress).
+			SetScannedAt(scannedAt).
+			SetDeviceID(in.DeviceID).
+			Save(ctx)
+		if err != nil {
+			if !ent.IsConstraintError(err) {
+				return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+			}
+			// 3. Sudah ada: intent yang 'failed' diantrikan ulang (misal: user baru register setelah scan pertama)
+			requeued, err := h.DB.CheckInIntent.Update().
+				Where(
+					checkinintent.EventIDEQ(eventID),
+					checkinintent.UserAddressEQ(userAddress),
+					checkinintent.StatusEQ(checkinintent.StatusFailed),
+				).
+				SetStatus(checkinintent.StatusPending).
+				SetAttempts(0).
+				ClearLastError().
+				SetNextAttemptAt(time.Now()).
+				SetScannedAt(scannedAt).
+				SetDeviceID(in.DeviceID).
+				Save(ctx)
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+			}
+			if requeued > 0 {
+				result.Status = "requeued"
+			} else {
+				result.Status = "duplicate"
+			}
+			continue
+		}
+		result.Status = "queued"
+	}
+
+	return c.JSON(http.StatusAccepted, APIResponse{Data: results})
+}
+
+// @Summary     Progres Antrian Check-in
+// @Description Menampilkan jumlah intent per status (pending/processing/done/failed) untuk satu event,
+// @Description beserta daftar intent yang gagal.
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       eventID query    int  true  "Event ID (On-Chain ID)"
+// @Success     200 {object} APIResponse{data=swagdto.CheckInQueueProgress} "Progres antrian"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     403 {object} APIResponse "Tidak punya izin check-in"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /event/check-in/queue [get]
+func (h *Handler) getCheckInQueue(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	eventID, err := strconv.ParseUint(c.QueryParam("eventID"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "eventID harus berupa angka (UInt64)"})
+	}
+	if ok, err := h.authorizeEvent(c, eventID, permCheckIn); !ok {
+		return err
+	}
+
+	// 1. Hitung per status
+	var counts []struct {
+		Status checkinintent.Status `json:"status"`
+		Count  int                  `json:"count"`
+	}
+	err = h.DB.CheckInIntent.Query().
+		Where(checkinintent.EventIDEQ(eventID)).
+		GroupBy(checkinintent.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	progress := &swagdto.CheckInQueueProgress{EventID: eventID}
+	for _, row := range counts {
+		switch row.Status {
+		case checkinintent.StatusPending:
+			progress.Pending = row.Count
+		case checkinintent.StatusProcessing:
+			progress.Processing = row.Count
+		case checkinintent.StatusDone:
+			progress.Done = row.Count
+		case checkinintent.StatusFailed:
+			progress.Failed = row.Count
+		}
+	}
+
+	// 2. Daftar yang gagal (agar staff bisa menindaklanjuti)
+	failed, err := h.DB.CheckInIntent.Query().
+		Where(
+			checkinintent.EventIDEQ(eventID),
+			checkinintent.StatusEQ(checkinintent.StatusFailed),
+		).
+		Order(ent.Desc(checkinintent.FieldScannedAt)).
+		Limit(100).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	for _, f := range failed {
+		progress.Failures = append(progress.Failures, &swagdto.CheckInQueueFailure{
+			UserAddress: f.UserAddress,
+			DeviceID:    f.DeviceID,
+			Attempts:    f.Attempts,
+			LastError:   f.LastError,
+			ScannedAt:   f.ScannedAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: progress})
+}
+
+// runCheckInQueueWorker menguras antrian 'CheckInIntent' menjadi check-in on-chain.
+// Dijalankan sebagai goroutine dari main().
+func (h *Handler) runCheckInQueueWorker(ctx context.Context) {
+	ticker := time.NewTicker(checkInQueueInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.drainCheckInQueue(ctx)
+		}
+	}
+}
+
+func (h *Handler) drainCheckInQueue(ctx context.Context) {
+	now := time.Now()
+
+	// Intent 'processing' yang tertinggal (misal: instance mati di tengah transaksi) dikembalikan ke 'pending'.
+	// Hanya yang sudah lama tidak berubah, karena instance lain mungkin masih memprosesnya.
+	n, err := h.DB.CheckInIntent.Update().
+		Where(
+			checkinintent.StatusEQ(checkinintent.StatusProcessing),
+			checkinintent.UpdatedAtLT(now.Add(-checkInIntentStaleAfter)),
+		).
+		SetStatus(checkinintent.StatusPending).
+		Save(ctx)
+	if err != nil {
+		log.Printf("Gagal reset antrian check-in: %v", err)
+	} else if n > 0 {
+		log.Printf("%d intent check-in dikembalikan ke antrian", n)
+	}
+
+	intents, err := h.DB.CheckInIntent.Query().
+		Where(
+			checkinintent.StatusEQ(checkinintent.StatusPending),
+			checkinintent.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(checkinintent.FieldScannedAt)).
+		Limit(checkInQueueBatchSize).
+		All(ctx)
+	if err != nil {
+		log.Printf("Gagal mengambil antrian check-in: %v", err)
+		return
+	}
+
+	for _, in := range intents {
+		// 'Klaim' intent ini. Jika 0 baris ter-update, instance lain sudah mengambilnya.
+		claimed, err := h.DB.CheckInIntent.Update().
+			Where(
+				checkinintent.IDEQ(in.ID),
+				checkinintent.StatusEQ(checkinintent.StatusPending),
+			).
+			SetStatus(checkinintent.StatusProcessing).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil || claimed == 0 {
+			continue
+		}
+
+		h.processCheckInIntent(ctx, in)
+	}
+}
+
+func (h *Handler) processCheckInIntent(ctx context.Context, in *ent.CheckInIntent) {
+	// 0. Event yang dibatalkan tidak boleh di-check-in (gagal permanen)
+	cancelled, err := h.eventCancelled(ctx, in.EventID)
+	if err != nil {
+		h.retryCheckInIntent(ctx, in, err)
+		return
+	}
+	if cancelled {
+		h.finishCheckInIntent(ctx, in, checkinintent.StatusFailed, errEventCancelled.Error())
+		return
+	}
+
+	// 1. Cek status di tabel Attendance (hasil indexer)
+	att, err := h.DB.Attendance.Query().
+		Where(
+			attendance.HasEventWith(event.EventIDEQ(in.EventID)),
+			attendance.HasUserWith(user.AddressEQ(in.UserAddress)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Registrasi bisa saja belum ter-indeks; coba lagi dengan backoff
+			h.retryCheckInIntent(ctx, in, errors.New("user belum register ke event ini"))
+			return
+		}
+		h.retryCheckInIntent(ctx, in, err)
+		return
+	}
+	if att.CheckedIn {
+		h.finishCheckInIntent(ctx, in, checkinintent.StatusDone, "")
+		return
+	}
+
+	// 2. Jalankan transaksi check-in
+	if err := transactions.UserCheckin(in.EventID, in.UserAddress); err != nil {
+		log.Printf("Gagal check-in dari antrian (event %d, user %s): %v", in.EventID, in.UserAddress, err)
+		h.retryCheckInIntent(ctx, in, err)
+		return
+	}
+	if in.DeviceID == joinLinkDeviceID {
+		h.recordCheckInVerification(ctx, in.EventID, []string{in.UserAddress}, attendance.CheckInMethodJoinLink, "")
+	} else {
+		// Diverifikasi oleh host/staff yang mendaftarkan kiosk
+		verifiedBy := ""
+		if d, err := h.DB.KioskDevice.Query().Where(kioskdevice.DeviceIDEQ(in.DeviceID)).Only(ctx); err == nil {
+			verifiedBy = d.ProvisionedBy
+		}
+		h.recordCheckInVerification(ctx, in.EventID, []string{in.UserAddress}, attendance.CheckInMethodKiosk, verifiedBy)
+	}
+
+	h.finishCheckInIntent(ctx, in, checkinintent.StatusDone, "")
+}
+
+// retryCheckInIntent mengembalikan intent ke antrian dengan backoff, atau menandainya 'failed'
+// jika sudah mencapai batas percobaan.
+func (h *Handler) retryCheckInIntent(ctx context.Context, in *ent.CheckInIntent, cause error) {
+	// 'in.Attempts' adalah nilai sebelum di-klaim (klaim menambah 1)
+	if in.Attempts+1 >= checkInQueueMaxAttempts {
+		h.finishCheckInIntent(ctx, in, checkinintent.StatusFailed, cause.Error())
+		return
+	}
+
+	err := h.DB.CheckInIntent.UpdateOneID(in.ID).
+		SetStatus(checkinintent.StatusPending).
+		SetLastError(cause.Error()).
+		SetNextAttemptAt(time.Now().Add(checkInQueueBackoff(in.Attempts + 1))).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Gagal mengembalikan intent %d ke antrian: %v", in.ID, err)
+	}
+}
+
+// checkInQueueBackoff mengembalikan jeda setelah percobaan ke-'attempts' (mulai dari 1).
+func checkInQueueBackoff(attempts int) time.Duration {
+	backoff := checkInQueueBaseBackoff
+	for i := 1; i < attempts && backoff < checkInQueueMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, checkInQueueMaxBackoff)
+}
+
+func (h *Handler) finishCheckInIntent(ctx context.Context, in *ent.CheckInIntent, status checkinintent.Status, lastError string) {
+	err := h.DB.CheckInIntent.UpdateOneID(in.ID).
+		SetStatus(status).
+		SetLastError(lastError).
+		SetProcessedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Gagal menyimpan status intent %d: %v", in.ID, err)
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/checkintokenuse"
+	"backend/ent/event"
+	"backend/ent/user"
+	"backend/swagdto"
+	"backend/transactions"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// checkInTokenTTL adalah masa berlaku token QR. Sengaja pendek
+// agar screenshot QR tidak bisa dibagikan ke orang lain.
+const checkInTokenTTL = 2 * time.Minute
+
+// checkInTokenClaims adalah isi token QR check-in.
+type checkInTokenClaims struct {
+	EventID     uint64
+	UserAddress string
+	Nonce       string
+	ExpiresAt   time.Time
+}
+
+func checkInTokenSecret() ([]byte, error) {
+	secret := os.Getenv("CHECKIN_TOKEN_SECRET")
+	if secret == "" {
+		return nil, fmt.Errorf("CHECKIN_TOKEN_SECRET tidak ditemukan di environment variables")
+	}
+	return []byte(secret), nil
+}
+
+// signCheckInToken membuat token dengan format:
+// base64url("eventID|address|nonce|expiry") + "." + base64url(HMAC-SHA256)
+func signCheckInToken(claims checkInTokenClaims) (string, error) {
+	secret, err := checkInTokenSecret()
+	if err != nil {
+		return "", err
+	}
+
+	payload := fmt.Sprintf("%d|%s|%s|%d", claims.EventID, claims.UserAddress, claims.Nonce, claims.ExpiresAt.Unix())
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// parseCheckInToken memverifikasi signature dan masa berlaku token.
+// Pengecekan replay dilakukan terpisah (lihat 'CheckInTokenUse').
+func parseCheckInToken(token string) (*checkInTokenClaims, error) {
+	secret, err := checkInTokenSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encodedPayload, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, fmt.Errorf("format token tidak valid")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, fmt.Errorf("format token tidak valid")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil {
+		return nil, fmt.Errorf("format token tidak valid")
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, fmt.Errorf("signature token tidak valid")
+	}
+
+	parts := strings.Split(string(payload), "|")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("format token tidak valid")
+	}
+	eventID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("format token tidak valid")
+	}
+	expiry, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("format token tidak valid")
+	}
+
+	claims := &checkInTokenClaims{
+		EventID:     eventID,
+		UserAddress: parts[1],
+		Nonce:       parts[2],
+		ExpiresAt:   time.Unix(expiry, 0),
+	}
+	if time.Now().After(claims.ExpiresAt) {
+		return nil, fmt.Errorf("token sudah kedaluwarsa")
+	}
+	return claims, nil
+}
+
+func newNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// @Summary     Ambil Token QR Check-in
+// @Description Registrant mengambil token check-in bertanda tangan yang berlaku singkat (2 menit).
+// @Description Default-nya mengembalikan gambar QR (PNG). Gunakan 'format=json' untuk mendapatkan token mentah.
+// @Tags        Events
+// @Produce     png,json
+// @Security    BearerAuth
+// @Param       id      path     int     true  "Event ID (On-Chain ID)"
+// @Param       format  query    string  false "'png' (default) atau 'json'"
+// @Success     200 {object} APIResponse{data=swagdto.CheckInTokenResponse} "Token (format=json) atau gambar QR PNG"
+// @Failure     400 {object} APIResponse "Input tidak valid / sudah check-in"
+// @Failure     404 {object} APIResponse "User belum register ke event ini"
+// @Failure     409 {object} APIResponse "Event sudah dibatalkan"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /events/{id}/check-in-token [get]
+func (h *Handler) getCheckInToken(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	eventID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+	}
+	address := sessionAddress(c)
+	if ok, err := h.rejectCancelledEvent(c, eventID); !ok {
+		return err
+	}
+
+	// 1. Hanya registrant yang belum check-in yang boleh mendapat token
+	att, err := h.DB.Attendance.Query().
+		Where(
+			attendance.HasEventWith(event.EventIDEQ(eventID)),
+			attendance.HasUserWith(user.AddressEQ(address)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "User belum register ke event ini"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if att.CheckedIn {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "User sudah check-in"})
+	}
+
+	// 2. Buat token
+	nonce, err := newNonce()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	expiresAt := time.Now().Add(checkInTokenTTL)
+	token, err := signCheckInToken(checkInTokenClaims{
+		EventID:     eventID,
+		UserAddress: address,
+		Nonce:       nonce,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	if c.QueryParam("format") == "json" {
+		return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.CheckInTokenResponse{
+			Token:     token,
+			ExpiresAt: expiresAt,
+		}})
+	}
+
+	// 3. Render sebagai QR (PNG)
+	png, err := qrcode.Encode(token, qrcode.Medium, 512)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: "gagal membuat QR: " + err.Error()})
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.Blob(http.StatusOK, "image/png", png)
+}
+
+// @Summary     Check-in dengan Token QR (Staff)
+// @Description Staff men-scan QR registrant. Token diverifikasi (signature + masa berlaku),
+// @Description ditolak jika sudah pernah dipakai, lalu transaksi check-in dijalankan.
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       body body     TokenCheckInRequest true "Token hasil scan QR"
+// @Success     200 {object} APIResponse{data=swagdto.CheckInDataResponse} "User berhasil check-in"
+// @Failure     400 {object} APIResponse "Token tidak valid / kedaluwarsa"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Failure     403 {object} APIResponse "Bukan host/staff event ini"
+// @Failure     409 {object} APIResponse "Token sudah pernah dipakai / event sudah dibatalkan"
+// @Failure     500 {object} APIResponse "Internal Server Error (misal: tx gagal)"
+// @Router      /event/check-in/token [post]
+func (h *Handler) checkInWithToken(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := new(TokenCheckInRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.Token == "" {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "token wajib diisi"})
+	}
+
+	// 1. Verifikasi token
+	claims, err := parseCheckInToken(req.Token)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+	if ok, err := h.authorizeEvent(c, claims.EventID, permCheckIn); !ok {
+		return err
+	}
+	if ok, err := h.rejectCancelledEvent(c, claims.EventID); !ok {
+		return err
+	}
+
+	// 2. Tandai nonce sebagai terpakai SEBELUM transaksi dikirim.
+	// Unique index pada 'nonce' menolak replay (termasuk scan ganda yang bersamaan).
+	_, err = h.DB.CheckInTokenUse.Create().
+		SetNonce(claims.Nonce).
+		SetEventID(claims.EventID).
+		SetUserAddress(claims.UserAddress).
+		SetExpiresAt(claims.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return c.JSON(http.StatusConflict, APIResponse{Error: "Token sudah pernah dipakai"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// Bersihkan catatan nonce yang sudah kedaluwarsa (tidak kritis)
+	if _, err := h.DB.CheckInTokenUse.Delete().
+		Where(checkintokenuse.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		log.Printf("Gagal membersihkan token check-in lama: %v", err)
+	}
+
+	// 3. Jalankan transaksi check-in
+	if err := transactions.UserCheckin(claims.EventID, claims.UserAddress); err != nil {
+		log.Printf("Gagal menjalankan transaksi check-in (token): %v", err)
+		// Transaksi gagal: token boleh dicoba lagi selama belum kedaluwarsa
+		if _, delErr := h.DB.CheckInTokenUse.Delete().
+			Where(checkintokenuse.NonceEQ(claims.Nonce)).
+			Exec(ctx); delErr != nil {
+			log.Printf("Gagal melepas nonce %s: %v", claims.Nonce, delErr)
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	h.recordCheckInVerification(ctx, claims.EventID, []string{claims.UserAddress}, attendance.CheckInMethodStaff, sessionAddress(c))
+
+	eventID := strconv.FormatUint(claims.EventID, 10)
+	return c.JSON(http.StatusOK, APIResponse{
+		Data: map[string]string{
+			"message":     "User checked in successfully!",
+			"userAddress": claims.UserAddress,
+			"eventID":     eventID,
+		},
+	})
+}
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/claim"
+	"backend/ent/claimquota"
+	"backend/ent/event"
+	"backend/ent/mintcredit"
+	"backend/ent/nftmoment"
+	"backend/ent/user"
+	"backend/swagdto"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	claimKindFreeMint = "free_mint"
+	claimScopeGlobal  = "global"
+
+	// Reservasi yang tidak di-commit/release dalam waktu ini dianggap basi
+	// (misal: server mati di tengah transaksi) dan direkonsiliasi (lihat 'reconcileStaleClaims').
+	claimReservationTTL = 15 * time.Minute
+	// Reservasi basi yang transaksinya mungkin sudah terkirim, tetapi moment-nya belum juga
+	// ter-indeks setelah waktu ini, dianggap gagal dan slot-nya boleh dipakai lagi.
+	claimReconcileHorizon = 24 * time.Hour
+)
+
+var (
+	errClaimQuotaExhausted = errors.New("kuota sudah habis")
+	errClaimPoolExhausted  = errors.New("kuota total sudah habis")
+	errClaimNoQuota        = errors.New("tidak ada kuota untuk cakupan ini")
+	errClaimNotActive      = errors.New("kuota belum/tidak lagi berlaku")
+)
+
+func eventClaimScope(eventID uint64) string {
+	return fmt.Sprintf("event:%d", eventID)
+}
+
+func campaignClaimScope(name string) string {
+	return "campaign:" + name
+}
+
+// defaultFreeMintPerUser adalah kuota free mint global per user jika belum ada
+// konfigurasi di tabel ClaimQuota (env FREE_MINT_PER_USER, default 1).
+func defaultFreeMintPerUser() int {
+	if v, err := strconv.Atoi(os.Getenv("FREE_MINT_PER_USER")); err == nil && v >= 0 {
+		return v
+	}
+	return 1
+}
+
+// claimQuotaFor mengambil konfigurasi kuota. Untuk free mint global, ada default dari env.
+func (h *Handler) claimQuotaFor(ctx context.Context, kind, scope string) (*ent.ClaimQuota, error) {
+	q, err := h.DB.ClaimQuota.Query().
+		Where(claimquota.KindEQ(kind), claimquota.ScopeEQ(scope)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		if kind == claimKindFreeMint && scope == claimScopeGlobal {
+			return &ent.ClaimQuota{Kind: kind, Scope: scope, PerSubjectLimit: defaultFreeMintPerUser()}, nil
+		}
+		return nil, errClaimNoQuota
+	}
+	return q, err
+}
+
+// reserveClaim memesan satu unit kuota secara atomik, SEBELUM transaksi dikirim.
+// Panggil 'commitClaim' setelah transaksi sukses, atau 'releaseClaim' jika gagal.
+func (h *Handler) reserveClaim(ctx context.Context, kind, scope, subject string) (*ent.Claim, error) {
+	now := time.Now()
+
+	quota, err := h.claimQuotaFor(ctx, kind, scope)
+	if err != nil {
+		return nil, err
+	}
+	if (quota.StartsAt != nil && now.Before(*quota.StartsAt)) || (quota.EndsAt != nil && now.After(*quota.EndsAt)) {
+		return nil, errClaimNotActive
+	}
+
+	// 1. Selesaikan reservasi basi di cakupan ini (commit jika mint-nya ternyata sukses)
+	if err := h.reconcileStaleClaims(ctx, kind, scope); err != nil {
+		return nil, err
+	}
+
+	// 2. Tempati slot per-user pertama yang kosong. Unique index menolak
+	// request paralel yang mencoba slot yang sama.
+	var c *ent.Claim
+	for slot := 0; slot < quota.PerSubjectLimit && c == nil; slot++ {
+		c, err = h.DB.Claim.Create().
+			SetKind(kind).
+			SetScope(scope).
+			SetSubject(subject).
+			SetSlot(slot).
+			SetExpiresAt(now.Add(claimReservationTTL)).
+			Save(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return nil, err
+		}
+	}
+	if c == nil {
+		return nil, errClaimQuotaExhausted
+	}
+
+	// 3. Jika ada batas total, tempati juga slot pool. Mulai dari jumlah klaim
+	// yang ada (perkiraan slot kosong pertama) agar tidak mencoba dari 0.
+	if quota.TotalLimit > 0 {
+		start, err := h.DB.Claim.Query().
+			Where(claim.KindEQ(kind), claim.ScopeEQ(scope), claim.PoolSlotNotNil()).
+			Count(ctx)
+		if err != nil {
+			h.releaseClaim(ctx, c)
+			return nil, err
+		}
+		placed := false
+		for i := 0; i < quota.TotalLimit && !placed; i++ {
+			poolSlot := (start + i) % quota.TotalLimit
+			err = c.Update().SetPoolSlot(poolSlot).Exec(ctx)
+			if err != nil && !ent.IsConstraintError(err) {
+				h.releaseClaim(ctx, c)
+				return nil, err
+			}
+			placed = err == nil
+		}
+		if !placed {
+			h.releaseClaim(ctx, c)
+			return nil, errClaimPoolExhausted
+		}
+	}
+
+	return c, nil
+}
+
+// commitClaim menandai klaim sebagai terpakai permanen (transaksi sudah seal).
+func (h *Handler) commitClaim(ctx context.Context, c *ent.Claim, reference string) error {
+	return c.Update().
+		SetStatus(claim.StatusCommitted).
+		SetReference(reference).
+		SetCommittedAt(time.Now()).
+		Exec(ctx)
+}
+
+// setClaimReference mencatat referensi hasil (thumbnail moment) pada reservasi SEBELUM transaksi dikirim,
+// agar reservasi yang basi bisa dicocokkan dengan moment yang ter-indeks (lihat 'reconcileStaleClaims').
+func (h *Handler) setClaimReference(ctx context.Context, c *ent.Claim, reference string) error {
+	return c.Update().SetReference(reference).Exec(ctx)
+}
+
+// reconcileStaleClaims menyelesaikan reservasi basi di sebuah cakupan:
+//   - tanpa 'reference' (transaksi belum dikirim): dihapus, slot boleh dipakai lagi;
+//   - dengan 'reference': di-commit jika moment dengan thumbnail tsb sudah ter-indeks untuk user tsb,
+//     dihapus jika belum juga ter-indeks setelah 'claimReconcileHorizon', selain itu dibiarkan (tetap memakai slot).
+func (h *Handler) reconcileStaleClaims(ctx context.Context, kind, scope string) error {
+	now := time.Now()
+	stale, err := h.DB.Claim.Query().
+		Where(
+			claim.KindEQ(kind),
+			claim.ScopeEQ(scope),
+			claim.StatusEQ(claim.StatusReserved),
+			claim.ExpiresAtLT(now),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range stale {
+		if c.Reference != "" {
+			minted, err := h.DB.NFTMoment.Query().
+				Where(
+					nftmoment.ThumbnailEQ(c.Reference),
+					nftmoment.HasOwnerWith(user.AddressEQ(c.Subject)),
+				).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if minted {
+				if err := h.commitClaim(ctx, c, c.Reference); err != nil {
+					return err
+				}
+				continue
+			}
+			if now.Before(c.CreatedAt.Add(claimReconcileHorizon)) {
+				continue
+			}
+		}
+		if err := h.DB.Claim.DeleteOne(c).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseClaim mengembalikan kuota (transaksi gagal). Error hanya di-log;
+// jika gagal, reservasi akan direkonsiliasi setelah 'claimReservationTTL'.
+func (h *Handler) releaseClaim(ctx context.Context, c *ent.Claim) {
+	if err := h.DB.Claim.DeleteOne(c).Exec(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("Gagal release klaim %d (%s/%s/%s): %v", c.ID, c.Kind, c.Scope, c.Subject, err)
+	}
+}
+
+// remainingClaims menghitung sisa kuota user di sebuah cakupan (reservasi yang belum selesai ikut dihitung).
+func (h *Handler) remainingClaims(ctx context.Context, kind, scope, subject string) (int, *ent.ClaimQuota, error) {
+	quota, err := h.claimQuotaFor(ctx, kind, scope)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := h.reconcileStaleClaims(ctx, kind, scope); err != nil {
+		return 0, nil, err
+	}
+	used, err := h.DB.Claim.Query().
+		Where(
+			claim.KindEQ(kind),
+			claim.ScopeEQ(scope),
+			claim.SubjectEQ(subject),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return max(quota.PerSubjectLimit-used, 0), quota, nil
+}
+
+// syncLegacyFreeMintFlag menyamakan 'User.is_free_minted' dengan sisa kuota free mint global
+// ditambah mint credit yang masih tersedia.
+func (h *Handler) syncLegacyFreeMintFlag(ctx context.Context, address string) {
+	remaining, _, err := h.remainingClaims(ctx, claimKindFreeMint, claimScopeGlobal, address)
+	if err != nil {
+		log.Printf("Gagal menghitung sisa free mint %s: %v", address, err)
+		return
+	}
+	credits, err := h.DB.MintCredit.Query().
+		Where(mintcredit.AddressEQ(address), mintcredit.StatusEQ(mintcredit.StatusAvailable)).
+		Count(ctx)
+	if err != nil {
+		log.Printf("Gagal menghitung mint credit %s: %v", address, err)
+		return
+	}
+	if err := h.DB.User.Update().
+		Where(user.AddressEQ(address)).
+		SetIsFreeMinted(remaining+credits == 0).
+		Exec(ctx); err != nil {
+		log.Printf("Gagal update status free mint user %s: %v", address, err)
+	}
+}
+
+// backfillLegacyFreeMintClaims membuat klaim 'committed' untuk user yang sudah
+// free mint sebelum tabel Claim ada, agar kuotanya tidak ter-reset.
+func (h *Handler) backfillLegacyFreeMintClaims(ctx context.Context) error {
+	users, err := h.DB.User.Query().
+		Where(user.IsFreeMintedEQ(true)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	created := 0
+	for _, u := range users {
+		exists, err := h.DB.Claim.Query().
+			Where(
+				claim.KindEQ(claimKindFreeMint),
+				claim.ScopeEQ(claimScopeGlobal),
+				claim.SubjectEQ(u.Address),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		now := time.Now()
+		if err := h.DB.Claim.Create().
+			SetKind(claimKindFreeMint).
+			SetScope(claimScopeGlobal).
+			SetSubject(u.Address).
+			SetSlot(0).
+			SetStatus(claim.StatusCommitted).
+			SetReference("legacy:is_free_minted").
+			SetExpiresAt(now).
+			SetCommittedAt(now).
+			Exec(ctx); err != nil && !ent.IsConstraintError(err) {
+			return err
+		}
+		created++
+	}
+	if created > 0 {
+		log.Printf("Backfill klaim free mint legacy: %d user", created)
+	}
+	return nil
+}
+
+// freeMintClaimScope menentukan cakupan kuota free mint dari form ('eventID' / 'campaign').
+// Untuk cakupan event, user harus terdaftar di event tersebut.
+func (h *Handler) freeMintClaimScope(c echo.Context, address string) (string, error) {
+	if v := c.FormValue("eventID"); v != "" {
+		eventID, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("eventID harus berupa angka (UInt64)")
+		}
+		registered, err := h.DB.Attendance.Query().
+			Where(
+				attendance.HasEventWith(event.EventIDEQ(eventID)),
+				attendance.HasUserWith(user.AddressEQ(address)),
+			).
+			Exist(c.Request().Context())
+		if err != nil {
+			return "", err
+		}
+		if !registered {
+			return "", fmt.Errorf("user belum register ke event ini")
+		}
+		return eventClaimScope(eventID), nil
+	}
+	if v := strings.TrimSpace(c.FormValue("campaign")); v != "" {
+		return campaignClaimScope(v), nil
+	}
+	return claimScopeGlobal, nil
+}
+
+func validClaimScope(scope string) bool {
+	if scope == claimScopeGlobal {
+		return true
+	}
+	if v, ok := strings.CutPrefix(scope, "event:"); ok {
+		_, err := strconv.ParseUint(v, 10, 64)
+		return err == nil
+	}
+	v, ok := strings.CutPrefix(scope, "campaign:")
+	return ok && v != ""
+}
+
+func claimQuotaResponse(q *ent.ClaimQuota) *swagdto.ClaimQuotaResponse {
+	return &swagdto.ClaimQuotaResponse{
+		Kind:            q.Kind,
+		Scope:           q.Scope,
+		PerSubjectLimit: q.PerSubjectLimit,
+		TotalLimit:      q.TotalLimit,
+		StartsAt:        q.StartsAt,
+		EndsAt:          q.EndsAt,
+	}
+}
+
+// @Summary     Atur Kuota Klaim (Admin)
+// @Description Membuat atau mengubah kuota klaim untuk satu cakupan.
+// @Description Cakupan: "global", "event:<eventID>", atau "campaign:<nama>". Jenis klaim saat ini: "free_mint".
+// @Tags        Claims
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       body body     UpsertClaimQuotaRequest true "Konfigurasi kuota"
+// @Success     200 {object} APIResponse{data=swagdto.ClaimQuotaResponse} "Kuota tersimpan"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     403 {object} APIResponse "Bukan admin"
+// @Router      /admin/claim-quotas [put]
+func (h *Handler) upsertClaimQuota(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := new(UpsertClaimQuotaRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.Kind != claimKindFreeMint {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "kind tidak dikenal: " + req.Kind})
+	}
+	if !validClaimScope(req.Scope) {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "scope harus 'global', 'event:<eventID>', atau 'campaign:<nama>'"})
+	}
+	if req.PerSubjectLimit < 0 || req.TotalLimit < 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "perSubjectLimit dan totalLimit tidak boleh negatif"})
+	}
+	if req.StartsAt != nil && req.EndsAt != nil && req.EndsAt.Before(*req.StartsAt) {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "endsAt harus setelah startsAt"})
+	}
+
+	q, err := h.DB.ClaimQuota.Query().
+		Where(claimquota.KindEQ(req.Kind), claimquota.ScopeEQ(req.Scope)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		q, err = h.DB.ClaimQuota.Create().
+			SetKind(req.Kind).
+			SetScope(req.Scope).
+			SetPerSubjectLimit(req.PerSubjectLimit).
+			SetTotalLimit(req.TotalLimit).
+			SetNillableStartsAt(req.StartsAt).
+			SetNillableEndsAt(req.EndsAt).
+			Save(ctx)
+	case err == nil:
+		upd := q.Update().
+			SetPerSubjectLimit(req.PerSubjectLimit).
+			SetTotalLimit(req.TotalLimit)
+		if req.StartsAt != nil {
+			upd.SetStartsAt(*req.StartsAt)
+		} else {
+			upd.ClearStartsAt()
+		}
+		if req.EndsAt != nil {
+			upd.SetEndsAt(*req.EndsAt)
+		} else {
+			upd.ClearEndsAt()
+		}
+		q, err = upd.Save(ctx)
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: claimQuotaResponse(q)})
+}
+
+// @Summary     Daftar Kuota Klaim (Admin)
+// @Description Menampilkan semua konfigurasi kuota klaim.
+// @Tags        Claims
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse{data=[]swagdto.ClaimQuotaResponse} "Daftar kuota"
+// @Failure     403 {object} APIResponse "Bukan admin"
+// @Router      /admin/claim-quotas [get]
+func (h *Handler) getClaimQuotas(c echo.Context) error {
+	quotas, err := h.DB.ClaimQuota.Query().
+		Order(ent.Asc(claimquota.FieldKind), ent.Asc(claimquota.FieldScope)).
+		All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	responses := make([]*swagdto.ClaimQuotaResponse, 0, len(quotas))
+	for _, q := range quotas {
+		responses = append(responses, claimQuotaResponse(q))
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses})
+}
+
+// @Summary     Sisa Kuota Klaim Saya
+// @Description Menampilkan sisa kuota user yang login untuk satu jenis klaim & cakupan.
+// @Tags        Claims
+// @Produce     json
+// @Security    BearerAuth
+// @Param       kind  query    string  false "Jenis klaim (default: free_mint)"
+// @Param       scope query    string  false "Cakupan (default: global)"
+// @Success     200 {object} APIResponse{data=swagdto.ClaimRemainingResponse} "Sisa kuota"
+// @Failure     404 {object} APIResponse "Tidak ada kuota untuk cakupan ini"
+// @Router      /claims/me [get]
+func (h *Handler) getMyClaims(c echo.Context) error {
+	kind := c.QueryParam("kind")
+	if kind == "" {
+		kind = claimKindFreeMint
+	}
+	scope := c.QueryParam("scope")
+	if scope == "" {
+		scope = claimScopeGlobal
+	}
+
+	remaining, quota, err := h.remainingClaims(c.Request().Context(), kind, scope, sessionAddress(c))
+	if err != nil {
+		if errors.Is(err, errClaimNoQuota) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.ClaimRemainingResponse{
+		Kind:      kind,
+		Scope:     scope,
+		Limit:     quota.PerSubjectLimit,
+		Remaining: remaining,
+	}})
+}
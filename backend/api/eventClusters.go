@@ -0,0 +1,241 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/event"
+	"backend/swagdto"
+	"backend/utils"
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Clustering event untuk peta (/events/clusters).
+//
+// Dunia dibagi menjadi tile per zoom: 2^zoom x 2^zoom tile dalam derajat (lng 360/2^zoom, lat 180/2^zoom),
+// dan setiap tile dibagi lagi menjadi grid 'clusterGridSize' x 'clusterGridSize' sel. Event di sel yang sama
+// digabung menjadi satu cluster. Hasil per tile di-cache, dan seluruh cache dibuang saat data event berubah
+// (terdeteksi lewat penghitung versi 'events' yang dinaikkan indexer dan handler perubahan event).
+
+const (
+	clusterGridSize   = 8  // Sel per sisi tile
+	clusterMaxZoom    = 18 // Zoom maksimum yang didukung
+	clusterMaxTiles   = 64 // Batas tile per request (bbox terlalu luas untuk zoom tsb. ditolak)
+	clusterSampleSize = 5  // Jumlah contoh event ID per cluster
+	clusterCacheLimit = 10000
+)
+
+type clusterTileKey struct {
+	Zoom, X, Y int
+}
+
+// eventClusterCache menyimpan cluster per tile untuk satu versi data event.
+type eventClusterCache struct {
+	mu      sync.Mutex
+	version string
+	tiles   map[clusterTileKey][]*swagdto.EventClusterResponse
+}
+
+var clusterCache = &eventClusterCache{tiles: map[clusterTileKey][]*swagdto.EventClusterResponse{}}
+
+// get mengembalikan cluster tile dari cache. Jika versi berbeda, seluruh cache dibuang lebih dulu.
+func (cc *eventClusterCache) get(version string, key clusterTileKey) ([]*swagdto.EventClusterResponse, bool) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.version != version {
+		cc.version = version
+		cc.tiles = map[clusterTileKey][]*swagdto.EventClusterResponse{}
+	}
+	clusters, ok := cc.tiles[key]
+	return clusters, ok
+}
+
+func (cc *eventClusterCache) put(version string, key clusterTileKey, clusters []*swagdto.EventClusterResponse) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.version != version {
+		return // Data berubah saat tile dihitung; jangan simpan hasil lama
+	}
+	if len(cc.tiles) >= clusterCacheLimit {
+		cc.tiles = map[clusterTileKey][]*swagdto.EventClusterResponse{}
+	}
+	cc.tiles[key] = clusters
+}
+
+// eventsVersion adalah penanda versi data event, dibaca dari penghitung 'events' (lihat 'utils.BumpDataVersion').
+// Penghitung dinaikkan indexer saat event dibuat / kuota berubah, dan oleh handler saat host mengubah/membatalkan event.
+func (h *Handler) eventsVersion(ctx context.Context) (string, error) {
+	v, err := utils.DataVersionOf(ctx, h.DB, utils.EventsDataVersion)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(v, 10), nil
+}
+
+// tileBounds mengembalikan batas tile (minLng, minLat, lebar, tinggi) dalam derajat.
+func tileBounds(key clusterTileKey) (float64, float64, float64, float64) {
+	n := float64(int(1) << key.Zoom)
+	w, hgt := 360/n, 180/n
+	return -180 + float64(key.X)*w, -90 + float64(key.Y)*hgt, w, hgt
+}
+
+// clusterTile menghitung cluster untuk satu tile dari database.
+func (h *Handler) clusterTile(ctx context.Context, key clusterTileKey) ([]*swagdto.EventClusterResponse, error) {
+	minLng, minLat, w, hgt := tileBounds(key)
+
+	// 1. Ambil event offline yang tidak dibatalkan di dalam tile (memakai index lat/long)
+	events, err := h.DB.Event.Query().
+		Where(
+			event.EventTypeNEQ(0),
+			event.CancelledAtIsNil(),
+			event.LatGTE(minLat), event.LatLT(minLat+hgt),
+			event.LongGTE(minLng), event.LongLT(minLng+w),
+		).
+		Order(ent.Desc(event.FieldStartDate)).
+		Select(event.FieldEventID, event.FieldLat, event.FieldLong).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Kelompokkan per sel grid
+	cellW, cellH := w/clusterGridSize, hgt/clusterGridSize
+	cells := map[[2]int]*swagdto.EventClusterResponse{}
+	var order [][2]int
+	for _, ev := range events {
+		cell := [2]int{
+			min(int((ev.Long-minLng)/cellW), clusterGridSize-1),
+			min(int((ev.Lat-minLat)/cellH), clusterGridSize-1),
+		}
+		cl, ok := cells[cell]
+		if !ok {
+			cl = &swagdto.EventClusterResponse{
+				MinLat: ev.Lat, MaxLat: ev.Lat, MinLong: ev.Long, MaxLong: ev.Long,
+				EventIDs: []uint64{},
+			}
+			cells[cell] = cl
+			order = append(order, cell)
+		}
+		cl.Count++
+		// Simpan jumlah dulu, dibagi menjadi rata-rata (centroid) di bawah
+		cl.Lat += ev.Lat
+		cl.Long += ev.Long
+		cl.MinLat, cl.MaxLat = min(cl.MinLat, ev.Lat), max(cl.MaxLat, ev.Lat)
+		cl.MinLong, cl.MaxLong = min(cl.MinLong, ev.Long), max(cl.MaxLong, ev.Long)
+		if len(cl.EventIDs) < clusterSampleSize {
+			cl.EventIDs = append(cl.EventIDs, ev.EventID)
+		}
+	}
+
+	clusters := make([]*swagdto.EventClusterResponse, 0, len(order))
+	for _, cell := range order {
+		cl := cells[cell]
+		cl.Lat /= float64(cl.Count)
+		cl.Long /= float64(cl.Count)
+		clusters = append(clusters, cl)
+	}
+	return clusters, nil
+}
+
+// clusterTilesFor mengembalikan tile yang menutupi bbox pada zoom tertentu.
+func clusterTilesFor(g *geoQuery, zoom int) []clusterTileKey {
+	n := int(1) << zoom
+	w, hgt := 360/float64(n), 180/float64(n)
+	index := func(v, size float64) int {
+		return min(max(int(math.Floor(v/size)), 0), n-1)
+	}
+
+	y0, y1 := index(g.minLat+90, hgt), index(g.maxLat+90, hgt)
+	x0, x1 := index(g.minLng+180, w), index(g.maxLng+180, w)
+	var xs []int
+	if g.minLng <= g.maxLng {
+		for x := x0; x <= x1; x++ {
+			xs = append(xs, x)
+		}
+	} else {
+		// Melewati antimeridian
+		for x := x0; x < n; x++ {
+			xs = append(xs, x)
+		}
+		for x := 0; x <= x1; x++ {
+			xs = append(xs, x)
+		}
+	}
+
+	var keys []clusterTileKey
+	for y := y0; y <= y1; y++ {
+		for _, x := range xs {
+			keys = append(keys, clusterTileKey{Zoom: zoom, X: x, Y: y})
+		}
+	}
+	return keys
+}
+
+// @Summary     Cluster Event untuk Peta
+// @Description Mengelompokkan event offline di dalam bbox menjadi cluster (jumlah, centroid, batas, dan contoh event ID).
+// @Description Cluster dihitung dengan grid per tile zoom dan di-cache; cache otomatis diperbarui saat ada event baru.
+// @Tags        Events
+// @Produce     json
+// @Param       bbox  query    string  true  "Kotak peta 'minLng,minLat,maxLng,maxLat'"
+// @Param       zoom  query    int     true  "Level zoom peta (0-18)"
+// @Success     200 {object} APIResponse{data=swagdto.EventClustersResponse} "Daftar cluster"
+// @Failure     400 {object} APIResponse "Parameter tidak valid / bbox terlalu luas"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /events/clusters [get]
+func (h *Handler) getEventClusters(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 1. Validasi parameter
+	zoom, err := strconv.Atoi(c.QueryParam("zoom"))
+	if err != nil || zoom < 0 || zoom > clusterMaxZoom {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: fmt.Sprintf("zoom harus angka 0-%d", clusterMaxZoom)})
+	}
+	if c.QueryParam("bbox") == "" || c.QueryParam("near") != "" {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "bbox wajib diisi"})
+	}
+	g, err := parseGeoQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+	tiles := clusterTilesFor(g, zoom)
+	if len(tiles) > clusterMaxTiles {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "bbox terlalu luas untuk zoom ini"})
+	}
+
+	// 2. Versi data event (untuk invalidasi cache)
+	version, err := h.eventsVersion(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 3. Ambil cluster per tile (dari cache, atau hitung)
+	clusters := []*swagdto.EventClusterResponse{}
+	total := 0
+	for _, key := range tiles {
+		tileClusters, ok := clusterCache.get(version, key)
+		if !ok {
+			tileClusters, err = h.clusterTile(ctx, key)
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+			}
+			clusterCache.put(version, key, tileClusters)
+		}
+		for _, cl := range tileClusters {
+			clusters = append(clusters, cl)
+			total += cl.Count
+		}
+	}
+	sort.SliceStable(clusters, func(i, j int) bool { return clusters[i].Count > clusters[j].Count })
+
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.EventClustersResponse{
+		Zoom:        zoom,
+		TotalEvents: total,
+		Clusters:    clusters,
+	}})
+}
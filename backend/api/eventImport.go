@@ -0,0 +1,976 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/event"
+	"backend/ent/eventimportrow"
+	"backend/ent/user"
+	"backend/swagdto"
+	"backend/transactions"
+	"backend/utils"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Import event massal dari file iCalendar (.ics) atau CSV (POST /events/import).
+//
+// Alur: file di-parse menjadi baris, setiap baris divalidasi sesuai parameter transaksi
+// 'create_event.cdc' (name, location, thumbnailURL wajib; eventType 0/1; quota > 0; endDate > startDate),
+// alamat event offline tanpa koordinat di-geocode, lalu:
+//   - dryRun=true (default): hanya preview hasil validasi per baris
+//   - dryRun=false: baris yang valid disimpan ke antrian (EventImportRow) dan request langsung selesai;
+//     worker import mengirim transaksi 'createEvent' satu per satu (seperti scheduler event series).
+//     Status per baris dicek lewat GET /events/import/:id.
+//
+// Baris yang sama dengan event milik host yang sudah ada, atau dengan baris import host yang
+// masih antri/sedang dibuat (nama + waktu mulai), dilewati, sehingga import ulang file yang sama
+// tidak membuat event ganda.
+//
+// Kolom CSV (baris pertama = header, huruf besar/kecil & '_' diabaikan):
+//
+//	name, description, event_type (0/1/online/offline), location, lat, long,
+//	start_date, end_date, quota, thumbnail_url, event_pass_img_url
+
+const (
+	maxImportFileSize = 1 << 20 // 1 MB
+	maxImportRows     = 100
+
+	importFormatICS = "ics"
+	importFormatCSV = "csv"
+
+	importStatusValid   = "valid"
+	importStatusInvalid = "invalid"
+	importStatusSkipped = "skipped"
+	importStatusQueued  = "queued"
+
+	importWorkerInterval = 15 * time.Second
+	// Percobaan maksimal untuk error sebelum transaksi terkirim (setelah terkirim tidak dicoba lagi)
+	importMaxAttempts = 3
+	// Baris 'creating' lebih lama dari ini dianggap terputus (misal: server restart saat transaksi)
+	importCreatingTimeout = 15 * time.Minute
+)
+
+// importedEvent adalah satu baris hasil parsing (sebelum divalidasi).
+type importedEvent struct {
+	row          int
+	name         string
+	description  string
+	location     string
+	url          string // ICS: properti URL (link meeting untuk event online)
+	thumbnailURL string
+	eventPassImg string
+	eventType    *uint8 // nil = ditentukan dari lokasi
+	lat, long    *float64
+	start, end   time.Time
+	quota        uint64
+	geocoded     bool
+	skipReason   string
+	errs         []string
+}
+
+func (ev *importedEvent) fail(format string, args ...any) {
+	ev.errs = append(ev.errs, fmt.Sprintf(format, args...))
+}
+
+// importOptions adalah opsi dari form request.
+type importOptions struct {
+	loc          *time.Location // Zona waktu untuk tanggal tanpa offset
+	defaultQuota uint64         // Dipakai jika baris tidak punya quota (ICS tidak punya kapasitas)
+	hasThumbnail bool           // File 'thumbnail' di-upload sebagai default
+}
+
+// ---------- CSV ----------
+
+// csvImportColumns memetakan nama kolom (sudah dinormalisasi) ke nama kanonik.
+var csvImportColumns = map[string]string{
+	"name":            "name",
+	"description":     "description",
+	"eventtype":       "event_type",
+	"type":            "event_type",
+	"location":        "location",
+	"address":         "location",
+	"lat":             "lat",
+	"latitude":        "lat",
+	"long":            "long",
+	"lng":             "long",
+	"longitude":       "long",
+	"startdate":       "start_date",
+	"start":           "start_date",
+	"enddate":         "end_date",
+	"end":             "end_date",
+	"quota":           "quota",
+	"capacity":        "quota",
+	"thumbnailurl":    "thumbnail_url",
+	"thumbnail":       "thumbnail_url",
+	"eventpassimgurl": "event_pass_img_url",
+	"eventpassimg":    "event_pass_img_url",
+}
+
+// csvDateLayouts adalah format tanggal CSV tanpa offset (dibaca di zona waktu 'timezone').
+var csvDateLayouts = []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+func parseImportTime(raw string, loc *time.Location) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	for _, layout := range csvDateLayouts {
+		if t, err := time.ParseInLocation(layout, raw, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("format tanggal tidak dikenal (gunakan RFC3339 atau 'YYYY-MM-DD HH:MM')")
+}
+
+func parseImportCSV(data []byte, opts importOptions) ([]*importedEvent, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))) // Buang BOM (export Excel)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca header CSV: %w", err)
+	}
+	cols := map[string]int{}
+	for i, name := range header {
+		key := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+		if canonical, ok := csvImportColumns[key]; ok {
+			cols[canonical] = i
+		}
+	}
+	for _, required := range []string{"name", "location", "start_date", "end_date"} {
+		if _, ok := cols[required]; !ok {
+			return nil, fmt.Errorf("kolom '%s' wajib ada di header CSV", required)
+		}
+	}
+
+	var rows []*importedEvent
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSV tidak valid: %w", err)
+		}
+		get := func(col string) string {
+			if i, ok := cols[col]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue // Baris kosong
+		}
+
+		ev := &importedEvent{
+			row:          line,
+			name:         get("name"),
+			description:  get("description"),
+			location:     get("location"),
+			thumbnailURL: get("thumbnail_url"),
+			eventPassImg: get("event_pass_img_url"),
+			quota:        opts.defaultQuota,
+		}
+
+		switch v := strings.ToLower(get("event_type")); v {
+		case "":
+		case "0", "online":
+			ev.eventType = new(uint8)
+		case "1", "offline":
+			t := uint8(1)
+			ev.eventType = &t
+		default:
+			ev.fail("event_type harus 0 (online) atau 1 (offline)")
+		}
+
+		if v := get("lat"); v != "" {
+			if f, err := strconv.ParseFloat(v, 64); err != nil {
+				ev.fail("lat bukan angka")
+			} else {
+				ev.lat = &f
+			}
+		}
+		if v := get("long"); v != "" {
+			if f, err := strconv.ParseFloat(v, 64); err != nil {
+				ev.fail("long bukan angka")
+			} else {
+				ev.long = &f
+			}
+		}
+
+		if ev.start, err = parseImportTime(get("start_date"), opts.loc); err != nil {
+			ev.fail("start_date: %v", err)
+		}
+		if ev.end, err = parseImportTime(get("end_date"), opts.loc); err != nil {
+			ev.fail("end_date: %v", err)
+		}
+
+		if v := get("quota"); v != "" {
+			if q, err := strconv.ParseUint(v, 10, 64); err != nil {
+				ev.fail("quota harus berupa angka")
+			} else {
+				ev.quota = q
+			}
+		}
+
+		rows = append(rows, ev)
+		if len(rows) > maxImportRows {
+			return nil, fmt.Errorf("maksimal %d event per import", maxImportRows)
+		}
+	}
+	return rows, nil
+}
+
+// ---------- iCalendar ----------
+
+// icalProperty adalah satu baris content iCalendar: NAME;PARAM=VALUE:value
+type icalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// icalUnfold menggabungkan baris yang dilipat (diawali spasi/tab) dan memecah per baris.
+func icalUnfold(data []byte) []string {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\n ", "")
+	text = strings.ReplaceAll(text, "\n\t", "")
+	return strings.Split(text, "\n")
+}
+
+func parseICalLine(line string) (icalProperty, bool) {
+	// Cari ':' pertama di luar tanda kutip (nilai parameter boleh berisi ':')
+	inQuote, sep := false, -1
+	for i, r := range line {
+		if r == '"' {
+			inQuote = !inQuote
+		} else if r == ':' && !inQuote {
+			sep = i
+			break
+		}
+	}
+	if sep < 0 {
+		return icalProperty{}, false
+	}
+
+	parts := strings.Split(line[:sep], ";")
+	p := icalProperty{name: strings.ToUpper(parts[0]), params: map[string]string{}, value: line[sep+1:]}
+	for _, param := range parts[1:] {
+		if k, v, ok := strings.Cut(param, "="); ok {
+			p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return p, true
+}
+
+// icalUnescape membalik escape teks RFC 5545.
+func icalUnescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// parseICalTime membaca DTSTART/DTEND (UTC 'Z', waktu lokal dengan TZID, atau VALUE=DATE).
+func parseICalTime(p icalProperty, loc *time.Location) (t time.Time, allDay bool, err error) {
+	if tzid := p.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	v := strings.TrimSpace(p.value)
+	switch {
+	case p.params["VALUE"] == "DATE" || len(v) == 8:
+		t, err = time.ParseInLocation("20060102", v, loc)
+		return t, true, err
+	case strings.HasSuffix(v, "Z"):
+		t, err = time.Parse(icalTimeLayout, v)
+	default:
+		t, err = time.ParseInLocation("20060102T150405", v, loc)
+	}
+	return t, false, err
+}
+
+var icalDurationRe = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseICalDuration membaca DURATION sederhana (misal: PT2H30M, P1D).
+func parseICalDuration(v string) (time.Duration, error) {
+	m := icalDurationRe.FindStringSubmatch(strings.TrimPrefix(strings.TrimSpace(v), "+"))
+	if m == nil || v == "P" {
+		return 0, errors.New("DURATION tidak valid")
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+1] != "" {
+			n, _ := strconv.Atoi(m[i+1])
+			d += time.Duration(n) * unit
+		}
+	}
+	return d, nil
+}
+
+func isHTTPURL(s string) bool {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+func parseImportICS(data []byte, opts importOptions) ([]*importedEvent, error) {
+	var (
+		rows      []*importedEvent
+		props     []icalProperty
+		inEvent   bool
+		depth     int // Komponen bersarang di dalam VEVENT (misal: VALARM)
+		sawCalObj bool
+	)
+
+	for _, line := range icalUnfold(data) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		p, ok := parseICalLine(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VCALENDAR"):
+			sawCalObj = true
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT") && !inEvent:
+			inEvent, props = true, nil
+		case p.name == "BEGIN" && inEvent:
+			depth++
+		case p.name == "END" && inEvent && depth > 0:
+			depth--
+		case p.name == "END" && strings.EqualFold(p.value, "VEVENT") && inEvent:
+			inEvent = false
+			rows = append(rows, icalToImportedEvent(len(rows)+1, props, opts))
+			if len(rows) > maxImportRows {
+				return nil, fmt.Errorf("maksimal %d event per import", maxImportRows)
+			}
+		case inEvent && depth == 0:
+			props = append(props, p)
+		}
+	}
+
+	if !sawCalObj {
+		return nil, errors.New("file bukan iCalendar (BEGIN:VCALENDAR tidak ditemukan)")
+	}
+	return rows, nil
+}
+
+// icalToImportedEvent mengubah properti satu VEVENT menjadi baris import.
+func icalToImportedEvent(row int, props []icalProperty, opts importOptions) *importedEvent {
+	ev := &importedEvent{row: row, quota: opts.defaultQuota}
+	var dtstart, dtend, duration *icalProperty
+	for i := range props {
+		p := &props[i]
+		switch p.name {
+		case "SUMMARY":
+			ev.name = strings.TrimSpace(icalUnescape(p.value))
+		case "DESCRIPTION":
+			ev.description = strings.TrimSpace(icalUnescape(p.value))
+		case "LOCATION":
+			ev.location = strings.TrimSpace(icalUnescape(p.value))
+		case "URL":
+			ev.url = strings.TrimSpace(p.value)
+		case "GEO":
+			// Format: lat;long
+			la, lo, ok := strings.Cut(p.value, ";")
+			if !ok {
+				ev.fail("GEO tidak valid")
+				continue
+			}
+			lat, err1 := strconv.ParseFloat(strings.TrimSpace(la), 64)
+			long, err2 := strconv.ParseFloat(strings.TrimSpace(lo), 64)
+			if err1 != nil || err2 != nil {
+				ev.fail("GEO tidak valid")
+				continue
+			}
+			ev.lat, ev.long = &lat, &long
+		case "IMAGE":
+			// RFC 7986
+			if ev.thumbnailURL == "" && isHTTPURL(p.value) {
+				ev.thumbnailURL = strings.TrimSpace(p.value)
+			}
+		case "ATTACH":
+			if strings.HasPrefix(strings.ToLower(p.params["FMTTYPE"]), "image/") && ev.thumbnailURL == "" && isHTTPURL(p.value) {
+				ev.thumbnailURL = strings.TrimSpace(p.value)
+			}
+		case "DTSTART":
+			dtstart = p
+		case "DTEND":
+			dtend = p
+		case "DURATION":
+			duration = p
+		case "RRULE":
+			ev.fail("event berulang (RRULE) belum didukung, hanya event tunggal")
+		case "STATUS":
+			if strings.EqualFold(p.value, "CANCELLED") {
+				ev.skipReason = "event dibatalkan (STATUS:CANCELLED)"
+			}
+		case "X-CAPT-QUOTA":
+			if q, err := strconv.ParseUint(strings.TrimSpace(p.value), 10, 64); err == nil {
+				ev.quota = q
+			}
+		}
+	}
+
+	// Waktu mulai & selesai
+	if dtstart == nil {
+		ev.fail("DTSTART wajib ada")
+		return ev
+	}
+	start, allDay, err := parseICalTime(*dtstart, opts.loc)
+	if err != nil {
+		ev.fail("DTSTART tidak valid")
+		return ev
+	}
+	ev.start = start
+	switch {
+	case dtend != nil:
+		if ev.end, _, err = parseICalTime(*dtend, opts.loc); err != nil {
+			ev.fail("DTEND tidak valid")
+		}
+	case duration != nil:
+		d, err := parseICalDuration(duration.value)
+		if err != nil {
+			ev.fail("%v", err)
+		}
+		ev.end = start.Add(d)
+	case allDay:
+		// RFC 5545: event seharian tanpa DTEND berlangsung satu hari
+		ev.end = start.AddDate(0, 0, 1)
+	default:
+		ev.fail("DTEND atau DURATION wajib ada")
+	}
+	return ev
+}
+
+// ---------- Validasi ----------
+
+// validateImportedEvent melengkapi (tipe event, geocoding) dan memvalidasi satu baris
+// sesuai parameter transaksi 'create_event.cdc'.
+func validateImportedEvent(ctx context.Context, geocoder utils.Geocoder, ev *importedEvent, opts importOptions) {
+	if ev.name == "" {
+		ev.fail("name wajib diisi")
+	}
+
+	// 1. Tipe event: jika tidak disebut, event dianggap online bila lokasinya berupa link
+	//    (atau hanya punya URL) dan tidak punya koordinat.
+	if ev.eventType == nil {
+		t := uint8(1)
+		if ev.lat == nil && (isHTTPURL(ev.location) || (ev.location == "" && isHTTPURL(ev.url))) {
+			t = 0
+		}
+		ev.eventType = &t
+	}
+
+	if *ev.eventType == 0 {
+		// 2a. Event online: lokasi = link meeting (dipakai oleh join link)
+		if !isHTTPURL(ev.location) && isHTTPURL(ev.url) {
+			ev.location = ev.url
+		}
+		if !isHTTPURL(ev.location) {
+			ev.fail("event online membutuhkan link meeting (http/https) sebagai location")
+		}
+		ev.lat, ev.long = nil, nil
+	} else {
+		// 2b. Event offline: butuh alamat & koordinat (geocode jika tidak ada)
+		if ev.location == "" {
+			ev.fail("location wajib diisi")
+		} else if ev.lat == nil || ev.long == nil {
+			lat, long, err := geocoder.Geocode(ctx, ev.location)
+			if err != nil {
+				ev.fail("gagal geocode alamat '%s': %v", ev.location, err)
+			} else {
+				ev.lat, ev.long, ev.geocoded = &lat, &long, true
+			}
+		}
+		if ev.lat != nil && (*ev.lat < -90 || *ev.lat > 90) {
+			ev.fail("lat tidak valid")
+		}
+		if ev.long != nil && (*ev.long < -180 || *ev.long > 180) {
+			ev.fail("long tidak valid")
+		}
+	}
+
+	// 3. Waktu (startDate/endDate on-chain berupa UFix64 detik Unix)
+	if !ev.start.IsZero() && !ev.end.IsZero() {
+		if ev.start.Unix() < 0 {
+			ev.fail("startDate tidak valid")
+		}
+		if !ev.end.After(ev.start) {
+			ev.fail("endDate harus setelah startDate")
+		}
+	}
+
+	// 4. Quota & thumbnail
+	if ev.quota == 0 {
+		ev.fail("quota harus lebih dari 0 (isi kolom quota atau defaultQuota)")
+	}
+	if ev.thumbnailURL == "" && !opts.hasThumbnail {
+		ev.fail("thumbnail wajib ada (kolom thumbnail_url atau file 'thumbnail')")
+	} else if ev.thumbnailURL != "" && !isHTTPURL(ev.thumbnailURL) {
+		ev.fail("thumbnail_url harus berupa URL http/https")
+	}
+	if ev.eventPassImg != "" && !isHTTPURL(ev.eventPassImg) {
+		ev.fail("event_pass_img_url harus berupa URL http/https")
+	}
+}
+
+func (ev *importedEvent) preview() *swagdto.EventImportPreview {
+	pv := &swagdto.EventImportPreview{
+		Name:         ev.name,
+		Description:  ev.description,
+		Location:     ev.location,
+		Lat:          ev.lat,
+		Long:         ev.long,
+		Geocoded:     ev.geocoded,
+		Quota:        ev.quota,
+		Thumbnail:    ev.thumbnailURL,
+		EventPassImg: ev.eventPassImg,
+	}
+	if ev.eventType != nil {
+		pv.EventType = *ev.eventType
+	}
+	if !ev.start.IsZero() {
+		pv.StartDate = ev.start.UTC().Format(time.RFC3339)
+	}
+	if !ev.end.IsZero() {
+		pv.EndDate = ev.end.UTC().Format(time.RFC3339)
+	}
+	return pv
+}
+
+// detectImportFormat menentukan format dari field 'format', ekstensi, atau isi file.
+func detectImportFormat(requested, filename string, data []byte) (string, error) {
+	switch strings.ToLower(requested) {
+	case importFormatICS, importFormatCSV:
+		return strings.ToLower(requested), nil
+	case "":
+	default:
+		return "", errors.New("format harus 'ics' atau 'csv'")
+	}
+
+	lower := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(lower, ".ics") || strings.HasSuffix(lower, ".ical"):
+		return importFormatICS, nil
+	case strings.HasSuffix(lower, ".csv"):
+		return importFormatCSV, nil
+	case bytes.HasPrefix(bytes.TrimSpace(data), []byte("BEGIN:VCALENDAR")):
+		return importFormatICS, nil
+	}
+	return importFormatCSV, nil
+}
+
+// @Summary     Import Event dari iCalendar / CSV
+// @Description Membuat banyak event sekaligus dari file .ics atau CSV (maks. 100 event, 1 MB).
+// @Description Setiap baris divalidasi sesuai parameter 'create_event.cdc'; alamat event offline tanpa lat/long di-geocode.
+// @Description Default 'dryRun=true': hanya preview per baris tanpa mengirim transaksi. Kirim 'dryRun=false' untuk membuat event:
+// @Description baris valid masuk antrian (status 'queued', respon 202 berisi 'importID') dan dibuat on-chain oleh worker di background.
+// @Description Status per baris dicek lewat GET /events/import/{id}.
+// @Description Event yang sudah ada atau masih di antrian import (nama + waktu mulai sama, host sama) dilewati.
+// @Description Kolom CSV: name, description, event_type, location, lat, long, start_date, end_date, quota, thumbnail_url, event_pass_img_url.
+// @Tags        Events
+// @Accept      multipart/form-data
+// @Produce     json
+// @Security    BearerAuth
+// @Param       file         formData file   true  "File .ics atau .csv"
+// @Param       format       formData string false "Format file: 'ics' atau 'csv' (default: dari ekstensi/isi file)"
+// @Param       dryRun       formData bool   false "true = preview saja (default), false = kirim transaksi"
+// @Param       timezone     formData string false "Zona waktu untuk tanggal tanpa offset, misal 'Asia/Jakarta' (default: UTC)"
+// @Param       defaultQuota formData int    false "Quota untuk baris tanpa quota (wajib untuk .ics)"
+// @Param       thumbnail    formData file   false "Thumbnail default untuk baris tanpa thumbnail_url"
+// @Param       Idempotency-Key header string false "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama"
+// @Success     200 {object} APIResponse{data=swagdto.EventImportResponse} "Hasil preview per baris (dry run)"
+// @Success     202 {object} APIResponse{data=swagdto.EventImportResponse} "Baris valid masuk antrian"
+// @Failure     400 {object} APIResponse "File / parameter tidak valid"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Failure     403 {object} APIResponse "Bukan host terdaftar (HOST_ADDRESSES) / host belum punya profil"
+// @Failure     422 {object} APIResponse "Idempotency-Key sudah dipakai untuk request berbeda"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /events/import [post]
+func (h *Handler) importEvents(c echo.Context) error {
+	ctx := c.Request().Context()
+	hostAddress := sessionAddress(c)
+
+	// 1. Opsi import
+	dryRun := true
+	if v := c.FormValue("dryRun"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "dryRun harus true atau false"})
+		}
+		dryRun = b
+	}
+	opts := importOptions{loc: time.UTC}
+	if v := c.FormValue("timezone"); v != "" {
+		loc, err := time.LoadLocation(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "timezone tidak dikenal"})
+		}
+		opts.loc = loc
+	}
+	if v := c.FormValue("defaultQuota"); v != "" {
+		q, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || q == 0 {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "defaultQuota harus berupa angka lebih dari 0"})
+		}
+		opts.defaultQuota = q
+	}
+	if _, err := c.FormFile("thumbnail"); err == nil {
+		opts.hasThumbnail = true
+	}
+
+	// 2. Baca file
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "file wajib ada"})
+	}
+	if fh.Size > maxImportFileSize {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "ukuran file maksimal 1 MB"})
+	}
+	src, err := fh.Open()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	data, err := io.ReadAll(io.LimitReader(src, maxImportFileSize+1))
+	src.Close()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 3. Parse
+	format, err := detectImportFormat(c.FormValue("format"), fh.Filename, data)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+	var rows []*importedEvent
+	if format == importFormatICS {
+		rows, err = parseImportICS(data, opts)
+	} else {
+		rows, err = parseImportCSV(data, opts)
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+	if len(rows) == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "file tidak berisi event"})
+	}
+
+	// 4. Host harus sudah punya profil (sama seperti POST /events)
+	host, err := h.DB.User.Query().Where(user.AddressEQ(hostAddress)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusForbidden, APIResponse{Error: "Host not found. Please setup profile first."})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 5. Validasi & tandai duplikat (event host yang sudah ada, baris import host yang masih
+	// di antrian / belum ter-indeks, atau baris ganda di file)
+	existing, err := host.QueryHostedEvents().Select(event.FieldName, event.FieldStartDate).All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	pendingRows, err := h.DB.EventImportRow.Query().
+		Where(
+			eventimportrow.HostAddressEQ(hostAddress),
+			eventimportrow.StatusIn(eventimportrow.StatusQueued, eventimportrow.StatusCreating, eventimportrow.StatusCreated),
+		).
+		Select(eventimportrow.FieldName, eventimportrow.FieldStartDate).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	dupKey := func(name string, start time.Time) string {
+		return strings.ToLower(strings.TrimSpace(name)) + "|" + strconv.FormatInt(start.Unix(), 10)
+	}
+	seen := map[string]bool{}
+	for _, ev := range existing {
+		seen[dupKey(ev.Name, ev.StartDate)] = true
+	}
+	for _, r := range pendingRows {
+		seen[dupKey(r.Name, r.StartDate)] = true
+	}
+
+	resp := &swagdto.EventImportResponse{Format: format, DryRun: dryRun, Total: len(rows)}
+	results := make([]*swagdto.EventImportRowResult, len(rows))
+	for i, ev := range rows {
+		validateImportedEvent(ctx, h.Geocoder, ev, opts)
+		res := &swagdto.EventImportRowResult{Row: ev.row, Event: ev.preview(), Errors: ev.errs}
+		switch {
+		case len(ev.errs) > 0:
+			res.Status = importStatusInvalid
+			resp.Invalid++
+		case ev.skipReason != "":
+			res.Status, res.Reason = importStatusSkipped, ev.skipReason
+			resp.Skipped++
+		case seen[dupKey(ev.name, ev.start)]:
+			res.Status, res.Reason = importStatusSkipped, "event dengan nama dan waktu mulai yang sama sudah ada atau sedang diimport"
+			resp.Skipped++
+		default:
+			seen[dupKey(ev.name, ev.start)] = true
+			res.Status = importStatusValid
+			resp.Valid++
+		}
+		results[i] = res
+	}
+	resp.Rows = results
+
+	if dryRun || resp.Valid == 0 {
+		return c.JSON(http.StatusOK, APIResponse{Data: resp})
+	}
+
+	// 6. Upload thumbnail default sekali saja (jika ada baris yang memakainya)
+	defaultThumbnail := ""
+	for i, ev := range rows {
+		if results[i].Status == importStatusValid && ev.thumbnailURL == "" {
+			if defaultThumbnail, err = h.handleFormFileUpload(c, "thumbnail"); err != nil {
+				return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+			}
+			break
+		}
+	}
+
+	// 7. Simpan baris valid ke antrian; worker yang mengirim transaksi
+	importID, err := newNonce()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	var builders []*ent.EventImportRowCreate
+	for i, ev := range rows {
+		res := results[i]
+		if res.Status != importStatusValid {
+			continue
+		}
+		if ev.thumbnailURL == "" {
+			ev.thumbnailURL = defaultThumbnail
+			res.Event.Thumbnail = defaultThumbnail
+		}
+		builders = append(builders, h.DB.EventImportRow.Create().
+			SetImportID(importID).
+			SetHostAddress(hostAddress).
+			SetFormat(format).
+			SetRow(ev.row).
+			SetName(ev.name).
+			SetDescription(ev.description).
+			SetThumbnailURL(ev.thumbnailURL).
+			SetEventPassImg(ev.eventPassImg).
+			SetEventType(*ev.eventType).
+			SetLocation(ev.location).
+			SetNillableLat(ev.lat).
+			SetNillableLong(ev.long).
+			SetGeocoded(ev.geocoded).
+			SetStartDate(ev.start).
+			SetEndDate(ev.end).
+			SetQuota(ev.quota))
+		res.Status = importStatusQueued
+	}
+	if err := h.DB.EventImportRow.CreateBulk(builders...).Exec(ctx); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	resp.ImportID = importID
+	resp.Queued = len(builders)
+
+	return c.JSON(http.StatusAccepted, APIResponse{Data: resp})
+}
+
+// ---------- Antrian ----------
+
+// runEventImportWorker membuat event on-chain dari baris import yang masih antri secara berkala.
+func (h *Handler) runEventImportWorker(ctx context.Context) {
+	ticker := time.NewTicker(importWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		h.processImportQueue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Handler) processImportQueue(ctx context.Context) {
+	// 1. Transaksi yang terputus tidak dikirim ulang otomatis (bisa jadi sudah masuk on-chain)
+	err := h.DB.EventImportRow.Update().
+		Where(
+			eventimportrow.StatusEQ(eventimportrow.StatusCreating),
+			eventimportrow.UpdatedAtLT(time.Now().Add(-importCreatingTimeout)),
+		).
+		SetStatus(eventimportrow.StatusFailed).
+		SetError("transaksi terputus; cek event host sebelum import ulang").
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Gagal menandai baris import yang terputus: %v", err)
+	}
+
+	// 2. Kirim transaksi berurutan (satu signer admin, sequence number harus berurutan)
+	queued, err := h.DB.EventImportRow.Query().
+		Where(eventimportrow.StatusEQ(eventimportrow.StatusQueued)).
+		Order(ent.Asc(eventimportrow.FieldCreatedAt), ent.Asc(eventimportrow.FieldRow)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Gagal mengambil antrian import: %v", err)
+		return
+	}
+
+	for _, r := range queued {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// 2a. Klaim baris (aman jika worker berjalan paralel)
+		n, err := h.DB.EventImportRow.Update().
+			Where(eventimportrow.IDEQ(r.ID), eventimportrow.StatusEQ(eventimportrow.StatusQueued)).
+			SetStatus(eventimportrow.StatusCreating).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			log.Printf("Gagal mengklaim baris import %d: %v", r.ID, err)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+
+		// 2b. Kirim transaksi
+		params := transactions.CreateEventParams{
+			HostAddress:  r.HostAddress,
+			Name:         r.Name,
+			Description:  r.Description,
+			ThumbnailURL: r.ThumbnailURL,
+			EventPassImg: r.EventPassImg,
+			EventType:    r.EventType,
+			Location:     r.Location,
+			StartDate:    r.StartDate,
+			EndDate:      r.EndDate,
+			Quota:        r.Quota,
+		}
+		if r.Lat != nil && r.Long != nil {
+			params.Lat, params.Long = *r.Lat, *r.Long
+		}
+		eventID, txErr := transactions.CreateEvent(params)
+
+		// 2c. Simpan hasil. Hanya error sebelum transaksi terkirim yang dicoba lagi;
+		// setelah terkirim event mungkin sudah dibuat on-chain, jadi baris ditandai 'failed'.
+		var sentErr *transactions.SentTxError
+		update := r.Update()
+		switch {
+		case txErr == nil:
+			update.SetStatus(eventimportrow.StatusCreated).SetEventID(eventID).SetError("")
+		case errors.As(txErr, &sentErr):
+			log.Printf("Import event baris %d (import %s, host %s) tidak dicoba lagi: %v", r.Row, r.ImportID, r.HostAddress, txErr)
+			update.SetStatus(eventimportrow.StatusFailed).SetError(txErr.Error() + " (cek event host sebelum import ulang)")
+		case r.Attempts+1 >= importMaxAttempts:
+			log.Printf("Gagal import event baris %d (import %s, host %s): %v", r.Row, r.ImportID, r.HostAddress, txErr)
+			update.SetStatus(eventimportrow.StatusFailed).SetError(txErr.Error())
+		default:
+			log.Printf("Gagal import event baris %d (import %s, dicoba lagi): %v", r.Row, r.ImportID, txErr)
+			update.SetStatus(eventimportrow.StatusQueued).SetError(txErr.Error())
+		}
+		if err := update.Exec(ctx); err != nil {
+			log.Printf("Gagal menyimpan status baris import %d (event_id %d): %v", r.ID, eventID, err)
+		}
+	}
+}
+
+// @Summary     Status Import Event
+// @Description Status per baris dari import yang sudah masuk antrian (queued, creating, created, failed).
+// @Description Baris yang invalid/dilewati hanya ada di respon POST /events/import.
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     string true "importID dari POST /events/import"
+// @Success     200 {object} APIResponse{data=swagdto.EventImportResponse} "Status per baris"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Failure     404 {object} APIResponse "Import tidak ditemukan"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /events/import/{id} [get]
+func (h *Handler) getEventImport(c echo.Context) error {
+	ctx := c.Request().Context()
+	address := sessionAddress(c)
+
+	query := h.DB.EventImportRow.Query().Where(eventimportrow.ImportIDEQ(c.Param("id")))
+	if !isPlatformAdmin(address) {
+		// Import milik host lain diperlakukan seperti tidak ada
+		query = query.Where(eventimportrow.HostAddressEQ(address))
+	}
+	rows, err := query.Order(ent.Asc(eventimportrow.FieldRow)).All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if len(rows) == 0 {
+		return c.JSON(http.StatusNotFound, APIResponse{Error: "Import tidak ditemukan"})
+	}
+
+	resp := &swagdto.EventImportResponse{
+		ImportID: rows[0].ImportID,
+		Format:   rows[0].Format,
+		Total:    len(rows),
+		Rows:     make([]*swagdto.EventImportRowResult, 0, len(rows)),
+	}
+	for _, r := range rows {
+		res := &swagdto.EventImportRowResult{
+			Row:     r.Row,
+			Status:  string(r.Status),
+			EventID: r.EventID,
+			Event: &swagdto.EventImportPreview{
+				Name:         r.Name,
+				Description:  r.Description,
+				EventType:    r.EventType,
+				Location:     r.Location,
+				Lat:          r.Lat,
+				Long:         r.Long,
+				Geocoded:     r.Geocoded,
+				StartDate:    r.StartDate.UTC().Format(time.RFC3339),
+				EndDate:      r.EndDate.UTC().Format(time.RFC3339),
+				Quota:        r.Quota,
+				Thumbnail:    r.ThumbnailURL,
+				EventPassImg: r.EventPassImg,
+			},
+		}
+		if r.Error != "" {
+			res.Errors = []string{r.Error}
+		}
+		switch r.Status {
+		case eventimportrow.StatusQueued, eventimportrow.StatusCreating:
+			resp.Queued++
+		case eventimportrow.StatusCreated:
+			resp.Created++
+		case eventimportrow.StatusFailed:
+			resp.Failed++
+		}
+		resp.Rows = append(resp.Rows, res)
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: resp})
+}
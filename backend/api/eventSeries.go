@@ -0,0 +1,802 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/event"
+	"backend/ent/eventseries"
+	"backend/ent/predicate"
+	"backend/ent/seriesoccurrence"
+	"backend/ent/user"
+	"backend/swagdto"
+	"backend/transactions"
+	"backend/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Event berulang (EventSeries).
+//
+// Host menyimpan template event + aturan pengulangan (subset RRULE, lihat 'utils.RRule').
+// Kontrak tidak mengenal series, jadi setiap occurrence tetap event on-chain biasa:
+//   - Scheduler menjadwalkan occurrence (SeriesOccurrence 'pending') yang jatuh dalam 'lead_days' ke depan,
+//   - lalu mengirim transaksi 'createEvent' satu per satu dan menyimpan 'event_id' hasilnya.
+//
+// Rekap kehadiran series ("hadir 8 dari 10 sesi") dihitung dari Attendance di event-event tsb.
+
+const (
+	seriesSchedulerInterval = time.Minute
+	// Percobaan maksimal untuk error sebelum transaksi terkirim (setelah terkirim tidak dicoba lagi)
+	seriesMaxAttempts = 3
+	// Occurrence 'creating' lebih lama dari ini dianggap terputus (misal: server restart saat transaksi)
+	seriesCreatingTimeout = 15 * time.Minute
+
+	defaultSeriesLeadDays = 28
+	maxSeriesLeadDays     = 90
+	seriesPreviewCount    = 5
+	// Jangkauan pencarian pratinjau jadwal berikutnya
+	seriesPreviewWindow = 2 * 365 * 24 * time.Hour
+
+	notificationSeriesOccurrenceFailed = "series_occurrence_failed"
+)
+
+// runSeriesScheduler menjadwalkan & membuat occurrence semua series aktif secara berkala.
+func (h *Handler) runSeriesScheduler(ctx context.Context) {
+	ticker := time.NewTicker(seriesSchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		h.processAllSeries(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Handler) processAllSeries(ctx context.Context) {
+	now := time.Now()
+
+	// 1. Transaksi yang terputus tidak dikirim ulang otomatis (bisa jadi sudah masuk on-chain)
+	err := h.DB.SeriesOccurrence.Update().
+		Where(
+			seriesoccurrence.StatusEQ(seriesoccurrence.StatusCreating),
+			seriesoccurrence.UpdatedAtLT(now.Add(-seriesCreatingTimeout)),
+		).
+		SetStatus(seriesoccurrence.StatusFailed).
+		SetError("transaksi terputus; cek event host sebelum membuat ulang").
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Gagal menandai occurrence series yang terputus: %v", err)
+	}
+
+	// 2. Jadwalkan & buat occurrence per series aktif
+	series, err := h.DB.EventSeries.Query().
+		Where(eventseries.StatusEQ(eventseries.StatusActive)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Gagal mengambil event series aktif: %v", err)
+		return
+	}
+	for _, s := range series {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := h.planSeriesOccurrences(ctx, s, now); err != nil {
+			log.Printf("Gagal menjadwalkan series %d: %v", s.ID, err)
+			continue
+		}
+		h.createSeriesOccurrences(ctx, s)
+	}
+}
+
+// seriesRule mengembalikan aturan & DTSTART series dalam zona waktunya.
+func seriesRule(s *ent.EventSeries) (*utils.RRule, time.Time, error) {
+	rule, err := utils.ParseRRule(s.Rrule)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return rule, s.StartDate.In(loc), nil
+}
+
+// nextSeriesOccurrences mengembalikan maksimal 'n' jadwal setelah 'now' menurut aturan.
+func nextSeriesOccurrences(rule *utils.RRule, dtstart, now time.Time, n int) []time.Time {
+	all, _ := rule.Occurrences(dtstart, now.Add(seriesPreviewWindow))
+	next := make([]time.Time, 0, n)
+	for _, t := range all {
+		if t.After(now) {
+			next = append(next, t.UTC())
+			if len(next) == n {
+				break
+			}
+		}
+	}
+	return next
+}
+
+// planSeriesOccurrences membuat SeriesOccurrence 'pending' untuk jadwal dalam 'lead_days' ke depan.
+// Jadwal yang sudah lewat tidak dibuat. Jika aturan sudah habis dan semua occurrence selesai
+// diproses, series ditandai 'completed'.
+func (h *Handler) planSeriesOccurrences(ctx context.Context, s *ent.EventSeries, now time.Time) error {
+	rule, dtstart, err := seriesRule(s)
+	if err != nil {
+		return err
+	}
+	starts, done := rule.Occurrences(dtstart, now.AddDate(0, 0, s.LeadDays))
+
+	existing, err := h.DB.SeriesOccurrence.Query().
+		Where(seriesoccurrence.SeriesIDEQ(s.ID)).
+		Select(seriesoccurrence.FieldStartDate).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	planned := make(map[int64]bool, len(existing))
+	for _, o := range existing {
+		planned[o.StartDate.Unix()] = true
+	}
+
+	duration := time.Duration(s.DurationSeconds) * time.Second
+	var builders []*ent.SeriesOccurrenceCreate
+	for _, t := range starts {
+		if !t.After(now) || planned[t.Unix()] {
+			continue
+		}
+		builders = append(builders, h.DB.SeriesOccurrence.Create().
+			SetSeriesID(s.ID).
+			SetStartDate(t).
+			SetEndDate(t.Add(duration)))
+	}
+	if len(builders) > 0 {
+		// Unique (series_id, start_date): jika scheduler lain sudah membuatnya, cukup lewati
+		if err := h.DB.SeriesOccurrence.CreateBulk(builders...).Exec(ctx); err != nil && !ent.IsConstraintError(err) {
+			return err
+		}
+	}
+
+	if !done {
+		return nil
+	}
+	open, err := h.DB.SeriesOccurrence.Query().
+		Where(
+			seriesoccurrence.SeriesIDEQ(s.ID),
+			seriesoccurrence.StatusIn(seriesoccurrence.StatusPending, seriesoccurrence.StatusCreating),
+		).
+		Exist(ctx)
+	if err != nil || open {
+		return err
+	}
+	return h.DB.EventSeries.Update().
+		Where(eventseries.IDEQ(s.ID), eventseries.StatusEQ(eventseries.StatusActive)).
+		SetStatus(eventseries.StatusCompleted).
+		Exec(ctx)
+}
+
+// createSeriesOccurrences mengirim transaksi 'createEvent' untuk occurrence 'pending' series 's'.
+// Transaksi dikirim berurutan (satu signer admin, sequence number harus berurutan).
+func (h *Handler) createSeriesOccurrences(ctx context.Context, s *ent.EventSeries) {
+	pending, err := h.DB.SeriesOccurrence.Query().
+		Where(seriesoccurrence.SeriesIDEQ(s.ID), seriesoccurrence.StatusEQ(seriesoccurrence.StatusPending)).
+		Order(ent.Asc(seriesoccurrence.FieldStartDate)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Gagal mengambil occurrence series %d: %v", s.ID, err)
+		return
+	}
+
+	for _, o := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// 1. Jadwal sudah lewat (misal: series sempat di-pause) -> tidak dibuat
+		if !o.StartDate.After(time.Now()) {
+			err := h.DB.SeriesOccurrence.Update().
+				Where(seriesoccurrence.IDEQ(o.ID), seriesoccurrence.StatusEQ(seriesoccurrence.StatusPending)).
+				SetStatus(seriesoccurrence.StatusSkipped).
+				SetError("jadwal sudah lewat sebelum event dibuat").
+				Exec(ctx)
+			if err != nil {
+				log.Printf("Gagal melewati occurrence %d: %v", o.ID, err)
+			}
+			continue
+		}
+
+		// 2. Klaim occurrence (aman jika scheduler berjalan paralel)
+		n, err := h.DB.SeriesOccurrence.Update().
+			Where(seriesoccurrence.IDEQ(o.ID), seriesoccurrence.StatusEQ(seriesoccurrence.StatusPending)).
+			SetStatus(seriesoccurrence.StatusCreating).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			log.Printf("Gagal mengklaim occurrence %d: %v", o.ID, err)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+
+		// 3. Kirim transaksi
+		eventID, txErr := transactions.CreateEvent(transactions.CreateEventParams{
+			HostAddress:  s.HostAddress,
+			Name:         s.Name,
+			Description:  s.Description,
+			ThumbnailURL: s.ThumbnailURL,
+			EventPassImg: s.EventPassImg,
+			EventType:    s.EventType,
+			Location:     s.Location,
+			Lat:          s.Lat,
+			Long:         s.Long,
+			StartDate:    o.StartDate,
+			EndDate:      o.EndDate,
+			Quota:        s.Quota,
+		})
+
+		// 4. Simpan hasil. Hanya error sebelum transaksi terkirim yang dicoba lagi;
+		// setelah terkirim event mungkin sudah dibuat on-chain, jadi occurrence ditandai 'failed'.
+		var sentErr *transactions.SentTxError
+		update := o.Update()
+		switch {
+		case txErr == nil:
+			update.SetStatus(seriesoccurrence.StatusCreated).SetEventID(eventID).SetError("")
+		case errors.As(txErr, &sentErr), o.Attempts+1 >= seriesMaxAttempts:
+			log.Printf("Gagal membuat occurrence %d series %d: %v", o.ID, s.ID, txErr)
+			reason := txErr.Error()
+			if sentErr != nil {
+				reason += " (cek event host sebelum membuat ulang)"
+			}
+			update.SetStatus(seriesoccurrence.StatusFailed).SetError(reason)
+			h.notify(ctx, s.HostAddress, notificationSeriesOccurrenceFailed,
+				"Event series gagal dibuat",
+				fmt.Sprintf("Sesi '%s' tanggal %s gagal dibuat on-chain.", s.Name, o.StartDate.Format("2006-01-02 15:04 MST")),
+				map[string]any{"series_id": s.ID, "occurrence_id": o.ID})
+		default:
+			log.Printf("Gagal membuat occurrence %d series %d (dicoba lagi): %v", o.ID, s.ID, txErr)
+			update.SetStatus(seriesoccurrence.StatusPending).SetError(txErr.Error())
+		}
+		if err := update.Exec(ctx); err != nil {
+			log.Printf("Gagal menyimpan status occurrence %d (event_id %d): %v", o.ID, eventID, err)
+		}
+	}
+}
+
+// seriesResponse mengubah series ke DTO (dengan pratinjau jadwal berikutnya jika masih aktif).
+func seriesResponse(s *ent.EventSeries, now time.Time) *swagdto.EventSeriesResponse {
+	resp := &swagdto.EventSeriesResponse{
+		ID:              s.ID,
+		HostAddress:     s.HostAddress,
+		Name:            s.Name,
+		Description:     s.Description,
+		Thumbnail:       s.ThumbnailURL,
+		EventPassImg:    s.EventPassImg,
+		EventType:       s.EventType,
+		Location:        s.Location,
+		Lat:             s.Lat,
+		Long:            s.Long,
+		Quota:           s.Quota,
+		RRule:           s.Rrule,
+		Timezone:        s.Timezone,
+		StartDate:       s.StartDate,
+		DurationMinutes: s.DurationSeconds / 60,
+		LeadDays:        s.LeadDays,
+		Status:          string(s.Status),
+		NextOccurrences: []time.Time{},
+		CreatedAt:       s.CreatedAt,
+	}
+	if s.Status == eventseries.StatusActive || s.Status == eventseries.StatusPaused {
+		if rule, dtstart, err := seriesRule(s); err == nil {
+			resp.NextOccurrences = nextSeriesOccurrences(rule, dtstart, now, seriesPreviewCount)
+		}
+	}
+	return resp
+}
+
+func seriesOccurrenceResponse(o *ent.SeriesOccurrence) *swagdto.SeriesOccurrenceResponse {
+	return &swagdto.SeriesOccurrenceResponse{
+		ID:        o.ID,
+		StartDate: o.StartDate,
+		EndDate:   o.EndDate,
+		Status:    string(o.Status),
+		EventID:   o.EventID,
+		Attempts:  o.Attempts,
+		Error:     o.Error,
+	}
+}
+
+// loadSeries mengambil series dari parameter ':id'. Jika gagal, respon error sudah ditulis.
+// 'hostOnly' = hanya host series atau admin platform yang boleh.
+func (h *Handler) loadSeries(c echo.Context, hostOnly bool) (*ent.EventSeries, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return nil, c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Series ID format"})
+	}
+	s, err := h.DB.EventSeries.Get(c.Request().Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, c.JSON(http.StatusNotFound, APIResponse{Error: "Series not found"})
+		}
+		return nil, c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if hostOnly {
+		address := sessionAddress(c)
+		if s.HostAddress != address && !isPlatformAdmin(address) {
+			return nil, c.JSON(http.StatusForbidden, APIResponse{Error: "Hanya host series ini"})
+		}
+	}
+	return s, nil
+}
+
+// @Summary     Buat Event Series (Event Berulang)
+// @Description Menyimpan template event + aturan pengulangan. Occurrence dibuat on-chain oleh scheduler
+// @Description 'leadDays' hari sebelum jadwalnya (event biasa, bisa di-update/dibatalkan per sesi).
+// @Description Aturan (subset RRULE): FREQ=DAILY|WEEKLY|MONTHLY, INTERVAL, BYDAY (WEEKLY), BYMONTHDAY (MONTHLY), COUNT atau UNTIL.
+// @Description Jam lokal di 'timezone' tetap sama di setiap sesi (termasuk saat pergantian DST).
+// @Tags        Events
+// @Accept      multipart/form-data
+// @Produce     json
+// @Security    BearerAuth
+// @Param       name         formData string true  "Nama event"
+// @Param       description  formData string false "Deskripsi event"
+// @Param       eventType    formData int    true  "Tipe event (0 = Online, 1 = Offline)"
+// @Param       location     formData string true  "URL (online) atau alamat (offline)"
+// @Param       lat          formData number false "Latitude (offline; default: geocode dari location)"
+// @Param       long         formData number false "Longitude"
+// @Param       startDate    formData string true  "Waktu mulai sesi pertama (RFC3339)"
+// @Param       endDate      formData string true  "Waktu selesai sesi pertama (RFC3339), menentukan durasi setiap sesi"
+// @Param       quota        formData int    true  "Kuota peserta per sesi"
+// @Param       rrule        formData string true  "Aturan pengulangan, misal: FREQ=WEEKLY;BYDAY=TU;COUNT=10"
+// @Param       timezone     formData string false "Zona waktu IANA (default: UTC)"
+// @Param       leadDays     formData int    false "Berapa hari sebelumnya sesi dibuat on-chain (1-90, default: 28)"
+// @Param       thumbnail    formData file   true  "Gambar thumbnail event"
+// @Param       eventPassImg formData file   false "Gambar Event Pass (SBT)"
+// @Success     201 {object} APIResponse{data=swagdto.EventSeriesResponse} "Series dibuat"
+// @Failure     400 {object} APIResponse "Input / aturan tidak valid"
+// @Failure     403 {object} APIResponse "Bukan host terdaftar (HOST_ADDRESSES) / host belum punya profil"
+// @Router      /series [post]
+func (h *Handler) createSeries(c echo.Context) error {
+	ctx := c.Request().Context()
+	hostAddress := sessionAddress(c)
+	now := time.Now()
+
+	// 1. Template event (validasi sama dengan POST /events)
+	name := c.FormValue("name")
+	location := c.FormValue("location")
+	if name == "" || location == "" {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "name dan location adalah field wajib"})
+	}
+	eventType, err := strconv.ParseUint(c.FormValue("eventType"), 10, 8)
+	if err != nil || eventType > 1 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "eventType harus 0 (online) atau 1 (offline)"})
+	}
+	quota, err := strconv.ParseUint(c.FormValue("quota"), 10, 64)
+	if err != nil || quota == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "quota harus berupa angka lebih dari 0"})
+	}
+
+	var lat, long float64
+	hasCoords := c.FormValue("lat") != "" && c.FormValue("long") != ""
+	if hasCoords {
+		if lat, err = strconv.ParseFloat(c.FormValue("lat"), 64); err != nil || lat < -90 || lat > 90 {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "lat tidak valid"})
+		}
+		if long, err = strconv.ParseFloat(c.FormValue("long"), 64); err != nil || long < -180 || long > 180 {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "long tidak valid"})
+		}
+	} else if eventType == 1 {
+		if lat, long, err = h.Geocoder.Geocode(ctx, location); err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: fmt.Sprintf("gagal geocode alamat '%s': %v (isi lat/long)", location, err)})
+		}
+	}
+
+	startDate, err := time.Parse(time.RFC3339, c.FormValue("startDate"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "startDate harus format RFC3339"})
+	}
+	endDate, err := time.Parse(time.RFC3339, c.FormValue("endDate"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "endDate harus format RFC3339"})
+	}
+	if !endDate.After(startDate) {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "endDate harus setelah startDate"})
+	}
+
+	// 2. Aturan pengulangan
+	timezone := c.FormValue("timezone")
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "timezone tidak dikenal"})
+	}
+	leadDays := defaultSeriesLeadDays
+	if v := c.FormValue("leadDays"); v != "" {
+		if leadDays, err = strconv.Atoi(v); err != nil || leadDays < 1 || leadDays > maxSeriesLeadDays {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: fmt.Sprintf("leadDays harus angka 1-%d", maxSeriesLeadDays)})
+		}
+	}
+	rruleStr := c.FormValue("rrule")
+	rule, err := utils.ParseRRule(rruleStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "rrule tidak valid: " + err.Error()})
+	}
+	if len(nextSeriesOccurrences(rule, startDate.In(loc), now, 1)) == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "rrule tidak menghasilkan jadwal di masa depan"})
+	}
+
+	// 3. Host harus sudah punya profil (indexer hanya menyimpan event milik user terdaftar)
+	exists, err := h.DB.User.Query().Where(user.AddressEQ(hostAddress)).Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if !exists {
+		return c.JSON(http.StatusForbidden, APIResponse{Error: "Host not found. Please setup profile first."})
+	}
+
+	// 4. Upload gambar (thumbnail wajib, eventPassImg opsional)
+	thumbnailUrl, err := h.handleFormFileUpload(c, "thumbnail")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	eventPassImgUrl := ""
+	if _, err := c.FormFile("eventPassImg"); err == nil {
+		if eventPassImgUrl, err = h.handleFormFileUpload(c, "eventPassImg"); err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+	}
+
+	// 5. Simpan series & jadwalkan occurrence pertama (transaksi dikirim oleh scheduler)
+	s, err := h.DB.EventSeries.Create().
+		SetHostAddress(hostAddress).
+		SetName(name).
+		SetDescription(c.FormValue("description")).
+		SetThumbnailURL(thumbnailUrl).
+		SetEventPassImg(eventPassImgUrl).
+		SetEventType(uint8(eventType)).
+		SetLocation(location).
+		SetLat(lat).
+		SetLong(long).
+		SetQuota(quota).
+		SetRrule(rruleStr).
+		SetTimezone(timezone).
+		SetStartDate(startDate).
+		SetDurationSeconds(int64(endDate.Sub(startDate) / time.Second)).
+		SetLeadDays(leadDays).
+		Save(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if err := h.planSeriesOccurrences(ctx, s, now); err != nil {
+		log.Printf("Gagal menjadwalkan series %d: %v", s.ID, err)
+	}
+
+	occurrences, err := h.DB.SeriesOccurrence.Query().
+		Where(seriesoccurrence.SeriesIDEQ(s.ID)).
+		Order(ent.Asc(seriesoccurrence.FieldStartDate)).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	resp := seriesResponse(s, now)
+	resp.Occurrences = make([]*swagdto.SeriesOccurrenceResponse, 0, len(occurrences))
+	for _, o := range occurrences {
+		resp.Occurrences = append(resp.Occurrences, seriesOccurrenceResponse(o))
+	}
+	return c.JSON(http.StatusCreated, APIResponse{Data: resp})
+}
+
+// @Summary     Daftar Event Series
+// @Description Menampilkan event series (terbaru lebih dulu), bisa difilter per host & status.
+// @Tags        Events
+// @Produce     json
+// @Param       host     query    string false "Alamat host"
+// @Param       status   query    string false "active | paused | completed | stopped"
+// @Param       page     query    int    false "Nomor halaman"
+// @Param       pageSize query    int    false "Jumlah item per halaman"
+// @Param       cursor   query    string false "Cursor keyset (kosong = halaman pertama)"
+// @Success     200 {object} APIResponse{data=[]swagdto.EventSeriesResponse} "Daftar series"
+// @Failure     400 {object} APIResponse "Parameter tidak valid"
+// @Router      /series [get]
+func (h *Handler) getSeriesList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	page, err := newListPage(c, seriesFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+
+	query := h.DB.EventSeries.Query()
+	if v := c.QueryParam("host"); v != "" {
+		query = query.Where(eventseries.HostAddressEQ(normalizeAddress(v)))
+	}
+	if v := c.QueryParam("status"); v != "" {
+		status := eventseries.Status(v)
+		if err := eventseries.StatusValidator(status); err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "status harus active, paused, completed, atau stopped"})
+		}
+		query = query.Where(eventseries.StatusEQ(status))
+	}
+
+	if err := page.count(ctx, query.Count); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.EventSeries(w))
+	}
+
+	items, err := query.
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(eventseries.OrderOption(page.order())).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	items, pagination := finishPage(page, items, func(s *ent.EventSeries) (any, int) { return s.CreatedAt, s.ID })
+
+	now := time.Now()
+	responses := make([]*swagdto.EventSeriesResponse, 0, len(items))
+	for _, s := range items {
+		responses = append(responses, seriesResponse(s, now))
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses, Pagination: pagination})
+}
+
+// @Summary     Detail Event Series
+// @Description Menampilkan series beserta semua occurrence yang sudah dijadwalkan (dan event on-chain-nya).
+// @Tags        Events
+// @Produce     json
+// @Param       id  path     int  true  "Series ID"
+// @Success     200 {object} APIResponse{data=swagdto.EventSeriesResponse} "Detail series"
+// @Failure     404 {object} APIResponse "Series tidak ditemukan"
+// @Router      /series/{id} [get]
+func (h *Handler) getSeriesByID(c echo.Context) error {
+	s, err := h.loadSeries(c, false)
+	if s == nil {
+		return err
+	}
+
+	occurrences, err := h.DB.SeriesOccurrence.Query().
+		Where(seriesoccurrence.SeriesIDEQ(s.ID)).
+		Order(ent.Asc(seriesoccurrence.FieldStartDate)).
+		All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	resp := seriesResponse(s, time.Now())
+	resp.Occurrences = make([]*swagdto.SeriesOccurrenceResponse, 0, len(occurrences))
+	for _, o := range occurrences {
+		resp.Occurrences = append(resp.Occurrences, seriesOccurrenceResponse(o))
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: resp})
+}
+
+// @Summary     Ubah Status Event Series (Host)
+// @Description 'paused' menghentikan sementara pembuatan sesi baru, 'active' melanjutkan (sesi yang terlewat tidak dibuat),
+// @Description 'stopped' menghentikan series permanen. Sesi yang sudah dibuat on-chain tidak berubah
+// @Description (batalkan per sesi lewat POST /events/{id}/cancel).
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id   path     int                       true "Series ID"
+// @Param       body body     UpdateSeriesStatusRequest true "Status baru"
+// @Success     200 {object} APIResponse{data=swagdto.EventSeriesResponse} "Series setelah diubah"
+// @Failure     400 {object} APIResponse "Status tidak valid"
+// @Failure     403 {object} APIResponse "Bukan host series ini"
+// @Failure     409 {object} APIResponse "Series sudah selesai/dihentikan"
+// @Router      /series/{id}/status [put]
+func (h *Handler) updateSeriesStatus(c echo.Context) error {
+	ctx := c.Request().Context()
+	s, err := h.loadSeries(c, true)
+	if s == nil {
+		return err
+	}
+
+	req := new(UpdateSeriesStatusRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	status := eventseries.Status(req.Status)
+	if status != eventseries.StatusActive && status != eventseries.StatusPaused && status != eventseries.StatusStopped {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "status harus active, paused, atau stopped"})
+	}
+
+	// 1. Hanya series yang masih berjalan yang bisa diubah (guard terhadap perubahan paralel)
+	n, err := h.DB.EventSeries.Update().
+		Where(eventseries.IDEQ(s.ID), eventseries.StatusIn(eventseries.StatusActive, eventseries.StatusPaused)).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if n == 0 {
+		return c.JSON(http.StatusConflict, APIResponse{Error: "Series sudah selesai atau dihentikan"})
+	}
+
+	// 2. Series dihentikan: jadwal yang belum dibuat on-chain dibatalkan
+	if status == eventseries.StatusStopped {
+		err := h.DB.SeriesOccurrence.Update().
+			Where(seriesoccurrence.SeriesIDEQ(s.ID), seriesoccurrence.StatusEQ(seriesoccurrence.StatusPending)).
+			SetStatus(seriesoccurrence.StatusSkipped).
+			SetError("series dihentikan host").
+			Exec(ctx)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+	}
+
+	s, err = h.DB.EventSeries.Get(ctx, s.ID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: seriesResponse(s, time.Now())})
+}
+
+// seriesAttendance merekap kehadiran di semua event on-chain milik series.
+// Sesi yang dibatalkan tidak dihitung; 'sessionsHeld' hanya sesi yang sudah selesai,
+// dan registrasi/kehadiran per user juga hanya dihitung dari sesi tsb.
+// Jika 'address' tidak kosong, hanya user tsb. yang direkap (field 'Me').
+func (h *Handler) seriesAttendance(ctx context.Context, seriesID int, address string) (*swagdto.SeriesAttendanceResponse, error) {
+	resp := &swagdto.SeriesAttendanceResponse{SeriesID: seriesID}
+
+	linked, err := h.DB.SeriesOccurrence.Query().
+		Where(seriesoccurrence.SeriesIDEQ(seriesID), seriesoccurrence.EventIDNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eventIDs := make([]uint64, 0, len(linked))
+	for _, o := range linked {
+		eventIDs = append(eventIDs, *o.EventID)
+	}
+
+	events, err := h.DB.Event.Query().
+		Where(event.EventIDIn(eventIDs...)).
+		WithAttendances(func(q *ent.AttendanceQuery) {
+			q.WithUser()
+			if address != "" {
+				q.Where(attendance.HasUserWith(user.AddressEQ(address)))
+			}
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Hitung sesi
+	now := time.Now()
+	stats := map[string]*swagdto.SeriesAttendeeResponse{}
+	for _, ev := range events {
+		if ev.CancelledAt != nil {
+			resp.SessionsCancelled++
+			continue
+		}
+		resp.SessionsTotal++
+		if !now.After(ev.EndDate) {
+			// Sesi yang belum selesai tidak dihitung, agar 'attended' tidak melebihi 'sessionsHeld'
+			continue
+		}
+		resp.SessionsHeld++
+
+		// 2. Per user: sesi (yang sudah selesai) yang didaftari & dihadiri
+		for _, att := range ev.Edges.Attendances {
+			if att.Edges.User == nil {
+				continue
+			}
+			addr := att.Edges.User.Address
+			st := stats[addr]
+			if st == nil {
+				st = &swagdto.SeriesAttendeeResponse{Address: addr}
+				stats[addr] = st
+			}
+			st.Registered++
+			if att.CheckedIn {
+				st.Attended++
+			}
+		}
+	}
+
+	for _, st := range stats {
+		st.SessionsHeld = resp.SessionsHeld
+		if resp.SessionsHeld > 0 {
+			st.AttendanceRate = float64(st.Attended) / float64(resp.SessionsHeld)
+		}
+	}
+
+	if address != "" {
+		resp.Me = stats[address]
+		if resp.Me == nil {
+			resp.Me = &swagdto.SeriesAttendeeResponse{Address: address, SessionsHeld: resp.SessionsHeld}
+		}
+		return resp, nil
+	}
+
+	resp.Attendees = make([]*swagdto.SeriesAttendeeResponse, 0, len(stats))
+	for _, st := range stats {
+		resp.Attendees = append(resp.Attendees, st)
+	}
+	sort.Slice(resp.Attendees, func(i, j int) bool {
+		a, b := resp.Attendees[i], resp.Attendees[j]
+		if a.Attended != b.Attended {
+			return a.Attended > b.Attended
+		}
+		return a.Address < b.Address
+	})
+	return resp, nil
+}
+
+// @Summary     Rekap Kehadiran Event Series (Host)
+// @Description Menghitung kehadiran setiap peserta di seluruh sesi series, misal "hadir 8 dari 10 sesi"
+// @Description ('attended' dari 'sessionsHeld'). Hanya sesi yang sudah selesai yang dihitung; sesi yang dibatalkan tidak dihitung. Host/admin saja.
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "Series ID"
+// @Success     200 {object} APIResponse{data=swagdto.SeriesAttendanceResponse} "Rekap kehadiran"
+// @Failure     403 {object} APIResponse "Bukan host series ini"
+// @Failure     404 {object} APIResponse "Series tidak ditemukan"
+// @Router      /series/{id}/attendance [get]
+func (h *Handler) getSeriesAttendance(c echo.Context) error {
+	s, err := h.loadSeries(c, true)
+	if s == nil {
+		return err
+	}
+
+	resp, err := h.seriesAttendance(c.Request().Context(), s.ID, "")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: resp})
+}
+
+// @Summary     Kehadiran Saya di Event Series
+// @Description Rekap kehadiran user yang login di seluruh sesi series ('me': hadir X dari Y sesi).
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "Series ID"
+// @Success     200 {object} APIResponse{data=swagdto.SeriesAttendanceResponse} "Rekap kehadiran user"
+// @Failure     404 {object} APIResponse "Series tidak ditemukan"
+// @Router      /series/{id}/attendance/me [get]
+func (h *Handler) getMySeriesAttendance(c echo.Context) error {
+	s, err := h.loadSeries(c, false)
+	if s == nil {
+		return err
+	}
+
+	resp, err := h.seriesAttendance(c.Request().Context(), s.ID, sessionAddress(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: resp})
+}
+
+// eventSeriesID mengembalikan ID series jika event adalah occurrence dari sebuah series.
+func (h *Handler) eventSeriesID(ctx context.Context, eventID uint64) (*int, error) {
+	o, err := h.DB.SeriesOccurrence.Query().
+		Where(seriesoccurrence.EventIDEQ(eventID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &o.SeriesID, nil
+}
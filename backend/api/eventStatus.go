@@ -0,0 +1,237 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/event"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/labstack/echo/v4"
+)
+
+// Status event dihitung dari waktu dan jumlah registrasi (tidak disimpan di DB):
+//
+//	status:              upcoming (belum mulai) | ongoing (sedang berlangsung) | ended (sudah selesai) | cancelled (dibatalkan host)
+//	registration_status: open (kuota masih ada) | full (kuota penuh) | closed (event selesai/dibatalkan)
+//
+// Registrasi on-chain tidak dibatasi waktu, hanya kuota (lihat 'EventManager.registerEvent'),
+// sehingga registrasi tetap dibuka selama event berlangsung.
+//
+// Filter '?status=' di /events menerima: upcoming, registration_open, full, ongoing, ended, cancelled
+// (boleh lebih dari satu, dipisah koma).
+
+const (
+	eventStatusUpcoming  = "upcoming"
+	eventStatusOngoing   = "ongoing"
+	eventStatusEnded     = "ended"
+	eventStatusCancelled = "cancelled"
+
+	registrationOpen   = "open"
+	registrationFull   = "full"
+	registrationClosed = "closed"
+
+	// Nilai filter '?status=' tambahan
+	statusFilterRegistrationOpen = "registration_open"
+	statusFilterFull             = "full"
+)
+
+// eventLifecycle adalah status terhitung sebuah event.
+type eventLifecycle struct {
+	Status             string
+	RegistrationStatus string
+	RegisteredCount    int
+	CheckedInCount     int
+}
+
+// computeEventLifecycle menghitung status dari attendances yang sudah di-load ('WithAttendances').
+func computeEventLifecycle(ev *ent.Event, now time.Time) eventLifecycle {
+	var checkedIn int
+	for _, att := range ev.Edges.Attendances {
+		if att.CheckedIn {
+			checkedIn++
+		}
+	}
+	return eventLifecycleFromCounts(ev, eventAttendanceCount{Registered: len(ev.Edges.Attendances), CheckedIn: checkedIn}, now)
+}
+
+// eventLifecycleFromCounts menghitung status dari jumlah registrasi/check-in yang sudah dihitung (lihat 'eventAttendanceCounts').
+func eventLifecycleFromCounts(ev *ent.Event, counts eventAttendanceCount, now time.Time) eventLifecycle {
+	lc := eventLifecycle{RegisteredCount: counts.Registered, CheckedInCount: counts.CheckedIn}
+
+	switch {
+	case ev.CancelledAt != nil:
+		lc.Status = eventStatusCancelled
+	case now.After(ev.EndDate):
+		lc.Status = eventStatusEnded
+	case now.Before(ev.StartDate):
+		lc.Status = eventStatusUpcoming
+	default:
+		lc.Status = eventStatusOngoing
+	}
+
+	switch {
+	case lc.Status == eventStatusEnded || lc.Status == eventStatusCancelled:
+		lc.RegistrationStatus = registrationClosed
+	case uint64(lc.RegisteredCount) >= ev.Quota:
+		lc.RegistrationStatus = registrationFull
+	default:
+		lc.RegistrationStatus = registrationOpen
+	}
+	return lc
+}
+
+// registeredCountSQL adalah subquery jumlah registrasi sebuah event.
+func registeredCountSQL(s *sql.Selector) string {
+	return fmt.Sprintf("(SELECT count(*) FROM %s WHERE %s.%s = %s)",
+		attendance.Table, attendance.Table, attendance.EventColumn, s.C("id"))
+}
+
+// checkedInCountSQL adalah subquery jumlah peserta yang sudah check-in di sebuah event.
+func checkedInCountSQL(s *sql.Selector) string {
+	return fmt.Sprintf("(SELECT count(*) FROM %s WHERE %s.%s = %s AND %s.%s)",
+		attendance.Table, attendance.Table, attendance.EventColumn, s.C("id"), attendance.Table, attendance.FieldCheckedIn)
+}
+
+// eventAttendanceCount adalah jumlah registrasi & check-in sebuah event.
+type eventAttendanceCount struct {
+	Registered int
+	CheckedIn  int
+}
+
+// eventAttendanceCounts menghitung jumlah registrasi & check-in beberapa event dalam satu query agregat
+// (tanpa me-load baris Attendance). Key map adalah ID (ent) event.
+func (h *Handler) eventAttendanceCounts(ctx context.Context, ids []int) (map[int]eventAttendanceCount, error) {
+	counts := make(map[int]eventAttendanceCount, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		ID         int `json:"id"`
+		Registered int `json:"registered_count"`
+		CheckedIn  int `json:"checked_in_count"`
+	}
+	if err := h.DB.Event.Query().
+		Where(event.IDIn(ids...)).
+		GroupBy(event.FieldID).
+		Aggregate(
+			func(s *sql.Selector) string { return sql.As(registeredCountSQL(s), "registered_count") },
+			func(s *sql.Selector) string { return sql.As(checkedInCountSQL(s), "checked_in_count") },
+		).
+		Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		counts[r.ID] = eventAttendanceCount{Registered: r.Registered, CheckedIn: r.CheckedIn}
+	}
+	return counts, nil
+}
+
+// eventStatusPredicate membangun predicate untuk satu nilai filter '?status='.
+// Event yang dibatalkan hanya cocok dengan status 'cancelled'.
+func eventStatusPredicate(status string, now time.Time) (func(*sql.Selector) *sql.Predicate, error) {
+	switch status {
+	case eventStatusUpcoming:
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.And(sql.IsNull(s.C("cancelled_at")), sql.GT(s.C("start_date"), now))
+		}, nil
+	case eventStatusOngoing:
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.And(sql.IsNull(s.C("cancelled_at")), sql.LTE(s.C("start_date"), now), sql.GTE(s.C("end_date"), now))
+		}, nil
+	case eventStatusEnded:
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.And(sql.IsNull(s.C("cancelled_at")), sql.LT(s.C("end_date"), now))
+		}, nil
+	case eventStatusCancelled:
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.NotNull(s.C("cancelled_at"))
+		}, nil
+	case statusFilterRegistrationOpen:
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.And(
+				sql.IsNull(s.C("cancelled_at")),
+				sql.GTE(s.C("end_date"), now),
+				sql.ExprP(registeredCountSQL(s)+" < "+s.C("quota")),
+			)
+		}, nil
+	case statusFilterFull:
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.And(
+				sql.IsNull(s.C("cancelled_at")),
+				sql.GTE(s.C("end_date"), now),
+				sql.ExprP(registeredCountSQL(s)+" >= "+s.C("quota")),
+			)
+		}, nil
+	}
+	return nil, fmt.Errorf("status tidak dikenal: '%s'", status)
+}
+
+// parseDateParam menerima RFC3339 atau tanggal saja (YYYY-MM-DD).
+func parseDateParam(raw string) (t time.Time, dateOnly bool, err error) {
+	if t, err = time.Parse(time.RFC3339, raw); err == nil {
+		return t, false, nil
+	}
+	t, err = time.Parse("2006-01-02", raw)
+	return t, true, err
+}
+
+// eventTimeFilter membaca '?from=', '?to=' dan '?status=' menjadi satu predicate (nil jika tidak ada).
+//
+// from/to memilih event yang waktunya beririsan dengan rentang: 'end_date >= from' dan 'start_date <= to'.
+// Jika 'to' berupa tanggal saja, seluruh hari tersebut ikut dihitung.
+func eventTimeFilter(c echo.Context, now time.Time) (func(*sql.Selector), error) {
+	var preds []func(*sql.Selector) *sql.Predicate
+
+	if v := c.QueryParam("from"); v != "" {
+		from, _, err := parseDateParam(v)
+		if err != nil {
+			return nil, errors.New("from harus RFC3339 atau YYYY-MM-DD")
+		}
+		preds = append(preds, func(s *sql.Selector) *sql.Predicate { return sql.GTE(s.C("end_date"), from) })
+	}
+	if v := c.QueryParam("to"); v != "" {
+		to, dateOnly, err := parseDateParam(v)
+		if err != nil {
+			return nil, errors.New("to harus RFC3339 atau YYYY-MM-DD")
+		}
+		if dateOnly {
+			end := to.AddDate(0, 0, 1)
+			preds = append(preds, func(s *sql.Selector) *sql.Predicate { return sql.LT(s.C("start_date"), end) })
+		} else {
+			preds = append(preds, func(s *sql.Selector) *sql.Predicate { return sql.LTE(s.C("start_date"), to) })
+		}
+	}
+
+	if v := c.QueryParam("status"); v != "" {
+		// Beberapa status digabung dengan OR
+		var anyOf []func(*sql.Selector) *sql.Predicate
+		for _, st := range strings.Split(v, ",") {
+			p, err := eventStatusPredicate(strings.TrimSpace(st), now)
+			if err != nil {
+				return nil, err
+			}
+			anyOf = append(anyOf, p)
+		}
+		preds = append(preds, func(s *sql.Selector) *sql.Predicate {
+			ps := make([]*sql.Predicate, len(anyOf))
+			for i, p := range anyOf {
+				ps[i] = p(s)
+			}
+			return sql.Or(ps...)
+		})
+	}
+
+	if len(preds) == 0 {
+		return nil, nil
+	}
+	return func(s *sql.Selector) {
+		for _, p := range preds {
+			s.Where(p(s))
+		}
+	}, nil
+}
@@ -0,0 +1,470 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/event"
+	"backend/ent/eventchange"
+	"backend/ent/schema"
+	"backend/ent/user"
+	"backend/ent/waitlistentry"
+	"backend/swagdto"
+	"backend/transactions"
+	"backend/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Perubahan & pembatalan event oleh host.
+//
+// Data event on-chain bersifat tetap kecuali kuota ('EventManager.Admin.updateEventQuota'), jadi waktu,
+// lokasi, dan deskripsi diubah off-chain di database; kuota tetap lewat transaksi on-chain.
+// Setiap perubahan menaikkan 'Event.revision', dicatat di EventChange, dan dikirim sebagai notifikasi
+// ke semua attendee yang sudah register. Pembatalan juga menutup waitlist event.
+//
+// PENTING: perubahan waktu/lokasi/deskripsi dan pembatalan HANYA ada di database. 'EventManager.getEventDetails'
+// (dan event 'EventCreated') tetap berisi data awal, jadi client harus membaca event dari API ini, bukan dari chain.
+// Karena kontrak tidak tahu event dibatalkan, semua jalur check-in di backend (staff, batch, token QR, antrian
+// kiosk, self check-in, join link) menolak event yang sudah dibatalkan (lihat 'rejectCancelledEvent').
+
+const (
+	notificationEventUpdated   = "event_updated"
+	notificationEventCancelled = "event_cancelled"
+)
+
+// eventFieldLabels adalah nama field yang ditampilkan di notifikasi.
+var eventFieldLabels = map[string]string{
+	"start_date":  "waktu mulai",
+	"end_date":    "waktu selesai",
+	"location":    "lokasi",
+	"lat":         "koordinat",
+	"long":        "koordinat",
+	"description": "deskripsi",
+	"quota":       "kuota",
+}
+
+// errEventNotEditable dikembalikan untuk event yang sudah selesai atau dibatalkan.
+var errEventNotEditable = errors.New("event yang sudah selesai atau dibatalkan tidak bisa diubah")
+
+// errEventCancelled dikembalikan oleh jalur check-in untuk event yang sudah dibatalkan.
+var errEventCancelled = errors.New("event sudah dibatalkan")
+
+// eventCancelled mengecek apakah event 'eventID' sudah dibatalkan (event yang tidak ada dianggap tidak).
+func (h *Handler) eventCancelled(ctx context.Context, eventID uint64) (bool, error) {
+	return h.DB.Event.Query().
+		Where(event.EventIDEQ(eventID), event.CancelledAtNotNil()).
+		Exist(ctx)
+}
+
+// rejectCancelledEvent menolak check-in (dan penerbitan token/link check-in) untuk event yang dibatalkan.
+// Jika ditolak, respon error sudah ditulis dan 'ok' bernilai false.
+func (h *Handler) rejectCancelledEvent(c echo.Context, eventID uint64) (bool, error) {
+	cancelled, err := h.eventCancelled(c.Request().Context(), eventID)
+	if err != nil {
+		return false, c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if cancelled {
+		return false, c.JSON(http.StatusConflict, APIResponse{Error: errEventCancelled.Error()})
+	}
+	return true, nil
+}
+
+// eventRegistrantAddresses mengembalikan alamat semua attendee yang sudah register.
+func (h *Handler) eventRegistrantAddresses(ctx context.Context, eventID uint64) ([]string, error) {
+	return h.DB.User.Query().
+		Where(user.HasAttendancesWith(attendance.HasEventWith(event.EventIDEQ(eventID)))).
+		Select(user.FieldAddress).
+		Strings(ctx)
+}
+
+// planEventUpdate memvalidasi perubahan dan mengembalikan daftar field yang benar-benar berubah.
+// Lokasi event offline yang berubah tanpa lat/long di-geocode (hasilnya diisi ke 'req').
+func (h *Handler) planEventUpdate(ctx context.Context, ev *ent.Event, req *UpdateEventRequest, now time.Time) ([]schema.EventFieldChange, error) {
+	if ev.CancelledAt != nil || now.After(ev.EndDate) {
+		return nil, errEventNotEditable
+	}
+
+	var changes []schema.EventFieldChange
+	add := func(field string, old, new any) {
+		changes = append(changes, schema.EventFieldChange{Field: field, Old: old, New: new})
+	}
+
+	// 1. Waktu
+	start, end := ev.StartDate, ev.EndDate
+	if req.StartDate != nil {
+		start = *req.StartDate
+	}
+	if req.EndDate != nil {
+		end = *req.EndDate
+	}
+	if !end.After(start) {
+		return nil, errors.New("endDate harus setelah startDate")
+	}
+	if !end.After(now) {
+		return nil, errors.New("endDate harus di masa depan")
+	}
+	if !start.Equal(ev.StartDate) {
+		add("start_date", ev.StartDate, start)
+	}
+	if !end.Equal(ev.EndDate) {
+		add("end_date", ev.EndDate, end)
+	}
+
+	// 2. Lokasi
+	if req.Location != nil {
+		loc := strings.TrimSpace(*req.Location)
+		req.Location = &loc
+		if loc == "" {
+			return nil, errors.New("location tidak boleh kosong")
+		}
+		if ev.EventType == 0 && !isHTTPURL(loc) {
+			return nil, errors.New("location event online harus berupa link http/https")
+		}
+		if loc != ev.Location {
+			add("location", ev.Location, loc)
+		}
+	}
+	if ev.EventType == 1 {
+		if (req.Lat == nil) != (req.Long == nil) {
+			return nil, errors.New("lat dan long harus diisi bersamaan")
+		}
+		if req.Lat == nil && req.Location != nil && *req.Location != ev.Location {
+			lat, long, err := h.Geocoder.Geocode(ctx, *req.Location)
+			if err != nil {
+				return nil, fmt.Errorf("gagal geocode lokasi baru (isi lat/long): %w", err)
+			}
+			req.Lat, req.Long = &lat, &long
+		}
+		if req.Lat != nil {
+			if *req.Lat < -90 || *req.Lat > 90 || *req.Long < -180 || *req.Long > 180 {
+				return nil, errors.New("lat/long tidak valid")
+			}
+			if *req.Lat != ev.Lat {
+				add("lat", ev.Lat, *req.Lat)
+			}
+			if *req.Long != ev.Long {
+				add("long", ev.Long, *req.Long)
+			}
+		}
+	} else if req.Lat != nil || req.Long != nil {
+		return nil, errors.New("event online tidak punya lat/long")
+	}
+
+	// 3. Deskripsi
+	if req.Description != nil && *req.Description != ev.Description {
+		add("description", ev.Description, *req.Description)
+	}
+
+	// 4. Kuota (tidak boleh di bawah jumlah attendee yang sudah register)
+	if req.Quota != nil && *req.Quota != ev.Quota {
+		registered, err := h.DB.Attendance.Query().Where(attendance.HasEventWith(event.IDEQ(ev.ID))).Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if *req.Quota == 0 || *req.Quota < uint64(registered) {
+			return nil, fmt.Errorf("quota minimal %d (jumlah attendee yang sudah register)", max(registered, 1))
+		}
+		add("quota", ev.Quota, *req.Quota)
+	}
+
+	if len(changes) == 0 {
+		return nil, errors.New("tidak ada perubahan")
+	}
+	return changes, nil
+}
+
+// commitEventUpdate menyimpan perubahan (kuota on-chain lebih dulu), mencatat riwayat, dan memberi notifikasi.
+func (h *Handler) commitEventUpdate(ctx context.Context, ev *ent.Event, req *UpdateEventRequest, changes []schema.EventFieldChange, by string) (*ent.EventChange, error) {
+	quotaChanged := false
+	for _, ch := range changes {
+		quotaChanged = quotaChanged || ch.Field == "quota"
+	}
+
+	// 1. Kuota on-chain (jika gagal, tidak ada yang diubah)
+	if quotaChanged {
+		if err := transactions.UpdateEventQuota(ev.EventID, *req.Quota); err != nil {
+			log.Printf("Gagal menjalankan transaksi update kuota event %d: %v", ev.EventID, err)
+			return nil, err
+		}
+	}
+
+	// 2. Update event + riwayat dalam satu transaksi DB
+	tx, err := h.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	upd := tx.Event.UpdateOne(ev).AddRevision(1)
+	for _, ch := range changes {
+		switch ch.Field {
+		case "start_date":
+			upd.SetStartDate(*req.StartDate)
+		case "end_date":
+			upd.SetEndDate(*req.EndDate)
+		case "location":
+			upd.SetLocation(*req.Location)
+		case "lat":
+			upd.SetLat(*req.Lat)
+		case "long":
+			upd.SetLong(*req.Long)
+		case "description":
+			upd.SetDescription(*req.Description)
+		case "quota":
+			// Indexer akan menulis nilai yang sama dari event 'EventQuotaUpdated'
+			upd.SetQuota(*req.Quota)
+		}
+	}
+	updated, err := upd.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, err := tx.EventChange.Create().
+		SetEventID(ev.EventID).
+		SetRevision(updated.Revision).
+		SetKind(eventchange.KindUpdated).
+		SetChanges(changes).
+		SetReason(req.Reason).
+		SetChangedBy(by).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.BumpDataVersion(ctx, tx.Client(), utils.EventsDataVersion); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// 3. Notifikasi ke attendee
+	labels, seen := []string{}, map[string]bool{}
+	for _, ch := range changes {
+		if l := eventFieldLabels[ch.Field]; !seen[l] {
+			seen[l] = true
+			labels = append(labels, l)
+		}
+	}
+	body := fmt.Sprintf("Host mengubah %s untuk '%s'.", strings.Join(labels, ", "), ev.Name)
+	if req.Reason != "" {
+		body += " Alasan: " + req.Reason
+	}
+	h.notifyRegistrants(ctx, ev.EventID, notificationEventUpdated, "Event diperbarui", body,
+		map[string]any{"event_id": ev.EventID, "revision": updated.Revision, "changes": changes})
+
+	// 4. Kuota naik -> tawarkan slot ke waitlist
+	if quotaChanged {
+		if err := h.processEventWaitlist(ctx, ev.EventID); err != nil {
+			log.Printf("Gagal memproses waitlist event %d: %v", ev.EventID, err)
+		}
+	}
+	return change, nil
+}
+
+// notifyRegistrants mengirim notifikasi ke semua attendee event (kegagalan hanya di-log).
+func (h *Handler) notifyRegistrants(ctx context.Context, eventID uint64, kind, title, body string, data map[string]any) {
+	addresses, err := h.eventRegistrantAddresses(ctx, eventID)
+	if err != nil {
+		log.Printf("Gagal mengambil attendee event %d untuk notifikasi: %v", eventID, err)
+		return
+	}
+	h.notifyMany(ctx, addresses, kind, title, body, data)
+}
+
+func eventChangeResponse(ch *ent.EventChange) *swagdto.EventChangeResponse {
+	fields := make([]swagdto.EventFieldChangeResponse, len(ch.Changes))
+	for i, f := range ch.Changes {
+		fields[i] = swagdto.EventFieldChangeResponse{Field: f.Field, Old: f.Old, New: f.New}
+	}
+	return &swagdto.EventChangeResponse{
+		Revision:  ch.Revision,
+		Kind:      string(ch.Kind),
+		Changes:   fields,
+		Reason:    ch.Reason,
+		ChangedBy: ch.ChangedBy,
+		CreatedAt: ch.CreatedAt,
+	}
+}
+
+// eventChangeHistory mengembalikan riwayat perubahan event (terbaru lebih dulu).
+func (h *Handler) eventChangeHistory(ctx context.Context, eventID uint64) ([]*swagdto.EventChangeResponse, error) {
+	changes, err := h.DB.EventChange.Query().
+		Where(eventchange.EventIDEQ(eventID)).
+		Order(ent.Desc(eventchange.FieldRevision)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]*swagdto.EventChangeResponse, len(changes))
+	for i, ch := range changes {
+		responses[i] = eventChangeResponse(ch)
+	}
+	return responses, nil
+}
+
+// @Summary     Ubah Event (Host)
+// @Description Host mengubah waktu, lokasi, deskripsi, atau kuota event. Field yang tidak dikirim tidak berubah.
+// @Description Lokasi baru event offline tanpa lat/long akan di-geocode. Kuota diubah lewat transaksi on-chain.
+// @Description Perubahan dicatat di riwayat (lihat 'changes' di GET /events/{id}) dan semua attendee mendapat notifikasi.
+// @Description Waktu, lokasi, dan deskripsi hanya diubah di database: data on-chain (getEventDetails) tetap data awal.
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id   path     int                true "Event ID (On-Chain ID)"
+// @Param       body body     UpdateEventRequest true "Perubahan event"
+// @Success     200 {object} APIResponse{data=swagdto.EventChangeResponse} "Perubahan tersimpan"
+// @Failure     400 {object} APIResponse "Input tidak valid / tidak ada perubahan"
+// @Failure     403 {object} APIResponse "Tidak punya izin mengubah event ini"
+// @Failure     409 {object} APIResponse "Event sudah selesai atau dibatalkan"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /events/{id} [patch]
+func (h *Handler) updateEvent(c echo.Context) error {
+	req := new(UpdateEventRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	return h.applyEventUpdate(c, req)
+}
+
+// applyEventUpdate menjalankan 'planEventUpdate' + 'commitEventUpdate' untuk event ':id'.
+func (h *Handler) applyEventUpdate(c echo.Context, req *UpdateEventRequest) error {
+	ctx := c.Request().Context()
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	// Izin (host/admin) sudah dicek oleh middleware 'requireEventPermission'
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).Only(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	changes, err := h.planEventUpdate(ctx, ev, req, time.Now())
+	if err != nil {
+		if errors.Is(err, errEventNotEditable) {
+			return c.JSON(http.StatusConflict, APIResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+
+	change, err := h.commitEventUpdate(ctx, ev, req, changes, sessionAddress(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: eventChangeResponse(change)})
+}
+
+// @Summary     Batalkan Event (Host)
+// @Description Host membatalkan event. Status event menjadi 'cancelled', waitlist ditutup, dan semua attendee
+// @Description (serta user di waitlist) mendapat notifikasi. Pembatalan tidak bisa diurungkan.
+// @Description Registrasi on-chain ditutup permanen lebih dulu (kontrak menolak 'registerEvent' untuk event ini);
+// @Description jika transaksi itu gagal, event tidak dibatalkan dan request boleh diulang.
+// @Description Setelah dibatalkan, check-in (staff, token, kiosk, self check-in, join link) ditolak.
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id   path     int                true  "Event ID (On-Chain ID)"
+// @Param       body body     CancelEventRequest false "Alasan pembatalan"
+// @Success     200 {object} APIResponse{data=swagdto.EventChangeResponse} "Event dibatalkan"
+// @Failure     403 {object} APIResponse "Tidak punya izin mengubah event ini"
+// @Failure     409 {object} APIResponse "Event sudah selesai atau dibatalkan"
+// @Failure     500 {object} APIResponse "Gagal menutup registrasi on-chain / Internal Server Error"
+// @Router      /events/{id}/cancel [post]
+func (h *Handler) cancelEvent(c echo.Context) error {
+	ctx := c.Request().Context()
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+	now := time.Now()
+
+	req := new(CancelEventRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	req.Reason = strings.TrimSpace(req.Reason)
+
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).Only(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if ev.CancelledAt != nil || now.After(ev.EndDate) {
+		return c.JSON(http.StatusConflict, APIResponse{Error: errEventNotEditable.Error()})
+	}
+	previous := computeEventLifecycle(ev, now).Status
+
+	// 1. Tutup registrasi on-chain. Dilakukan sebelum DB agar event tidak tercatat batal
+	//    sementara user masih bisa register; transaksi ini idempoten sehingga aman diulang.
+	if err := transactions.CloseEventRegistration(eventID); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: "Gagal menutup registrasi on-chain: " + err.Error()})
+	}
+
+	// 2. Tandai dibatalkan + riwayat (hanya jika belum dibatalkan request lain)
+	tx, err := h.DB.Tx(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	defer tx.Rollback()
+
+	// Revisi diambil dari baris hasil update, bukan dari 'ev' yang bisa basi karena PATCH paralel
+	updated, err := tx.Event.UpdateOne(ev).
+		Where(event.CancelledAtIsNil()).
+		SetCancelledAt(now).
+		SetCancelReason(req.Reason).
+		AddRevision(1).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusConflict, APIResponse{Error: errEventNotEditable.Error()})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	change, err := tx.EventChange.Create().
+		SetEventID(eventID).
+		SetRevision(updated.Revision).
+		SetKind(eventchange.KindCancelled).
+		SetChanges([]schema.EventFieldChange{{Field: "status", Old: previous, New: eventStatusCancelled}}).
+		SetReason(req.Reason).
+		SetChangedBy(sessionAddress(c)).
+		Save(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if err := utils.BumpDataVersion(ctx, tx.Client(), utils.EventsDataVersion); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 3. Tutup waitlist
+	waitlisted, err := tx.WaitlistEntry.Query().
+		Where(waitlistentry.EventIDEQ(eventID), waitlistentry.StatusIn(activeWaitlistStatuses...)).
+		Select(waitlistentry.FieldAddress).
+		Strings(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if err := tx.WaitlistEntry.Update().
+		Where(waitlistentry.EventIDEQ(eventID), waitlistentry.StatusIn(activeWaitlistStatuses...)).
+		SetStatus(waitlistentry.StatusExpired).
+		Exec(ctx); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if err := tx.Commit(); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 4. Notifikasi ke attendee & waitlist
+	body := fmt.Sprintf("Event '%s' dibatalkan oleh host.", ev.Name)
+	if req.Reason != "" {
+		body += " Alasan: " + req.Reason
+	}
+	data := map[string]any{"event_id": eventID, "revision": updated.Revision}
+	h.notifyRegistrants(ctx, eventID, notificationEventCancelled, "Event dibatalkan", body, data)
+	h.notifyMany(ctx, waitlisted, notificationEventCancelled, "Event dibatalkan", body, data)
+
+	return c.JSON(http.StatusOK, APIResponse{Data: eventChangeResponse(change)})
+}
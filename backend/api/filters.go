@@ -0,0 +1,370 @@
+package main
+
+import (
+	"backend/ent/event"
+	"backend/ent/eventpass"
+	"backend/ent/listing"
+	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/user"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/labstack/echo/v4"
+)
+
+// Bahasa filter & sort untuk endpoint list:
+//
+//	?filter[event_type]=1                  -> eq (operator default)
+//	?filter[event_type][in]=0,1            -> in (nilai dipisah koma)
+//	?filter[start_date][gte]=2025-01-01    -> gte / lte / gt / lt
+//	?filter[name][contains]=jakarta        -> contains (case-insensitive)
+//	?sort=-start_date,name                 -> '-' = DESC
+//
+// Hanya field yang ada di whitelist entity yang boleh dipakai (lihat '*Filters' di bawah).
+// Daftar field juga otomatis ditambahkan ke dokumentasi swagger (lihat 'swaggerFilters.go').
+
+type filterOp string
+
+const (
+	opEQ       filterOp = "eq"
+	opNE       filterOp = "ne"
+	opIn       filterOp = "in"
+	opGT       filterOp = "gt"
+	opGTE      filterOp = "gte"
+	opLT       filterOp = "lt"
+	opLTE      filterOp = "lte"
+	opContains filterOp = "contains"
+)
+
+type filterType string
+
+const (
+	filterString filterType = "string"
+	filterInt    filterType = "integer"
+	filterFloat  filterType = "number"
+	filterTime   filterType = "time"
+	filterBool   filterType = "boolean"
+)
+
+var (
+	opsEquality = []filterOp{opEQ, opNE, opIn}
+	opsRange    = []filterOp{opEQ, opNE, opIn, opGT, opGTE, opLT, opLTE}
+	opsText     = []filterOp{opEQ, opNE, opIn, opContains}
+	opsRelation = []filterOp{opEQ, opIn}
+)
+
+// filterField adalah satu field yang boleh difilter / di-sort.
+type filterField struct {
+	Name     string
+	Column   string // Kolom DB. Kosong untuk filter relasi (pakai 'Relation').
+	Type     filterType
+	Ops      []filterOp
+	Sortable bool
+
+	// Filter lewat relasi (misal: alamat pemilik). Menerima semua nilai (eq = 1 nilai, in = banyak).
+	Relation func(values []string) func(*sql.Selector)
+
+	// Nama query param lama yang tetap didukung (misal: '?owner_address=0x..'), dengan operatornya
+	Legacy   string
+	LegacyOp filterOp
+}
+
+// entityFilters adalah whitelist filter/sort sebuah entity, beserta urutan default-nya.
+type entityFilters struct {
+	Keyset keysetSpec
+	Fields []filterField
+}
+
+func (f *entityFilters) field(name string) (filterField, bool) {
+	for _, fd := range f.Fields {
+		if fd.Name == name {
+			return fd, true
+		}
+	}
+	return filterField{}, false
+}
+
+func (fd filterField) allows(op filterOp) bool {
+	for _, o := range fd.Ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (fd filterField) parseValue(raw string) (any, error) {
+	switch fd.Type {
+	case filterInt:
+		return strconv.ParseInt(raw, 10, 64)
+	case filterFloat:
+		return strconv.ParseFloat(raw, 64)
+	case filterBool:
+		return strconv.ParseBool(raw)
+	case filterTime:
+		if t, err := time.Parse(time.RFC3339, raw); err == nil {
+			return t, nil
+		}
+		return time.Parse("2006-01-02", raw)
+	}
+	return raw, nil
+}
+
+// predicate membangun predicate SQL untuk satu filter.
+func (fd filterField) predicate(op filterOp, raw string) (func(*sql.Selector), error) {
+	if !fd.allows(op) {
+		return nil, fmt.Errorf("operator '%s' tidak didukung untuk field '%s'", op, fd.Name)
+	}
+	rawValues := []string{raw}
+	if op == opIn {
+		rawValues = strings.Split(raw, ",")
+	}
+
+	if fd.Relation != nil {
+		return fd.Relation(rawValues), nil
+	}
+
+	if op == opContains {
+		return sql.FieldContainsFold(fd.Column, raw), nil
+	}
+	values := make([]any, 0, len(rawValues))
+	for _, rv := range rawValues {
+		v, err := fd.parseValue(strings.TrimSpace(rv))
+		if err != nil {
+			return nil, fmt.Errorf("nilai tidak valid untuk field '%s': %q", fd.Name, rv)
+		}
+		values = append(values, v)
+	}
+
+	switch op {
+	case opEQ:
+		return sql.FieldEQ(fd.Column, values[0]), nil
+	case opNE:
+		return sql.FieldNEQ(fd.Column, values[0]), nil
+	case opIn:
+		return sql.FieldIn(fd.Column, values...), nil
+	case opGT:
+		return sql.FieldGT(fd.Column, values[0]), nil
+	case opGTE:
+		return sql.FieldGTE(fd.Column, values[0]), nil
+	case opLT:
+		return sql.FieldLT(fd.Column, values[0]), nil
+	case opLTE:
+		return sql.FieldLTE(fd.Column, values[0]), nil
+	}
+	return nil, fmt.Errorf("operator tidak dikenal: %s", op)
+}
+
+// sortTerm adalah satu kolom urutan.
+type sortTerm struct {
+	Column string
+	Desc   bool
+}
+
+var filterParamPattern = regexp.MustCompile(`^filter\[([a-z_]+)\](?:\[([a-z]+)\])?$`)
+
+// parseFilters membaca 'filter[...]' (dan param lama) menjadi daftar predicate.
+// Hanya 'filter[...]' yang divalidasi ketat; nilai param lama yang tidak valid diabaikan.
+func (f *entityFilters) parseFilters(c echo.Context) ([]func(*sql.Selector), error) {
+	var preds []func(*sql.Selector)
+	for key, values := range c.QueryParams() {
+		m := filterParamPattern.FindStringSubmatch(key)
+		if m == nil {
+			continue
+		}
+		fd, ok := f.field(m[1])
+		if !ok {
+			return nil, fmt.Errorf("field filter tidak dikenal: '%s'", m[1])
+		}
+		op := opEQ
+		if m[2] != "" {
+			op = filterOp(m[2])
+		}
+		for _, v := range values {
+			p, err := fd.predicate(op, v)
+			if err != nil {
+				return nil, err
+			}
+			preds = append(preds, p)
+		}
+	}
+
+	for _, fd := range f.Fields {
+		if fd.Legacy == "" {
+			continue
+		}
+		if v := c.QueryParam(fd.Legacy); v != "" {
+			op := fd.LegacyOp
+			if op == "" {
+				op = opEQ
+			}
+			// Param lama tetap longgar seperti sebelumnya: nilai tidak valid diabaikan, bukan 400
+			p, err := fd.predicate(op, v)
+			if err != nil {
+				continue
+			}
+			preds = append(preds, p)
+		}
+	}
+	return preds, nil
+}
+
+// parseSort membaca 'sort=-start_date,name'. Mengembalikan nil jika kosong (pakai urutan default).
+func (f *entityFilters) parseSort(raw string) ([]sortTerm, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var terms []sortTerm
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		name, desc := strings.CutPrefix(part, "-")
+		fd, ok := f.field(name)
+		if !ok || !fd.Sortable {
+			return nil, fmt.Errorf("field sort tidak dikenal: '%s'", name)
+		}
+		terms = append(terms, sortTerm{Column: fd.Column, Desc: desc})
+	}
+	return terms, nil
+}
+
+// ---------------------------------------------------------------------------
+// Whitelist per entity
+// ---------------------------------------------------------------------------
+
+func ownerAddressFilter(has func(values []string) func(*sql.Selector)) filterField {
+	return filterField{
+		Name:     "owner_address",
+		Type:     filterString,
+		Ops:      opsRelation,
+		Relation: has,
+	}
+}
+
+var momentFilters = &entityFilters{
+	Keyset: keysetByID,
+	Fields: []filterField{
+		{Name: "id", Column: nftmoment.FieldID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "nft_id", Column: nftmoment.FieldNftID, Type: filterInt, Ops: opsRange, Sortable: true, Legacy: "nft_id"},
+		{Name: "name", Column: nftmoment.FieldName, Type: filterString, Ops: opsText, Sortable: true},
+		{Name: "like_count", Column: nftmoment.FieldLikeCount, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "comment_count", Column: nftmoment.FieldCommentCount, Type: filterInt, Ops: opsRange, Sortable: true},
+		func() filterField {
+			f := ownerAddressFilter(func(v []string) func(*sql.Selector) {
+				return nftmoment.HasOwnerWith(user.AddressIn(v...))
+			})
+			f.Legacy = "owner_address"
+			return f
+		}(),
+	},
+}
+
+// Catatan: param lama 'owner_address' di /accessories punya arti khusus (hanya yang tidak
+// sedang dipakai) sehingga tetap ditangani di handler; 'filter[owner_address]' adalah filter biasa.
+var accessoryFilters = &entityFilters{
+	Keyset: keysetByID,
+	Fields: []filterField{
+		{Name: "id", Column: nftaccessory.FieldID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "nft_id", Column: nftaccessory.FieldNftID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "name", Column: nftaccessory.FieldName, Type: filterString, Ops: opsText, Sortable: true},
+		{Name: "equipment_type", Column: nftaccessory.FieldEquipmentType, Type: filterString, Ops: opsText, Sortable: true},
+		ownerAddressFilter(func(v []string) func(*sql.Selector) {
+			return nftaccessory.HasOwnerWith(user.AddressIn(v...))
+		}),
+	},
+}
+
+var eventFilters = &entityFilters{
+	Keyset: keysetByStartDate,
+	Fields: []filterField{
+		{Name: "id", Column: event.FieldID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "event_id", Column: event.FieldEventID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "name", Column: event.FieldName, Type: filterString, Ops: opsText, Sortable: true},
+		{Name: "event_type", Column: event.FieldEventType, Type: filterInt, Ops: opsEquality, Sortable: true, Legacy: "type"},
+		{Name: "location", Column: event.FieldLocation, Type: filterString, Ops: opsText},
+		{Name: "start_date", Column: event.FieldStartDate, Type: filterTime, Ops: opsRange, Sortable: true},
+		{Name: "end_date", Column: event.FieldEndDate, Type: filterTime, Ops: opsRange, Sortable: true},
+		{Name: "quota", Column: event.FieldQuota, Type: filterInt, Ops: opsRange, Sortable: true},
+		{
+			Name: "host_address", Type: filterString, Ops: opsRelation,
+			Relation: func(v []string) func(*sql.Selector) {
+				return event.HasHostWith(user.AddressIn(v...))
+			},
+		},
+	},
+}
+
+var eventPassFilters = &entityFilters{
+	Keyset: keysetByID,
+	Fields: []filterField{
+		{Name: "id", Column: eventpass.FieldID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "pass_id", Column: eventpass.FieldPassID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "name", Column: eventpass.FieldName, Type: filterString, Ops: opsText, Sortable: true},
+		{Name: "event_type", Column: eventpass.FieldEventType, Type: filterInt, Ops: opsEquality, Sortable: true},
+		{Name: "is_used", Column: eventpass.FieldIsUsed, Type: filterBool, Ops: []filterOp{opEQ}},
+		{
+			Name: "event_id", Type: filterString, Ops: opsRelation,
+			Relation: func(v []string) func(*sql.Selector) {
+				ids := make([]uint64, 0, len(v))
+				for _, s := range v {
+					if id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64); err == nil {
+						ids = append(ids, id)
+					}
+				}
+				return eventpass.HasEventWith(event.EventIDIn(ids...))
+			},
+		},
+		func() filterField {
+			f := ownerAddressFilter(func(v []string) func(*sql.Selector) {
+				return eventpass.HasOwnerWith(user.AddressIn(v...))
+			})
+			f.Legacy = "owner_address"
+			return f
+		}(),
+	},
+}
+
+var listingFilters = &entityFilters{
+	Keyset: keysetByID,
+	Fields: []filterField{
+		{Name: "id", Column: listing.FieldID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "listing_id", Column: listing.FieldListingID, Type: filterInt, Ops: opsRange, Sortable: true},
+		{Name: "price", Column: listing.FieldPrice, Type: filterFloat, Ops: opsRange, Sortable: true},
+		{Name: "payment_vault_type", Column: listing.FieldPaymentVaultType, Type: filterString, Ops: opsEquality},
+		{Name: "nft_type_id", Column: listing.FieldNftTypeID, Type: filterString, Ops: opsEquality},
+		{Name: "expiry", Column: listing.FieldExpiry, Type: filterTime, Ops: opsRange, Sortable: true},
+		{
+			Name: "seller_address", Type: filterString, Ops: opsRelation, Legacy: "seller_address",
+			Relation: func(v []string) func(*sql.Selector) {
+				return listing.HasSellerWith(user.AddressIn(v...))
+			},
+		},
+	},
+}
+
+var userFilters = &entityFilters{
+	Keyset: keysetByID,
+	Fields: []filterField{
+		{Name: "id", Column: user.FieldID, Type: filterInt, Ops: opsRange, Sortable: true},
+		// Param lama '?address=' = pencarian sebagian alamat
+		{Name: "address", Column: user.FieldAddress, Type: filterString, Ops: opsText, Sortable: true, Legacy: "address", LegacyOp: opContains},
+		{Name: "nickname", Column: user.FieldNickname, Type: filterString, Ops: opsText, Sortable: true},
+		{Name: "is_free_minted", Column: user.FieldIsFreeMinted, Type: filterBool, Ops: []filterOp{opEQ}},
+	},
+}
+
+// Komentar hanya mendukung pagination (tanpa filter/sort kustom).
+var commentFilters = &entityFilters{Keyset: keysetByCreatedAt}
+
+// Notifikasi user memakai '?unread=' (tanpa filter/sort kustom).
+var notificationFilters = &entityFilters{Keyset: keysetByCreatedAt}
+
+// Event series memakai '?host=' & '?status=' (tanpa filter/sort kustom).
+var seriesFilters = &entityFilters{Keyset: keysetByCreatedAt}
+
+// Pencarian user memakai 'q' (tanpa filter/sort kustom).
+var userSearchFilters = &entityFilters{Keyset: keysetByID}
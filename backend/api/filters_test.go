@@ -0,0 +1,156 @@
+package main
+
+import (
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+func TestParseFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    url.Values
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:    "tanpa filter",
+			query:   url.Values{"page": {"2"}},
+			wantSQL: `SELECT * FROM "events"`,
+		},
+		{
+			name:     "eq (operator default)",
+			query:    url.Values{"filter[event_type]": {"1"}},
+			wantSQL:  `SELECT * FROM "events" WHERE "events"."event_type" = $1`,
+			wantArgs: []any{int64(1)},
+		},
+		{
+			name:     "in",
+			query:    url.Values{"filter[event_type][in]": {"0, 1"}},
+			wantSQL:  `SELECT * FROM "events" WHERE "events"."event_type" IN ($1, $2)`,
+			wantArgs: []any{int64(0), int64(1)},
+		},
+		{
+			name:     "gte tanggal",
+			query:    url.Values{"filter[start_date][gte]": {"2026-01-01"}},
+			wantSQL:  `SELECT * FROM "events" WHERE "events"."start_date" >= $1`,
+			wantArgs: []any{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "lt RFC3339",
+			query:    url.Values{"filter[end_date][lt]": {"2026-03-08T10:00:00+07:00"}},
+			wantSQL:  `SELECT * FROM "events" WHERE "events"."end_date" < $1`,
+			wantArgs: []any{time.Date(2026, 3, 8, 3, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "contains",
+			query:    url.Values{"filter[name][contains]": {"Jakarta"}},
+			wantSQL:  `SELECT * FROM "events" WHERE "events"."name" ILIKE $1`,
+			wantArgs: []any{"%jakarta%"},
+		},
+		{
+			name:     "param lama 'type'",
+			query:    url.Values{"type": {"0"}},
+			wantSQL:  `SELECT * FROM "events" WHERE "events"."event_type" = $1`,
+			wantArgs: []any{int64(0)},
+		},
+		{
+			name:    "param lama tidak valid diabaikan",
+			query:   url.Values{"type": {"online"}},
+			wantSQL: `SELECT * FROM "events"`,
+		},
+		{
+			name:     "nilai berulang digabung dengan AND",
+			query:    url.Values{"filter[quota][gt]": {"10", "20"}},
+			wantSQL:  `SELECT * FROM "events" WHERE "events"."quota" > $1 AND "events"."quota" > $2`,
+			wantArgs: []any{int64(10), int64(20)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preds, err := eventFilters.parseFilters(newTestContext(tt.query))
+			if err != nil {
+				t.Fatalf("parseFilters: %v", err)
+			}
+			s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("events"))
+			for _, p := range preds {
+				p(s)
+			}
+			query, args := s.Query()
+			if query != tt.wantSQL {
+				t.Errorf("SQL = %s\ningin %s", query, tt.wantSQL)
+			}
+			if len(args) != len(tt.wantArgs) {
+				t.Fatalf("args = %v, ingin %v", args, tt.wantArgs)
+			}
+			for i := range args {
+				if at, ok := args[i].(time.Time); ok {
+					if wt, ok := tt.wantArgs[i].(time.Time); !ok || !at.Equal(wt) {
+						t.Errorf("arg %d = %v, ingin %v", i, args[i], tt.wantArgs[i])
+					}
+				} else if args[i] != tt.wantArgs[i] {
+					t.Errorf("arg %d = %#v, ingin %#v", i, args[i], tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseFiltersErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{"field tidak dikenal", url.Values{"filter[status]": {"ongoing"}}},
+		{"operator tidak didukung", url.Values{"filter[event_type][gt]": {"0"}}},
+		{"operator tidak dikenal", url.Values{"filter[quota][between]": {"1"}}},
+		{"contains di field angka", url.Values{"filter[quota][contains]": {"1"}}},
+		{"nilai angka tidak valid", url.Values{"filter[quota]": {"banyak"}}},
+		{"salah satu nilai in tidak valid", url.Values{"filter[event_type][in]": {"0,x"}}},
+		{"tanggal tidak valid", url.Values{"filter[start_date][gte]": {"01-01-2026"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if preds, err := eventFilters.parseFilters(newTestContext(tt.query)); err == nil {
+				t.Fatalf("parseFilters berhasil (%d predicate), ingin error", len(preds))
+			}
+		})
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    []sortTerm
+		wantErr bool
+	}{
+		{raw: "", want: nil},
+		{raw: "name", want: []sortTerm{{Column: "name"}}},
+		{raw: "-start_date, id", want: []sortTerm{{Column: "start_date", Desc: true}, {Column: "id"}}},
+		{raw: "location", wantErr: true},     // ada di whitelist tapi tidak sortable
+		{raw: "host_address", wantErr: true}, // filter relasi
+		{raw: "-popularity", wantErr: true},  // tidak dikenal
+		{raw: "name,", wantErr: true},        // bagian kosong
+	}
+	for _, tt := range tests {
+		got, err := eventFilters.parseSort(tt.raw)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseSort(%q) = %v, ingin error", tt.raw, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseSort(%q): %v", tt.raw, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("parseSort(%q) = %v, ingin %v", tt.raw, got, tt.want)
+		}
+	}
+}
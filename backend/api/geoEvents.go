@@ -0,0 +1,238 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/swagdto"
+	"backend/utils"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/labstack/echo/v4"
+)
+
+// Pencarian event berbasis lokasi untuk /events:
+//
+//	?near=-6.2,106.8&radius_km=5         -> event dalam radius 5 km dari titik
+//	?bbox=106.7,-6.3,106.9,-6.1          -> event di dalam kotak (minLng,minLat,maxLng,maxLat)
+//
+// Hasil diurutkan dari yang terdekat (bbox: dari titik tengah kotak) dan menyertakan 'distance_km'.
+// Event online (event_type = 0) tidak punya lokasi fisik sehingga selalu dikecualikan.
+// Bounding box memakai index (lat, long); jarak persis dihitung dengan rumus haversine.
+
+const (
+	defaultGeoRadiusKm = 10.0
+	maxGeoRadiusKm     = 500.0
+	earthRadiusKm      = 6371.0
+	kmPerDegreeLat     = 111.32
+)
+
+// geoQuery adalah parameter pencarian lokasi yang sudah divalidasi.
+type geoQuery struct {
+	// Titik acuan jarak (near: titik yang diminta, bbox: titik tengah kotak)
+	lat, lng float64
+	// 0 = tanpa batas radius (mode bbox)
+	radiusKm float64
+	// Bounding box. minLng > maxLng berarti kotak melewati garis antimeridian.
+	minLat, maxLat, minLng, maxLng float64
+	// false jika kotak mencakup semua bujur (radius di dekat kutub)
+	boundLng bool
+	// true = event online ikut disertakan (tanpa jarak, diurutkan setelah event offline)
+	includeOnline bool
+}
+
+// EventListItem adalah item di /events: data event + status terhitung + jarak (hanya untuk pencarian lokasi).
+// Sengaja tidak meng-embed '*ent.Event' agar edge yang ter-load (misal: attendances) tidak ikut terkirim.
+type EventListItem struct {
+	ID                 int                `json:"id"`
+	EventID            uint64             `json:"event_id"`
+	Name               string             `json:"name"`
+	Description        string             `json:"description"`
+	Thumbnail          string             `json:"thumbnail"`
+	EventType          uint8              `json:"event_type"`
+	Location           string             `json:"location"`
+	Lat                float64            `json:"lat"`
+	Long               float64            `json:"long"`
+	StartDate          time.Time          `json:"start_date"`
+	EndDate            time.Time          `json:"end_date"`
+	Quota              uint64             `json:"quota"`
+	CheckinRadiusM     float64            `json:"checkin_radius_m"`
+	CancelledAt        *time.Time         `json:"cancelled_at,omitempty"`
+	CancelReason       string             `json:"cancel_reason,omitempty"`
+	Revision           int                `json:"revision"`
+	AttendeeVisibility string             `json:"attendee_visibility"`
+	Status             string             `json:"status"`
+	RegistrationStatus string             `json:"registration_status"`
+	RegisteredCount    int                `json:"registered_count"`
+	CheckedInCount     int                `json:"checked_in_count"`
+	DistanceKm         *float64           `json:"distance_km,omitempty"`
+	Edges              swagdto.EventEdges `json:"edges"` // Hanya 'host'
+}
+
+func parseLatLng(lat, lng string) (float64, float64, error) {
+	la, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	if err != nil || la < -90 || la > 90 {
+		return 0, 0, errors.New("latitude tidak valid")
+	}
+	lo, err := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+	if err != nil || lo < -180 || lo > 180 {
+		return 0, 0, errors.New("longitude tidak valid")
+	}
+	return la, lo, nil
+}
+
+// parseGeoQuery membaca 'near' + 'radius_km' atau 'bbox' (+ 'include_online'). Mengembalikan nil jika tidak ada.
+func parseGeoQuery(c echo.Context) (*geoQuery, error) {
+	g, err := parseGeoArea(c)
+	if err != nil || g == nil {
+		return g, err
+	}
+	if v := c.QueryParam("include_online"); v != "" {
+		if g.includeOnline, err = strconv.ParseBool(v); err != nil {
+			return nil, errors.New("include_online harus true atau false")
+		}
+	}
+	return g, nil
+}
+
+// parseGeoArea membaca area pencarian: 'near' + 'radius_km' atau 'bbox'.
+func parseGeoArea(c echo.Context) (*geoQuery, error) {
+	near, bbox := c.QueryParam("near"), c.QueryParam("bbox")
+	switch {
+	case near != "" && bbox != "":
+		return nil, errors.New("gunakan salah satu: near atau bbox")
+
+	case near != "":
+		parts := strings.Split(near, ",")
+		if len(parts) != 2 {
+			return nil, errors.New("format near: lat,lng")
+		}
+		lat, lng, err := parseLatLng(parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+		radius := defaultGeoRadiusKm
+		if v := c.QueryParam("radius_km"); v != "" {
+			radius, err = strconv.ParseFloat(v, 64)
+			if err != nil || radius <= 0 || radius > maxGeoRadiusKm {
+				return nil, fmt.Errorf("radius_km harus antara 0 dan %.0f", maxGeoRadiusKm)
+			}
+		}
+
+		g := &geoQuery{lat: lat, lng: lng, radiusKm: radius}
+		dLat := radius / kmPerDegreeLat
+		g.minLat, g.maxLat = max(lat-dLat, -90), min(lat+dLat, 90)
+		// Lebar 1 derajat bujur mengecil ke arah kutub
+		if cosLat := math.Cos(lat * math.Pi / 180); g.minLat > -90 && g.maxLat < 90 && cosLat > 0 {
+			dLng := radius / (kmPerDegreeLat * cosLat)
+			if dLng < 180 {
+				g.boundLng = true
+				g.minLng, g.maxLng = wrapLng(lng-dLng), wrapLng(lng+dLng)
+			}
+		}
+		return g, nil
+
+	case bbox != "":
+		parts := strings.Split(bbox, ",")
+		if len(parts) != 4 {
+			return nil, errors.New("format bbox: minLng,minLat,maxLng,maxLat")
+		}
+		minLat, minLng, err := parseLatLng(parts[1], parts[0])
+		if err != nil {
+			return nil, err
+		}
+		maxLat, maxLng, err := parseLatLng(parts[3], parts[2])
+		if err != nil {
+			return nil, err
+		}
+		if minLat > maxLat {
+			return nil, errors.New("bbox: minLat lebih besar dari maxLat")
+		}
+
+		g := &geoQuery{minLat: minLat, maxLat: maxLat, minLng: minLng, maxLng: maxLng, boundLng: true}
+		g.lat = (minLat + maxLat) / 2
+		if minLng <= maxLng {
+			g.lng = (minLng + maxLng) / 2
+		} else {
+			g.lng = wrapLng((minLng + maxLng + 360) / 2)
+		}
+		return g, nil
+	}
+	return nil, nil
+}
+
+// wrapLng menormalkan bujur ke rentang [-180, 180].
+func wrapLng(lng float64) float64 {
+	for lng > 180 {
+		lng -= 360
+	}
+	for lng < -180 {
+		lng += 360
+	}
+	return lng
+}
+
+// distanceSQL adalah ekspresi SQL jarak (km, haversine) dari titik acuan ke kolom lat/long event.
+// Koordinat ditulis sebagai literal (sudah di-parse sebagai float64) karena argumen di ORDER BY
+// tidak ikut dinomori oleh ent untuk Postgres.
+func (g *geoQuery) distanceSQL(s *sql.Selector) string {
+	lat, lng := s.C("lat"), s.C("long")
+	return fmt.Sprintf(
+		"%g * 2 * asin(sqrt(power(sin(radians(%s - (%g)) / 2), 2) + cos(radians(%g)) * cos(radians(%s)) * power(sin(radians(%s - (%g)) / 2), 2)))",
+		earthRadiusKm, lat, g.lat, g.lat, lat, lng, g.lng,
+	)
+}
+
+// where membangun predicate: event offline di dalam bounding box dan (mode near) di dalam radius,
+// ditambah semua event online jika 'include_online=true'.
+func (g *geoQuery) where() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		preds := []*sql.Predicate{
+			sql.NEQ(s.C("event_type"), 0),
+			sql.And(sql.GTE(s.C("lat"), g.minLat), sql.LTE(s.C("lat"), g.maxLat)),
+		}
+		if g.boundLng {
+			lng := s.C("long")
+			if g.minLng <= g.maxLng {
+				preds = append(preds, sql.And(sql.GTE(lng, g.minLng), sql.LTE(lng, g.maxLng)))
+			} else {
+				// Melewati antimeridian: dua rentang
+				preds = append(preds, sql.Or(sql.GTE(lng, g.minLng), sql.LTE(lng, g.maxLng)))
+			}
+		}
+		if g.radiusKm > 0 {
+			preds = append(preds, sql.P(func(b *sql.Builder) {
+				b.WriteString(g.distanceSQL(s) + " <= ").Arg(g.radiusKm)
+			}))
+		}
+		if g.includeOnline {
+			s.Where(sql.Or(sql.EQ(s.C("event_type"), 0), sql.And(preds...)))
+			return
+		}
+		s.Where(sql.And(preds...))
+	}
+}
+
+// order mengurutkan dari yang terdekat (id sebagai tie-breaker). Event online (jika disertakan) paling akhir.
+func (g *geoQuery) order() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if g.includeOnline {
+			s.OrderExpr(sql.Expr(s.C("event_type") + " = 0"))
+		}
+		s.OrderExpr(sql.Expr(g.distanceSQL(s)))
+		s.OrderBy(s.C("id"))
+	}
+}
+
+// distanceKm menghitung jarak (km) sebuah event dari titik acuan, untuk respon. Event online tidak punya jarak.
+func (g *geoQuery) distanceKm(ev *ent.Event) *float64 {
+	if ev.EventType == 0 {
+		return nil
+	}
+	d := math.Round(utils.HaversineMeters(g.lat, g.lng, ev.Lat, ev.Long)) / 1000
+	return &d
+}
@@ -10,18 +10,23 @@ import (
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
+	"backend/ent/predicate"
 	"backend/ent/user"
 	"backend/swagdto"
 	"backend/transactions"
 	"backend/utils"
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"log"
-	"math"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -31,14 +36,19 @@ import (
 // untuk "menyuntikkan" (inject) koneksi database 'ent' kita
 // ke dalam fungsi-fungsi API kita.
 type Handler struct {
-	DB *ent.Client
+	DB  *ent.Client
+	SQL *sql.DB // Koneksi mentah untuk query khusus Postgres (misal: full-text search)
+	// Geocoder alamat -> koordinat (import event)
+	Geocoder utils.Geocoder
 }
 
 type Pagination struct {
-	TotalItems  int `json:"totalItems"`
-	TotalPages  int `json:"totalPages"`
-	CurrentPage int `json:"currentPage"`
-	PageSize    int `json:"pageSize"`
+	TotalItems  int    `json:"totalItems"`
+	TotalPages  int    `json:"totalPages"`
+	CurrentPage int    `json:"currentPage"`
+	PageSize    int    `json:"pageSize"`
+	NextCursor  string `json:"nextCursor,omitempty"`
+	PrevCursor  string `json:"prevCursor,omitempty"`
 }
 
 // APIResponse adalah "bungkusan" standar kita.
@@ -57,8 +67,8 @@ type MomentResponse struct {
 }
 
 type GetEventsResponse struct {
-	Data       []*ent.Event `json:"data"`       // <-- Tipe data spesifik
-	Pagination *Pagination  `json:"pagination"` // <-- Pagination Anda
+	Data       []*EventListItem `json:"data"`       // <-- Tipe data spesifik
+	Pagination *Pagination      `json:"pagination"` // <-- Pagination Anda
 }
 
 func getPagination(c echo.Context) (limit, offset, page, pageSize int) {
@@ -92,6 +102,9 @@ func getPagination(c echo.Context) (limit, offset, page, pageSize int) {
 // @Param       owner_address query    string  false  "Filter berdasarkan alamat pemilik (misal: 0x...)"
 // @Param       page          query    int     false  "Nomor Halaman (default: 1)"
 // @Param       pageSize      query    int     false  "Jumlah item per halaman (default: 20)"
+// @Param       cursor     query    string  false  "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor"
+// @Param       withTotal  query    bool    false  "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)"
+// @Param       sort       query    string  false  "Urutan, misal: '-start_date,name' ('-' = DESC)"
 // @Success     200 {object} swagdto.GetMomentsResponse "Daftar momen berhasil diambil"
 // @Failure     404 {object} swagdto.Response404 "User (pemilik) tidak ditemukan"
 // @Failure     500 {object} APIResponse "Internal Server Error"
@@ -99,46 +112,28 @@ func getPagination(c echo.Context) (limit, offset, page, pageSize int) {
 func (h *Handler) getMoments(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	// 1. Dapatkan parameter pagination
-	limit, offset, page, pageSize := getPagination(c)
+	// 1. Dapatkan parameter pagination (offset atau cursor)
+	page, err := newListPage(c, momentFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
 
 	// 2. Siapkan query dasar
 	query := h.DB.NFTMoment.Query()
 
-	// 3. Terapkan Filter (jika ada)
-	//    Cek apakah ada filter 'owner_address' di URL
-	ownerAddress := c.QueryParam("owner_address")
-	if ownerAddress != "" {
-		// Jika ada, tambahkan 'Where' clause (filter) ke query
-		query = query.Where(
-			// Filter berdasarkan relasi 'owner'
-			nftmoment.HasOwnerWith(user.AddressEQ(ownerAddress)),
-		)
-	}
-
-	// Filter by NFT ID
-	nftIDStr := c.QueryParam("nft_id")
-	if nftIDStr != "" {
-		nftID, err := strconv.ParseUint(nftIDStr, 10, 64)
-		if err == nil {
-			query = query.Where(nftmoment.NftIDEQ(nftID))
-		}
+	// 3. Terapkan Filter (jika ada): 'filter[...]' + param lama 'owner_address' & 'nft_id'
+	if f := page.filter(); f != nil {
+		query = query.Where(predicate.NFTMoment(f))
 	}
-	// ---
 
-	// 4. Hitung total item (setelah filter diterapkan)
-	totalItems, err := query.Count(ctx)
-	if err != nil {
+	// 4. Hitung total item (opsional, setelah filter diterapkan)
+	if err := page.count(ctx, query.Count); err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
 
-	// 5. Buat Metadata Pagination
-	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
-	pagination := &Pagination{
-		TotalItems:  totalItems,
-		TotalPages:  totalPages,
-		CurrentPage: page,
-		PageSize:    pageSize,
+	// 5. Terapkan cursor (mode keyset)
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.NFTMoment(w))
 	}
 
 	// 6. Jalankan Query UTAMA dengan Limit/Offset
@@ -146,14 +141,15 @@ func (h *Handler) getMoments(c echo.Context) error {
 		WithOwner().
 		WithEquippedAccessories(). // <-- 'Preload' data aksesoris yang terpasang
 		WithMintedWithPass().      // <-- 'Preload' data EventPass yang digunakan
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc("id")). // Urutkan dari yang terbaru
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(nftmoment.OrderOption(page.order())). // Urutkan dari yang terbaru
 		All(ctx)
 
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	moments, pagination := finishPage(page, moments, func(m *ent.NFTMoment) (any, int) { return m.ID, m.ID })
 
 	// 6.5 Cek 'IsLiked' jika ada viewer
 	viewerAddress := c.QueryParam("viewer")
@@ -227,6 +223,9 @@ func (h *Handler) getMoments(c echo.Context) error {
 // @Param       owner_address query    string  false  "Filter berdasarkan alamat pemilik (misal: 0x...)"
 // @Param       page          query    int     false  "Nomor Halaman (default: 1)"
 // @Param       pageSize      query    int     false  "Jumlah item per halaman (default: 20)"
+// @Param       cursor     query    string  false  "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor"
+// @Param       withTotal  query    bool    false  "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)"
+// @Param       sort       query    string  false  "Urutan, misal: '-start_date,name' ('-' = DESC)"
 // @Success     200 {object} swagdto.GetAccessoriesResponse "Daftar aksesori berhasil diambil"
 // @Failure     404 {object} swagdto.Response404 "404 Not Found"
 // @Failure     500 {object} APIResponse "Internal Server Error"
@@ -234,11 +233,17 @@ func (h *Handler) getMoments(c echo.Context) error {
 func (h *Handler) getAccessories(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	// 1. Dapatkan parameter pagination
-	limit, offset, page, pageSize := getPagination(c)
+	// 1. Dapatkan parameter pagination (offset atau cursor)
+	page, err := newListPage(c, accessoryFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
 
 	// 2. Siapkan query dasar
 	query := h.DB.NFTAccessory.Query()
+	if f := page.filter(); f != nil {
+		query = query.Where(predicate.NFTAccessory(f))
+	}
 
 	// 3. --- INI ADALAH LOGIKA BARU ANDA ---
 	//    Cek apakah ada filter 'owner_address' di URL
@@ -254,19 +259,14 @@ func (h *Handler) getAccessories(c echo.Context) error {
 	}
 	// --- AKHIR LOGIKA BARU ---
 
-	// 4. Hitung total item (setelah filter diterapkan)
-	totalItems, err := query.Count(ctx)
-	if err != nil {
+	// 4. Hitung total item (opsional, setelah filter diterapkan)
+	if err := page.count(ctx, query.Count); err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
 
-	// 5. Buat Metadata Pagination
-	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
-	pagination := &Pagination{
-		TotalItems:  totalItems,
-		TotalPages:  totalPages,
-		CurrentPage: page,
-		PageSize:    pageSize,
+	// 5. Terapkan cursor (mode keyset)
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.NFTAccessory(w))
 	}
 
 	// 6. Jalankan Query UTAMA dengan Limit/Offset
@@ -274,14 +274,15 @@ func (h *Handler) getAccessories(c echo.Context) error {
 		WithOwner().
 		WithEquippedOnMoment().
 		WithListing().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc("id")). // Urutkan dari yang terbaru
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(nftaccessory.OrderOption(page.order())). // Urutkan dari yang terbaru
 		All(ctx)
 
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	accessories, pagination := finishPage(page, accessories, func(a *ent.NFTAccessory) (any, int) { return a.ID, a.ID })
 
 	// 7. Kembalikan Respon Standar (Terbungkus)
 	response := APIResponse{
@@ -292,11 +293,17 @@ func (h *Handler) getAccessories(c echo.Context) error {
 }
 
 func (h *Handler) handleUGCUpload(c echo.Context) (string, error) {
+	return h.handleFormFileUpload(c, "thumbnail")
+}
+
+// handleFormFileUpload sama seperti 'handleUGCUpload', tapi nama field
+// form-nya bisa ditentukan (misal: 'eventPassImg').
+func (h *Handler) handleFormFileUpload(c echo.Context, fieldName string) (string, error) {
 	// 1. Ambil data FILE dari form multipart
-	file, err := c.FormFile("thumbnail")
+	file, err := c.FormFile(fieldName)
 	if err != nil {
-		log.Println("Error mengambil form file 'thumbnail':", err)
-		return "", fmt.Errorf("file 'thumbnail' wajib ada")
+		log.Printf("Error mengambil form file '%s': %v", fieldName, err)
+		return "", fmt.Errorf("file '%s' wajib ada", fieldName)
 	}
 
 	// 2. Buka file yang di-upload
@@ -357,10 +364,14 @@ func (h *Handler) handleUGCUpload(c echo.Context) (string, error) {
 // @Tags        Upload
 // @Accept      multipart/form-data
 // @Produce     json
+// @Security    BearerAuth
+// @Param       Idempotency-Key header string false "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama"
 // @Param       file formData file true "File gambar (JPG/PNG/WEBP)"
 // @Success     200 {object} map[string]string "Upload sukses, returns URL"
 // @Failure     400 {object} APIResponse "File tidak valid"
 // @Failure     500 {object} APIResponse "Upload gagal"
+// @Failure     429 {object} APIResponse "Terlalu banyak request (lihat header Retry-After)"
+// @Failure     422 {object} APIResponse "Idempotency-Key sudah dipakai untuk request berbeda"
 // @Router      /upload [post]
 func (h *Handler) uploadImage(c echo.Context) error {
 	// Panggil helper untuk upload
@@ -377,50 +388,78 @@ func (h *Handler) uploadImage(c echo.Context) error {
 
 // @Summary     Mint NFT Moment (Gratis)
 // @Description Minting 'NFTMoment' (UGC) gratis. Endpoint ini menerima 'multipart/form-data'.
+// @Description Memakai 1 kuota free mint (default: global, atau per event/campaign jika 'eventID'/'campaign' diisi).
+// @Description Jika kuota global sudah habis, 1 mint credit (hadiah referral) dipakai.
 // @Tags        Moments
 // @Accept      multipart/form-data
 // @Produce     json
-// @Param       recipient   formData string true "Alamat Flow penerima (misal: 0x...)"
+// @Security    BearerAuth
+// @Param       Idempotency-Key header string false "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama"
 // @Param       name        formData string true "Nama untuk NFT Moment"
 // @Param       description formData string false "Deskripsi untuk NFT Moment"
+// @Param       eventID     formData string false "Pakai kuota free mint event ini (harus terdaftar)"
+// @Param       campaign    formData string false "Pakai kuota free mint campaign ini"
 // @Param       thumbnail   formData file   true "File gambar (JPG/PNG/WEBP) untuk Momen UGC"
 // @Success     201 {object} swagdto.MintResponse "Minting sukses"
-// @Failure     400 {object} APIResponse "Input tidak valid (field wajib hilang)"
+// @Failure     400 {object} APIResponse "Input tidak valid / kuota habis"
 // @Failure     500 {object} APIResponse "Internal Server Error (upload/transaksi gagal)"
+// @Failure     429 {object} APIResponse "Terlalu banyak request (lihat header Retry-After)"
+// @Failure     422 {object} APIResponse "Idempotency-Key sudah dipakai untuk request berbeda"
 // @Router      /moment/free [post]
 func (h *Handler) freeMintMoment(c echo.Context) error {
-	// 1. Ambil data TEKS
-	recipient := c.FormValue("recipient")
+	// 1. Ambil data TEKS (penerima = user yang sedang login)
+	recipient := sessionAddress(c)
 	name := c.FormValue("name")
 	description := c.FormValue("description")
 
 	// 2. Validasi
-	if recipient == "" || name == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "recipient dan name adalah field wajib"})
+	if name == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "name adalah field wajib"})
 	}
 
-	// 2.5. Cek apakah user sudah pernah free mint
-	user, err := h.DB.User.Query().Where(user.AddressEQ(recipient)).Only(c.Request().Context())
+	// 2.5. User harus sudah punya profil
+	ctx := c.Request().Context()
+	exists, err := h.DB.User.Query().Where(user.AddressEQ(recipient)).Exist(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			// Jika user belum ada, kita bisa buatkan (atau return error suruh register dulu)
-			// Untuk amannya, kita return error agar user register/login dulu
-			return c.JSON(http.StatusBadRequest, map[string]string{"error": "User not found. Please login/register first."})
-		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
+	if !exists {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "User not found. Please login/register first."})
+	}
 
-	if user.IsFreeMinted {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "You have already used your free mint quota."})
+	// 2.6. Pesan kuota free mint secara atomik SEBELUM upload & transaksi,
+	// agar request paralel tidak bisa mint melebihi kuota.
+	scope, err := h.freeMintClaimScope(c, recipient)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	// Jika kuota global habis, mint credit (hadiah referral) dipakai
+	grant, err := h.reserveFreeMint(ctx, scope, recipient)
+	if err != nil {
+		if errors.Is(err, errClaimQuotaExhausted) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "You have already used your free mint quota."})
+		}
+		if errors.Is(err, errClaimPoolExhausted) || errors.Is(err, errClaimNoQuota) || errors.Is(err, errClaimNotActive) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
 	// 3. Panggil helper untuk 'pekerjaan kotor' (upload)
 	thumbnailUrl, err := h.handleUGCUpload(c)
 	if err != nil {
+		h.releaseFreeMint(ctx, grant)
 		// handleUGCUpload sudah mem-format error-nya
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
+	// 3.5. Catat thumbnail di reservasi: jika server mati setelah transaksi terkirim,
+	// reservasi bisa dicocokkan dengan moment yang ter-indeks
+	if err := h.setFreeMintReference(ctx, grant, thumbnailUrl); err != nil {
+		h.releaseFreeMint(ctx, grant)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
 	// 4. Panggil transaksi
 	err = transactions.FreeMintNFTMoment(
 		recipient,
@@ -429,16 +468,20 @@ func (h *Handler) freeMintMoment(c echo.Context) error {
 		thumbnailUrl,
 	)
 	if err != nil {
+		h.releaseFreeMint(ctx, grant)
 		log.Printf("Gagal menjalankan transaksi mint: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
-	// 4.5. Update status free mint user
-	_, err = user.Update().SetIsFreeMinted(true).Save(c.Request().Context())
-	if err != nil {
-		log.Printf("Gagal update status free mint user %s: %v", recipient, err)
+	// 4.5. Transaksi sudah seal: commit klaim/credit (+ cermin ke flag legacy 'is_free_minted')
+	saveCtx := context.WithoutCancel(ctx)
+	if err := h.commitFreeMint(saveCtx, grant, thumbnailUrl); err != nil {
+		log.Printf("Gagal commit klaim free mint user %s: %v", recipient, err)
 		// Kita tidak return error ke user karena minting on-chain sudah sukses
 	}
+	if scope == claimScopeGlobal {
+		h.syncLegacyFreeMintFlag(saveCtx, recipient)
+	}
 
 	// 5. Kirim respon sukses
 	return c.JSON(http.StatusCreated, map[string]string{
@@ -454,7 +497,8 @@ func (h *Handler) freeMintMoment(c echo.Context) error {
 // @Tags        Moments
 // @Accept      multipart/form-data
 // @Produce     json
-// @Param       recipient   formData string true "Alamat Flow penerima (misal: 0x...)"
+// @Security    BearerAuth
+// @Param       Idempotency-Key header string false "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama"
 // @Param       eventPassID formData string true "ID dari EventPass (SBT) yang akan digunakan"
 // @Param       tier        formData string false "Tingkatan (tier) dari Momen 0 untuk community 1 untuk pro"
 // @Param       name        formData string true "Nama untuk NFT Moment"
@@ -463,10 +507,12 @@ func (h *Handler) freeMintMoment(c echo.Context) error {
 // @Success     201 {object} swagdto.MintResponse "Minting sukses"
 // @Failure     400 {object} APIResponse "Input tidak valid (field wajib hilang)"
 // @Failure     500 {object} APIResponse "Internal Server Error (upload/transaksi gagal)"
+// @Failure     429 {object} APIResponse "Terlalu banyak request (lihat header Retry-After)"
+// @Failure     422 {object} APIResponse "Idempotency-Key sudah dipakai untuk request berbeda"
 // @Router      /moment/with-event-pass [post]
 func (h *Handler) mintMomentWithEventPass(c echo.Context) error {
-	// 1. Ambil data TEKS
-	recipient := c.FormValue("recipient")
+	// 1. Ambil data TEKS (penerima = user yang sedang login)
+	recipient := sessionAddress(c)
 	eventPassID := c.FormValue("eventPassID")
 	tier := c.FormValue("tier")
 	if tier == "" {
@@ -476,8 +522,8 @@ func (h *Handler) mintMomentWithEventPass(c echo.Context) error {
 	description := c.FormValue("description")
 
 	// 2. Validasi
-	if recipient == "" || name == "" || eventPassID == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "recipient, name, dan eventPassID adalah field wajib"})
+	if name == "" || eventPassID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "name dan eventPassID adalah field wajib"})
 	}
 
 	// 3. Panggil helper untuk 'pekerjaan kotor' (upload)
@@ -517,54 +563,53 @@ func (h *Handler) mintMomentWithEventPass(c echo.Context) error {
 // @Param       seller_address query    string  false  "Filter berdasarkan alamat penjual (misal: 0x...)"
 // @Param       page           query    int     false  "Nomor Halaman (default: 1)"
 // @Param       pageSize       query    int     false  "Jumlah item per halaman (default: 20)"
+// @Param       cursor     query    string  false  "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor"
+// @Param       withTotal  query    bool    false  "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)"
+// @Param       sort       query    string  false  "Urutan, misal: '-start_date,name' ('-' = DESC)"
 // @Success     200 {object} swagdto.GetListingsResponse "Daftar penjualan berhasil diambil"
 // @Failure     500 {object} APIResponse "Internal Server Error"
 // @Router      /listings [get]
 func (h *Handler) getListings(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	// 1. Dapatkan parameter pagination
-	limit, offset, page, pageSize := getPagination(c)
+	// 1. Dapatkan parameter pagination (offset atau cursor)
+	page, err := newListPage(c, listingFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
 
 	// 2. Siapkan query dasar
 	query := h.DB.Listing.Query()
 
-	// 3. Terapkan Filter (jika ada)
-	sellerAddress := c.QueryParam("seller_address")
-	if sellerAddress != "" {
-		query = query.Where(
-			listing.HasSellerWith(user.AddressEQ(sellerAddress)),
-		)
+	// 3. Terapkan Filter (jika ada): 'filter[...]' + param lama 'seller_address'
+	if f := page.filter(); f != nil {
+		query = query.Where(predicate.Listing(f))
 	}
 
-	// 4. HITUNG TOTAL ITEM (PENTING!)
-	// Jalankan query COUNT() SEBELUM Limit/Offset
-	totalItems, err := query.Count(ctx)
-	if err != nil {
+	// 4. HITUNG TOTAL ITEM (opsional)
+	// Jalankan query COUNT() SEBELUM Limit/Offset & cursor
+	if err := page.count(ctx, query.Count); err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
 
-	// 5. Buat Metadata Pagination
-	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
-	pagination := &Pagination{
-		TotalItems:  totalItems,
-		TotalPages:  totalPages,
-		CurrentPage: page,
-		PageSize:    pageSize,
+	// 5. Terapkan cursor (mode keyset)
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.Listing(w))
 	}
 
 	// 6. Jalankan Query UTAMA dengan Limit/Offset
 	listings, err := query.
 		WithSeller().
 		WithNftAccessory().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc("id")).
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(listing.OrderOption(page.order())).
 		All(ctx)
 
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	listings, pagination := finishPage(page, listings, func(l *ent.Listing) (any, int) { return l.ID, l.ID })
 
 	// 7. Kembalikan Respon Standar (Terbungkus)
 	response := APIResponse{
@@ -582,73 +627,149 @@ func (h *Handler) getListings(c echo.Context) error {
 // @Param       type       query    int     false  "Filter berdasarkan Tipe Event (0 = Online, 1 = Offline)"
 // @Param       page       query    int     false  "Nomor Halaman (default: 1)"
 // @Param       pageSize   query    int     false  "Jumlah item per halaman (default: 20)"
+// @Param       cursor     query    string  false  "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor"
+// @Param       withTotal  query    bool    false  "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)"
+// @Param       sort       query    string  false  "Urutan, misal: '-start_date,name' ('-' = DESC)"
+// @Param       near       query    string  false  "Cari di sekitar titik 'lat,lng' (diurutkan dari yang terdekat, event online dikecualikan kecuali include_online=true)"
+// @Param       radius_km  query    number  false  "Radius untuk 'near' dalam km (default: 10, maks: 500)"
+// @Param       bbox       query    string  false  "Cari di dalam kotak 'minLng,minLat,maxLng,maxLat' (diurutkan dari titik tengah)"
+// @Param       include_online query bool  false  "Sertakan event online pada pencarian near/bbox (tanpa distance_km, di urutan akhir; default: false)"
+// @Param       from       query    string  false  "Event yang belum selesai pada waktu ini (RFC3339 atau YYYY-MM-DD)"
+// @Param       to         query    string  false  "Event yang sudah mulai sebelum waktu ini (RFC3339 atau YYYY-MM-DD, tanggal = sampai akhir hari)"
+// @Param       status     query    string  false  "Filter status (dipisah koma): upcoming, registration_open, full, ongoing, ended"
 // @Success     200 {object} swagdto.GetEventsResponse "Daftar event berhasil diambil"
+// @Failure     400 {object} APIResponse "Parameter tidak valid"
 // @Failure     500 {object} APIResponse "Internal Server Error"
 // @Router      /events [get]
 func (h *Handler) getEvents(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	// 1. Dapatkan parameter pagination lengkap
-	limit, offset, page, pageSize := getPagination(c)
+	// 1. Dapatkan parameter pagination lengkap (offset atau cursor)
+	page, err := newListPage(c, eventFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+	geo, err := parseGeoQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+	if geo != nil && page.cursorMode {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Pencarian lokasi hanya didukung di mode offset (tanpa cursor)"})
+	}
 
 	// 2. Siapkan query dasar
 	query := h.DB.Event.Query()
 
-	// 3. Terapkan Filter (kode Anda sudah benar)
-	eventTypeParam := c.QueryParam("type")
-	if eventTypeParam != "" {
-		eventType, err := strconv.Atoi(eventTypeParam)
-		if err == nil { // Abaikan jika 'type' bukan angka
-			query = query.Where(event.EventTypeEQ(uint8(eventType)))
+	// 3. Terapkan Filter: 'filter[...]' + param lama 'type' (= filter[event_type])
+	if f := page.filter(); f != nil {
+		query = query.Where(predicate.Event(f))
+	}
+	// Rentang waktu & status (from/to/status)
+	now := time.Now()
+	timeFilter, err := eventTimeFilter(c, now)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+	if timeFilter != nil {
+		query = query.Where(predicate.Event(timeFilter))
+	}
+	// Pencarian lokasi (near/bbox)
+	order := event.OrderOption(page.order()) // Urutkan dari yang paling baru
+	if geo != nil {
+		query = query.Where(predicate.Event(geo.where()))
+		if page.sort == nil {
+			order = event.OrderOption(geo.order()) // Urutkan dari yang terdekat
 		}
 	}
-	// (Anda bisa menambahkan filter lain di sini)
 
-	// 4. HITUNG TOTAL ITEM (PENTING!)
-	// Jalankan query COUNT() SEBELUM Limit/Offset
-	totalItems, err := query.Count(ctx)
-	if err != nil {
+	// 4. HITUNG TOTAL ITEM (opsional)
+	// Jalankan query COUNT() SEBELUM Limit/Offset & cursor
+	if err := page.count(ctx, query.Count); err != nil {
 		// Gunakan 'APIResponse' untuk error
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
 
-	// 5. Buat Metadata Pagination
-	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
-	pagination := &Pagination{
-		TotalItems:  totalItems,
-		TotalPages:  totalPages,
-		CurrentPage: page,
-		PageSize:    pageSize,
+	// 5. Terapkan cursor (mode keyset)
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.Event(w))
 	}
 
 	// 6. Jalankan Query UTAMA dengan Limit/Offset
 	events, err := query.
 		WithHost(). // Ambil data 'User' (host)
-		WithAttendances().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc("start_date")). // Urutkan dari yang paling baru
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(order).
 		All(ctx)
 
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	events, pagination := finishPage(page, events, func(e *ent.Event) (any, int) { return e.StartDate, e.ID })
 
-	// 7. Kembalikan Respon Standar (Terbungkus)
+	// 7. Jumlah registrasi & check-in dihitung dengan satu query agregat (tanpa me-load Attendance)
+	ids := make([]int, len(events))
+	for i, ev := range events {
+		ids[i] = ev.ID
+	}
+	counts, err := h.eventAttendanceCounts(ctx, ids)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 8. Kembalikan Respon Standar (Terbungkus), dengan status terhitung dan jarak jika pencarian lokasi
+	items := make([]*EventListItem, len(events))
+	for i, ev := range events {
+		lc := eventLifecycleFromCounts(ev, counts[ev.ID], now)
+		items[i] = &EventListItem{
+			ID:                 ev.ID,
+			EventID:            ev.EventID,
+			Name:               ev.Name,
+			Description:        ev.Description,
+			Thumbnail:          ev.Thumbnail,
+			EventType:          ev.EventType,
+			Location:           ev.Location,
+			Lat:                ev.Lat,
+			Long:               ev.Long,
+			StartDate:          ev.StartDate,
+			EndDate:            ev.EndDate,
+			Quota:              ev.Quota,
+			CheckinRadiusM:     ev.CheckinRadiusM,
+			CancelledAt:        ev.CancelledAt,
+			CancelReason:       ev.CancelReason,
+			Revision:           ev.Revision,
+			AttendeeVisibility: string(ev.AttendeeVisibility),
+			Status:             lc.Status,
+			RegistrationStatus: lc.RegistrationStatus,
+			RegisteredCount:    lc.RegisteredCount,
+			CheckedInCount:     lc.CheckedInCount,
+		}
+		if host := ev.Edges.Host; host != nil {
+			items[i].Edges.Host = &swagdto.HostResponse{ID: host.ID, Address: host.Address}
+		}
+		if geo != nil {
+			items[i].DistanceKm = geo.distanceKm(ev)
+		}
+	}
 	response := GetEventsResponse{
-		Data:       events,
+		Data:       items,
 		Pagination: pagination,
 	}
 	return c.JSON(http.StatusOK, response)
 }
 
 // @Summary     Ambil Detail Event
-// @Description Mengambil satu event berdasarkan 'event_id' (ID on-chain).
+// @Description Mengambil satu event berdasarkan 'event_id' (ID on-chain), termasuk status terkini dan riwayat perubahan oleh host.
+// @Description Login opsional: 'is_registered'/'is_checked_in' dihitung untuk user yang login, dan daftar peserta
+// @Description ('edges.attendances') mengikuti 'attendee_visibility' event & pilihan privasi peserta.
+// @Description Pengunjung anonim hanya mendapat jumlah peserta.
+// @Description Deprecated: '?viewer=' tanpa login hanya mengisi 'is_registered'/'is_checked_in' jika registrasinya publik.
 // @Tags        Events
 // @Accept      json
 // @Produce     json
+// @Security    BearerAuth
 // @Param       id   path      int  true  "Event ID (On-Chain ID)"
-// @Param       viewer   query      string  false  "userAddress"
+// @Param       viewer query    string false "Deprecated: alamat viewer untuk client yang belum login"
 // @Success     200 {object} APIResponse{data=swagdto.EventResponse} "Detail event"
 // @Failure     404 {object} APIResponse "Event tidak ditemukan"
 // @Failure     500 {object} APIResponse "Internal Server Error"
@@ -656,7 +777,11 @@ func (h *Handler) getEvents(c echo.Context) error {
 func (h *Handler) getEventByID(c echo.Context) error {
 	ctx := c.Request().Context()
 	idStr := c.Param("id")
-	viewerAddress := c.QueryParam("viewer")
+
+	// '/events/:id.ics' -> feed iCalendar (Echo tidak mendukung akhiran pada parameter route)
+	if id, ok := strings.CutSuffix(idStr, ".ics"); ok {
+		return h.getEventICS(c, id)
+	}
 
 	// 1. Parse ID
 	eventID, err := strconv.ParseUint(idStr, 10, 64)
@@ -680,28 +805,24 @@ func (h *Handler) getEventByID(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
 
-	isRegistered := false
-	isCheckedIn := false
+	// Viewer diambil dari sesi login (bukan query param) agar status registrasi user lain tidak bisa dicek
+	viewerAddress := h.viewerAddress(c)
+	access, err := h.eventAttendeeAccess(ctx, ev, viewerAddress)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
 
-	// Hanya cek jika viewerAddress dikirim
-	if viewerAddress != "" {
-		// Cek apakah ada 'Attendance' yang menghubungkan Event ini dengan User ini
-		att, err := h.DB.Attendance.Query().
-			Where(
-				attendance.HasEventWith(event.EventIDEQ(ev.EventID)),
-				attendance.HasUserWith(user.AddressEQ(viewerAddress)),
-			).
-			Only(ctx)
-
-		if err == nil && att != nil {
-			isRegistered = true
-			// Cek apakah user sudah check-in
-			if att.CheckedIn {
-				isCheckedIn = true
-			}
-		}
+	// Deprecated: '?viewer=' untuk client lama yang belum login. Status registrasi hanya diisi
+	// jika memang publik (visibility 'public' & peserta tidak memilih disembunyikan).
+	legacyViewer := ""
+	if v := c.QueryParam("viewer"); viewerAddress == "" && v != "" {
+		legacyViewer = normalizeAddress(v)
+		c.Response().Header().Set("Deprecation", "true")
 	}
 
+	isRegistered := false
+	isCheckedIn := false
+
 	// 3. Mapping ke DTO Bersih (Sama seperti getEvents)
 	// Kita pakai struct dari 'swagdto' atau struct lokal 'EventResponse' di handlers.go
 	// (Asumsi Anda menaruh struct EventResponse di handlers.go atau import dari swagdto)
@@ -717,12 +838,21 @@ func (h *Handler) getEventByID(c echo.Context) error {
 
 	var attendanceResponses []*swagdto.AttendanceResponse
 	for _, att := range ev.Edges.Attendances {
+		if viewerAddress != "" && att.Edges.User != nil && att.Edges.User.Address == viewerAddress {
+			isRegistered = true
+			isCheckedIn = att.CheckedIn
+		}
+		if legacyViewer != "" && ev.AttendeeVisibility == event.AttendeeVisibilityPublic &&
+			att.Edges.User != nil && att.Edges.User.Address == legacyViewer && !att.Edges.User.HideFromAttendeeLists {
+			isRegistered = true
+			isCheckedIn = att.CheckedIn
+		}
 
-		// Ambil address user jika ada
-		userAddr := ""
-		if att.Edges.User != nil {
-			userAddr = att.Edges.User.Address
+		// Privasi: peserta yang tidak boleh dilihat viewer tetap dihitung, tapi tidak ditampilkan
+		if !attendeeVisible(access, viewerAddress, att.Edges.User) {
+			continue
 		}
+		userAddr := att.Edges.User.Address
 
 		attendanceResponses = append(attendanceResponses, &swagdto.AttendanceResponse{
 			ID:               att.ID,
@@ -732,18 +862,39 @@ func (h *Handler) getEventByID(c echo.Context) error {
 		})
 	}
 
+	changes, err := h.eventChangeHistory(ctx, ev.EventID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	seriesID, err := h.eventSeriesID(ctx, ev.EventID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	lc := computeEventLifecycle(ev, time.Now())
 	response := &swagdto.EventResponse{
-		ID:           ev.ID,
-		EventID:      ev.EventID,
-		Name:         ev.Name,
-		Description:  ev.Description,
-		Thumbnail:    ev.Thumbnail,
-		Location:     ev.Location,
-		StartDate:    ev.StartDate,
-		EndDate:      ev.EndDate,
-		Quota:        ev.Quota,
-		IsRegistered: isRegistered,
-		IsCheckedIn:  isCheckedIn,
+		ID:                 ev.ID,
+		EventID:            ev.EventID,
+		Name:               ev.Name,
+		Description:        ev.Description,
+		Thumbnail:          ev.Thumbnail,
+		Location:           ev.Location,
+		StartDate:          ev.StartDate,
+		EndDate:            ev.EndDate,
+		Quota:              ev.Quota,
+		CheckinRadiusM:     ev.CheckinRadiusM,
+		Status:             lc.Status,
+		RegistrationStatus: lc.RegistrationStatus,
+		RegisteredCount:    lc.RegisteredCount,
+		CheckedInCount:     lc.CheckedInCount,
+		IsRegistered:       isRegistered,
+		IsCheckedIn:        isCheckedIn,
+		CancelledAt:        ev.CancelledAt,
+		CancelReason:       ev.CancelReason,
+		Revision:           ev.Revision,
+		Changes:            changes,
+		SeriesID:           seriesID,
+		AttendeeVisibility: string(ev.AttendeeVisibility),
 		Edges: swagdto.EventEdges{
 			Host:        hostResponse,
 			Attendances: attendanceResponses,
@@ -775,13 +926,18 @@ func (h *Handler) getUserProfile(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "address is required"})
 	}
 
+	// Event pass hanya yang boleh dilihat viewer (lihat 'attendeePrivacy.go')
+	query, err := h.withVisibleEventPasses(ctx, h.DB.User.Query(), h.viewerAddress(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
 	// Ambil 'User' dan SEMUA relasinya dalam satu query
-	user, err := h.DB.User.Query().
+	user, err := query.
 		Where(user.AddressEQ(address)).
 		// Eager load semua data yang terkait dengan User ini
 		WithMoments().      // Ambil 10 momen terakhir (contoh pagination)
 		WithAccessories().  // Ambil 10 aksesoris terakhir
-		WithEventPasses().  // Ambil 10 pass terakhir
 		WithHostedEvents(). // Ambil 10 event yang di-host
 		WithListings().     // Ambil 10 listing terakhir
 		Only(ctx)
@@ -798,16 +954,22 @@ func (h *Handler) getUserProfile(c echo.Context) error {
 	return c.JSON(http.StatusOK, APIResponse{Data: user})
 }
 
-// @Summary     Check-in User ke Event (Admin)
-// @Description Mencatat check-in untuk seorang user di sebuah event. Ini harus dipanggil oleh admin/backend.
+// @Summary     Check-in User ke Event (Host/Staff)
+// @Description Mencatat check-in untuk seorang user di sebuah event. Hanya host, staff event, atau admin platform.
 // @Description Menerima 'application/json' ATAU 'multipart/form-data'.
 // @Tags        Events
 // @Accept      json,multipart/form-data
 // @Produce     json
+// @Security    BearerAuth
+// @Param       Idempotency-Key header string false "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama"
 // @Param       body body     CheckInRequest true "Alamat User dan ID Event"
 // @Success     200 {object} APIResponse{data=swagdto.CheckInDataResponse} "User berhasil check-in"
 // @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     403 {object} APIResponse "Tidak punya izin check-in"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Failure     409 {object} APIResponse "Event sudah dibatalkan"
 // @Failure     500 {object} APIResponse "Internal Server Error (misal: tx gagal)"
+// @Failure     422 {object} APIResponse "Idempotency-Key sudah dipakai untuk request berbeda"
 // @Router      /event/check-in [post]
 func (h *Handler) checkInUser(c echo.Context) error {
 
@@ -834,6 +996,14 @@ func (h *Handler) checkInUser(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, APIResponse{Error: "eventID harus berupa angka (UInt64)"})
 	}
 
+	// 4.5. Hanya host/staff/admin yang boleh check-in-kan user
+	if ok, err := h.authorizeEvent(c, eventID, permCheckIn); !ok {
+		return err
+	}
+	if ok, err := h.rejectCancelledEvent(c, eventID); !ok {
+		return err
+	}
+
 	// 5. Panggil Fungsi Transaksi (dari 'checkin_transaction.go')
 	err = transactions.UserCheckin(eventID, req.UserAddress)
 
@@ -843,6 +1013,7 @@ func (h *Handler) checkInUser(c echo.Context) error {
 		// Kirim 'APIResponse' standar kita
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	h.recordCheckInVerification(c.Request().Context(), eventID, []string{req.UserAddress}, attendance.CheckInMethodStaff, sessionAddress(c))
 
 	// 7. Kirim Respon Sukses (Gunakan 'APIResponse')
 	return c.JSON(http.StatusOK, APIResponse{
@@ -854,50 +1025,453 @@ func (h *Handler) checkInUser(c echo.Context) error {
 	})
 }
 
+// Status hasil check-in per alamat (lihat swagdto.BatchCheckInResult)
+const (
+	checkInStatusCheckedIn        = "checked_in"
+	checkInStatusAlreadyCheckedIn = "already_checked_in"
+	checkInStatusNotRegistered    = "not_registered"
+	checkInStatusInvalidAddress   = "invalid_address"
+	checkInStatusFailed           = "failed"
+)
+
+// recordCheckInVerification mencatat cara check-in diverifikasi dan siapa verifikatornya
+// di tabel Attendance (dipakai anti-sybil referral). Gagal mencatat tidak membatalkan check-in.
+func (h *Handler) recordCheckInVerification(ctx context.Context, eventID uint64, addresses []string, method attendance.CheckInMethod, verifiedBy string) {
+	if err := h.DB.Attendance.Update().
+		Where(
+			attendance.HasEventWith(event.EventIDEQ(eventID)),
+			attendance.HasUserWith(user.AddressIn(addresses...)),
+		).
+		SetCheckInMethod(method).
+		SetVerifiedBy(verifiedBy).
+		Exec(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("Gagal mencatat verifikasi check-in event %d: %v", eventID, err)
+	}
+}
+
+// batchCheckInChunkSize adalah jumlah alamat maksimum per transaksi.
+// Setiap alamat = 1 check-in + 1 mint EventPass, jadi jangan terlalu besar
+// agar tidak melewati batas computation limit Flow.
+const batchCheckInChunkSize = 25
+
+// @Summary     Batch Check-in User ke Event (Host/Staff)
+// @Description Check-in banyak user sekaligus. Alamat dipecah menjadi beberapa transaksi (maks. 25 alamat per transaksi).
+// @Description Alamat dinormalisasi (boleh tanpa '0x'); alamat tidak valid, belum register, atau sudah check-in
+// @Description (menurut tabel Attendance) dilewati. Jika satu transaksi gagal (misal: satu alamat belum punya koleksi
+// @Description EventPass), alamat di transaksi tersebut dicoba ulang satu per satu sehingga hanya alamat bermasalah yang 'failed'.
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       body body     BatchCheckInRequest true "ID Event dan daftar alamat user"
+// @Success     200 {object} APIResponse{data=swagdto.BatchCheckInResponse} "Hasil check-in per alamat"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     403 {object} APIResponse "Tidak punya izin check-in"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Failure     409 {object} APIResponse "Event sudah dibatalkan"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /event/check-in/batch [post]
+func (h *Handler) batchCheckInUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 1. Bind & validasi request
+	req := new(BatchCheckInRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.EventID == "" || len(req.UserAddresses) == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "eventID dan userAddresses adalah field wajib"})
+	}
+
+	eventID, err := strconv.ParseUint(req.EventID, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "eventID harus berupa angka (UInt64)"})
+	}
+
+	// Hanya host/staff/admin (sekaligus memastikan event ada)
+	if ok, err := h.authorizeEvent(c, eventID, permCheckIn); !ok {
+		return err
+	}
+	if ok, err := h.rejectCancelledEvent(c, eventID); !ok {
+		return err
+	}
+
+	// 2. Normalisasi alamat, lalu ambil status Attendance untuk semua alamat sekaligus
+	addresses := make([]string, len(req.UserAddresses))
+	valid := make([]string, 0, len(req.UserAddresses))
+	for i, addr := range req.UserAddresses {
+		if normalized, ok := parseFlowAddress(addr); ok {
+			addresses[i] = normalized
+			valid = append(valid, normalized)
+		}
+	}
+	attendances, err := h.DB.Attendance.Query().
+		Where(
+			attendance.HasEventWith(event.EventIDEQ(eventID)),
+			attendance.HasUserWith(user.AddressIn(valid...)),
+		).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	checkedInByAddress := make(map[string]bool)
+	for _, att := range attendances {
+		if att.Edges.User != nil {
+			checkedInByAddress[att.Edges.User.Address] = att.CheckedIn
+		}
+	}
+
+	// 3. Tentukan alamat mana yang perlu di-check-in (dan hapus duplikat)
+	results := make([]*swagdto.BatchCheckInResult, 0, len(req.UserAddresses))
+	resultByAddress := make(map[string]*swagdto.BatchCheckInResult)
+	var pending []string
+
+	for i, addr := range addresses {
+		if addr == "" {
+			results = append(results, &swagdto.BatchCheckInResult{
+				UserAddress: req.UserAddresses[i],
+				Status:      checkInStatusInvalidAddress,
+			})
+			continue
+		}
+		if _, seen := resultByAddress[addr]; seen {
+			continue
+		}
+
+		result := &swagdto.BatchCheckInResult{UserAddress: addr}
+		checkedIn, registered := checkedInByAddress[addr]
+		switch {
+		case !registered:
+			result.Status = checkInStatusNotRegistered
+		case checkedIn:
+			result.Status = checkInStatusAlreadyCheckedIn
+		default:
+			pending = append(pending, addr)
+		}
+
+		resultByAddress[addr] = result
+		results = append(results, result)
+	}
+
+	// 4. Kirim per 'chunk'. Transaksi dijalankan berurutan karena
+	// semuanya memakai proposal key yang sama (sequence number).
+	response := &swagdto.BatchCheckInResponse{EventID: req.EventID}
+	for start := 0; start < len(pending); start += batchCheckInChunkSize {
+		end := min(start+batchCheckInChunkSize, len(pending))
+		chunk := pending[start:end]
+
+		response.Transactions++
+		err := transactions.UserCheckinBatch(eventID, chunk)
+		if err == nil {
+			for _, addr := range chunk {
+				resultByAddress[addr].Status = checkInStatusCheckedIn
+			}
+			continue
+		}
+		log.Printf("Gagal menjalankan batch check-in event %d (%d alamat), dicoba satu per satu: %v", eventID, len(chunk), err)
+
+		// Transaksi batch atomik: satu alamat bermasalah membatalkan seluruh chunk.
+		// Ulangi per alamat agar hanya alamat tersebut yang gagal.
+		for _, addr := range chunk {
+			response.Transactions++
+			if err := transactions.UserCheckin(eventID, addr); err != nil {
+				resultByAddress[addr].Status = checkInStatusFailed
+				resultByAddress[addr].Error = err.Error()
+				continue
+			}
+			resultByAddress[addr].Status = checkInStatusCheckedIn
+		}
+	}
+
+	// 5. Ringkasan
+	var checkedIn []string
+	for _, r := range results {
+		switch r.Status {
+		case checkInStatusCheckedIn:
+			checkedIn = append(checkedIn, r.UserAddress)
+			response.CheckedIn++
+		case checkInStatusFailed:
+			response.Failed++
+		default:
+			response.Skipped++
+		}
+	}
+	response.Results = results
+	if len(checkedIn) > 0 {
+		h.recordCheckInVerification(ctx, eventID, checkedIn, attendance.CheckInMethodStaff, sessionAddress(c))
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: response})
+}
+
+// @Summary     Buat Event (oleh Backend)
+// @Description Membuat event on-chain atas nama host. Gambar 'thumbnail' dan 'eventPassImg' di-upload ke IPFS (Pinata),
+// @Description lalu transaksi 'createEvent' dikirim oleh backend. Respon menunggu sampai event ter-indeks oleh indexer.
+// @Description Jika indexer belum selesai dalam batas waktu, respon 202 tetap berisi 'event_id' on-chain.
+// @Description Hanya untuk host terdaftar (admin platform atau env HOST_ADDRESSES), karena transaksi dibayar akun admin.
+// @Tags        Events
+// @Accept      multipart/form-data
+// @Produce     json
+// @Security    BearerAuth
+// @Param       name         formData string true  "Nama event"
+// @Param       description  formData string false "Deskripsi event"
+// @Param       eventType    formData int    true  "Tipe event (0 = Online, 1 = Offline)"
+// @Param       location     formData string true  "URL (online) atau alamat (offline)"
+// @Param       lat          formData number false "Latitude"
+// @Param       long         formData number false "Longitude"
+// @Param       startDate    formData string true  "Waktu mulai (RFC3339, misal: 2025-01-31T19:00:00+07:00)"
+// @Param       endDate      formData string true  "Waktu selesai (RFC3339)"
+// @Param       quota        formData int    true  "Kuota peserta"
+// @Param       thumbnail    formData file   true  "Gambar thumbnail event"
+// @Param       eventPassImg formData file   false "Gambar Event Pass (SBT)"
+// @Success     201 {object} APIResponse{data=swagdto.CreateEventResponse} "Event dibuat dan sudah ter-indeks"
+// @Success     202 {object} APIResponse{data=swagdto.CreateEventResponse} "Event dibuat, indexer belum selesai"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Failure     403 {object} APIResponse "Bukan host terdaftar (HOST_ADDRESSES) / host belum punya profil"
+// @Failure     500 {object} APIResponse "Internal Server Error (upload/transaksi gagal)"
+// @Router      /events [post]
+func (h *Handler) createEvent(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 1. Ambil & validasi data TEKS
+	// Host = user yang sedang login (harus sudah punya profil)
+	hostAddress := sessionAddress(c)
+	name := c.FormValue("name")
+	description := c.FormValue("description")
+	location := c.FormValue("location")
+	if name == "" || location == "" {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "name dan location adalah field wajib"})
+	}
+
+	eventType, err := strconv.ParseUint(c.FormValue("eventType"), 10, 8)
+	if err != nil || eventType > 1 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "eventType harus 0 (online) atau 1 (offline)"})
+	}
+
+	quota, err := strconv.ParseUint(c.FormValue("quota"), 10, 64)
+	if err != nil || quota == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "quota harus berupa angka lebih dari 0"})
+	}
+
+	// lat/long opsional (event online boleh 0,0)
+	var lat, long float64
+	if v := c.FormValue("lat"); v != "" {
+		if lat, err = strconv.ParseFloat(v, 64); err != nil || lat < -90 || lat > 90 {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "lat tidak valid"})
+		}
+	}
+	if v := c.FormValue("long"); v != "" {
+		if long, err = strconv.ParseFloat(v, 64); err != nil || long < -180 || long > 180 {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "long tidak valid"})
+		}
+	}
+
+	startDate, err := time.Parse(time.RFC3339, c.FormValue("startDate"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "startDate harus format RFC3339"})
+	}
+	endDate, err := time.Parse(time.RFC3339, c.FormValue("endDate"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "endDate harus format RFC3339"})
+	}
+	if !endDate.After(startDate) {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "endDate harus setelah startDate"})
+	}
+
+	if _, err := c.FormFile("thumbnail"); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "file 'thumbnail' wajib ada"})
+	}
+
+	// 2. Pastikan host sudah punya profil.
+	// Indexer hanya menyimpan 'EventCreated' jika host sudah ada di tabel User.
+	exists, err := h.DB.User.Query().Where(user.AddressEQ(hostAddress)).Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if !exists {
+		return c.JSON(http.StatusForbidden, APIResponse{Error: "Host not found. Please setup profile first."})
+	}
+
+	// 3. Upload gambar (thumbnail wajib, eventPassImg opsional), setelah semua cek yang murah
+	thumbnailUrl, err := h.handleFormFileUpload(c, "thumbnail")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	eventPassImgUrl := ""
+	if _, err := c.FormFile("eventPassImg"); err == nil {
+		eventPassImgUrl, err = h.handleFormFileUpload(c, "eventPassImg")
+		if err != nil {
+			unpinUploads(thumbnailUrl)
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+	}
+
+	// 4. Panggil transaksi
+	eventID, err := transactions.CreateEvent(transactions.CreateEventParams{
+		HostAddress:  hostAddress,
+		Name:         name,
+		Description:  description,
+		ThumbnailURL: thumbnailUrl,
+		EventPassImg: eventPassImgUrl,
+		EventType:    uint8(eventType),
+		Location:     location,
+		Lat:          lat,
+		Long:         long,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Quota:        quota,
+	})
+	if err != nil {
+		log.Printf("Gagal menjalankan transaksi create event: %v", err)
+		// Gambar hanya dilepas jika event pasti tidak dibuat (transaksi belum terkirim / gagal di chain)
+		var sentErr *transactions.SentTxError
+		if !errors.As(err, &sentErr) {
+			unpinUploads(thumbnailUrl, eventPassImgUrl)
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 5. Tunggu indexer menyimpan event ke database
+	indexed := h.waitForIndexedEvent(ctx, eventID)
+
+	response := &swagdto.CreateEventResponse{
+		Message:      "Event created successfully!",
+		EventID:      eventID,
+		Indexed:      indexed,
+		Thumbnail:    thumbnailUrl,
+		EventPassImg: eventPassImgUrl,
+	}
+	if !indexed {
+		return c.JSON(http.StatusAccepted, APIResponse{Data: response})
+	}
+	return c.JSON(http.StatusCreated, APIResponse{Data: response})
+}
+
+// unpinUploads melepas pin gambar hasil 'handleFormFileUpload' yang tidak jadi dipakai.
+// Kegagalan hanya dicatat di log (file tetap ada di IPFS, tapi tidak merusak apa pun).
+func unpinUploads(urls ...string) {
+	for _, u := range urls {
+		if u == "" {
+			continue
+		}
+		if err := utils.UnpinFromPinata(path.Base(u)); err != nil {
+			log.Printf("Gagal melepas pin %s: %v", u, err)
+		}
+	}
+}
+
+// waitForIndexedEvent menunggu sampai indexer menyimpan 'Event' dengan 'event_id' ini.
+// Mengembalikan false jika batas waktu habis (event tetap ada on-chain).
+func (h *Handler) waitForIndexedEvent(ctx context.Context, eventID uint64) bool {
+	const indexTimeout = 30 * time.Second
+
+	timeout := time.After(indexTimeout)
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		exists, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).Exist(ctx)
+		if err == nil && exists {
+			return true
+		}
+
+		select {
+		case <-timeout:
+			log.Printf("Event ID %d belum ter-indeks setelah %s", eventID, indexTimeout)
+			return false
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
 // @Summary     Ambil Daftar Event Pass (SBT)
 // @Description Mengambil daftar Event Pass (Proof of Attendance). Mendukung filter owner_address untuk melihat koleksi user tertentu.
+// @Description Mengikuti privasi peserta: pengunjung anonim hanya mendapat 'pagination.totalItems', user yang login hanya
+// @Description melihat pass miliknya, pass di event yang ia kelola, dan pass peserta lain sesuai 'attendee_visibility' event.
+// @Description Deprecated: tanpa login, 'owner_address' masih mengembalikan pass milik alamat tsb yang publik
+// @Description (event 'public' & pemilik tidak memilih disembunyikan).
 // @Tags        EventPass
+// @Security    BearerAuth
 // @Accept      json
 // @Produce     json
 // @Param       owner_address query    string  false  "Filter berdasarkan alamat pemilik (misal: 0x...)"
 // @Param       page          query    int     false  "Nomor Halaman (default: 1)"
 // @Param       pageSize      query    int     false  "Jumlah item per halaman (default: 20)"
-// @Success     200 {object} my-project/backend/swagdto.GetEventPassesResponse "Data berhasil diambil"
+// @Param       cursor     query    string  false  "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor"
+// @Param       withTotal  query    bool    false  "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)"
+// @Param       sort       query    string  false  "Urutan, misal: '-start_date,name' ('-' = DESC)"
+// @Success     200 {object} swagdto.GetEventPassesResponse "Data berhasil diambil"
 // @Failure     500 {object} APIResponse "Internal Server Error"
 // @Router      /event-passes [get]
 func (h *Handler) getEventPasses(c echo.Context) error {
 	ctx := c.Request().Context()
-	limit, offset, page, pageSize := getPagination(c)
+	page, err := newListPage(c, eventPassFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
 
 	query := h.DB.EventPass.Query()
 
-	// 1. Filter by Owner Address
-	ownerAddress := c.QueryParam("owner_address")
-	if ownerAddress != "" {
-		query = query.Where(
-			eventpass.HasOwnerWith(user.AddressEQ(ownerAddress)),
-		)
+	// 1. Filter: 'filter[...]' + param lama 'owner_address'
+	if f := page.filter(); f != nil {
+		query = query.Where(predicate.EventPass(f))
 	}
 
-	// 2. Hitung Total
-	totalItems, err := query.Count(ctx)
-	if err != nil {
+	// 1b. Privasi peserta: anonim hanya mendapat jumlah, user login hanya melihat pass
+	//     yang pemiliknya boleh ia lihat (lihat 'attendeePrivacy.go')
+	viewerAddress := h.viewerAddress(c)
+	if viewerAddress == "" && c.QueryParam("owner_address") != "" {
+		// Deprecated: client lama melihat koleksi sebuah alamat tanpa login (hanya pass publik)
+		c.Response().Header().Set("Deprecation", "true")
+		query = query.Where(publicEventPassPredicate())
+	} else if viewerAddress == "" {
+		total, err := query.Count(ctx)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, swagdto.GetEventPassesResponse{
+			Data:       []*swagdto.DTOEventPass{},
+			Pagination: &swagdto.Pagination{TotalItems: total},
+		})
+	}
+	if viewerAddress != "" {
+		visible, err := h.visibleEventPassPredicate(ctx, viewerAddress)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		if visible != nil {
+			query = query.Where(visible)
+		}
+	}
+
+	// 2. Hitung Total (opsional), lalu terapkan cursor
+	if err := page.count(ctx, query.Count); err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.EventPass(w))
+	}
 
 	// 3. Query Data dengan Eager Loading
 	passes, err := query.
 		WithOwner().
 		WithEvent().
 		WithMoment(). // Cek apakah sudah dipakai minting moment
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc("id")). // Terbaru dulu
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(eventpass.OrderOption(page.order())). // Terbaru dulu
 		All(ctx)
 
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	passes, pagination := finishPage(page, passes, func(p *ent.EventPass) (any, int) { return p.ID, p.ID })
 
 	// 4. Mapping ke DTO (swagdto)
 	// (Kita lakukan manual mapping agar swagger konsisten)
@@ -948,23 +1522,20 @@ func (h *Handler) getEventPasses(c echo.Context) error {
 
 	// 5. Return Response
 	return c.JSON(http.StatusOK, swagdto.GetEventPassesResponse{
-		Data: dtos,
-		Pagination: &swagdto.Pagination{
-			TotalItems:  totalItems,
-			TotalPages:  int(math.Ceil(float64(totalItems) / float64(pageSize))),
-			CurrentPage: page,
-			PageSize:    pageSize,
-		},
+		Data:       dtos,
+		Pagination: (*swagdto.Pagination)(pagination),
 	})
 }
 
 // @Summary     Ambil Detail Event Pass
 // @Description Mengambil detail satu Event Pass berdasarkan 'pass_id' (ID On-Chain).
+// @Description 'edges.owner' hanya diisi jika pemilik boleh dilihat viewer (aturan privasi peserta yang sama dengan /events/{id}).
 // @Tags        EventPass
+// @Security    BearerAuth
 // @Accept      json
 // @Produce     json
 // @Param       id   path      int  true  "Pass ID (On-Chain ID)"
-// @Success     200 {object} my-project/backend/swagdto.GetEventPassDetailResponse "Detail pass berhasil diambil"
+// @Success     200 {object} swagdto.GetEventPassDetailResponse "Detail pass berhasil diambil"
 // @Failure     404 {object} APIResponse "Event Pass tidak ditemukan"
 // @Failure     500 {object} APIResponse "Internal Server Error"
 // @Router      /event-passes/{id} [get]
@@ -994,9 +1565,20 @@ func (h *Handler) getEventPassByID(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
 
+	// Privasi peserta: pemilik hanya ditampilkan jika boleh dilihat viewer
+	viewerAddress := h.viewerAddress(c)
+	ownerVisible := false
+	if p.Edges.Event != nil {
+		access, err := h.eventAttendeeAccess(ctx, p.Edges.Event, viewerAddress)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		ownerVisible = attendeeVisible(access, viewerAddress, p.Edges.Owner)
+	}
+
 	// Mapping Single DTO
 	var ownerDto *swagdto.DTOUser
-	if p.Edges.Owner != nil {
+	if ownerVisible {
 		ownerDto = &swagdto.DTOUser{ID: p.Edges.Owner.ID, Address: p.Edges.Owner.Address}
 	}
 
@@ -1051,54 +1633,59 @@ func (h *Handler) getEventPassByID(c echo.Context) error {
 // @Param       address    query    string  false  "Cari berdasarkan sebagian alamat (misal: 0x12...)"
 // @Param       page       query    int     false  "Nomor Halaman (default: 1)"
 // @Param       pageSize   query    int     false  "Jumlah item per halaman (default: 20)"
+// @Param       cursor     query    string  false  "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor"
+// @Param       withTotal  query    bool    false  "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)"
+// @Param       sort       query    string  false  "Urutan, misal: '-start_date,name' ('-' = DESC)"
 // @Success     200 {object} swagdto.GetUsersResponse "Daftar user berhasil diambil"
 // @Failure     500 {object} APIResponse "Internal Server Error"
 // @Router      /users [get]
 func (h *Handler) getUsers(c echo.Context) error {
 	ctx := c.Request().Context()
-	limit, offset, page, pageSize := getPagination(c)
+	page, err := newListPage(c, userFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
 
 	query := h.DB.User.Query()
 
-	// Filter sederhana (Search by Address)
-	addressSearch := c.QueryParam("address")
-	if addressSearch != "" {
-		// Contains (case-insensitive biasanya ditangani DB, tapi address flow case-sensitive)
-		query = query.Where(user.AddressContains(addressSearch))
+	// Filter: 'filter[...]' + param lama 'address' (pencarian sebagian alamat)
+	if f := page.filter(); f != nil {
+		query = query.Where(predicate.User(f))
 	}
 
-	// Hitung Total
-	totalItems, err := query.Count(ctx)
-	if err != nil {
+	// Hitung Total (opsional), lalu terapkan cursor
+	if err := page.count(ctx, query.Count); err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.User(w))
+	}
 
-	// Query Data (Dengan Eager Loading Relasi)
+	// Query Data (Dengan Eager Loading Relasi; event pass hanya yang boleh dilihat viewer)
+	query, err = h.withVisibleEventPasses(ctx, query, h.viewerAddress(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
 	users, err := query.
 		WithMoments().
 		WithAccessories().
-		WithEventPasses().
 		WithHostedEvents().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc("id")). // User terbaru dulu
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(user.OrderOption(page.order())). // User terbaru dulu
 		All(ctx)
 
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	users, pagination := finishPage(page, users, func(u *ent.User) (any, int) { return u.ID, u.ID })
 
 	// Return users directly without DTO mapping
 
 	// Response
 	return c.JSON(http.StatusOK, APIResponse{
-		Data: users,
-		Pagination: &Pagination{
-			TotalItems:  totalItems,
-			TotalPages:  int(math.Ceil(float64(totalItems) / float64(pageSize))),
-			CurrentPage: page,
-			PageSize:    pageSize,
-		},
+		Data:       users,
+		Pagination: pagination,
 	})
 }
 
@@ -1120,12 +1707,15 @@ func (h *Handler) getUserByAddress(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Address wajib diisi"})
 	}
 
-	// Query Single User
-	u, err := h.DB.User.Query().
+	// Query Single User (event pass hanya yang boleh dilihat viewer)
+	query, err := h.withVisibleEventPasses(ctx, h.DB.User.Query(), h.viewerAddress(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	u, err := query.
 		Where(user.AddressEQ(address)).
 		WithMoments().
 		WithAccessories().
-		WithEventPasses().
 		WithHostedEvents().
 		Only(ctx)
 
@@ -1150,13 +1740,18 @@ func (h *Handler) getUserByAddress(c echo.Context) error {
 // @Param       q          query    string  true   "Kata kunci pencarian (0x... atau nama)"
 // @Param       page       query    int     false  "Nomor Halaman (default: 1)"
 // @Param       pageSize   query    int     false  "Jumlah item per halaman (default: 10)"
+// @Param       cursor     query    string  false  "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor"
+// @Param       withTotal  query    bool    false  "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)"
 // @Success     200 {object} swagdto.GetUsersResponse "Hasil pencarian"
 // @Failure     400 {object} APIResponse "Query kosong"
 // @Failure     500 {object} APIResponse "Internal Server Error"
 // @Router      /users/search [get]
 func (h *Handler) searchUsers(c echo.Context) error {
 	ctx := c.Request().Context()
-	limit, offset, page, pageSize := getPagination(c)
+	page, err := newListPage(c, userSearchFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
 
 	// 1. Ambil query param 'q'
 	searchTerm := c.QueryParam("q")
@@ -1164,7 +1759,7 @@ func (h *Handler) searchUsers(c echo.Context) error {
 		// Jika kosong, kembalikan list kosong (atau error, tergantung selera UX)
 		return c.JSON(http.StatusOK, swagdto.GetUsersResponse{
 			Data:       []*swagdto.DTOUserProfile{},
-			Pagination: &swagdto.Pagination{CurrentPage: 1, PageSize: pageSize},
+			Pagination: &swagdto.Pagination{CurrentPage: 1, PageSize: page.pageSize},
 		})
 	}
 
@@ -1178,38 +1773,39 @@ func (h *Handler) searchUsers(c echo.Context) error {
 			),
 		)
 
-	// 3. Hitung Total (untuk pagination)
-	totalItems, err := query.Count(ctx)
-	if err != nil {
+	// 3. Hitung Total (opsional, untuk pagination), lalu terapkan cursor
+	if err := page.count(ctx, query.Count); err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.User(w))
+	}
 
-	// 4. Ambil Data dengan Relasi
+	// 4. Ambil Data dengan Relasi (event pass hanya yang boleh dilihat viewer)
+	query, err = h.withVisibleEventPasses(ctx, query, h.viewerAddress(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
 	users, err := query.
 		WithMoments().
 		WithAccessories().
-		WithEventPasses().
 		WithHostedEvents().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc("id")). // Relevansi bisa diatur, tapi ID desc cukup untuk sekarang
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(user.OrderOption(page.order())). // Relevansi bisa diatur, tapi ID desc cukup untuk sekarang
 		All(ctx)
 
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	users, pagination := finishPage(page, users, func(u *ent.User) (any, int) { return u.ID, u.ID })
 
 	// 5. Return users directly without DTO mapping
 
 	// 6. Return Response
 	return c.JSON(http.StatusOK, APIResponse{
-		Data: users,
-		Pagination: &Pagination{
-			TotalItems:  totalItems,
-			TotalPages:  int(math.Ceil(float64(totalItems) / float64(pageSize))),
-			CurrentPage: page,
-			PageSize:    pageSize,
-		},
+		Data:       users,
+		Pagination: pagination,
 	})
 }
 
@@ -1218,18 +1814,16 @@ func (h *Handler) searchUsers(c echo.Context) error {
 // @Tags        Social
 // @Accept      json
 // @Produce     json
+// @Security    BearerAuth
 // @Param       id   path      int  true  "Moment ID (Internal ID)"
-// @Param       user query     string true  "User Address (0x...)"
 // @Success     200 {object} APIResponse "Success"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Failure     429 {object} APIResponse "Terlalu banyak request (lihat header Retry-After)"
 // @Router      /moments/{id}/like [post]
 func (h *Handler) toggleLike(c echo.Context) error {
 	ctx := c.Request().Context()
 	idStr := c.Param("id")
-	userAddress := c.QueryParam("user")
-
-	if userAddress == "" {
-		return c.JSON(http.StatusBadRequest, APIResponse{Error: "User address is required"})
-	}
+	userAddress := sessionAddress(c)
 
 	momentID, err := strconv.Atoi(idStr)
 	if err != nil {
@@ -1280,8 +1874,11 @@ func (h *Handler) toggleLike(c echo.Context) error {
 // @Accept      json
 // @Produce     json
 // @Param       id   path      int  true  "Moment ID (Internal ID)"
-// @Param       body body      CreateCommentRequest true "Comment Content & User Address"
+// @Security    BearerAuth
+// @Param       body body      CreateCommentRequest true "Comment Content"
 // @Success     201 {object} APIResponse "Comment created"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Failure     429 {object} APIResponse "Terlalu banyak request (lihat header Retry-After)"
 // @Router      /moments/{id}/comments [post]
 func (h *Handler) createComment(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -1291,20 +1888,17 @@ func (h *Handler) createComment(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Moment ID"})
 	}
 
-	var req struct {
-		UserAddress string `json:"userAddress"`
-		Content     string `json:"content"`
-	}
-	if err := c.Bind(&req); err != nil {
+	req := new(CreateCommentRequest)
+	if err := c.Bind(req); err != nil {
 		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body"})
 	}
 
-	if req.UserAddress == "" || req.Content == "" {
-		return c.JSON(http.StatusBadRequest, APIResponse{Error: "User address and content are required"})
+	if req.Content == "" {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Content is required"})
 	}
 
 	// 1. Get User
-	u, err := h.DB.User.Query().Where(user.AddressEQ(req.UserAddress)).Only(ctx)
+	u, err := h.DB.User.Query().Where(user.AddressEQ(sessionAddress(c))).Only(ctx)
 	if err != nil {
 		return c.JSON(http.StatusNotFound, APIResponse{Error: "User not found"})
 	}
@@ -1330,6 +1924,8 @@ func (h *Handler) createComment(c echo.Context) error {
 // @Param       id   path      int  true  "Moment ID (Internal ID)"
 // @Param       page query     int  false "Page number"
 // @Param       pageSize query int  false "Page size"
+// @Param       cursor     query    string  false  "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor"
+// @Param       withTotal  query    bool    false  "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)"
 // @Success     200 {object} APIResponse "List of comments"
 // @Router      /moments/{id}/comments [get]
 func (h *Handler) getComments(c echo.Context) error {
@@ -1340,25 +1936,31 @@ func (h *Handler) getComments(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Moment ID"})
 	}
 
-	limit, offset, page, pageSize := getPagination(c)
+	page, err := newListPage(c, commentFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
 
 	query := h.DB.Comment.Query().
 		Where(comment.HasMomentWith(nftmoment.IDEQ(momentID)))
 
-	totalItems, err := query.Count(ctx)
-	if err != nil {
+	if err := page.count(ctx, query.Count); err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.Comment(w))
+	}
 
 	comments, err := query.
 		WithUser().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc("created_at")).
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(comment.OrderOption(page.order())).
 		All(ctx)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
 	}
+	comments, pagination := finishPage(page, comments, func(cm *ent.Comment) (any, int) { return cm.CreatedAt, cm.ID })
 
 	var data []map[string]interface{}
 	for _, cm := range comments {
@@ -1375,14 +1977,6 @@ func (h *Handler) getComments(c echo.Context) error {
 		})
 	}
 
-	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
-	pagination := &Pagination{
-		TotalItems:  totalItems,
-		TotalPages:  totalPages,
-		CurrentPage: page,
-		PageSize:    pageSize,
-	}
-
 	return c.JSON(http.StatusOK, APIResponse{
 		Data:       data,
 		Pagination: pagination,
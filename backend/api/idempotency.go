@@ -0,0 +1,252 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/idempotencykey"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerIdempotencyKey = "Idempotency-Key"
+	// Berapa lama respon disimpan (retry dengan key yang sama dalam jendela ini akan di-replay)
+	idempotencyKeyTTL = 24 * time.Hour
+	// Berapa lama request duplikat menunggu request pertama yang masih berjalan
+	// (minting bisa menunggu seal transaksi cukup lama)
+	idempotencyWaitTimeout = 90 * time.Second
+	// Record 'in_progress' yang lebih lama dari ini dianggap ditinggal request yang mati (misal: server restart)
+	// dan boleh diambil alih oleh retry. Harus jauh di atas durasi request terpanjang (upload + seal + indexer).
+	idempotencyStaleAfter = 10 * time.Minute
+	idempotencyPollEvery  = 500 * time.Millisecond
+	maxIdempotencyKeyLen  = 255
+)
+
+// transientStatuses adalah status 4xx yang hasilnya bisa berubah jika di-retry
+// (rate limit, timeout, sesi kedaluwarsa), sehingga tidak boleh di-replay selama 'idempotencyKeyTTL'.
+var transientStatuses = map[int]bool{
+	http.StatusUnauthorized:    true,
+	http.StatusRequestTimeout:  true,
+	http.StatusLocked:          true,
+	http.StatusTooEarly:        true,
+	http.StatusTooManyRequests: true,
+}
+
+// responseRecorder meneruskan respon ke client sambil menyalin body-nya.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// requestFingerprint menghitung hash dari method, route, dan isi request.
+// Untuk multipart, hash dihitung dari nilai field & isi file (bukan body mentah),
+// karena boundary multipart berubah setiap kali client membangun ulang request.
+func requestFingerprint(c echo.Context) (string, error) {
+	req := c.Request()
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", req.Method, c.Path())
+	for _, name := range c.ParamNames() {
+		fmt.Fprintf(h, "param %s=%s\n", name, c.Param(name))
+	}
+
+	if strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		form, err := c.MultipartForm()
+		if err != nil {
+			return "", err
+		}
+		keys := make([]string, 0, len(form.Value))
+		for k := range form.Value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(h, "field %s=%q\n", k, form.Value[k])
+		}
+
+		fileKeys := make([]string, 0, len(form.File))
+		for k := range form.File {
+			fileKeys = append(fileKeys, k)
+		}
+		sort.Strings(fileKeys)
+		for _, k := range fileKeys {
+			for _, fh := range form.File[k] {
+				f, err := fh.Open()
+				if err != nil {
+					return "", err
+				}
+				fileHash := sha256.New()
+				_, err = io.Copy(fileHash, f)
+				f.Close()
+				if err != nil {
+					return "", err
+				}
+				fmt.Fprintf(h, "file %s=%x\n", k, fileHash.Sum(nil))
+			}
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	// Body biasa (JSON/form-urlencoded): baca lalu kembalikan agar bisa di-Bind oleh handler
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// idempotent adalah middleware untuk header 'Idempotency-Key'.
+//   - Request pertama dijalankan dan responnya disimpan (kecuali 5xx & 4xx sementara seperti 429, agar boleh di-retry).
+//   - Retry dengan key & isi yang sama mendapat respon yang sama (header 'Idempotent-Replayed: true').
+//   - Duplikat yang datang saat request pertama masih berjalan akan menunggu hasilnya.
+//   - Record 'in_progress' yang ditinggal (server mati di tengah request) diambil alih retry setelah 'idempotencyStaleAfter'.
+//   - Key yang sama dengan isi berbeda ditolak (422).
+//
+// Pasang SETELAH 'requireAuth' (key di-scope per alamat) dan SETELAH 'rateLimit',
+// agar request yang ditolak rate limit tidak pernah mengklaim key.
+func (h *Handler) idempotent(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(headerIdempotencyKey)
+		if key == "" {
+			return next(c)
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "Idempotency-Key terlalu panjang"})
+		}
+		ctx := c.Request().Context()
+
+		scope := sessionAddress(c)
+		if scope == "" {
+			scope = "ip:" + c.RealIP()
+		}
+		fingerprint, err := requestFingerprint(c)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "Gagal membaca request: " + err.Error()})
+		}
+
+		// 1. Klaim key. Jika sudah ada, replay / tunggu hasil request sebelumnya.
+		record, err := h.claimIdempotencyKey(ctx, scope, key, fingerprint)
+		if err != nil {
+			if !ent.IsConstraintError(err) {
+				return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+			}
+			return h.replayIdempotentResponse(c, scope, key, fingerprint)
+		}
+
+		// 2. Jalankan handler sambil merekam respon
+		rec := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = rec
+		handlerErr := next(c)
+		c.Response().Writer = rec.ResponseWriter
+
+		// Gunakan context baru: request bisa saja sudah dibatalkan oleh client
+		saveCtx := context.WithoutCancel(ctx)
+		status := c.Response().Status
+		if handlerErr != nil || !c.Response().Committed || status >= http.StatusInternalServerError || transientStatuses[status] {
+			// Gagal: lepas key agar client boleh retry
+			if err := h.DB.IdempotencyKey.DeleteOne(record).Exec(saveCtx); err != nil {
+				log.Printf("Gagal melepas idempotency key %s: %v", key, err)
+			}
+			return handlerErr
+		}
+
+		if err := record.Update().
+			SetStatus(idempotencykey.StatusCompleted).
+			SetResponseStatus(status).
+			SetResponseContentType(c.Response().Header().Get(echo.HeaderContentType)).
+			SetResponseBody(rec.body.Bytes()).
+			Exec(saveCtx); err != nil {
+			log.Printf("Gagal menyimpan respon idempotency key %s: %v", key, err)
+		}
+		return nil
+	}
+}
+
+// claimIdempotencyKey membuat record 'in_progress'. Record lama yang sudah kedaluwarsa dihapus dulu,
+// begitu juga record 'in_progress' key ini yang ditinggal request yang mati (lihat 'idempotencyStaleAfter'),
+// agar retry tidak ditolak "masih diproses" sampai 'idempotencyKeyTTL' habis.
+func (h *Handler) claimIdempotencyKey(ctx context.Context, scope, key, fingerprint string) (*ent.IdempotencyKey, error) {
+	now := time.Now()
+	if _, err := h.DB.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLT(now)).
+		Exec(ctx); err != nil {
+		log.Printf("Gagal membersihkan idempotency key lama: %v", err)
+	}
+	n, err := h.DB.IdempotencyKey.Delete().
+		Where(
+			idempotencykey.ScopeEQ(scope),
+			idempotencykey.KeyEQ(key),
+			idempotencykey.StatusEQ(idempotencykey.StatusInProgress),
+			idempotencykey.CreatedAtLT(now.Add(-idempotencyStaleAfter)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		log.Printf("Idempotency key %s (%s) yang ditinggal diambil alih", key, scope)
+	}
+
+	return h.DB.IdempotencyKey.Create().
+		SetScope(scope).
+		SetKey(key).
+		SetFingerprint(fingerprint).
+		SetExpiresAt(now.Add(idempotencyKeyTTL)).
+		Save(ctx)
+}
+
+// replayIdempotentResponse menunggu request pertama selesai lalu mengirim ulang responnya.
+func (h *Handler) replayIdempotentResponse(c echo.Context, scope, key, fingerprint string) error {
+	ctx := c.Request().Context()
+	deadline := time.Now().Add(idempotencyWaitTimeout)
+
+	for {
+		record, err := h.DB.IdempotencyKey.Query().
+			Where(idempotencykey.ScopeEQ(scope), idempotencykey.KeyEQ(key)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				// Request pertama gagal dan key dilepas; client boleh retry
+				return c.JSON(http.StatusConflict, APIResponse{Error: "Request sebelumnya dengan Idempotency-Key ini gagal, silakan coba lagi"})
+			}
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		if record.Fingerprint != fingerprint {
+			return c.JSON(http.StatusUnprocessableEntity, APIResponse{Error: "Idempotency-Key sudah dipakai untuk request yang berbeda"})
+		}
+
+		if record.Status == idempotencykey.StatusCompleted {
+			c.Response().Header().Set("Idempotent-Replayed", "true")
+			return c.Blob(record.ResponseStatus, record.ResponseContentType, record.ResponseBody)
+		}
+
+		if time.Since(record.CreatedAt) > idempotencyStaleAfter {
+			// Record yang ditinggal diambil alih oleh retry berikutnya (lihat 'claimIdempotencyKey')
+			return c.JSON(http.StatusConflict, APIResponse{Error: "Request sebelumnya dengan Idempotency-Key ini terputus, silakan coba lagi"})
+		}
+		if time.Now().After(deadline) {
+			return c.JSON(http.StatusConflict, APIResponse{Error: "Request dengan Idempotency-Key ini masih diproses"})
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(idempotencyPollEvery):
+		}
+	}
+}
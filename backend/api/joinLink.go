@@ -0,0 +1,279 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/checkinintent"
+	"backend/ent/event"
+	"backend/ent/joinlink"
+	"backend/ent/user"
+	"backend/swagdto"
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// Link join bisa dipakai sejak sekian menit sebelum event dimulai
+	joinLinkEarlyWindow = 15 * time.Minute
+	// Default durasi kehadiran minimum (bisa diubah lewat JOIN_MIN_PRESENCE_MINUTES)
+	defaultJoinMinPresence = 10 * time.Minute
+	// 'device_id' untuk intent check-in yang berasal dari join link
+	joinLinkDeviceID = "join-link"
+)
+
+// joinMinPresence adalah durasi minimum antara klik pertama dan heartbeat
+// agar user dianggap hadir di event online.
+func joinMinPresence() time.Duration {
+	if v := os.Getenv("JOIN_MIN_PRESENCE_MINUTES"); v != "" {
+		if minutes, err := strconv.Atoi(v); err == nil && minutes > 0 {
+			return time.Duration(minutes) * time.Minute
+		}
+	}
+	return defaultJoinMinPresence
+}
+
+// publicURL menggabungkan PUBLIC_API_URL dengan path (jika env kosong, path relatif dikembalikan).
+func publicURL(path string) string {
+	return strings.TrimRight(os.Getenv("PUBLIC_API_URL"), "/") + path
+}
+
+// inJoinWindow: event berlangsung (termasuk jendela awal) dan belum dibatalkan.
+func inJoinWindow(ev *ent.Event, t time.Time) bool {
+	return ev.CancelledAt == nil && !t.Before(ev.StartDate.Add(-joinLinkEarlyWindow)) && !t.After(ev.EndDate)
+}
+
+// @Summary     Ambil Join Link (Event Online)
+// @Description Registrant event online mendapatkan link join unik. Link tersebut me-redirect ke URL meeting host
+// @Description dan dipakai untuk memverifikasi kehadiran (klik + heartbeat).
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id      path     int     true  "Event ID (On-Chain ID)"
+// @Success     200 {object} APIResponse{data=swagdto.JoinLinkResponse} "Join link"
+// @Failure     400 {object} APIResponse "Bukan event online / URL meeting tidak valid"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan / user belum register"
+// @Failure     409 {object} APIResponse "Event sudah dibatalkan"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /events/{id}/join-link [get]
+func (h *Handler) getJoinLink(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	eventID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+	}
+	address := sessionAddress(c)
+
+	// 1. Pastikan event online dan punya URL meeting yang valid
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Event not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if ev.CancelledAt != nil {
+		return c.JSON(http.StatusConflict, APIResponse{Error: errEventCancelled.Error()})
+	}
+	if ev.EventType != 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Join link hanya untuk event online"})
+	}
+	if u, err := url.Parse(ev.Location); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Event tidak punya URL meeting yang valid"})
+	}
+
+	// 2. Hanya registrant yang boleh mendapat link
+	registered, err := h.DB.Attendance.Query().
+		Where(
+			attendance.HasEventWith(event.EventIDEQ(eventID)),
+			attendance.HasUserWith(user.AddressEQ(address)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if !registered {
+		return c.JSON(http.StatusNotFound, APIResponse{Error: "User belum register ke event ini"})
+	}
+
+	// 3. Get-or-Create link
+	link, err := h.getOrCreateJoinLink(ctx, eventID, address)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.JoinLinkResponse{
+		EventID:     eventID,
+		UserAddress: address,
+		URL:         publicURL("/join/" + link.Token),
+		Heartbeat:   publicURL("/join/" + link.Token + "/heartbeat"),
+	}})
+}
+
+// getOrCreateJoinLink mengambil link join milik registrant, atau membuatnya jika belum ada.
+func (h *Handler) getOrCreateJoinLink(ctx context.Context, eventID uint64, address string) (*ent.JoinLink, error) {
+	link, err := h.DB.JoinLink.Query().
+		Where(joinlink.EventIDEQ(eventID), joinlink.UserAddressEQ(address)).
+		Only(ctx)
+	if !ent.IsNotFound(err) {
+		return link, err
+	}
+
+	token, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+	link, err = h.DB.JoinLink.Create().
+		SetToken(token).
+		SetEventID(eventID).
+		SetUserAddress(address).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Request paralel sudah membuat link-nya
+		return h.DB.JoinLink.Query().
+			Where(joinlink.EventIDEQ(eventID), joinlink.UserAddressEQ(address)).
+			Only(ctx)
+	}
+	return link, err
+}
+
+// @Summary     Redirect Join Link
+// @Description Mencatat waktu klik pertama (jika di dalam jendela waktu event) lalu me-redirect ke URL meeting host.
+// @Tags        Events
+// @Param       token path     string true "Token join link"
+// @Success     302 "Redirect ke URL meeting"
+// @Failure     404 {object} APIResponse "Link tidak ditemukan"
+// @Router      /join/{token} [get]
+func (h *Handler) redirectJoinLink(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	link, ev, err := h.getJoinLinkWithEvent(ctx, c.Param("token"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Join link not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// Hanya klik PERTAMA di dalam jendela waktu yang dicatat
+	now := time.Now()
+	if link.ClickedAt == nil && inJoinWindow(ev, now) {
+		if _, err := h.DB.JoinLink.Update().
+			Where(joinlink.IDEQ(link.ID), joinlink.ClickedAtIsNil()).
+			SetClickedAt(now).
+			Save(ctx); err != nil {
+			log.Printf("Gagal mencatat klik join link %d: %v", link.ID, err)
+		}
+	}
+
+	return c.Redirect(http.StatusFound, ev.Location)
+}
+
+// @Summary     Heartbeat Join Link
+// @Description Dipanggil client secara berkala selama mengikuti event online. Jika selisih antara klik pertama
+// @Description dan heartbeat sudah mencapai durasi minimum (default 10 menit), check-in otomatis diantrikan.
+// @Tags        Events
+// @Produce     json
+// @Param       token path     string true "Token join link"
+// @Success     200 {object} APIResponse{data=swagdto.JoinHeartbeatResponse} "Status kehadiran"
+// @Failure     400 {object} APIResponse "Di luar jendela waktu / belum klik link"
+// @Failure     404 {object} APIResponse "Link tidak ditemukan"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /join/{token}/heartbeat [post]
+func (h *Handler) joinLinkHeartbeat(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	link, ev, err := h.getJoinLinkWithEvent(ctx, c.Param("token"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Join link not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	now := time.Now()
+	if !inJoinWindow(ev, now) {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Event sedang tidak berlangsung"})
+	}
+	if link.ClickedAt == nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Buka join link terlebih dahulu"})
+	}
+
+	if err := link.Update().SetLastHeartbeatAt(now).Exec(ctx); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	required := joinMinPresence()
+	present := now.Sub(*link.ClickedAt)
+	qualified := link.Qualified
+
+	// Memenuhi syarat: antrikan check-in (sekali saja) lewat antrian check-in
+	if !qualified && present >= required {
+		claimed, err := h.DB.JoinLink.Update().
+			Where(joinlink.IDEQ(link.ID), joinlink.QualifiedEQ(false)).
+			SetQualified(true).
+			Save(ctx)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		if claimed > 0 {
+			if err := h.enqueueJoinLinkCheckIn(ctx, link, now); err != nil {
+				log.Printf("Gagal mengantrikan check-in join link %d: %v", link.ID, err)
+				// Kembalikan agar heartbeat berikutnya mencoba lagi
+				_ = link.Update().SetQualified(false).Exec(ctx)
+				return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+			}
+		}
+		qualified = true
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.JoinHeartbeatResponse{
+		PresentMinutes:  int(present.Minutes()),
+		RequiredMinutes: int(required.Minutes()),
+		Qualified:       qualified,
+	}})
+}
+
+// enqueueJoinLinkCheckIn memasukkan check-in ke antrian 'CheckInIntent'.
+// Worker antrian yang akan menjalankan transaksi 'UserCheckin'.
+func (h *Handler) enqueueJoinLinkCheckIn(ctx context.Context, link *ent.JoinLink, now time.Time) error {
+	_, err := h.DB.CheckInIntent.Create().
+		SetEventID(link.EventID).
+		SetUserAddress(link.UserAddress).
+		SetScannedAt(now).
+		SetDeviceID(joinLinkDeviceID).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Sudah ada di antrian (misal: dari kiosk). Jika sebelumnya gagal, coba lagi.
+		_, err = h.DB.CheckInIntent.Update().
+			Where(
+				checkinintent.EventIDEQ(link.EventID),
+				checkinintent.UserAddressEQ(link.UserAddress),
+				checkinintent.StatusEQ(checkinintent.StatusFailed),
+			).
+			SetStatus(checkinintent.StatusPending).
+			SetAttempts(0).
+			Save(ctx)
+	}
+	return err
+}
+
+func (h *Handler) getJoinLinkWithEvent(ctx context.Context, token string) (*ent.JoinLink, *ent.Event, error) {
+	link, err := h.DB.JoinLink.Query().Where(joinlink.TokenEQ(token)).Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(link.EventID)).Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return link, ev, nil
+}
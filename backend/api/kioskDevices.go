@@ -0,0 +1,203 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/kioskdevice"
+	"backend/swagdto"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Prefix ID kiosk yang dibuat server (tidak bisa bertabrakan dengan 'joinLinkDeviceID')
+const kioskDeviceIDPrefix = "kiosk_"
+
+func kioskDeviceResponse(d *ent.KioskDevice) *swagdto.KioskDeviceResponse {
+	return &swagdto.KioskDeviceResponse{
+		DeviceID:      d.DeviceID,
+		Name:          d.Name,
+		EventID:       d.EventID,
+		ProvisionedBy: d.ProvisionedBy,
+		RevokedAt:     d.RevokedAt,
+		CreatedAt:     d.CreatedAt,
+	}
+}
+
+// kioskVerifier memverifikasi intent dari kiosk dalam satu request.
+// Device dan izin pendaftarnya di-cache agar batch besar tidak mengulang query yang sama.
+type kioskVerifier struct {
+	h       *Handler
+	devices map[string]*ent.KioskDevice
+	allowed map[string]bool // key: "eventID|provisionedBy"
+}
+
+func (h *Handler) newKioskVerifier() *kioskVerifier {
+	return &kioskVerifier{h: h, devices: map[string]*ent.KioskDevice{}, allowed: map[string]bool{}}
+}
+
+// errKioskRejected menandai intent yang ditolak (bukan kesalahan server).
+var errKioskRejected = errors.New("kiosk ditolak")
+
+// verify memastikan intent ditandatangani kiosk yang terdaftar untuk event 'eventID',
+// dan pendaftar kiosk masih punya izin check-in di event itu.
+// 'userAddress' harus sudah dinormalisasi; signature dihitung dari alamat yang dinormalisasi.
+// Error yang membungkus 'errKioskRejected' berarti intent tidak valid.
+func (v *kioskVerifier) verify(ctx context.Context, in CheckInIntentRequest, eventID uint64, userAddress string) error {
+	// 1. Device harus ada, belum dicabut, dan terikat ke event ini
+	d, ok := v.devices[in.DeviceID]
+	if !ok {
+		var err error
+		d, err = v.h.DB.KioskDevice.Query().Where(kioskdevice.DeviceIDEQ(in.DeviceID)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		v.devices[in.DeviceID] = d
+	}
+	if d == nil || d.RevokedAt != nil {
+		return fmt.Errorf("%w: device '%s' tidak terdaftar", errKioskRejected, in.DeviceID)
+	}
+	if d.EventID != eventID {
+		return fmt.Errorf("%w: device '%s' tidak terdaftar untuk event ini", errKioskRejected, in.DeviceID)
+	}
+
+	// 2. Signature
+	payload := fmt.Sprintf("%s|%s|%d|%s", in.EventID, userAddress, in.ScannedAt, in.DeviceID)
+	mac := hmac.New(sha256.New, []byte(d.Secret))
+	mac.Write([]byte(payload))
+	signature, err := hex.DecodeString(in.Signature)
+	if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+		return fmt.Errorf("%w: signature tidak valid", errKioskRejected)
+	}
+
+	// 3. Pendaftar kiosk masih host/staff event ini (staff yang dicabut ikut mematikan kiosk-nya)
+	key := fmt.Sprintf("%d|%s", eventID, d.ProvisionedBy)
+	allowed, ok := v.allowed[key]
+	if !ok {
+		role, err := v.h.eventRole(ctx, eventID, d.ProvisionedBy)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		allowed = roleHasPermission(role, permCheckIn)
+		v.allowed[key] = allowed
+	}
+	if !allowed {
+		return fmt.Errorf("%w: pendaftar device '%s' tidak lagi punya izin check-in", errKioskRejected, in.DeviceID)
+	}
+	return nil
+}
+
+// @Summary     Daftar Kiosk Event
+// @Description Menampilkan kiosk check-in (mode offline) yang terdaftar untuk event ini, termasuk yang sudah dicabut.
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "Event ID (On-Chain ID)"
+// @Success     200 {object} APIResponse{data=[]swagdto.KioskDeviceResponse} "Daftar kiosk"
+// @Failure     403 {object} APIResponse "Tidak punya izin check-in"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Router      /events/{id}/kiosks [get]
+func (h *Handler) getKioskDevices(c echo.Context) error {
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	devices, err := h.DB.KioskDevice.Query().
+		Where(kioskdevice.EventIDEQ(eventID)).
+		Order(ent.Asc(kioskdevice.FieldCreatedAt)).
+		All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	responses := make([]*swagdto.KioskDeviceResponse, 0, len(devices))
+	for _, d := range devices {
+		responses = append(responses, kioskDeviceResponse(d))
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses})
+}
+
+// @Summary     Daftarkan Kiosk Event
+// @Description Host/staff mendaftarkan kiosk check-in (mode offline) untuk event ini.
+// @Description Secret HMAC hanya ditampilkan sekali di respon ini; kiosk memakainya untuk menandatangani intent
+// @Description ke POST /event/check-in/queue. Kiosk hanya bisa meng-check-in event ini, dan berhenti berlaku
+// @Description jika dicabut atau pendaftarnya kehilangan izin check-in.
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id   path     int                  true "Event ID (On-Chain ID)"
+// @Param       body body     ProvisionKioskRequest true "Label kiosk"
+// @Success     201 {object} APIResponse{data=swagdto.KioskDeviceResponse} "Kiosk terdaftar (beserta secret)"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     403 {object} APIResponse "Tidak punya izin check-in"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Router      /events/{id}/kiosks [post]
+func (h *Handler) provisionKioskDevice(c echo.Context) error {
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	req := new(ProvisionKioskRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+
+	id, err := newNonce()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	secret, err := newNonce()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	d, err := h.DB.KioskDevice.Create().
+		SetDeviceID(kioskDeviceIDPrefix + id).
+		SetName(req.Name).
+		SetSecret(secret).
+		SetEventID(eventID).
+		SetProvisionedBy(sessionAddress(c)).
+		Save(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	resp := kioskDeviceResponse(d)
+	resp.Secret = d.Secret
+	return c.JSON(http.StatusCreated, APIResponse{Data: resp})
+}
+
+// @Summary     Cabut Kiosk Event
+// @Description Menonaktifkan kiosk. Intent baru dari kiosk ini ditolak; intent yang sudah diantrikan tetap diproses.
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id       path     int     true "Event ID (On-Chain ID)"
+// @Param       deviceId path     string  true "ID kiosk"
+// @Success     200 {object} APIResponse "Kiosk dicabut"
+// @Failure     403 {object} APIResponse "Tidak punya izin check-in"
+// @Failure     404 {object} APIResponse "Kiosk tidak ditemukan"
+// @Router      /events/{id}/kiosks/{deviceId} [delete]
+func (h *Handler) revokeKioskDevice(c echo.Context) error {
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	n, err := h.DB.KioskDevice.Update().
+		Where(
+			kioskdevice.EventIDEQ(eventID),
+			kioskdevice.DeviceIDEQ(c.Param("deviceId")),
+			kioskdevice.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if n == 0 {
+		return c.JSON(http.StatusNotFound, APIResponse{Error: "Kiosk tidak ditemukan"})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: map[string]string{"message": "Kiosk revoked"}})
+}
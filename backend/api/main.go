@@ -6,8 +6,6 @@ import (
 	"log"
 	"os"
 
-	_ "backend/docs"
-
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -18,13 +16,21 @@ import (
 // @version 1.0
 // @host localhost:8000
 // @BasePath /
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Token sesi dari /auth/login, format: "Bearer <token>"
+// @securityDefinitions.apikey APIKeyAuth
+// @in header
+// @name X-API-Key
+// @description API key partner (dibuat oleh admin)
 func main() {
 	err := godotenv.Load()
 	if err != nil {
 		log.Println("Warning: .env file not found, using environment variables from system:", err)
 	}
 
-	client := utils.Open(os.Getenv("DATABASE_URL"))
+	client, db := utils.OpenWithDB(os.Getenv("DATABASE_URL"))
 	defer client.Close()
 
 	ctx := context.Background()
@@ -33,17 +39,81 @@ func main() {
 	}
 
 	e := echo.New()
+	// IP client untuk rate limit (lihat TRUSTED_PROXIES)
+	e.IPExtractor = ipExtractorFromEnv()
 
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
 
 	e.Use(middleware.CORS())
 
-	h := &Handler{DB: client}
-	e.GET("/swagger/*", echoSwagger.WrapHandler)
+	geocoder, err := utils.NewGeocoderFromEnv()
+	if err != nil {
+		log.Fatalf("gagal menyiapkan geocoder: %v", err)
+	}
+
+	h := &Handler{DB: client, SQL: db, Geocoder: geocoder}
+
+	// Index full-text search (tsvector GIN) untuk /search
+	if err := h.ensureSearchIndexes(ctx); err != nil {
+		log.Fatalf("gagal membuat index pencarian: %v", err)
+	}
+
+	// Klaim free mint untuk user lama yang hanya punya flag 'is_free_minted'
+	if err := h.backfillLegacyFreeMintClaims(ctx); err != nil {
+		log.Fatalf("gagal backfill klaim free mint: %v", err)
+	}
+
+	// API key partner (header 'X-API-Key'): scope, rate per menit, dan kuota harian.
+	e.Use(h.apiKeyMiddleware)
+
+	// Worker antrian check-in kiosk (mode offline)
+	go h.runCheckInQueueWorker(ctx)
+	// Worker kualifikasi referral (mint credit setelah invitee check-in)
+	go h.runReferralWorker(ctx)
+	// Worker waitlist (tawarkan slot kosong, tandai klaim/kedaluwarsa)
+	go h.runWaitlistWorker(ctx)
+	// Scheduler event series (buat occurrence on-chain sebelum jadwalnya)
+	go h.runSeriesScheduler(ctx)
+	// Worker import event (buat event on-chain dari baris import yang antri)
+	go h.runEventImportWorker(ctx)
+
+	e.GET("/swagger/*", echoSwagger.EchoWrapHandler(echoSwagger.InstanceName(filterDocsInstance)))
 	e.GET("/listings", h.getListings)
 	e.GET("/events", h.getEvents)
+	e.POST("/events", h.createEvent, h.rateLimit("create_event"), h.requireAuth, h.requireHost)
+	e.POST("/events/import", h.importEvents, h.rateLimit("import_events"), h.requireAuth, h.requireHost, h.idempotent)
+	e.GET("/events/import/:id", h.getEventImport, h.requireAuth)
+	e.GET("/events/clusters", h.getEventClusters)
 	e.GET("/events/:id", h.getEventByID)
+	e.PATCH("/events/:id", h.updateEvent, h.requireAuth, h.requireEventPermission(permEditEvent))
+	e.POST("/events/:id/cancel", h.cancelEvent, h.requireAuth, h.requireEventPermission(permEditEvent))
+	e.GET("/events/:id/check-in-token", h.getCheckInToken, h.requireAuth)
+	e.PUT("/events/:id/geofence", h.updateEventGeofence, h.requireAuth, h.requireEventPermission(permEditEvent))
+	e.POST("/events/:id/self-check-in", h.selfCheckIn, h.requireAuth)
+	e.GET("/events/:id/join-link", h.getJoinLink, h.requireAuth)
+	e.GET("/events/:id/attendees/export", h.exportAttendees, h.requireAuthOrAPIKey, h.requireEventPermission(permExportAttendees))
+	e.GET("/events/:id/staff", h.getEventStaff, h.requireAuth, h.requireEventPermission(permManageStaff))
+	e.POST("/events/:id/staff", h.addEventStaff, h.requireAuth, h.requireEventPermission(permManageStaff))
+	e.DELETE("/events/:id/staff/:address", h.removeEventStaff, h.requireAuth, h.requireEventPermission(permManageStaff))
+	e.GET("/events/:id/kiosks", h.getKioskDevices, h.requireAuth, h.requireEventPermission(permCheckIn))
+	e.POST("/events/:id/kiosks", h.provisionKioskDevice, h.requireAuth, h.requireEventPermission(permCheckIn))
+	e.DELETE("/events/:id/kiosks/:deviceId", h.revokeKioskDevice, h.requireAuth, h.requireEventPermission(permCheckIn))
+	e.PUT("/events/:id/privacy", h.updateEventPrivacy, h.requireAuth, h.requireEventPermission(permEditEvent))
+	e.PUT("/events/:id/quota", h.updateEventQuota, h.requireAuth, h.requireEventPermission(permEditEvent))
+	e.POST("/events/:id/waitlist", h.joinWaitlist, h.requireAuth)
+	e.DELETE("/events/:id/waitlist", h.leaveWaitlist, h.requireAuth)
+	e.GET("/events/:id/waitlist/me", h.getMyWaitlistEntry, h.requireAuth)
+	e.GET("/events/:id/waitlist", h.getEventWaitlist, h.requireAuth, h.requireEventPermission(permManageWaitlist))
+	e.PUT("/events/:id/waitlist/order", h.reorderWaitlist, h.requireAuth, h.requireEventPermission(permManageWaitlist))
+	e.POST("/series", h.createSeries, h.requireAuth, h.requireHost, h.idempotent)
+	e.GET("/series", h.getSeriesList)
+	e.GET("/series/:id", h.getSeriesByID)
+	e.PUT("/series/:id/status", h.updateSeriesStatus, h.requireAuth)
+	e.GET("/series/:id/attendance", h.getSeriesAttendance, h.requireAuth)
+	e.GET("/series/:id/attendance/me", h.getMySeriesAttendance, h.requireAuth)
+	e.GET("/join/:token", h.redirectJoinLink)
+	e.POST("/join/:token/heartbeat", h.joinLinkHeartbeat)
 	e.GET("/profiles/:address", h.getUserProfile)
 	e.GET("/accessories", h.getAccessories)
 	e.GET("/moments", h.getMoments)
@@ -52,18 +122,58 @@ func main() {
 	e.GET("/users", h.getUsers)
 	e.GET("/users/:address", h.getUserByAddress)
 	e.GET("/users/search", h.searchUsers)
+	e.GET("/users/:address/calendar.ics", h.getUserCalendar)
+	e.POST("/users/me/calendar-token", h.createCalendarToken, h.requireAuth)
+	e.GET("/users/me/privacy", h.getMyPrivacy, h.requireAuth)
+	e.PUT("/users/me/privacy", h.updateMyPrivacy, h.requireAuth)
+	e.GET("/search", h.search)
 
-	e.POST("/moment/free", h.freeMintMoment)
-	e.POST("/moment/with-event-pass", h.mintMomentWithEventPass)
-	e.POST("/event/check-in", h.checkInUser)
+	e.POST("/moment/free", h.freeMintMoment, h.rateLimit("moment_free"), h.requireAuth, h.idempotent)
+	e.POST("/moment/with-event-pass", h.mintMomentWithEventPass, h.rateLimit("moment_with_event_pass"), h.requireAuth, h.idempotent)
+	e.POST("/event/check-in", h.checkInUser, h.requireAuth, h.idempotent)
+	e.POST("/event/check-in/batch", h.batchCheckInUsers, h.requireAuth)
+	e.POST("/event/check-in/queue", h.queueCheckIns)
+	e.GET("/event/check-in/queue", h.getCheckInQueue, h.requireAuth)
+	e.POST("/event/check-in/token", h.checkInWithToken, h.requireAuth)
 
 	// Social Routes
-	e.POST("/moments/:id/like", h.toggleLike)
-	e.POST("/moments/:id/comments", h.createComment)
+	e.POST("/moments/:id/like", h.toggleLike, h.rateLimit("like"), h.requireAuth)
+	e.POST("/moments/:id/comments", h.createComment, h.rateLimit("comment"), h.requireAuth)
 	e.GET("/moments/:id/comments", h.getComments)
+	e.DELETE("/moments/:id/comments/:commentId", h.deleteComment, h.requireAuth)
 
 	// Upload Route
-	e.POST("/upload", h.uploadImage)
+	e.POST("/upload", h.uploadImage, h.rateLimit("upload"), h.requireAuth, h.idempotent)
+
+	// Auth Routes (FCL account-proof)
+	e.GET("/auth/nonce", h.getAuthNonce)
+	e.POST("/auth/login", h.login)
+	e.POST("/auth/logout", h.logout, h.requireAuth)
+	e.GET("/auth/me", h.getMe, h.requireAuth)
+
+	// API Key Routes
+	e.GET("/api-keys/me", h.getAPIKeyUsage)
+	admin := e.Group("/admin", h.requireAuth, h.requireAdmin)
+	admin.POST("/api-keys", h.createAPIKey)
+	admin.GET("/api-keys", h.getAPIKeys)
+	admin.DELETE("/api-keys/:id", h.revokeAPIKey)
+	admin.PUT("/claim-quotas", h.upsertClaimQuota)
+	admin.GET("/claim-quotas", h.getClaimQuotas)
+	admin.GET("/referrals/stats", h.getReferralStats)
+
+	// Notification Routes
+	e.GET("/notifications", h.getNotifications, h.requireAuth)
+	e.POST("/notifications/read-all", h.markAllNotificationsRead, h.requireAuth)
+	e.POST("/notifications/:id/read", h.markNotificationRead, h.requireAuth)
+
+	// Claims Routes
+	e.GET("/claims/me", h.getMyClaims, h.requireAuth)
+
+	// Referral Routes
+	e.POST("/referrals/codes", h.createInviteCode, h.requireAuth)
+	e.GET("/referrals/codes", h.getMyInviteCodes, h.requireAuth)
+	e.POST("/referrals/redeem", h.redeemInviteCode, h.requireAuth)
+	e.GET("/referrals/stats", h.getMyReferralStats, h.requireAuth)
 
 	log.Println("Server API dimulai di http://localhost:8000")
 	e.Logger.Fatal(e.Start(":8000"))
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/notification"
+	"backend/ent/predicate"
+	"backend/swagdto"
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Notifikasi in-app. Dibuat oleh backend (misal: worker waitlist) dan dibaca user
+// lewat GET /notifications (polling dari frontend).
+
+// notify menyimpan notifikasi untuk satu user. Kegagalan hanya di-log
+// agar tidak menggagalkan proses utama.
+func (h *Handler) notify(ctx context.Context, address, kind, title, body string, data map[string]any) {
+	_, err := h.DB.Notification.Create().
+		SetAddress(address).
+		SetType(kind).
+		SetTitle(title).
+		SetBody(body).
+		SetData(data).
+		Save(ctx)
+	if err != nil {
+		log.Printf("Gagal menyimpan notifikasi '%s' untuk %s: %v", kind, address, err)
+	}
+}
+
+// notifyMany menyimpan notifikasi yang sama untuk banyak user sekaligus.
+func (h *Handler) notifyMany(ctx context.Context, addresses []string, kind, title, body string, data map[string]any) {
+	if len(addresses) == 0 {
+		return
+	}
+	builders := make([]*ent.NotificationCreate, len(addresses))
+	for i, address := range addresses {
+		builders[i] = h.DB.Notification.Create().
+			SetAddress(address).
+			SetType(kind).
+			SetTitle(title).
+			SetBody(body).
+			SetData(data)
+	}
+	if _, err := h.DB.Notification.CreateBulk(builders...).Save(ctx); err != nil {
+		log.Printf("Gagal menyimpan %d notifikasi '%s': %v", len(addresses), kind, err)
+	}
+}
+
+func notificationResponse(n *ent.Notification) *swagdto.NotificationResponse {
+	return &swagdto.NotificationResponse{
+		ID:        n.ID,
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+// @Summary     Notifikasi Saya
+// @Description Menampilkan notifikasi user yang login, terbaru lebih dulu.
+// @Tags        Notifications
+// @Produce     json
+// @Security    BearerAuth
+// @Param       unread   query    bool   false "true = hanya yang belum dibaca"
+// @Param       page     query    int    false "Nomor halaman"
+// @Param       pageSize query    int    false "Jumlah per halaman"
+// @Param       cursor   query    string false "Cursor pagination"
+// @Success     200 {object} APIResponse{data=[]swagdto.NotificationResponse} "Daftar notifikasi"
+// @Failure     400 {object} APIResponse "Parameter tidak valid"
+// @Failure     401 {object} APIResponse "Belum login"
+// @Router      /notifications [get]
+func (h *Handler) getNotifications(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	page, err := newListPage(c, notificationFilters)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+	}
+
+	query := h.DB.Notification.Query().Where(notification.AddressEQ(sessionAddress(c)))
+	if v := c.QueryParam("unread"); v != "" {
+		unread, err := strconv.ParseBool(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "unread harus true/false"})
+		}
+		if unread {
+			query = query.Where(notification.ReadAtIsNil())
+		}
+	}
+
+	if err := page.count(ctx, query.Count); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if w := page.where(); w != nil {
+		query = query.Where(predicate.Notification(w))
+	}
+
+	items, err := query.
+		Limit(page.limit()).
+		Offset(page.offset()).
+		Order(notification.OrderOption(page.order())).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	items, pagination := finishPage(page, items, func(n *ent.Notification) (any, int) { return n.CreatedAt, n.ID })
+
+	responses := make([]*swagdto.NotificationResponse, 0, len(items))
+	for _, n := range items {
+		responses = append(responses, notificationResponse(n))
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses, Pagination: pagination})
+}
+
+// @Summary     Tandai Notifikasi Dibaca
+// @Description Menandai satu notifikasi milik user sebagai sudah dibaca.
+// @Tags        Notifications
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "ID notifikasi"
+// @Success     200 {object} APIResponse{data=swagdto.NotificationResponse} "Notifikasi"
+// @Failure     400 {object} APIResponse "ID tidak valid"
+// @Failure     404 {object} APIResponse "Notifikasi tidak ditemukan"
+// @Router      /notifications/{id}/read [post]
+func (h *Handler) markNotificationRead(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "ID notifikasi tidak valid"})
+	}
+
+	n, err := h.DB.Notification.Query().
+		Where(notification.IDEQ(id), notification.AddressEQ(sessionAddress(c))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Notifikasi tidak ditemukan"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if n.ReadAt == nil {
+		if n, err = n.Update().SetReadAt(time.Now()).Save(ctx); err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: notificationResponse(n)})
+}
+
+// @Summary     Tandai Semua Notifikasi Dibaca
+// @Tags        Notifications
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse "Jumlah notifikasi yang ditandai"
+// @Router      /notifications/read-all [post]
+func (h *Handler) markAllNotificationsRead(c echo.Context) error {
+	updated, err := h.DB.Notification.Update().
+		Where(notification.AddressEQ(sessionAddress(c)), notification.ReadAtIsNil()).
+		SetReadAt(time.Now()).
+		Save(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: map[string]int{"updated": updated}})
+}
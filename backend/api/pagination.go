@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/labstack/echo/v4"
+)
+
+// Pagination mendukung dua mode:
+//   - Offset (default, kompatibel dengan client lama): '?page=2&pageSize=10'.
+//   - Cursor/keyset: '?cursor=' (kosong = halaman pertama), lalu pakai 'nextCursor'/'prevCursor'
+//     dari respon. Tidak ada duplikat walaupun ada data baru masuk saat paging.
+//
+// Total ('totalItems'/'totalPages') butuh COUNT() tambahan: default aktif di mode offset,
+// non-aktif di mode cursor. Bisa diatur dengan '?withTotal=true|false'.
+
+// keysetSpec adalah kolom urutan utama sebuah list (selalu DESC, dengan 'id' sebagai tie-breaker).
+type keysetSpec struct {
+	Column string
+	Time   bool // true jika kolom bertipe waktu
+}
+
+var (
+	keysetByID        = keysetSpec{Column: "id"}
+	keysetByStartDate = keysetSpec{Column: "start_date", Time: true}
+	keysetByCreatedAt = keysetSpec{Column: "created_at", Time: true}
+)
+
+// pageCursor adalah isi cursor (di-encode base64url, dianggap opaque oleh client).
+type pageCursor struct {
+	Key  string `json:"k,omitempty"`
+	ID   int    `json:"id"`
+	Prev bool   `json:"p,omitempty"` // true = halaman sebelumnya
+}
+
+var errInvalidCursor = errors.New("cursor tidak valid")
+
+func encodeCursor(pc pageCursor) string {
+	b, _ := json.Marshal(pc)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(raw string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	pc := new(pageCursor)
+	if err := json.Unmarshal(b, pc); err != nil {
+		return nil, errInvalidCursor
+	}
+	return pc, nil
+}
+
+// listPage adalah parameter pagination sebuah request list.
+type listPage struct {
+	spec       keysetSpec
+	cursorMode bool
+	cursor     *pageCursor
+	cursorKey  any // nilai 'cursor.Key' yang sudah di-parse sesuai tipe kolom
+	withTotal  bool
+	totalItems int
+
+	filters []func(*sql.Selector) // dari 'filter[...]' (lihat 'filters.go')
+	sort    []sortTerm            // dari '?sort=', nil = urutan default (keyset)
+
+	page, pageSize int
+}
+
+// newListPage membaca parameter pagination (page, pageSize, cursor, withTotal) beserta filter & sort.
+func newListPage(c echo.Context, f *entityFilters) (*listPage, error) {
+	spec := f.Keyset
+	_, _, page, pageSize := getPagination(c)
+	p := &listPage{
+		spec:       spec,
+		cursorMode: c.QueryParams().Has("cursor"),
+		page:       page,
+		pageSize:   pageSize,
+	}
+
+	p.withTotal = !p.cursorMode
+	if v := c.QueryParam("withTotal"); v != "" {
+		withTotal, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.New("withTotal harus true/false")
+		}
+		p.withTotal = withTotal
+	}
+
+	if raw := c.QueryParam("cursor"); raw != "" {
+		pc, err := decodeCursor(raw)
+		if err != nil {
+			return nil, err
+		}
+		if spec.Time {
+			t, err := time.Parse(time.RFC3339Nano, pc.Key)
+			if err != nil {
+				return nil, errInvalidCursor
+			}
+			p.cursorKey = t
+		}
+		p.cursor = pc
+	}
+
+	filters, err := f.parseFilters(c)
+	if err != nil {
+		return nil, err
+	}
+	p.filters = filters
+
+	sort, err := f.parseSort(c.QueryParam("sort"))
+	if err != nil {
+		return nil, err
+	}
+	// Cursor hanya berlaku untuk urutan keyset default (kolom keyset DESC)
+	if sort != nil && !(len(sort) == 1 && sort[0].Column == spec.Column && sort[0].Desc) {
+		if p.cursorMode {
+			return nil, errors.New("sort kustom hanya didukung di mode offset (tanpa cursor)")
+		}
+		p.sort = sort
+	}
+	return p, nil
+}
+
+// filter mengembalikan gabungan predicate dari 'filter[...]' (nil jika tidak ada).
+// Terapkan SEBELUM 'count' agar total ikut terfilter.
+func (p *listPage) filter() func(*sql.Selector) {
+	if len(p.filters) == 0 {
+		return nil
+	}
+	return func(s *sql.Selector) {
+		for _, f := range p.filters {
+			f(s)
+		}
+	}
+}
+
+// where mengembalikan predicate keyset (nil jika bukan mode cursor / halaman pertama).
+// Pakai dengan konversi ke tipe predicate entity, misal: 'predicate.Event(w)'.
+func (p *listPage) where() func(*sql.Selector) {
+	if p.cursor == nil {
+		return nil
+	}
+	pc := p.cursor
+	return func(s *sql.Selector) {
+		// Urutan DESC: halaman berikutnya = nilai lebih kecil, sebelumnya = lebih besar
+		cmp := sql.LT
+		if pc.Prev {
+			cmp = sql.GT
+		}
+		id := s.C("id")
+		if p.spec.Column == "id" {
+			s.Where(cmp(id, pc.ID))
+			return
+		}
+		col := s.C(p.spec.Column)
+		s.Where(sql.Or(
+			cmp(col, p.cursorKey),
+			sql.And(sql.EQ(col, p.cursorKey), cmp(id, pc.ID)),
+		))
+	}
+}
+
+// order mengembalikan urutan (kolom, id). Pakai dengan konversi ke tipe OrderOption entity.
+func (p *listPage) order() func(*sql.Selector) {
+	if p.sort != nil {
+		return func(s *sql.Selector) {
+			hasID := false
+			for _, t := range p.sort {
+				if t.Desc {
+					s.OrderBy(sql.Desc(s.C(t.Column)))
+				} else {
+					s.OrderBy(s.C(t.Column))
+				}
+				hasID = hasID || t.Column == "id"
+			}
+			// 'id' sebagai tie-breaker agar urutan offset stabil
+			if !hasID {
+				s.OrderBy(s.C("id"))
+			}
+		}
+	}
+	return func(s *sql.Selector) {
+		dir := sql.Desc
+		if p.cursor != nil && p.cursor.Prev {
+			// Ambil mundur, lalu dibalik di 'finishPage'
+			dir = sql.Asc
+		}
+		if p.spec.Column == "id" {
+			s.OrderBy(dir(s.C("id")))
+			return
+		}
+		s.OrderBy(dir(s.C(p.spec.Column)), dir(s.C("id")))
+	}
+}
+
+// limit: di mode cursor diambil 1 item ekstra untuk mengetahui apakah masih ada halaman lain.
+func (p *listPage) limit() int {
+	if p.cursorMode {
+		return p.pageSize + 1
+	}
+	return p.pageSize
+}
+
+func (p *listPage) offset() int {
+	if p.cursorMode {
+		return 0
+	}
+	return (p.page - 1) * p.pageSize
+}
+
+// count menjalankan COUNT() hanya jika total diminta. Panggil SEBELUM predicate keyset diterapkan.
+func (p *listPage) count(ctx context.Context, count func(context.Context) (int, error)) error {
+	if !p.withTotal {
+		return nil
+	}
+	n, err := count(ctx)
+	p.totalItems = n
+	return err
+}
+
+// finishPage memotong item ekstra, membalik urutan halaman 'prev', dan membuat metadata Pagination.
+// 'keyOf' mengembalikan nilai kolom urutan dan ID sebuah item.
+func finishPage[T any](p *listPage, rows []T, keyOf func(T) (any, int)) ([]T, *Pagination) {
+	pagination := &Pagination{PageSize: p.pageSize}
+	if p.withTotal {
+		pagination.TotalItems = p.totalItems
+		pagination.TotalPages = int(math.Ceil(float64(p.totalItems) / float64(p.pageSize)))
+	}
+	if !p.cursorMode {
+		pagination.CurrentPage = p.page
+		return rows, pagination
+	}
+
+	hasMore := len(rows) > p.pageSize
+	if hasMore {
+		rows = rows[:p.pageSize]
+	}
+	backward := p.cursor != nil && p.cursor.Prev
+	if backward {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, pagination
+	}
+
+	cursorOf := func(item T, prev bool) string {
+		key, id := keyOf(item)
+		pc := pageCursor{ID: id, Prev: prev}
+		if t, ok := key.(time.Time); ok {
+			pc.Key = t.UTC().Format(time.RFC3339Nano)
+		}
+		return encodeCursor(pc)
+	}
+	// Maju: ada 'next' jika masih ada sisa, ada 'prev' jika bukan halaman pertama.
+	// Mundur: kebalikannya.
+	if (!backward && hasMore) || backward {
+		pagination.NextCursor = cursorOf(rows[len(rows)-1], false)
+	}
+	if (backward && hasMore) || (!backward && p.cursor != nil) {
+		pagination.PrevCursor = cursorOf(rows[0], true)
+	}
+	return rows, pagination
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/labstack/echo/v4"
+)
+
+func newTestContext(query url.Values) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "/?"+query.Encode(), nil)
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []pageCursor{
+		{ID: 1},
+		{ID: 42, Prev: true},
+		{Key: "2026-03-08T14:00:00.123456789Z", ID: 7},
+		{Key: "2026-01-01T00:00:00Z", ID: 9, Prev: true},
+	}
+	for _, pc := range tests {
+		raw := encodeCursor(pc)
+		if strings.ContainsAny(raw, "+/=") {
+			t.Errorf("encodeCursor(%+v) = %q, bukan base64url tanpa padding", pc, raw)
+		}
+		got, err := decodeCursor(raw)
+		if err != nil {
+			t.Fatalf("decodeCursor(%q): %v", raw, err)
+		}
+		if *got != pc {
+			t.Errorf("decodeCursor(encodeCursor(%+v)) = %+v", pc, *got)
+		}
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	for _, raw := range []string{
+		"bukan base64!",
+		"eyJpZCI6MX0=", // base64 dengan padding
+		base64.RawURLEncoding.EncodeToString([]byte("bukan json")),
+		base64.RawURLEncoding.EncodeToString([]byte(`{"id":"satu"}`)),
+	} {
+		if _, err := decodeCursor(raw); !errors.Is(err, errInvalidCursor) {
+			t.Errorf("decodeCursor(%q) error = %v, ingin errInvalidCursor", raw, err)
+		}
+	}
+}
+
+func TestNewListPageErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{"cursor tidak valid", url.Values{"cursor": {"xyz!"}}},
+		{"key cursor bukan waktu", url.Values{"cursor": {encodeCursor(pageCursor{Key: "kemarin", ID: 1})}}},
+		{"withTotal tidak valid", url.Values{"withTotal": {"mungkin"}}},
+		{"sort kustom di mode cursor", url.Values{"cursor": {""}, "sort": {"name"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newListPage(newTestContext(tt.query), eventFilters); err == nil {
+				t.Fatal("newListPage berhasil, ingin error")
+			}
+		})
+	}
+}
+
+func TestListPageWhere(t *testing.T) {
+	key := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		filters  *entityFilters
+		cursor   pageCursor
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "id maju",
+			filters:  momentFilters,
+			cursor:   pageCursor{ID: 10},
+			wantSQL:  `SELECT * FROM "t" WHERE "t"."id" < $1`,
+			wantArgs: []any{10},
+		},
+		{
+			name:     "id mundur",
+			filters:  momentFilters,
+			cursor:   pageCursor{ID: 10, Prev: true},
+			wantSQL:  `SELECT * FROM "t" WHERE "t"."id" > $1`,
+			wantArgs: []any{10},
+		},
+		{
+			name:     "start_date maju",
+			filters:  eventFilters,
+			cursor:   pageCursor{Key: key.Format(time.RFC3339Nano), ID: 3},
+			wantSQL:  `SELECT * FROM "t" WHERE "t"."start_date" < $1 OR ("t"."start_date" = $2 AND "t"."id" < $3)`,
+			wantArgs: []any{key, key, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestContext(url.Values{"cursor": {encodeCursor(tt.cursor)}})
+			p, err := newListPage(c, tt.filters)
+			if err != nil {
+				t.Fatalf("newListPage: %v", err)
+			}
+			s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("t"))
+			p.where()(s)
+			query, args := s.Query()
+			if query != tt.wantSQL {
+				t.Errorf("SQL = %s\ningin %s", query, tt.wantSQL)
+			}
+			if len(args) != len(tt.wantArgs) {
+				t.Fatalf("args = %v, ingin %v", args, tt.wantArgs)
+			}
+			for i := range args {
+				if at, ok := args[i].(time.Time); ok {
+					if !at.Equal(tt.wantArgs[i].(time.Time)) {
+						t.Errorf("arg %d = %v, ingin %v", i, args[i], tt.wantArgs[i])
+					}
+				} else if args[i] != tt.wantArgs[i] {
+					t.Errorf("arg %d = %v, ingin %v", i, args[i], tt.wantArgs[i])
+				}
+			}
+		})
+	}
+}
+
+// testEvent adalah baris event in-memory untuk mensimulasikan query keyset.
+type testEvent struct {
+	id    int
+	start time.Time
+}
+
+// fetchPage mensimulasikan query yang dibangun dari 'where', 'order' dan 'limit' untuk keyset start_date.
+func fetchPage(p *listPage, all []testEvent) []testEvent {
+	// Urutan default: start_date DESC, id DESC
+	rows := slices.Clone(all)
+	slices.SortFunc(rows, func(a, b testEvent) int {
+		if c := b.start.Compare(a.start); c != 0 {
+			return c
+		}
+		return b.id - a.id
+	})
+	if p.cursor != nil {
+		key := p.cursorKey.(time.Time)
+		after := func(e testEvent) bool { // sesudah cursor dalam urutan DESC
+			return e.start.Before(key) || (e.start.Equal(key) && e.id < p.cursor.ID)
+		}
+		var out []testEvent
+		for _, e := range rows {
+			if after(e) != p.cursor.Prev && !(e.start.Equal(key) && e.id == p.cursor.ID) {
+				out = append(out, e)
+			}
+		}
+		rows = out
+		if p.cursor.Prev {
+			slices.Reverse(rows)
+		}
+	}
+	return rows[:min(len(rows), p.limit())]
+}
+
+func TestCursorPaging(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 10, 0, 0, 0, time.UTC) }
+	// Beberapa event berbagi start_date yang sama agar tie-breaker 'id' teruji
+	events := []testEvent{
+		{1, day(1)}, {2, day(3)}, {3, day(3)}, {4, day(3)},
+		{5, day(2)}, {6, day(5)}, {7, day(4)}, {8, day(4)},
+	}
+	want := []int{6, 8, 7, 4, 3, 2, 5, 1}
+	keyOf := func(e testEvent) (any, int) { return e.start, e.id }
+
+	load := func(cursor string) ([]testEvent, *Pagination) {
+		t.Helper()
+		p, err := newListPage(newTestContext(url.Values{"cursor": {cursor}, "pageSize": {"3"}}), eventFilters)
+		if err != nil {
+			t.Fatalf("newListPage(cursor=%q): %v", cursor, err)
+		}
+		return finishPage(p, fetchPage(p, events), keyOf)
+	}
+	ids := func(rows []testEvent) []int {
+		out := make([]int, len(rows))
+		for i, e := range rows {
+			out[i] = e.id
+		}
+		return out
+	}
+
+	// Maju sampai habis
+	var pages [][]int
+	var prevCursors []string
+	var got []int
+	cursor := ""
+	for range len(events) {
+		rows, pg := load(cursor)
+		pages = append(pages, ids(rows))
+		prevCursors = append(prevCursors, pg.PrevCursor)
+		got = append(got, ids(rows)...)
+		if (cursor == "") != (pg.PrevCursor == "") {
+			t.Errorf("halaman %d: prevCursor = %q", len(pages), pg.PrevCursor)
+		}
+		if pg.NextCursor == "" {
+			break
+		}
+		cursor = pg.NextCursor
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("urutan maju = %v, ingin %v", got, want)
+	}
+	if len(pages) != 3 {
+		t.Fatalf("jumlah halaman = %d, ingin 3", len(pages))
+	}
+
+	// Mundur dari halaman terakhir menghasilkan halaman yang sama
+	cursor = prevCursors[len(prevCursors)-1]
+	for i := len(pages) - 2; i >= 0; i-- {
+		rows, pg := load(cursor)
+		if !slices.Equal(ids(rows), pages[i]) {
+			t.Errorf("mundur ke halaman %d = %v, ingin %v", i+1, ids(rows), pages[i])
+		}
+		if pg.NextCursor == "" {
+			t.Errorf("mundur ke halaman %d: nextCursor kosong", i+1)
+		}
+		if i == 0 {
+			if pg.PrevCursor != "" {
+				t.Errorf("halaman pertama: prevCursor = %q, ingin kosong", pg.PrevCursor)
+			}
+			break
+		}
+		cursor = pg.PrevCursor
+	}
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// rateLimitRule adalah konfigurasi token bucket: maksimal 'Limit' request per 'Per'.
+// Bucket penuh berisi 'Limit' token (boleh burst), lalu terisi ulang secara merata.
+type rateLimitRule struct {
+	Limit int
+	Per   time.Duration
+}
+
+func (r rateLimitRule) refillPerSecond() float64 {
+	return float64(r.Limit) / r.Per.Seconds()
+}
+
+func (r rateLimitRule) String() string {
+	return fmt.Sprintf("%d/%s", r.Limit, r.Per)
+}
+
+// Batas default per route (per alamat). Batas per IP = 'rateLimitIPMultiplier' x batas per alamat,
+// karena banyak user bisa berbagi satu IP (NAT kampus/kantor/venue event).
+// Bisa di-override lewat env, misal: RATE_LIMIT_UPLOAD=20/h dan RATE_LIMIT_UPLOAD_IP=100/h.
+var defaultRateLimits = map[string]rateLimitRule{
+	"upload":                 {Limit: 30, Per: time.Hour},
+	"moment_free":            {Limit: 5, Per: time.Hour},
+	"moment_with_event_pass": {Limit: 10, Per: time.Hour},
+	"like":                   {Limit: 60, Per: time.Minute},
+	"comment":                {Limit: 10, Per: time.Minute},
+	// Upload gambar ke Pinata + transaksi on-chain oleh admin
+	"create_event":  {Limit: 10, Per: time.Hour},
+	"import_events": {Limit: 5, Per: time.Hour},
+}
+
+const rateLimitIPMultiplier = 5
+
+// parseRateLimitRule mem-parse format "<jumlah>/<s|m|h>" (misal: "10/m").
+func parseRateLimitRule(v string) (rateLimitRule, error) {
+	count, unit, ok := strings.Cut(strings.TrimSpace(v), "/")
+	if !ok {
+		return rateLimitRule{}, fmt.Errorf("format harus <jumlah>/<s|m|h>")
+	}
+	limit, err := strconv.Atoi(count)
+	if err != nil || limit <= 0 {
+		return rateLimitRule{}, fmt.Errorf("jumlah tidak valid: %q", count)
+	}
+	per := map[string]time.Duration{"s": time.Second, "m": time.Minute, "h": time.Hour}[unit]
+	if per == 0 {
+		return rateLimitRule{}, fmt.Errorf("satuan tidak valid: %q", unit)
+	}
+	return rateLimitRule{Limit: limit, Per: per}, nil
+}
+
+// rateLimitRuleFromEnv membaca env 'RATE_LIMIT_<NAME>', jika kosong/invalid pakai 'fallback'.
+func rateLimitRuleFromEnv(envKey string, fallback rateLimitRule) rateLimitRule {
+	v := os.Getenv(envKey)
+	if v == "" {
+		return fallback
+	}
+	rule, err := parseRateLimitRule(v)
+	if err != nil {
+		log.Printf("Peringatan: %s=%q tidak valid (%v), pakai default %s", envKey, v, err, fallback)
+		return fallback
+	}
+	return rule
+}
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// tokenBucketStore menyimpan bucket di memori (per instance server).
+type tokenBucketStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+var rateLimitStore = &tokenBucketStore{buckets: map[string]*tokenBucket{}}
+
+type rateLimitCheck struct {
+	key  string
+	rule rateLimitRule
+}
+
+// take mengambil 1 token dari SEMUA bucket sekaligus. Jika salah satu kosong,
+// tidak ada token yang diambil dan waktu tunggu terlama dikembalikan.
+func (s *tokenBucketStore) take(checks []rateLimitCheck, now time.Time) (bool, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	var wait time.Duration
+	buckets := make([]*tokenBucket, len(checks))
+	for i, chk := range checks {
+		b, ok := s.buckets[chk.key]
+		if !ok {
+			b = &tokenBucket{tokens: float64(chk.rule.Limit), updated: now}
+			s.buckets[chk.key] = b
+		}
+		// Isi ulang sesuai waktu yang sudah lewat
+		b.tokens = math.Min(float64(chk.rule.Limit), b.tokens+now.Sub(b.updated).Seconds()*chk.rule.refillPerSecond())
+		b.updated = now
+		buckets[i] = b
+
+		if b.tokens < 1 {
+			missing := (1 - b.tokens) / chk.rule.refillPerSecond()
+			wait = max(wait, time.Duration(missing*float64(time.Second)))
+		}
+	}
+	if wait > 0 {
+		return false, wait
+	}
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return true, 0
+}
+
+// remaining mengembalikan sisa token utuh di bucket 'key' (0 jika belum ada).
+func (s *tokenBucketStore) remaining(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b, ok := s.buckets[key]; ok {
+		return int(b.tokens)
+	}
+	return 0
+}
+
+// sweep menghapus bucket yang sudah lama tidak dipakai (pasti sudah penuh lagi).
+func (s *tokenBucketStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < 10*time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if now.Sub(b.updated) > 24*time.Hour {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// ipExtractorFromEnv menentukan cara membaca IP client untuk 'c.RealIP()'.
+// Jika server berada di belakang reverse proxy, isi TRUSTED_PROXIES dengan CIDR proxy (dipisah koma,
+// misal: "10.0.0.0/8,172.16.0.0/12") agar IP diambil dari header X-Forwarded-For yang dipasang proxy tsb.
+// Tanpa env ini, IP diambil langsung dari koneksi (header X-Forwarded-For/X-Real-IP diabaikan,
+// sehingga client tidak bisa memalsukan IP untuk menghindari rate limit).
+func ipExtractorFromEnv() echo.IPExtractor {
+	var options []echo.TrustOption
+	for _, cidr := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Printf("Peringatan: TRUSTED_PROXIES berisi CIDR tidak valid %q: %v", cidr, err)
+			continue
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	if len(options) == 0 {
+		return echo.ExtractIPDirect()
+	}
+	// Hanya proxy yang terdaftar yang dipercaya (bukan semua IP privat/loopback)
+	options = append(options, echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false))
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+// rateLimit adalah middleware token bucket untuk route 'name' (lihat 'defaultRateLimits').
+// Request dibatasi per IP dan per alamat (sesi dibaca sendiri jika ada), jadi pasang SEBELUM 'requireAuth'
+// agar flood tanpa login juga dibatasi per IP sebelum menyentuh database.
+// Jika batas terlampaui, respon 429 dengan header 'Retry-After' (detik).
+func (h *Handler) rateLimit(name string) echo.MiddlewareFunc {
+	fallback, ok := defaultRateLimits[name]
+	if !ok {
+		panic("rate limit tidak dikenal: " + name)
+	}
+	envKey := "RATE_LIMIT_" + strings.ToUpper(name)
+	addressRule := rateLimitRuleFromEnv(envKey, fallback)
+	ipRule := rateLimitRuleFromEnv(envKey+"_IP", rateLimitRule{
+		Limit: addressRule.Limit * rateLimitIPMultiplier,
+		Per:   addressRule.Per,
+	})
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			checks := []rateLimitCheck{{key: name + "|ip|" + c.RealIP(), rule: ipRule}}
+			// Cek IP dulu: request tanpa login (atau token palsu) ditolak tanpa query sesi
+			if ok, wait := rateLimitStore.take(checks, time.Now()); !ok {
+				return tooManyRequests(c, wait)
+			}
+			address := sessionAddress(c)
+			if address == "" && c.Request().Header.Get(echo.HeaderAuthorization) != "" {
+				address, _ = h.lookupSession(c)
+			}
+			if address == "" {
+				return next(c)
+			}
+
+			checks = []rateLimitCheck{{key: name + "|addr|" + address, rule: addressRule}}
+
+			if ok, wait := rateLimitStore.take(checks, time.Now()); !ok {
+				return tooManyRequests(c, wait)
+			}
+			return next(c)
+		}
+	}
+}
+
+func tooManyRequests(c echo.Context, wait time.Duration) error {
+	c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	return c.JSON(http.StatusTooManyRequests, APIResponse{Error: "Terlalu banyak request, coba lagi nanti"})
+}
@@ -0,0 +1,663 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/event"
+	"backend/ent/eventstaff"
+	"backend/ent/invitecode"
+	"backend/ent/mintcredit"
+	"backend/ent/nftmoment"
+	"backend/ent/referral"
+	"backend/ent/user"
+	"backend/swagdto"
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	inviteCodeLength      = 8
+	maxInviteCodesPerUser = 5
+	// Reservasi mint credit yang tidak di-commit/release dalam waktu ini dikembalikan
+	mintCreditReservationTTL = 15 * time.Minute
+	referralWorkerInterval   = time.Minute
+	topInvitersLimit         = 10
+)
+
+var errNoMintCredit = errors.New("tidak ada mint credit")
+
+// referralCredits membaca jumlah credit hadiah referral dari env (default 1).
+func referralCredits(envKey string) int {
+	if v, err := strconv.Atoi(os.Getenv(envKey)); err == nil && v >= 0 {
+		return v
+	}
+	return 1
+}
+
+func newInviteCode() (string, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.EncodeToString(b)[:inviteCodeLength], nil
+}
+
+// ---------------------------------------------------------------------------
+// Mint credit
+// ---------------------------------------------------------------------------
+
+// reserveMintCredit mengambil satu credit 'available' milik user secara atomik
+// (update bersyarat status=available, sehingga request paralel tidak memakai credit yang sama).
+func (h *Handler) reserveMintCredit(ctx context.Context, address string) (*ent.MintCredit, error) {
+	for attempt := 0; attempt < 5; attempt++ {
+		credit, err := h.DB.MintCredit.Query().
+			Where(mintcredit.AddressEQ(address), mintcredit.StatusEQ(mintcredit.StatusAvailable)).
+			Order(ent.Asc(mintcredit.FieldCreatedAt)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errNoMintCredit
+			}
+			return nil, err
+		}
+
+		n, err := h.DB.MintCredit.Update().
+			Where(mintcredit.IDEQ(credit.ID), mintcredit.StatusEQ(mintcredit.StatusAvailable)).
+			SetStatus(mintcredit.StatusReserved).
+			SetReservedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			return credit, nil
+		}
+		// Credit ini baru saja diambil request lain, coba credit berikutnya
+	}
+	return nil, errNoMintCredit
+}
+
+// freeMintGrant adalah sumber jatah sebuah free mint: kuota klaim, atau mint credit
+// jika kuota free mint global user sudah habis.
+type freeMintGrant struct {
+	claim  *ent.Claim
+	credit *ent.MintCredit
+}
+
+func (h *Handler) reserveFreeMint(ctx context.Context, scope, address string) (*freeMintGrant, error) {
+	c, err := h.reserveClaim(ctx, claimKindFreeMint, scope, address)
+	if err == nil {
+		return &freeMintGrant{claim: c}, nil
+	}
+	if !errors.Is(err, errClaimQuotaExhausted) || scope != claimScopeGlobal {
+		return nil, err
+	}
+
+	credit, err := h.reserveMintCredit(ctx, address)
+	if err != nil {
+		if errors.Is(err, errNoMintCredit) {
+			return nil, errClaimQuotaExhausted
+		}
+		return nil, err
+	}
+	return &freeMintGrant{credit: credit}, nil
+}
+
+func (h *Handler) commitFreeMint(ctx context.Context, g *freeMintGrant, reference string) error {
+	if g.claim != nil {
+		return h.commitClaim(ctx, g.claim, reference)
+	}
+	return h.DB.MintCredit.UpdateOneID(g.credit.ID).
+		SetStatus(mintcredit.StatusSpent).
+		SetReference(reference).
+		SetSpentAt(time.Now()).
+		Exec(ctx)
+}
+
+// setFreeMintReference mencatat referensi hasil (thumbnail moment) SEBELUM transaksi dikirim,
+// agar reservasi yang basi bisa dicocokkan dengan moment yang ter-indeks.
+func (h *Handler) setFreeMintReference(ctx context.Context, g *freeMintGrant, reference string) error {
+	if g.claim != nil {
+		return h.setClaimReference(ctx, g.claim, reference)
+	}
+	return h.DB.MintCredit.UpdateOneID(g.credit.ID).SetReference(reference).Exec(ctx)
+}
+
+func (h *Handler) releaseFreeMint(ctx context.Context, g *freeMintGrant) {
+	if g.claim != nil {
+		h.releaseClaim(ctx, g.claim)
+		return
+	}
+	if err := h.DB.MintCredit.UpdateOneID(g.credit.ID).
+		SetStatus(mintcredit.StatusAvailable).
+		ClearReservedAt().
+		ClearReference().
+		Exec(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("Gagal release mint credit %d: %v", g.credit.ID, err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Worker: kualifikasi referral
+// ---------------------------------------------------------------------------
+
+// runReferralWorker secara berkala meng-qualify referral yang check-in invitee-nya sudah diverifikasi host/staff,
+// dan mengembalikan reservasi mint credit yang basi.
+func (h *Handler) runReferralWorker(ctx context.Context) {
+	ticker := time.NewTicker(referralWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		h.qualifyPendingReferrals(ctx)
+		h.releaseStaleMintCredits(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// qualifyingCheckInsSQL memilih referral pending beserta satu event tempat invitee check-in
+// yang memenuhi syarat anti-sybil (satu query, tanpa N+1):
+//   - check-in diverifikasi host/staff (langsung, token, atau kiosk), bukan self check-in / join link;
+//   - host, staff, dan verifikator event tsb bukan akun yang terkait dengan inviter, yaitu
+//     inviter sendiri, user lain yang diundang inviter, atau yang mengundang inviter.
+var qualifyingCheckInsSQL = fmt.Sprintf(`
+	WITH linked AS (
+		SELECT r.id AS referral_id, r.inviter_address AS address FROM %[1]s r WHERE r.status = 'pending'
+		UNION
+		SELECT r.id, o.invitee_address FROM %[1]s r JOIN %[1]s o ON o.inviter_address = r.inviter_address
+		WHERE r.status = 'pending'
+		UNION
+		SELECT r.id, o.inviter_address FROM %[1]s r JOIN %[1]s o ON o.invitee_address = r.inviter_address
+		WHERE r.status = 'pending'
+	)
+	SELECT DISTINCT ON (r.id) r.id, r.inviter_address, r.invitee_address, e.event_id
+	FROM %[1]s r
+	JOIN %[2]s iu ON iu.address = r.invitee_address
+	JOIN %[3]s a ON a.%[4]s = iu.id AND a.checked_in AND a.check_in_method IN ('staff', 'kiosk')
+	JOIN %[5]s e ON e.id = a.%[6]s
+	JOIN %[2]s hu ON hu.id = e.%[7]s
+	WHERE r.status = 'pending'
+		AND NOT EXISTS (
+			SELECT 1 FROM linked l WHERE l.referral_id = r.id AND (
+				l.address = hu.address
+				OR l.address = a.verified_by
+				OR EXISTS (SELECT 1 FROM %[8]s s WHERE s.event_id = e.event_id AND s.address = l.address)
+			)
+		)
+	ORDER BY r.id, a.registration_time`,
+	referral.Table, user.Table, attendance.Table, attendance.UserColumn,
+	event.Table, attendance.EventColumn, event.HostColumn, eventstaff.Table)
+
+func (h *Handler) qualifyPendingReferrals(ctx context.Context) {
+	rows, err := h.SQL.QueryContext(ctx, qualifyingCheckInsSQL)
+	if err != nil {
+		log.Printf("Gagal mengambil referral pending: %v", err)
+		return
+	}
+	type qualifying struct {
+		ref     *ent.Referral
+		eventID uint64
+	}
+	var found []qualifying
+	for rows.Next() {
+		q := qualifying{ref: &ent.Referral{}}
+		if err := rows.Scan(&q.ref.ID, &q.ref.InviterAddress, &q.ref.InviteeAddress, &q.eventID); err != nil {
+			log.Printf("Gagal membaca referral pending: %v", err)
+			rows.Close()
+			return
+		}
+		found = append(found, q)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		log.Printf("Gagal mengambil referral pending: %v", err)
+		return
+	}
+
+	for _, q := range found {
+		if err := h.qualifyReferral(ctx, q.ref, q.eventID); err != nil {
+			log.Printf("Gagal qualify referral %d: %v", q.ref.ID, err)
+		}
+	}
+}
+
+// qualifyReferral menandai referral 'qualified' dan memberi mint credit ke kedua pihak (dalam satu transaksi DB).
+func (h *Handler) qualifyReferral(ctx context.Context, ref *ent.Referral, eventID uint64) error {
+	tx, err := h.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := tx.Referral.Update().
+		Where(referral.IDEQ(ref.ID), referral.StatusEQ(referral.StatusPending)).
+		SetStatus(referral.StatusQualified).
+		SetQualifyingEventID(eventID).
+		SetQualifiedAt(time.Now()).
+		Save(ctx)
+	if err != nil || n == 0 {
+		_ = tx.Rollback()
+		return err
+	}
+
+	grants := []struct {
+		address string
+		source  string
+		count   int
+	}{
+		{ref.InviterAddress, "referral:inviter", referralCredits("REFERRAL_CREDITS_INVITER")},
+		{ref.InviteeAddress, "referral:invitee", referralCredits("REFERRAL_CREDITS_INVITEE")},
+	}
+	for _, g := range grants {
+		for i := 0; i < g.count; i++ {
+			if err := tx.MintCredit.Create().
+				SetAddress(g.address).
+				SetSource(g.source).
+				SetReferralID(ref.ID).
+				Exec(ctx); err != nil {
+				_ = tx.Rollback()
+				return err
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Printf("Referral %d qualified (inviter %s, invitee %s, event %d)", ref.ID, ref.InviterAddress, ref.InviteeAddress, eventID)
+	h.syncLegacyFreeMintFlag(ctx, ref.InviterAddress)
+	h.syncLegacyFreeMintFlag(ctx, ref.InviteeAddress)
+	return nil
+}
+
+// releaseStaleMintCredits menyelesaikan reservasi mint credit yang basi, sama seperti 'reconcileStaleClaims':
+// tanpa 'reference' dikembalikan ke available; dengan 'reference' ditandai spent jika moment-nya sudah
+// ter-indeks, dikembalikan jika belum juga ter-indeks setelah 'claimReconcileHorizon', selain itu dibiarkan.
+func (h *Handler) releaseStaleMintCredits(ctx context.Context) {
+	now := time.Now()
+	stale, err := h.DB.MintCredit.Query().
+		Where(
+			mintcredit.StatusEQ(mintcredit.StatusReserved),
+			mintcredit.ReservedAtLT(now.Add(-mintCreditReservationTTL)),
+		).
+		All(ctx)
+	if err != nil {
+		log.Printf("Gagal mengambil mint credit basi: %v", err)
+		return
+	}
+
+	for _, cr := range stale {
+		if cr.Reference != "" {
+			minted, err := h.DB.NFTMoment.Query().
+				Where(
+					nftmoment.ThumbnailEQ(cr.Reference),
+					nftmoment.HasOwnerWith(user.AddressEQ(cr.Address)),
+				).
+				Exist(ctx)
+			if err != nil {
+				log.Printf("Gagal mencocokkan mint credit %d: %v", cr.ID, err)
+				continue
+			}
+			if minted {
+				if err := h.commitFreeMint(ctx, &freeMintGrant{credit: cr}, cr.Reference); err != nil {
+					log.Printf("Gagal menandai mint credit %d spent: %v", cr.ID, err)
+				}
+				continue
+			}
+			if cr.ReservedAt != nil && now.Before(cr.ReservedAt.Add(claimReconcileHorizon)) {
+				continue
+			}
+		}
+		if err := h.DB.MintCredit.Update().
+			Where(mintcredit.IDEQ(cr.ID), mintcredit.StatusEQ(mintcredit.StatusReserved)).
+			SetStatus(mintcredit.StatusAvailable).
+			ClearReservedAt().
+			ClearReference().
+			Exec(ctx); err != nil {
+			log.Printf("Gagal mengembalikan mint credit basi %d: %v", cr.ID, err)
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Handlers
+// ---------------------------------------------------------------------------
+
+func inviteCodeResponse(ic *ent.InviteCode) *swagdto.InviteCodeResponse {
+	return &swagdto.InviteCodeResponse{
+		Code:      ic.Code,
+		MaxUses:   ic.MaxUses,
+		Uses:      ic.Uses,
+		CreatedAt: ic.CreatedAt,
+	}
+}
+
+// @Summary     Buat Kode Undangan
+// @Description Membuat kode referral untuk dibagikan (maks. 5 kode per user).
+// @Tags        Referrals
+// @Produce     json
+// @Security    BearerAuth
+// @Success     201 {object} APIResponse{data=swagdto.InviteCodeResponse} "Kode dibuat"
+// @Failure     400 {object} APIResponse "Batas jumlah kode tercapai / belum punya profil"
+// @Router      /referrals/codes [post]
+func (h *Handler) createInviteCode(c echo.Context) error {
+	ctx := c.Request().Context()
+	address := sessionAddress(c)
+
+	exists, err := h.DB.User.Query().Where(user.AddressEQ(address)).Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if !exists {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "User not found. Please login/register first."})
+	}
+
+	count, err := h.DB.InviteCode.Query().Where(invitecode.OwnerAddressEQ(address)).Count(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if count >= maxInviteCodesPerUser {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: fmt.Sprintf("Maksimal %d kode undangan per user", maxInviteCodesPerUser)})
+	}
+
+	// Coba beberapa kali jika (sangat jarang) kode bentrok
+	for attempt := 0; attempt < 3; attempt++ {
+		code, err := newInviteCode()
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		ic, err := h.DB.InviteCode.Create().
+			SetCode(code).
+			SetOwnerAddress(address).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			continue
+		}
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusCreated, APIResponse{Data: inviteCodeResponse(ic)})
+	}
+	return c.JSON(http.StatusInternalServerError, APIResponse{Error: "Gagal membuat kode unik, coba lagi"})
+}
+
+// @Summary     Kode Undangan Saya
+// @Description Menampilkan kode referral milik user yang login.
+// @Tags        Referrals
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse{data=[]swagdto.InviteCodeResponse} "Daftar kode"
+// @Router      /referrals/codes [get]
+func (h *Handler) getMyInviteCodes(c echo.Context) error {
+	codes, err := h.DB.InviteCode.Query().
+		Where(invitecode.OwnerAddressEQ(sessionAddress(c))).
+		Order(ent.Desc(invitecode.FieldCreatedAt)).
+		All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	responses := make([]*swagdto.InviteCodeResponse, 0, len(codes))
+	for _, ic := range codes {
+		responses = append(responses, inviteCodeResponse(ic))
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses})
+}
+
+// @Summary     Pakai Kode Undangan
+// @Description User baru memakai kode referral. Mint credit untuk inviter & invitee diberikan
+// @Description setelah check-in invitee di sebuah event diverifikasi host/staff (bukan self check-in), dan event
+// @Description tersebut tidak di-host/di-staff/diverifikasi oleh inviter atau akun yang terkait dengan inviter.
+// @Tags        Referrals
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       body body     RedeemInviteCodeRequest true "Kode undangan"
+// @Success     201 {object} APIResponse{data=swagdto.ReferralResponse} "Referral tercatat (pending)"
+// @Failure     400 {object} APIResponse "Kode tidak valid / bukan user baru / referral sendiri"
+// @Failure     404 {object} APIResponse "Kode tidak ditemukan"
+// @Failure     409 {object} APIResponse "Sudah pernah memakai kode undangan"
+// @Router      /referrals/redeem [post]
+func (h *Handler) redeemInviteCode(c echo.Context) error {
+	ctx := c.Request().Context()
+	invitee := sessionAddress(c)
+
+	req := new(RedeemInviteCodeRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if code == "" {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "code wajib diisi"})
+	}
+
+	// 1. Validasi kode & cegah referral sendiri / melingkar
+	ic, err := h.DB.InviteCode.Query().Where(invitecode.CodeEQ(code)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Kode undangan tidak ditemukan"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if ic.OwnerAddress == invitee {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Tidak bisa memakai kode undangan sendiri"})
+	}
+	circular, err := h.DB.Referral.Query().
+		Where(referral.InviterAddressEQ(invitee), referral.InviteeAddressEQ(ic.OwnerAddress)).
+		Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if circular {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Tidak bisa saling me-refer"})
+	}
+
+	// 2. Hanya user baru: sudah punya profil, tapi belum punya moment & belum pernah check-in
+	u, err := h.DB.User.Query().Where(user.AddressEQ(invitee)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "User not found. Please login/register first."})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	hasMoments, err := h.DB.NFTMoment.Query().Where(nftmoment.HasOwnerWith(user.IDEQ(u.ID))).Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	hasCheckIns, err := h.DB.Attendance.Query().
+		Where(attendance.CheckedIn(true), attendance.HasUserWith(user.IDEQ(u.ID))).
+		Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if hasMoments || hasCheckIns {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Kode undangan hanya untuk user baru"})
+	}
+
+	// 3. Tambah pemakaian kode (bersyarat uses < max_uses) & catat referral dalam satu transaksi DB
+	tx, err := h.DB.Tx(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	n, err := tx.InviteCode.Update().
+		Where(
+			invitecode.IDEQ(ic.ID),
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(invitecode.FieldUses), s.C(invitecode.FieldMaxUses)))
+			},
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Kode undangan sudah mencapai batas pemakaian"})
+	}
+
+	ref, err := tx.Referral.Create().
+		SetCode(ic.Code).
+		SetInviterAddress(ic.OwnerAddress).
+		SetInviteeAddress(invitee).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return c.JSON(http.StatusConflict, APIResponse{Error: "Kamu sudah pernah memakai kode undangan"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if err := tx.Commit(); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, APIResponse{Data: &swagdto.ReferralResponse{
+		Code:           ref.Code,
+		InviterAddress: ref.InviterAddress,
+		InviteeAddress: ref.InviteeAddress,
+		Status:         string(ref.Status),
+		CreatedAt:      ref.CreatedAt,
+	}})
+}
+
+// @Summary     Statistik Referral Saya
+// @Description Ringkasan kode, undangan (pending/qualified), dan mint credit user yang login.
+// @Tags        Referrals
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse{data=swagdto.ReferralStatsResponse} "Statistik referral"
+// @Router      /referrals/stats [get]
+func (h *Handler) getMyReferralStats(c echo.Context) error {
+	ctx := c.Request().Context()
+	address := sessionAddress(c)
+
+	codes, err := h.DB.InviteCode.Query().
+		Where(invitecode.OwnerAddressEQ(address)).
+		Order(ent.Desc(invitecode.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	stats := &swagdto.ReferralStatsResponse{Codes: make([]*swagdto.InviteCodeResponse, 0, len(codes))}
+	for _, ic := range codes {
+		stats.Codes = append(stats.Codes, inviteCodeResponse(ic))
+	}
+
+	refs, err := h.DB.Referral.Query().Where(referral.InviterAddressEQ(address)).All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	for _, ref := range refs {
+		stats.Invited++
+		if ref.Status == referral.StatusQualified {
+			stats.Qualified++
+		} else {
+			stats.Pending++
+		}
+	}
+
+	credits, err := h.DB.MintCredit.Query().Where(mintcredit.AddressEQ(address)).All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	for _, cr := range credits {
+		stats.CreditsEarned++
+		switch cr.Status {
+		case mintcredit.StatusAvailable:
+			stats.CreditsAvailable++
+		case mintcredit.StatusSpent:
+			stats.CreditsSpent++
+		}
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: stats})
+}
+
+// @Summary     Statistik Referral (Admin)
+// @Description Ringkasan referral seluruh platform dan inviter teratas.
+// @Tags        Referrals
+// @Produce     json
+// @Security    BearerAuth
+// @Success     200 {object} APIResponse{data=swagdto.AdminReferralStatsResponse} "Statistik referral"
+// @Failure     403 {object} APIResponse "Bukan admin"
+// @Router      /admin/referrals/stats [get]
+func (h *Handler) getReferralStats(c echo.Context) error {
+	ctx := c.Request().Context()
+	stats := &swagdto.AdminReferralStatsResponse{}
+
+	var byStatus []struct {
+		Status referral.Status `json:"status"`
+		Count  int             `json:"count"`
+	}
+	if err := h.DB.Referral.Query().
+		GroupBy(referral.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &byStatus); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	for _, s := range byStatus {
+		stats.TotalReferrals += s.Count
+		if s.Status == referral.StatusQualified {
+			stats.Qualified = s.Count
+		} else {
+			stats.Pending += s.Count
+		}
+	}
+
+	var credits []struct {
+		Status mintcredit.Status `json:"status"`
+		Count  int               `json:"count"`
+	}
+	if err := h.DB.MintCredit.Query().
+		Where(mintcredit.SourceHasPrefix("referral:")).
+		GroupBy(mintcredit.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &credits); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	for _, cr := range credits {
+		stats.CreditsIssued += cr.Count
+		if cr.Status == mintcredit.StatusSpent {
+			stats.CreditsSpent = cr.Count
+		}
+	}
+
+	var inviters []struct {
+		InviterAddress string `json:"inviter_address"`
+		Count          int    `json:"count"`
+	}
+	if err := h.DB.Referral.Query().
+		Where(referral.StatusEQ(referral.StatusQualified)).
+		GroupBy(referral.FieldInviterAddress).
+		Aggregate(ent.Count()).
+		Scan(ctx, &inviters); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	sort.Slice(inviters, func(i, j int) bool { return inviters[i].Count > inviters[j].Count })
+	stats.TopInviters = make([]*swagdto.ReferralInviterStat, 0, min(len(inviters), topInvitersLimit))
+	for _, inv := range inviters[:min(len(inviters), topInvitersLimit)] {
+		stats.TopInviters = append(stats.TopInviters, &swagdto.ReferralInviterStat{
+			Address:   inv.InviterAddress,
+			Qualified: inv.Count,
+		})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: stats})
+}
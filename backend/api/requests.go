@@ -1,5 +1,10 @@
 package main
 
+import (
+	"backend/utils"
+	"time"
+)
+
 type MintMomentRequest struct {
 	Recipient   string `json:"recipient" form:"recipient" validate:"required"`
 	Name        string `json:"name" form:"name" validate:"required"`
@@ -11,3 +16,116 @@ type CheckInRequest struct {
 	UserAddress string `json:"userAddress" form:"userAddress"`
 	EventID     string `json:"eventID"     form:"eventID"`
 }
+
+type BatchCheckInRequest struct {
+	EventID       string   `json:"eventID"       form:"eventID"`
+	UserAddresses []string `json:"userAddresses" form:"userAddresses"`
+}
+
+// CheckInIntentRequest adalah satu hasil scan dari kiosk.
+// 'signature' = hex(HMAC-SHA256(secret_device, "eventID|userAddress|scannedAt|deviceID")),
+// dengan userAddress dalam bentuk normal ("0x" + 16 digit hex huruf kecil).
+type CheckInIntentRequest struct {
+	EventID     string `json:"eventID"`
+	UserAddress string `json:"userAddress"`
+	ScannedAt   int64  `json:"scannedAt"` // Unix timestamp (detik)
+	DeviceID    string `json:"deviceID"`
+	Signature   string `json:"signature"`
+}
+
+type QueueCheckInRequest struct {
+	Intents []CheckInIntentRequest `json:"intents"`
+}
+
+type TokenCheckInRequest struct {
+	Token string `json:"token" form:"token"`
+}
+
+type UpdateGeofenceRequest struct {
+	RadiusM float64 `json:"radiusM"`
+}
+
+// SelfCheckInRequest adalah lokasi (GPS fix) yang dikirim attendee.
+type SelfCheckInRequest struct {
+	UserAddress string  `json:"-"` // Diisi dari sesi login
+	Lat         float64 `json:"lat"`
+	Long        float64 `json:"long"`
+	AccuracyM   float64 `json:"accuracyM"` // Akurasi yang dilaporkan perangkat (meter)
+	Timestamp   int64   `json:"timestamp"` // Unix timestamp (detik) saat lokasi diambil
+}
+
+// LoginRequest adalah hasil FCL account-proof dari wallet.
+type LoginRequest struct {
+	Address    string                        `json:"address"`
+	Nonce      string                        `json:"nonce"`
+	Signatures []utils.AccountProofSignature `json:"signatures"`
+}
+
+type AddEventStaffRequest struct {
+	Address string `json:"address"`
+}
+
+type ProvisionKioskRequest struct {
+	Name string `json:"name"` // Label bebas (misal: "Pintu Utara")
+}
+
+type CreateAPIKeyRequest struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	OwnerAddress  string   `json:"ownerAddress"`  // Alamat yang diwakili key (wajib untuk route partner)
+	RatePerMinute int      `json:"ratePerMinute"` // 0 = default (60)
+	DailyQuota    int      `json:"dailyQuota"`    // 0 = default (10000)
+}
+
+type UpsertClaimQuotaRequest struct {
+	Kind            string     `json:"kind" example:"free_mint"`
+	Scope           string     `json:"scope" example:"campaign:launch"`
+	PerSubjectLimit int        `json:"perSubjectLimit" example:"1"`
+	TotalLimit      int        `json:"totalLimit" example:"500"` // 0 = tanpa batas
+	StartsAt        *time.Time `json:"startsAt"`
+	EndsAt          *time.Time `json:"endsAt"`
+}
+
+type RedeemInviteCodeRequest struct {
+	Code string `json:"code" example:"K3QZ7MNA"`
+}
+
+type ReorderWaitlistRequest struct {
+	Addresses []string `json:"addresses" example:"0x1bb6b1e0a5170088,0x2cc7c2f1b6281199"`
+}
+
+// UpdateEventRequest adalah perubahan event oleh host (field nil = tidak diubah).
+type UpdateEventRequest struct {
+	StartDate   *time.Time `json:"startDate"`
+	EndDate     *time.Time `json:"endDate"`
+	Location    *string    `json:"location"`
+	Lat         *float64   `json:"lat"` // Opsional untuk event offline (default: geocode dari location)
+	Long        *float64   `json:"long"`
+	Description *string    `json:"description"`
+	Quota       *uint64    `json:"quota"`
+	Reason      string     `json:"reason" example:"Venue dipindah"` // Ditampilkan di notifikasi & riwayat
+}
+
+type CancelEventRequest struct {
+	Reason string `json:"reason" example:"Pembicara berhalangan hadir"`
+}
+
+type UpdateEventQuotaRequest struct {
+	Quota uint64 `json:"quota" example:"150"`
+}
+
+type UpdateSeriesStatusRequest struct {
+	Status string `json:"status" example:"paused"` // active | paused | stopped
+}
+
+type UpdateEventPrivacyRequest struct {
+	AttendeeVisibility string `json:"attendeeVisibility" example:"attendees"` // public | attendees | host
+}
+
+type UpdatePrivacyRequest struct {
+	HideFromAttendeeLists *bool `json:"hideFromAttendeeLists" example:"true"`
+}
+
+type CreateCommentRequest struct {
+	Content string `json:"content" example:"Keren banget!"`
+}
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/comment"
+	"backend/ent/event"
+	"backend/ent/eventstaff"
+	"backend/ent/nftmoment"
+	"backend/ent/user"
+	"backend/swagdto"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Role user terhadap sebuah event
+const (
+	roleAdmin = "admin" // Admin platform (env ADMIN_ADDRESSES)
+	roleHost  = "host"  // Host event (edge 'Event.host')
+	roleStaff = "staff" // Staff yang didelegasikan host (tabel EventStaff)
+)
+
+// eventPermission adalah aksi yang bisa dilakukan terhadap sebuah event.
+type eventPermission string
+
+const (
+	permCheckIn         eventPermission = "check_in"
+	permEditEvent       eventPermission = "edit_event"
+	permExportAttendees eventPermission = "export_attendees"
+	permModerate        eventPermission = "moderate"
+	permManageStaff     eventPermission = "manage_staff"
+	permManageWaitlist  eventPermission = "manage_waitlist"
+)
+
+// rolePermissions memetakan role ke aksi yang diizinkan.
+// Admin platform selalu diizinkan (tidak perlu dicantumkan).
+var rolePermissions = map[string][]eventPermission{
+	roleHost:  {permCheckIn, permEditEvent, permExportAttendees, permModerate, permManageStaff, permManageWaitlist},
+	roleStaff: {permCheckIn, permExportAttendees, permModerate},
+}
+
+func roleHasPermission(role string, perm eventPermission) bool {
+	if role == roleAdmin {
+		return true
+	}
+	for _, p := range rolePermissions[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// isPlatformAdmin mengecek alamat terhadap env ADMIN_ADDRESSES (dipisah koma).
+func isPlatformAdmin(address string) bool {
+	if address == "" {
+		return false
+	}
+	address = normalizeAddress(address)
+	for _, a := range strings.Split(os.Getenv("ADMIN_ADDRESSES"), ",") {
+		if a = strings.TrimSpace(a); a != "" && normalizeAddress(a) == address {
+			return true
+		}
+	}
+	return false
+}
+
+// isApprovedHost mengecek apakah alamat boleh membuat event lewat backend (transaksi dibayar akun admin):
+// admin platform, atau terdaftar di env HOST_ADDRESSES (dipisah koma).
+func isApprovedHost(address string) bool {
+	if address == "" {
+		return false
+	}
+	if isPlatformAdmin(address) {
+		return true
+	}
+	address = normalizeAddress(address)
+	for _, a := range strings.Split(os.Getenv("HOST_ADDRESSES"), ",") {
+		if a = strings.TrimSpace(a); a != "" && normalizeAddress(a) == address {
+			return true
+		}
+	}
+	return false
+}
+
+// requireHost adalah middleware untuk route yang membuat event on-chain atas nama user.
+// Harus dipasang SETELAH 'requireAuth'.
+func (h *Handler) requireHost(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !isApprovedHost(sessionAddress(c)) {
+			return c.JSON(http.StatusForbidden, APIResponse{Error: "Hanya host terdaftar yang bisa membuat event"})
+		}
+		return next(c)
+	}
+}
+
+// requireAdmin adalah middleware untuk route khusus admin platform.
+// Harus dipasang SETELAH 'requireAuth'.
+func (h *Handler) requireAdmin(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !isPlatformAdmin(sessionAddress(c)) {
+			return c.JSON(http.StatusForbidden, APIResponse{Error: "Hanya admin platform"})
+		}
+		return next(c)
+	}
+}
+
+// eventRole mengembalikan role 'address' di event 'eventID' ("" jika tidak punya role).
+// Mengembalikan error NotFound jika event tidak ada.
+func (h *Handler) eventRole(ctx context.Context, eventID uint64, address string) (string, error) {
+	ev, err := h.DB.Event.Query().
+		Where(event.EventIDEQ(eventID)).
+		WithHost().
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	if isPlatformAdmin(address) {
+		return roleAdmin, nil
+	}
+	if ev.Edges.Host != nil && ev.Edges.Host.Address == address {
+		return roleHost, nil
+	}
+
+	isStaff, err := h.DB.EventStaff.Query().
+		Where(eventstaff.EventIDEQ(eventID), eventstaff.AddressEQ(address)).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if isStaff {
+		return roleStaff, nil
+	}
+	return "", nil
+}
+
+// authorizeEvent mengecek apakah user yang login boleh melakukan 'perm' di event 'eventID'.
+// Jika tidak, respon error sudah ditulis dan 'ok' bernilai false:
+//
+//	if ok, err := h.authorizeEvent(c, eventID, permCheckIn); !ok {
+//		return err
+//	}
+func (h *Handler) authorizeEvent(c echo.Context, eventID uint64, perm eventPermission) (bool, error) {
+	role, err := h.eventRole(c.Request().Context(), eventID, sessionAddress(c))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, c.JSON(http.StatusNotFound, APIResponse{Error: "Event not found"})
+		}
+		return false, c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if !roleHasPermission(role, perm) {
+		return false, c.JSON(http.StatusForbidden, APIResponse{Error: fmt.Sprintf("Tidak punya izin '%s' untuk event ini", perm)})
+	}
+	return true, nil
+}
+
+// requireEventPermission adalah middleware untuk route dengan parameter ':id' (Event ID on-chain).
+// Harus dipasang SETELAH 'requireAuth'.
+func (h *Handler) requireEventPermission(perm eventPermission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			eventID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+			}
+			if ok, err := h.authorizeEvent(c, eventID, perm); !ok {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// @Summary     Daftar Staff Event
+// @Description Menampilkan staff yang didelegasikan untuk event ini (host/admin).
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "Event ID (On-Chain ID)"
+// @Success     200 {object} APIResponse{data=[]swagdto.EventStaffResponse} "Daftar staff"
+// @Failure     403 {object} APIResponse "Bukan host event ini"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Router      /events/{id}/staff [get]
+func (h *Handler) getEventStaff(c echo.Context) error {
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	staff, err := h.DB.EventStaff.Query().
+		Where(eventstaff.EventIDEQ(eventID)).
+		Order(ent.Asc(eventstaff.FieldCreatedAt)).
+		All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	responses := make([]*swagdto.EventStaffResponse, 0, len(staff))
+	for _, s := range staff {
+		responses = append(responses, &swagdto.EventStaffResponse{
+			Address:   s.Address,
+			AddedBy:   s.AddedBy,
+			CreatedAt: s.CreatedAt,
+		})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses})
+}
+
+// @Summary     Tambah Staff Event
+// @Description Host mendelegasikan user sebagai staff event (check-in, export attendee, moderasi).
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id   path     int                true "Event ID (On-Chain ID)"
+// @Param       body body     AddEventStaffRequest true "Alamat staff"
+// @Success     201 {object} APIResponse{data=swagdto.EventStaffResponse} "Staff ditambahkan"
+// @Failure     400 {object} APIResponse "Input tidak valid / user belum punya profil"
+// @Failure     403 {object} APIResponse "Bukan host event ini"
+// @Failure     409 {object} APIResponse "User sudah menjadi staff"
+// @Router      /events/{id}/staff [post]
+func (h *Handler) addEventStaff(c echo.Context) error {
+	ctx := c.Request().Context()
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	req := new(AddEventStaffRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.Address == "" {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "address wajib diisi"})
+	}
+	address := normalizeAddress(req.Address)
+	if address == sessionAddress(c) {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Host tidak perlu ditambahkan sebagai staff"})
+	}
+
+	// Staff harus sudah punya profil (agar bisa login & terlihat di UI)
+	exists, err := h.DB.User.Query().Where(user.AddressEQ(address)).Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if !exists {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "User belum punya profil"})
+	}
+
+	s, err := h.DB.EventStaff.Create().
+		SetEventID(eventID).
+		SetAddress(address).
+		SetAddedBy(sessionAddress(c)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return c.JSON(http.StatusConflict, APIResponse{Error: "User sudah menjadi staff event ini"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, APIResponse{Data: &swagdto.EventStaffResponse{
+		Address:   s.Address,
+		AddedBy:   s.AddedBy,
+		CreatedAt: s.CreatedAt,
+	}})
+}
+
+// @Summary     Hapus Staff Event
+// @Description Host mencabut delegasi staff dari event.
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id      path     int     true "Event ID (On-Chain ID)"
+// @Param       address path     string  true "Alamat staff"
+// @Success     200 {object} APIResponse "Staff dihapus"
+// @Failure     403 {object} APIResponse "Bukan host event ini"
+// @Failure     404 {object} APIResponse "Staff tidak ditemukan"
+// @Router      /events/{id}/staff/{address} [delete]
+func (h *Handler) removeEventStaff(c echo.Context) error {
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	n, err := h.DB.EventStaff.Delete().
+		Where(
+			eventstaff.EventIDEQ(eventID),
+			eventstaff.AddressEQ(normalizeAddress(c.Param("address"))),
+		).
+		Exec(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if n == 0 {
+		return c.JSON(http.StatusNotFound, APIResponse{Error: "Staff tidak ditemukan"})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: map[string]string{"message": "Staff removed"}})
+}
+
+// @Summary     Export Attendee (CSV)
+// @Description Mengunduh daftar attendee event dalam format CSV (host/staff/admin).
+// @Description Partner bisa memakai header 'X-API-Key' (key dengan owner host/staff event ini) sebagai pengganti sesi login.
+// @Tags        Events
+// @Produce     text/csv
+// @Security    BearerAuth
+// @Security    APIKeyAuth
+// @Param       id  path     int  true  "Event ID (On-Chain ID)"
+// @Success     200 {file}   file "CSV: address,nickname,registration_time,checked_in"
+// @Failure     403 {object} APIResponse "Tidak punya izin"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Router      /events/{id}/attendees/export [get]
+func (h *Handler) exportAttendees(c echo.Context) error {
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	attendances, err := h.DB.Attendance.Query().
+		Where(attendance.HasEventWith(event.EventIDEQ(eventID))).
+		WithUser().
+		Order(ent.Asc(attendance.FieldRegistrationTime)).
+		All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"event-%d-attendees.csv\"", eventID))
+	c.Response().WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(c.Response())
+	_ = w.Write([]string{"address", "nickname", "registration_time", "checked_in"})
+	for _, att := range attendances {
+		var address, nickname string
+		if att.Edges.User != nil {
+			address = att.Edges.User.Address
+			nickname = att.Edges.User.Nickname
+		}
+		_ = w.Write([]string{
+			address,
+			nickname,
+			att.RegistrationTime.Format(time.RFC3339),
+			strconv.FormatBool(att.CheckedIn),
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// @Summary     Hapus Komentar (Moderasi)
+// @Description Menghapus komentar. Diizinkan untuk penulis komentar, admin platform,
+// @Description atau host/staff event tempat moment tersebut di-mint (via Event Pass).
+// @Tags        Social
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id        path     int  true "Moment ID (Internal ID)"
+// @Param       commentId path     int  true "Comment ID"
+// @Success     200 {object} APIResponse "Komentar dihapus"
+// @Failure     403 {object} APIResponse "Tidak punya izin"
+// @Failure     404 {object} APIResponse "Komentar tidak ditemukan"
+// @Router      /moments/{id}/comments/{commentId} [delete]
+func (h *Handler) deleteComment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	momentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Moment ID"})
+	}
+	commentID, err := strconv.Atoi(c.Param("commentId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Comment ID"})
+	}
+
+	// 1. Ambil komentar beserta penulis & event asal moment
+	cm, err := h.DB.Comment.Query().
+		Where(comment.IDEQ(commentID), comment.HasMomentWith(nftmoment.IDEQ(momentID))).
+		WithUser().
+		WithMoment(func(q *ent.NFTMomentQuery) {
+			q.WithMintedWithPass(func(q *ent.EventPassQuery) { q.WithEvent() })
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Comment not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 2. Cek izin: penulis / admin langsung boleh, selain itu harus punya izin moderasi di event asal
+	address := sessionAddress(c)
+	isAuthor := cm.Edges.User != nil && cm.Edges.User.Address == address
+	if !isAuthor && !isPlatformAdmin(address) {
+		ev := momentEvent(cm.Edges.Moment)
+		if ev == nil {
+			return c.JSON(http.StatusForbidden, APIResponse{Error: "Tidak punya izin menghapus komentar ini"})
+		}
+		if ok, err := h.authorizeEvent(c, ev.EventID, permModerate); !ok {
+			return err
+		}
+	}
+
+	// 3. Hapus
+	if err := h.DB.Comment.DeleteOneID(cm.ID).Exec(ctx); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: map[string]string{"message": "Comment deleted"}})
+}
+
+// momentEvent mengembalikan event asal moment (jika di-mint dengan Event Pass).
+func momentEvent(m *ent.NFTMoment) *ent.Event {
+	if m == nil || m.Edges.MintedWithPass == nil {
+		return nil
+	}
+	return m.Edges.MintedWithPass.Edges.Event
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"backend/swagdto"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Full-text search Postgres untuk /search.
+//
+// Setiap entity punya dokumen (gabungan beberapa kolom) yang di-index dua kali dengan GIN:
+// sekali dengan konfigurasi 'english' dan sekali dengan 'indonesian', sehingga stemming
+// kedua bahasa didukung. Query dicocokkan ke kedua index dan rank tertinggi yang dipakai.
+
+const (
+	searchDefaultLimit = 20
+	searchMaxLimit     = 50
+	// Opsi ts_headline: snippet pendek dengan kata yang cocok dibungkus <b>
+	searchHeadlineOptions = "StartSel=<b>, StopSel=</b>, MaxWords=30, MinWords=10, MaxFragments=2"
+)
+
+// searchConfigs adalah konfigurasi text search yang dipakai. 'indonesian' butuh Postgres 13+;
+// jika tidak tersedia diganti 'simple' (lihat 'ensureSearchIndexes').
+var searchConfigs = []string{"english", "indonesian"}
+
+// searchEntity adalah satu tipe entity yang bisa dicari.
+type searchEntity struct {
+	Type  string
+	Table string
+	Key   string // Kolom yang dikembalikan sebagai 'id' di hasil
+	Title string // Ekspresi SQL untuk judul
+	// Kolom dokumen beserta bobotnya ('A' = paling penting)
+	Columns []searchColumn
+}
+
+type searchColumn struct {
+	Name   string
+	Weight string
+}
+
+var searchEntities = []searchEntity{
+	{
+		Type: "event", Table: "events", Key: "event_id", Title: "name",
+		Columns: []searchColumn{{"name", "A"}, {"location", "B"}, {"description", "C"}},
+	},
+	{
+		Type: "moment", Table: "nft_moments", Key: "nft_id", Title: "name",
+		Columns: []searchColumn{{"name", "A"}, {"description", "C"}},
+	},
+	{
+		Type: "accessory", Table: "nft_accessories", Key: "nft_id", Title: "name",
+		Columns: []searchColumn{{"name", "A"}, {"description", "C"}},
+	},
+	{
+		Type: "user", Table: "users", Key: "address", Title: "coalesce(nullif(nickname, ''), address)",
+		Columns: []searchColumn{{"nickname", "A"}, {"bio", "C"}},
+	},
+}
+
+func findSearchEntity(t string) (searchEntity, bool) {
+	for _, se := range searchEntities {
+		if se.Type == t {
+			return se, true
+		}
+	}
+	return searchEntity{}, false
+}
+
+// vector adalah ekspresi tsvector berbobot. Harus identik dengan ekspresi index
+// agar Postgres memakai index GIN.
+func (se searchEntity) vector(config string) string {
+	parts := make([]string, len(se.Columns))
+	for i, col := range se.Columns {
+		parts[i] = fmt.Sprintf("setweight(to_tsvector('%s', coalesce(%s, '')), '%s')", config, col.Name, col.Weight)
+	}
+	return "(" + strings.Join(parts, " || ") + ")"
+}
+
+// document adalah teks polos untuk ts_headline.
+func (se searchEntity) document() string {
+	parts := make([]string, len(se.Columns))
+	for i, col := range se.Columns {
+		parts[i] = fmt.Sprintf("coalesce(%s, '')", col.Name)
+	}
+	return strings.Join(parts, " || ' ' || ")
+}
+
+// ensureSearchIndexes membuat index GIN full-text (jika belum ada). Dipanggil sekali saat startup.
+func (h *Handler) ensureSearchIndexes(ctx context.Context) error {
+	// 1. Cek ketersediaan konfigurasi 'indonesian'
+	var hasIndonesian bool
+	if err := h.SQL.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'indonesian')",
+	).Scan(&hasIndonesian); err != nil {
+		return err
+	}
+	if !hasIndonesian {
+		log.Println("Konfigurasi text search 'indonesian' tidak tersedia, memakai 'simple'")
+		searchConfigs = []string{"english", "simple"}
+	}
+
+	// 2. Index per entity per bahasa
+	for _, se := range searchEntities {
+		for _, config := range searchConfigs {
+			stmt := fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s_search_%s ON %s USING GIN (%s)",
+				se.Table, config, se.Table, se.vector(config))
+			if _, err := h.SQL.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("index %s (%s): %w", se.Table, config, err)
+			}
+		}
+	}
+	return nil
+}
+
+// searchEntityType menjalankan pencarian di satu entity: hasil teratas + jumlah total (untuk facet).
+func (h *Handler) searchEntityType(ctx context.Context, se searchEntity, q string, limit int) ([]*swagdto.SearchResult, int, error) {
+	en, id := searchConfigs[0], searchConfigs[1]
+	vecEN, vecID := se.vector(en), se.vector(id)
+	match := fmt.Sprintf("(%s @@ websearch_to_tsquery('%s', $1) OR %s @@ websearch_to_tsquery('%s', $1))", vecEN, en, vecID, id)
+
+	// 1. Jumlah total (facet)
+	var total int
+	if err := h.SQL.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT count(*) FROM %s WHERE %s", se.Table, match), q,
+	).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	// 2. Hasil teratas. Snippet memakai bahasa dengan rank tertinggi.
+	stmt := fmt.Sprintf(`
+		SELECT %[1]s::text, %[2]s, rank_en, rank_id,
+			CASE WHEN rank_en >= rank_id
+				THEN ts_headline('%[3]s', %[4]s, websearch_to_tsquery('%[3]s', $1), '%[5]s')
+				ELSE ts_headline('%[6]s', %[4]s, websearch_to_tsquery('%[6]s', $1), '%[5]s')
+			END
+		FROM (
+			SELECT * FROM (
+				SELECT *,
+					ts_rank(%[7]s, websearch_to_tsquery('%[3]s', $1)) AS rank_en,
+					ts_rank(%[8]s, websearch_to_tsquery('%[6]s', $1)) AS rank_id
+				FROM %[9]s
+				WHERE %[10]s
+			) AS ranked
+			ORDER BY greatest(rank_en, rank_id) DESC, id DESC
+			LIMIT $2
+		) AS top
+		ORDER BY greatest(rank_en, rank_id) DESC, id DESC`,
+		se.Key, se.Title, en, se.document(), searchHeadlineOptions, id, vecEN, vecID, se.Table, match)
+
+	rows, err := h.SQL.QueryContext(ctx, stmt, q, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var results []*swagdto.SearchResult
+	for rows.Next() {
+		r := &swagdto.SearchResult{Type: se.Type}
+		var rankEN, rankID float64
+		if err := rows.Scan(&r.ID, &r.Title, &rankEN, &rankID, &r.Snippet); err != nil {
+			return nil, 0, err
+		}
+		r.Rank = max(rankEN, rankID)
+		results = append(results, r)
+	}
+	return results, total, rows.Err()
+}
+
+// @Summary     Pencarian Gabungan (Full-Text)
+// @Description Mencari event (nama, lokasi, deskripsi), moment & aksesori (nama, deskripsi), dan user (nickname, bio)
+// @Description dengan full-text search Postgres (bahasa Indonesia & Inggris). Hasil diurutkan berdasarkan relevansi,
+// @Description dilengkapi snippet dengan kata yang cocok dibungkus <b>, dan facet jumlah hasil per tipe.
+// @Description Sintaks query: kata biasa, "frasa", OR, dan -kata (mengecualikan).
+// @Tags        Search
+// @Produce     json
+// @Param       q      query    string  true   "Kata kunci pencarian"
+// @Param       types  query    string  false  "Batasi tipe (dipisah koma): event, moment, accessory, user"
+// @Param       limit  query    int     false  "Jumlah hasil maksimum (default: 20, maks: 50)"
+// @Success     200 {object} APIResponse{data=swagdto.SearchResponse} "Hasil pencarian"
+// @Failure     400 {object} APIResponse "Query kosong / tipe tidak dikenal"
+// @Failure     500 {object} APIResponse "Internal Server Error"
+// @Router      /search [get]
+func (h *Handler) search(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 1. Validasi parameter
+	q := strings.TrimSpace(c.QueryParam("q"))
+	if q == "" {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "q wajib diisi"})
+	}
+	limit := searchDefaultLimit
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "limit harus angka positif"})
+		}
+		limit = min(n, searchMaxLimit)
+	}
+
+	entities := searchEntities
+	if v := c.QueryParam("types"); v != "" {
+		entities = nil
+		for _, t := range strings.Split(v, ",") {
+			se, ok := findSearchEntity(strings.TrimSpace(t))
+			if !ok {
+				return c.JSON(http.StatusBadRequest, APIResponse{Error: fmt.Sprintf("tipe tidak dikenal: '%s'", t)})
+			}
+			entities = append(entities, se)
+		}
+	}
+
+	// 2. Cari di setiap entity, kumpulkan facet
+	resp := &swagdto.SearchResponse{Query: q, Results: []*swagdto.SearchResult{}, Facets: []*swagdto.SearchFacet{}}
+	for _, se := range entities {
+		results, total, err := h.searchEntityType(ctx, se, q, limit)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+		resp.Facets = append(resp.Facets, &swagdto.SearchFacet{Type: se.Type, Count: total})
+		resp.Results = append(resp.Results, results...)
+	}
+
+	// 3. Gabungkan berdasarkan rank
+	sort.SliceStable(resp.Results, func(i, j int) bool {
+		return resp.Results[i].Rank > resp.Results[j].Rank
+	})
+	if len(resp.Results) > limit {
+		resp.Results = resp.Results[:limit]
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: resp})
+}
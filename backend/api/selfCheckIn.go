@@ -0,0 +1,243 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/event"
+	"backend/ent/locationfix"
+	"backend/ent/user"
+	"backend/swagdto"
+	"backend/transactions"
+	"backend/utils"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Heuristik anti-spoofing untuk self check-in
+const (
+	// Lokasi dengan akurasi lebih buruk dari ini ditolak
+	selfCheckInMaxAccuracyM = 100.0
+	// Selisih maksimum jam perangkat dan server
+	selfCheckInMaxClockSkew = 2 * time.Minute
+	// Kecepatan maksimum yang masuk akal antar dua lokasi (~300 km/jam)
+	selfCheckInMaxSpeedMPS = 83.0
+	// Lokasi sebelumnya yang lebih lama dari ini tidak dipakai untuk cek kecepatan
+	selfCheckInVelocityWindow = time.Hour
+	// Self check-in dibuka sejak sekian menit sebelum event dimulai
+	selfCheckInEarlyWindow = 30 * time.Minute
+	// Batas radius yang boleh diatur host
+	maxCheckInRadiusM = 5000.0
+)
+
+// @Summary     Atur Radius Self Check-in (Host/Admin)
+// @Description Host mengatur radius (meter) di sekitar koordinat event untuk self check-in. 0 = nonaktif.
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Param       id   path     int                   true "Event ID (On-Chain ID)"
+// @Security    BearerAuth
+// @Param       body body     UpdateGeofenceRequest true "Radius (meter)"
+// @Success     200 {object} APIResponse "Radius tersimpan"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     403 {object} APIResponse "Tidak punya izin mengubah event ini"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Router      /events/{id}/geofence [put]
+func (h *Handler) updateEventGeofence(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	eventID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+	}
+
+	req := new(UpdateGeofenceRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.RadiusM < 0 || req.RadiusM > maxCheckInRadiusM {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: fmt.Sprintf("radiusM harus antara 0 dan %.0f", maxCheckInRadiusM)})
+	}
+
+	// Izin (host/admin) sudah dicek oleh middleware 'requireEventPermission'
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).Only(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if ev.EventType != 1 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Self check-in hanya untuk event offline"})
+	}
+
+	ev, err = ev.Update().SetCheckinRadiusM(req.RadiusM).Save(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: map[string]interface{}{
+		"eventID":        ev.EventID,
+		"checkinRadiusM": ev.CheckinRadiusM,
+	}})
+}
+
+// @Summary     Self Check-in Berbasis Lokasi
+// @Description Attendee event offline melakukan check-in sendiri dengan mengirim lokasi GPS.
+// @Description Lokasi harus berada di dalam radius event, di dalam jendela waktu event
+// @Description (dibuka 30 menit sebelum mulai), dan lolos heuristik anti-spoofing
+// @Description (akurasi, selisih jam perangkat, kecepatan antar lokasi).
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Param       id   path     int                true "Event ID (On-Chain ID)"
+// @Security    BearerAuth
+// @Param       body body     SelfCheckInRequest true "Lokasi user"
+// @Success     200 {object} APIResponse{data=swagdto.SelfCheckInResponse} "User berhasil check-in"
+// @Failure     400 {object} APIResponse "Input tidak valid"
+// @Failure     403 {object} APIResponse "Lokasi/waktu ditolak"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan / user belum register"
+// @Failure     409 {object} APIResponse "Event sudah dibatalkan"
+// @Failure     500 {object} APIResponse "Internal Server Error (misal: tx gagal)"
+// @Router      /events/{id}/self-check-in [post]
+func (h *Handler) selfCheckIn(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	eventID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+	}
+
+	req := new(SelfCheckInRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	req.UserAddress = sessionAddress(c)
+	if req.Timestamp == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "timestamp adalah field wajib"})
+	}
+	if req.Lat < -90 || req.Lat > 90 || req.Long < -180 || req.Long > 180 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Koordinat tidak valid"})
+	}
+
+	// 1. Ambil event & cek konfigurasi
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Event not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if ev.CancelledAt != nil {
+		return c.JSON(http.StatusConflict, APIResponse{Error: errEventCancelled.Error()})
+	}
+	if ev.EventType != 1 || ev.CheckinRadiusM <= 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Self check-in tidak tersedia untuk event ini"})
+	}
+
+	// 2. Cek status registrasi
+	att, err := h.DB.Attendance.Query().
+		Where(
+			attendance.HasEventWith(event.EventIDEQ(eventID)),
+			attendance.HasUserWith(user.AddressEQ(req.UserAddress)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "User belum register ke event ini"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if att.CheckedIn {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "User sudah check-in"})
+	}
+
+	// 3. Validasi lokasi. Setiap lokasi dicatat (diterima maupun ditolak)
+	// agar bisa dipakai untuk cek kecepatan berikutnya.
+	reportedAt := time.Unix(req.Timestamp, 0)
+	distance := utils.HaversineMeters(ev.Lat, ev.Long, req.Lat, req.Long)
+
+	rejectReason := h.checkLocationFix(ctx, ev, req, reportedAt, distance)
+	if _, err := h.DB.LocationFix.Create().
+		SetEventID(eventID).
+		SetUserAddress(req.UserAddress).
+		SetLat(req.Lat).
+		SetLong(req.Long).
+		SetAccuracyM(req.AccuracyM).
+		SetReportedAt(reportedAt).
+		SetAccepted(rejectReason == "").
+		SetRejectReason(rejectReason).
+		Save(ctx); err != nil {
+		log.Printf("Gagal menyimpan location fix %s: %v", req.UserAddress, err)
+	}
+	if rejectReason != "" {
+		return c.JSON(http.StatusForbidden, APIResponse{Error: rejectReason})
+	}
+
+	// 4. Jalankan transaksi check-in
+	if err := transactions.UserCheckin(eventID, req.UserAddress); err != nil {
+		log.Printf("Gagal menjalankan transaksi self check-in: %v", err)
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	h.recordCheckInVerification(ctx, eventID, []string{req.UserAddress}, attendance.CheckInMethodSelf, "")
+
+	return c.JSON(http.StatusOK, APIResponse{Data: &swagdto.SelfCheckInResponse{
+		Message:     "User checked in successfully!",
+		UserAddress: req.UserAddress,
+		EventID:     eventID,
+		DistanceM:   distance,
+	}})
+}
+
+// checkLocationFix menjalankan semua pengecekan lokasi dan waktu.
+// Mengembalikan alasan penolakan, atau string kosong jika lolos.
+func (h *Handler) checkLocationFix(ctx context.Context, ev *ent.Event, req *SelfCheckInRequest, reportedAt time.Time, distance float64) string {
+	now := time.Now()
+
+	// Jendela waktu event
+	if now.Before(ev.StartDate.Add(-selfCheckInEarlyWindow)) {
+		return "Self check-in belum dibuka"
+	}
+	if now.After(ev.EndDate) {
+		return "Event sudah berakhir"
+	}
+
+	// Jam perangkat harus dekat dengan jam server
+	if skew := now.Sub(reportedAt); skew > selfCheckInMaxClockSkew || skew < -selfCheckInMaxClockSkew {
+		return "Waktu lokasi tidak valid (jam perangkat tidak sinkron atau lokasi lama)"
+	}
+
+	// Akurasi 0 atau negatif biasanya berasal dari lokasi palsu
+	if req.AccuracyM <= 0 || req.AccuracyM > selfCheckInMaxAccuracyM {
+		return fmt.Sprintf("Akurasi lokasi tidak memadai (maks. %.0f meter)", selfCheckInMaxAccuracyM)
+	}
+
+	if distance > ev.CheckinRadiusM {
+		return fmt.Sprintf("Lokasi di luar radius event (%.0f meter dari lokasi, maks. %.0f meter)", distance, ev.CheckinRadiusM)
+	}
+
+	// Kecepatan antar lokasi: bandingkan dengan lokasi terakhir user ini
+	prev, err := h.DB.LocationFix.Query().
+		Where(
+			locationfix.UserAddressEQ(req.UserAddress),
+			locationfix.ReportedAtGT(reportedAt.Add(-selfCheckInVelocityWindow)),
+			locationfix.ReportedAtLT(reportedAt),
+		).
+		Order(ent.Desc(locationfix.FieldReportedAt)).
+		First(ctx)
+	if err == nil {
+		elapsed := reportedAt.Sub(prev.ReportedAt).Seconds()
+		moved := utils.HaversineMeters(prev.Lat, prev.Long, req.Lat, req.Long)
+		// Toleransi sebesar akurasi kedua lokasi
+		moved = max(0, moved-prev.AccuracyM-req.AccuracyM)
+		if elapsed > 0 && moved/elapsed > selfCheckInMaxSpeedMPS {
+			return "Perpindahan lokasi tidak wajar"
+		}
+	} else if !ent.IsNotFound(err) {
+		log.Printf("Gagal mengambil location fix sebelumnya %s: %v", req.UserAddress, err)
+	}
+
+	return ""
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"backend/docs"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/swaggo/swag"
+)
+
+// filterDocsInstance adalah nama instance swagger yang sudah dilengkapi parameter filter.
+const filterDocsInstance = "swagger-filters"
+
+// listFilterRoutes memetakan route list ke whitelist filter-nya.
+// Dipakai untuk menambahkan parameter 'filter[...]' & daftar field 'sort' ke dokumentasi swagger.
+var listFilterRoutes = map[string]*entityFilters{
+	"/moments":      momentFilters,
+	"/accessories":  accessoryFilters,
+	"/events":       eventFilters,
+	"/event-passes": eventPassFilters,
+	"/listings":     listingFilters,
+	"/users":        userFilters,
+}
+
+// filterDocs membungkus dokumentasi hasil 'swag init' dan menyisipkan parameter filter
+// langsung dari whitelist, sehingga dokumentasi selalu sama dengan kode.
+type filterDocs struct {
+	base swag.Swagger
+}
+
+func (d filterDocs) ReadDoc() string {
+	doc := d.base.ReadDoc()
+
+	var spec map[string]any
+	if err := json.Unmarshal([]byte(doc), &spec); err != nil {
+		return doc
+	}
+	paths, _ := spec["paths"].(map[string]any)
+
+	for route, filters := range listFilterRoutes {
+		path, _ := paths[route].(map[string]any)
+		op, _ := path["get"].(map[string]any)
+		if op == nil {
+			continue
+		}
+		params, _ := op["parameters"].([]any)
+
+		var sortable []string
+		for _, fd := range filters.Fields {
+			ops := make([]string, len(fd.Ops))
+			for i, o := range fd.Ops {
+				ops[i] = string(o)
+			}
+			desc := fmt.Sprintf("Filter '%s' (%s). Operator: %s. Contoh: filter[%s][%s]=...",
+				fd.Name, fd.Type, strings.Join(ops, ", "), fd.Name, ops[len(ops)-1])
+			if fd.Legacy != "" {
+				desc += fmt.Sprintf(" (alias lama: '%s')", fd.Legacy)
+			}
+			params = append(params, map[string]any{
+				"name":        "filter[" + fd.Name + "]",
+				"in":          "query",
+				"type":        "string",
+				"required":    false,
+				"description": desc,
+			})
+			if fd.Sortable {
+				sortable = append(sortable, fd.Name)
+			}
+		}
+		sort.Strings(sortable)
+
+		// Lengkapi deskripsi param 'sort' dengan daftar field yang bisa di-sort
+		for _, p := range params {
+			if param, ok := p.(map[string]any); ok && param["name"] == "sort" {
+				param["description"] = fmt.Sprintf("%v. Field: %s", param["description"], strings.Join(sortable, ", "))
+			}
+		}
+		op["parameters"] = params
+	}
+
+	out, err := json.Marshal(spec)
+	if err != nil {
+		return doc
+	}
+	return string(out)
+}
+
+func init() {
+	swag.Register(filterDocsInstance, filterDocs{base: docs.SwaggerInfo})
+}
@@ -0,0 +1,568 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/event"
+	"backend/ent/user"
+	"backend/ent/waitlistentry"
+	"backend/swagdto"
+	"backend/transactions"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Waitlist untuk event yang kuotanya penuh.
+//
+// Registrasi tetap terjadi on-chain ('EventManager.registerEvent', ditandatangani user), jadi waitlist
+// hanya mengatur SIAPA yang dipersilakan mengisi slot kosong:
+//   - Slot kosong muncul saat attendee membatalkan registrasi ('user_cancel_registration.cdc',
+//     event 'UserUnregistered') atau host menaikkan kuota (PUT /events/:id/quota, event 'EventQuotaUpdated').
+//   - Worker menawarkan slot ke user teratas ('offered') + notifikasi, dengan jendela klaim 'waitlistClaimWindow'.
+//   - Jika user register dalam jendela tsb. -> 'claimed'; jika tidak -> 'expired' dan slot ditawarkan ke berikutnya.
+//
+// Slot yang sedang ditawarkan ditahan on-chain ('Admin.reserveEventSlot') sampai jendela klaim habis,
+// sehingga user lain tidak bisa "menyalip", baik lewat POST /events/:id/waitlist maupun register on-chain langsung.
+
+const (
+	waitlistClaimWindow    = 24 * time.Hour
+	waitlistWorkerInterval = 30 * time.Second
+
+	notificationWaitlistOffer   = "waitlist_offer"
+	notificationWaitlistExpired = "waitlist_expired"
+)
+
+// activeWaitlistStatuses adalah status entry yang masih berada di antrian.
+var activeWaitlistStatuses = []waitlistentry.Status{waitlistentry.StatusWaiting, waitlistentry.StatusOffered}
+
+// runWaitlistWorker memproses waitlist semua event secara berkala.
+func (h *Handler) runWaitlistWorker(ctx context.Context) {
+	ticker := time.NewTicker(waitlistWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		h.processWaitlists(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Handler) processWaitlists(ctx context.Context) {
+	eventIDs, err := h.DB.WaitlistEntry.Query().
+		Where(waitlistentry.StatusIn(activeWaitlistStatuses...)).
+		GroupBy(waitlistentry.FieldEventID).
+		Ints(ctx)
+	if err != nil {
+		log.Printf("Gagal mengambil event dengan waitlist: %v", err)
+		return
+	}
+	for _, id := range eventIDs {
+		eventID := uint64(id)
+		if err := h.processEventWaitlist(ctx, eventID); err != nil {
+			log.Printf("Gagal memproses waitlist event %d: %v", eventID, err)
+		}
+	}
+}
+
+// processEventWaitlist menandai klaim/kedaluwarsa lalu menawarkan slot kosong ke antrian teratas.
+func (h *Handler) processEventWaitlist(ctx context.Context, eventID uint64) error {
+	now := time.Now()
+
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil // Belum ter-indeks
+		}
+		return err
+	}
+
+	// 1. Event sudah selesai/dibatalkan: tutup seluruh antrian
+	if now.After(ev.EndDate) || ev.CancelledAt != nil {
+		return h.DB.WaitlistEntry.Update().
+			Where(waitlistentry.EventIDEQ(eventID), waitlistentry.StatusIn(activeWaitlistStatuses...)).
+			SetStatus(waitlistentry.StatusExpired).
+			Exec(ctx)
+	}
+
+	// 2. Alamat yang sudah register (on-chain, via indexer)
+	registered, err := h.eventRegistrantAddresses(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	isRegistered := make(map[string]bool, len(registered))
+	for _, a := range registered {
+		isRegistered[a] = true
+	}
+
+	entries, err := h.DB.WaitlistEntry.Query().
+		Where(waitlistentry.EventIDEQ(eventID), waitlistentry.StatusIn(activeWaitlistStatuses...)).
+		Order(ent.Asc(waitlistentry.FieldPosition), ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 3. Klaim & kedaluwarsa
+	var waiting []*ent.WaitlistEntry
+	activeOffers := 0
+	for _, e := range entries {
+		switch {
+		case isRegistered[e.Address]:
+			if err := e.Update().SetStatus(waitlistentry.StatusClaimed).Exec(ctx); err != nil {
+				return err
+			}
+		case e.Status == waitlistentry.StatusOffered && e.OfferExpiresAt != nil && now.After(*e.OfferExpiresAt):
+			// Hanya entry yang masih 'offered' yang diubah (aman jika worker berjalan paralel)
+			n, err := h.DB.WaitlistEntry.Update().
+				Where(waitlistentry.IDEQ(e.ID), waitlistentry.StatusEQ(waitlistentry.StatusOffered)).
+				SetStatus(waitlistentry.StatusExpired).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 1 {
+				h.notify(ctx, e.Address, notificationWaitlistExpired,
+					"Slot waitlist kedaluwarsa",
+					fmt.Sprintf("Waktu klaim slot untuk '%s' sudah habis.", ev.Name),
+					map[string]any{"event_id": eventID})
+			}
+		case e.Status == waitlistentry.StatusOffered:
+			activeOffers++
+		default:
+			waiting = append(waiting, e)
+		}
+	}
+
+	// 4. Tawarkan slot kosong ke antrian teratas
+	free := int(ev.Quota) - len(registered) - activeOffers
+	expiresAt := now.Add(waitlistClaimWindow)
+	if ev.EndDate.Before(expiresAt) {
+		expiresAt = ev.EndDate
+	}
+	for _, e := range waiting {
+		if free <= 0 {
+			break
+		}
+		n, err := h.DB.WaitlistEntry.Update().
+			Where(waitlistentry.IDEQ(e.ID), waitlistentry.StatusEQ(waitlistentry.StatusWaiting)).
+			SetStatus(waitlistentry.StatusOffered).
+			SetOfferedAt(now).
+			SetOfferExpiresAt(expiresAt).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue // Sudah diproses (user keluar / worker lain)
+		}
+		// Tahan slot on-chain agar tidak bisa diambil lewat register langsung
+		if err := transactions.ReserveEventSlot(eventID, e.Address, expiresAt); err != nil {
+			log.Printf("Gagal menahan slot event %d untuk %s: %v", eventID, e.Address, err)
+			if err := h.DB.WaitlistEntry.Update().
+				Where(waitlistentry.IDEQ(e.ID), waitlistentry.StatusEQ(waitlistentry.StatusOffered)).
+				SetStatus(waitlistentry.StatusWaiting).
+				ClearOfferedAt().
+				ClearOfferExpiresAt().
+				Exec(ctx); err != nil {
+				return err
+			}
+			// Kemungkinan slot sudah diambil register yang belum ter-indeks; coba lagi di putaran berikutnya
+			break
+		}
+		free--
+		h.notify(ctx, e.Address, notificationWaitlistOffer,
+			"Slot event tersedia!",
+			fmt.Sprintf("Slot untuk '%s' tersedia. Register sebelum %s agar slot tidak dialihkan.",
+				ev.Name, expiresAt.UTC().Format(time.RFC1123)),
+			map[string]any{"event_id": eventID, "offer_expires_at": expiresAt})
+	}
+	return nil
+}
+
+// waitlistRank mengembalikan urutan (1 = teratas) entry 'waiting' di antrian event-nya.
+func (h *Handler) waitlistRank(ctx context.Context, e *ent.WaitlistEntry) (int, error) {
+	ahead, err := h.DB.WaitlistEntry.Query().
+		Where(
+			waitlistentry.EventIDEQ(e.EventID),
+			waitlistentry.StatusEQ(waitlistentry.StatusWaiting),
+			waitlistentry.Or(
+				waitlistentry.PositionLT(e.Position),
+				waitlistentry.And(waitlistentry.PositionEQ(e.Position), waitlistentry.IDLT(e.ID)),
+			),
+		).
+		Count(ctx)
+	return ahead + 1, err
+}
+
+func waitlistEntryResponse(e *ent.WaitlistEntry, rank int) *swagdto.WaitlistEntryResponse {
+	return &swagdto.WaitlistEntryResponse{
+		EventID:        e.EventID,
+		Address:        e.Address,
+		Status:         string(e.Status),
+		Rank:           rank,
+		OfferedAt:      e.OfferedAt,
+		OfferExpiresAt: e.OfferExpiresAt,
+		CreatedAt:      e.CreatedAt,
+	}
+}
+
+// @Summary     Masuk Waitlist Event
+// @Description User masuk antrian event yang kuotanya penuh. Saat slot kosong, user teratas mendapat notifikasi
+// @Description dan harus register on-chain dalam jendela klaim (24 jam, atau sampai event selesai).
+// @Description Selama jendela klaim slot ditahan on-chain untuk user tsb. (user lain ditolak kontrak).
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "Event ID (On-Chain ID)"
+// @Success     201 {object} APIResponse{data=swagdto.WaitlistEntryResponse} "Masuk waitlist"
+// @Failure     400 {object} APIResponse "Event sudah selesai/dibatalkan / user belum punya profil"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Failure     409 {object} APIResponse "Sudah register / sudah di waitlist / kuota masih tersedia"
+// @Router      /events/{id}/waitlist [post]
+func (h *Handler) joinWaitlist(c echo.Context) error {
+	ctx := c.Request().Context()
+	address := sessionAddress(c)
+
+	eventID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+	}
+	ev, err := h.DB.Event.Query().Where(event.EventIDEQ(eventID)).WithAttendances().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "Event not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if time.Now().After(ev.EndDate) || ev.CancelledAt != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Event sudah selesai atau dibatalkan"})
+	}
+
+	// 1. User harus punya profil dan belum register
+	u, err := h.DB.User.Query().Where(user.AddressEQ(address)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "User belum punya profil"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	registered, err := u.QueryAttendances().Where(attendance.HasEventWith(event.EventIDEQ(eventID))).Exist(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if registered {
+		return c.JSON(http.StatusConflict, APIResponse{Error: "User sudah register ke event ini"})
+	}
+
+	// 2. Waitlist hanya jika slot habis (registrasi + tawaran aktif) atau antrian sudah ada
+	queued, err := h.DB.WaitlistEntry.Query().
+		Where(waitlistentry.EventIDEQ(eventID), waitlistentry.StatusIn(activeWaitlistStatuses...)).
+		Count(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if queued == 0 && uint64(len(ev.Edges.Attendances)) < ev.Quota {
+		return c.JSON(http.StatusConflict, APIResponse{Error: "Kuota masih tersedia, silakan register langsung"})
+	}
+
+	// 3. Masuk di akhir antrian (entry lama yang 'expired'/'left' dipakai ulang)
+	position := 1
+	last, err := h.DB.WaitlistEntry.Query().
+		Where(waitlistentry.EventIDEQ(eventID)).
+		Order(ent.Desc(waitlistentry.FieldPosition)).
+		First(ctx)
+	switch {
+	case err == nil:
+		position = last.Position + 1
+	case !ent.IsNotFound(err):
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	existing, err := h.DB.WaitlistEntry.Query().
+		Where(waitlistentry.EventIDEQ(eventID), waitlistentry.AddressEQ(address)).
+		Only(ctx)
+	var entry *ent.WaitlistEntry
+	switch {
+	case err == nil && (existing.Status == waitlistentry.StatusWaiting || existing.Status == waitlistentry.StatusOffered):
+		return c.JSON(http.StatusConflict, APIResponse{Error: "User sudah berada di waitlist"})
+	case err == nil:
+		entry, err = existing.Update().
+			SetStatus(waitlistentry.StatusWaiting).
+			SetPosition(position).
+			ClearOfferedAt().
+			ClearOfferExpiresAt().
+			Save(ctx)
+	case ent.IsNotFound(err):
+		entry, err = h.DB.WaitlistEntry.Create().
+			SetEventID(eventID).
+			SetAddress(address).
+			SetPosition(position).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			return c.JSON(http.StatusConflict, APIResponse{Error: "User sudah berada di waitlist"})
+		}
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	rank, err := h.waitlistRank(ctx, entry)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusCreated, APIResponse{Data: waitlistEntryResponse(entry, rank)})
+}
+
+// @Summary     Status Waitlist Saya
+// @Description Menampilkan posisi & status user di waitlist event (termasuk batas waktu klaim jika sedang ditawari slot).
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "Event ID (On-Chain ID)"
+// @Success     200 {object} APIResponse{data=swagdto.WaitlistEntryResponse} "Status waitlist"
+// @Failure     404 {object} APIResponse "User tidak berada di waitlist"
+// @Router      /events/{id}/waitlist/me [get]
+func (h *Handler) getMyWaitlistEntry(c echo.Context) error {
+	ctx := c.Request().Context()
+	eventID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+	}
+
+	entry, err := h.DB.WaitlistEntry.Query().
+		Where(waitlistentry.EventIDEQ(eventID), waitlistentry.AddressEQ(sessionAddress(c))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "User tidak berada di waitlist event ini"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	rank := 0
+	if entry.Status == waitlistentry.StatusWaiting {
+		if rank, err = h.waitlistRank(ctx, entry); err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: waitlistEntryResponse(entry, rank)})
+}
+
+// @Summary     Keluar dari Waitlist
+// @Description User keluar dari waitlist. Jika sedang ditawari slot, slot langsung dialihkan ke antrian berikutnya.
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "Event ID (On-Chain ID)"
+// @Success     200 {object} APIResponse "Keluar dari waitlist"
+// @Failure     404 {object} APIResponse "User tidak berada di waitlist"
+// @Router      /events/{id}/waitlist [delete]
+func (h *Handler) leaveWaitlist(c echo.Context) error {
+	ctx := c.Request().Context()
+	eventID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid Event ID format"})
+	}
+
+	address := sessionAddress(c)
+	entry, err := h.DB.WaitlistEntry.Query().
+		Where(
+			waitlistentry.EventIDEQ(eventID),
+			waitlistentry.AddressEQ(address),
+			waitlistentry.StatusIn(activeWaitlistStatuses...),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, APIResponse{Error: "User tidak berada di waitlist event ini"})
+		}
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	n, err := h.DB.WaitlistEntry.Update().
+		Where(waitlistentry.IDEQ(entry.ID), waitlistentry.StatusEQ(entry.Status)).
+		SetStatus(waitlistentry.StatusLeft).
+		Save(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if n == 0 {
+		return c.JSON(http.StatusNotFound, APIResponse{Error: "User tidak berada di waitlist event ini"})
+	}
+
+	// Slot yang sedang ditawarkan ke user ini dilepas on-chain lalu dialihkan (transaksi berjalan di background)
+	go func(ctx context.Context) {
+		if entry.Status == waitlistentry.StatusOffered {
+			if err := transactions.ReleaseEventSlot(eventID, address); err != nil {
+				log.Printf("Gagal melepas slot event %d untuk %s: %v", eventID, address, err)
+			}
+		}
+		if err := h.processEventWaitlist(ctx, eventID); err != nil {
+			log.Printf("Gagal memproses waitlist event %d: %v", eventID, err)
+		}
+	}(context.WithoutCancel(ctx))
+	return c.JSON(http.StatusOK, APIResponse{Data: map[string]string{"message": "Keluar dari waitlist"}})
+}
+
+// @Summary     Daftar Waitlist Event (Host)
+// @Description Menampilkan antrian aktif (waiting & offered) sesuai urutan. Host/admin saja.
+// @Tags        Events
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id  path     int  true  "Event ID (On-Chain ID)"
+// @Success     200 {object} APIResponse{data=[]swagdto.WaitlistEntryResponse} "Antrian waitlist"
+// @Failure     403 {object} APIResponse "Bukan host event ini"
+// @Failure     404 {object} APIResponse "Event tidak ditemukan"
+// @Router      /events/{id}/waitlist [get]
+func (h *Handler) getEventWaitlist(c echo.Context) error {
+	ctx := c.Request().Context()
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	responses, err := h.activeWaitlist(ctx, eventID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses})
+}
+
+// activeWaitlist mengembalikan entry 'offered' lalu 'waiting' (dengan rank) sesuai urutan antrian.
+func (h *Handler) activeWaitlist(ctx context.Context, eventID uint64) ([]*swagdto.WaitlistEntryResponse, error) {
+	entries, err := h.DB.WaitlistEntry.Query().
+		Where(waitlistentry.EventIDEQ(eventID), waitlistentry.StatusIn(activeWaitlistStatuses...)).
+		Order(ent.Asc(waitlistentry.FieldPosition), ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*swagdto.WaitlistEntryResponse, 0, len(entries))
+	rank := 0
+	for _, e := range entries {
+		if e.Status == waitlistentry.StatusOffered {
+			responses = append(responses, waitlistEntryResponse(e, 0))
+		}
+	}
+	for _, e := range entries {
+		if e.Status == waitlistentry.StatusWaiting {
+			rank++
+			responses = append(responses, waitlistEntryResponse(e, rank))
+		}
+	}
+	return responses, nil
+}
+
+// @Summary     Atur Ulang Urutan Waitlist (Host)
+// @Description Alamat yang dikirim dipindah ke depan antrian sesuai urutan; sisanya mengikuti dengan urutan lama.
+// @Description Hanya entry berstatus 'waiting' yang bisa diatur (slot yang sudah ditawarkan tidak berubah).
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id   path     int                    true "Event ID (On-Chain ID)"
+// @Param       body body     ReorderWaitlistRequest true "Urutan alamat"
+// @Success     200 {object} APIResponse{data=[]swagdto.WaitlistEntryResponse} "Antrian setelah diatur ulang"
+// @Failure     400 {object} APIResponse "Alamat tidak ada di antrian / duplikat"
+// @Failure     403 {object} APIResponse "Bukan host event ini"
+// @Router      /events/{id}/waitlist/order [put]
+func (h *Handler) reorderWaitlist(c echo.Context) error {
+	ctx := c.Request().Context()
+	eventID, _ := strconv.ParseUint(c.Param("id"), 10, 64)
+
+	req := new(ReorderWaitlistRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if len(req.Addresses) == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "addresses wajib diisi"})
+	}
+
+	tx, err := h.DB.Tx(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	defer tx.Rollback()
+
+	waiting, err := tx.WaitlistEntry.Query().
+		Where(waitlistentry.EventIDEQ(eventID), waitlistentry.StatusEQ(waitlistentry.StatusWaiting)).
+		Order(ent.Asc(waitlistentry.FieldPosition), ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	byAddress := make(map[string]*ent.WaitlistEntry, len(waiting))
+	for _, e := range waiting {
+		byAddress[e.Address] = e
+	}
+
+	// 1. Urutan baru: alamat dari request, lalu sisanya
+	ordered := make([]*ent.WaitlistEntry, 0, len(waiting))
+	picked := map[string]bool{}
+	for _, raw := range req.Addresses {
+		addr := normalizeAddress(raw)
+		e, ok := byAddress[addr]
+		if !ok {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: fmt.Sprintf("Alamat %s tidak sedang mengantri di waitlist", addr)})
+		}
+		if picked[addr] {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: fmt.Sprintf("Alamat %s duplikat", addr)})
+		}
+		picked[addr] = true
+		ordered = append(ordered, e)
+	}
+	for _, e := range waiting {
+		if !picked[e.Address] {
+			ordered = append(ordered, e)
+		}
+	}
+
+	// 2. Simpan posisi baru
+	for i, e := range ordered {
+		if err := tx.WaitlistEntry.UpdateOne(e).SetPosition(i + 1).Exec(ctx); err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	responses, err := h.activeWaitlist(ctx, eventID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, APIResponse{Data: responses})
+}
+
+// @Summary     Ubah Kuota Event (Host)
+// @Description Mengubah kuota event on-chain. Kuota tidak boleh lebih kecil dari jumlah attendee yang sudah register.
+// @Description Jika kuota dinaikkan, slot baru langsung ditawarkan ke antrian waitlist. Sama dengan PATCH /events/{id} berisi 'quota' saja.
+// @Tags        Events
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       id   path     int                    true "Event ID (On-Chain ID)"
+// @Param       body body     UpdateEventQuotaRequest true "Kuota baru"
+// @Success     200 {object} APIResponse{data=swagdto.EventChangeResponse} "Kuota tersimpan"
+// @Failure     400 {object} APIResponse "Kuota tidak valid"
+// @Failure     403 {object} APIResponse "Tidak punya izin mengubah event ini"
+// @Failure     409 {object} APIResponse "Event sudah selesai atau dibatalkan"
+// @Failure     500 {object} APIResponse "Transaksi gagal"
+// @Router      /events/{id}/quota [put]
+func (h *Handler) updateEventQuota(c echo.Context) error {
+	req := new(UpdateEventQuotaRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "Invalid request body: " + err.Error()})
+	}
+	if req.Quota == 0 {
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "quota harus lebih dari 0"})
+	}
+	return h.applyEventUpdate(c, &UpdateEventRequest{Quota: &req.Quota})
+}
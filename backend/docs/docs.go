@@ -46,6 +46,24 @@ const docTemplate = `{
                         "description": "Jumlah item per halaman (default: 20)",
                         "name": "pageSize",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)",
+                        "name": "withTotal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan, misal: '-start_date,name' ('-' = DESC)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -70,34 +88,77 @@ const docTemplate = `{
                 }
             }
         },
-        "/event/check-in": {
+        "/admin/api-keys": {
+            "get": {
+                "description": "Menampilkan semua API key beserta pemakaian hari ini dan total request.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Daftar API Key (Admin)",
+                "responses": {
+                    "200": {
+                        "description": "Daftar API key",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.APIKeyResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan admin",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
             "post": {
-                "description": "Mencatat check-in untuk seorang user di sebuah event. Ini harus dipanggil oleh admin/backend.\nMenerima 'application/json' ATAU 'multipart/form-data'.",
+                "description": "Membuat API key untuk partner. Kunci mentah hanya dikembalikan SEKALI di respon ini.\nScope: \"read\" (semua GET), \"*\" (semua route), atau route spesifik \"METHOD /path\" (misal: \"GET /events/:id\").\nRoute partner (misal: \"GET /events/:id/attendees/export\") hanya bisa diakses dengan key yang punya\n'ownerAddress' dan scope route tersebut (atau \"*\"); izin event dicek sebagai owner.",
                 "consumes": [
-                    "application/json",
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Events"
+                    "API Keys"
                 ],
-                "summary": "Check-in User ke Event (Admin)",
+                "summary": "Buat API Key (Admin)",
                 "parameters": [
                     {
-                        "description": "Alamat User dan ID Event",
+                        "description": "Nama, scope, dan batas pemakaian",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/main.CheckInRequest"
+                            "$ref": "#/definitions/main.CreateAPIKeyRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User berhasil check-in",
+                    "201": {
+                        "description": "API key dibuat",
                         "schema": {
                             "allOf": [
                                 {
@@ -107,7 +168,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/swagdto.CheckInDataResponse"
+                                            "$ref": "#/definitions/swagdto.CreateAPIKeyResponse"
                                         }
                                     }
                                 }
@@ -120,247 +181,422 @@ const docTemplate = `{
                             "$ref": "#/definitions/main.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error (misal: tx gagal)",
+                    "403": {
+                        "description": "Bukan admin",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/events": {
-            "get": {
-                "description": "Mengambil daftar semua event yang ada di platform, dengan pagination dan filter.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/admin/api-keys/{id}": {
+            "delete": {
+                "description": "Mencabut API key. Request berikutnya dengan key ini akan ditolak (401).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Events"
+                    "API Keys"
                 ],
-                "summary": "Ambil Daftar Event (Paginated)",
+                "summary": "Cabut API Key (Admin)",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Filter berdasarkan Tipe Event (0 = Online, 1 = Offline)",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Nomor Halaman (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Jumlah item per halaman (default: 20)",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Daftar event berhasil diambil",
+                        "description": "API key dicabut",
                         "schema": {
-                            "$ref": "#/definitions/swagdto.GetEventsResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.APIKeyResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Bukan admin",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "API key tidak ditemukan",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/listings": {
+        "/admin/claim-quotas": {
             "get": {
-                "description": "Mengambil daftar semua NFT yang dijual di marketplace, mendukung pagination dan filter.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Menampilkan semua konfigurasi kuota klaim.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Marketplace"
-                ],
-                "summary": "Ambil Daftar Penjualan (Listings)",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Filter berdasarkan alamat penjual (misal: 0x...)",
-                        "name": "seller_address",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Nomor Halaman (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Jumlah item per halaman (default: 20)",
-                        "name": "pageSize",
-                        "in": "query"
-                    }
+                    "Claims"
                 ],
+                "summary": "Daftar Kuota Klaim (Admin)",
                 "responses": {
                     "200": {
-                        "description": "Daftar penjualan berhasil diambil",
+                        "description": "Daftar kuota",
                         "schema": {
-                            "$ref": "#/definitions/swagdto.GetListingsResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.ClaimQuotaResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Bukan admin",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
                     }
-                }
-            }
-        },
-        "/moment/free": {
-            "post": {
-                "description": "Minting 'NFTMoment' (UGC) gratis. Endpoint ini menerima 'multipart/form-data'.",
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "Membuat atau mengubah kuota klaim untuk satu cakupan.\nCakupan: \"global\", \"event:\u003ceventID\u003e\", atau \"campaign:\u003cnama\u003e\". Jenis klaim saat ini: \"free_mint\".",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Moments"
+                    "Claims"
                 ],
-                "summary": "Mint NFT Moment (Gratis)",
+                "summary": "Atur Kuota Klaim (Admin)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Alamat Flow penerima (misal: 0x...)",
-                        "name": "recipient",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Nama untuk NFT Moment",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deskripsi untuk NFT Moment",
-                        "name": "description",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "file",
-                        "description": "File gambar (JPG/PNG/WEBP) untuk Momen UGC",
-                        "name": "thumbnail",
-                        "in": "formData",
-                        "required": true
+                        "description": "Konfigurasi kuota",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpsertClaimQuotaRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Minting sukses",
+                    "200": {
+                        "description": "Kuota tersimpan",
                         "schema": {
-                            "$ref": "#/definitions/swagdto.MintResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.ClaimQuotaResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Input tidak valid (field wajib hilang)",
+                        "description": "Input tidak valid",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error (upload/transaksi gagal)",
+                    "403": {
+                        "description": "Bukan admin",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/moment/with-event-pass": {
-            "post": {
-                "description": "Minting 'NFTMoment' (UGC) dengan 'membakar' (menggunakan) 'EventPass' (SBT). Endpoint ini menerima 'multipart/form-data'.",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/admin/referrals/stats": {
+            "get": {
+                "description": "Ringkasan referral seluruh platform dan inviter teratas.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Moments"
+                    "Referrals"
                 ],
-                "summary": "Mint NFT Moment (dengan Event Pass)",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Alamat Flow penerima (misal: 0x...)",
-                        "name": "recipient",
-                        "in": "formData",
-                        "required": true
+                "summary": "Statistik Referral (Admin)",
+                "responses": {
+                    "200": {
+                        "description": "Statistik referral",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.AdminReferralStatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
+                    "403": {
+                        "description": "Bukan admin",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
                     {
-                        "type": "string",
-                        "description": "ID dari EventPass (SBT) yang akan digunakan",
-                        "name": "eventPassID",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Tingkatan (tier) dari Momen 0 untuk community 1 untuk pro",
-                        "name": "tier",
-                        "in": "formData"
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api-keys/me": {
+            "get": {
+                "description": "Menampilkan batas dan pemakaian API key yang dikirim di header 'X-API-Key'.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Pemakaian API Key",
+                "responses": {
+                    "200": {
+                        "description": "Pemakaian API key",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.APIKeyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
+                    "401": {
+                        "description": "API key tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Nama untuk NFT Moment",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
+                        "APIKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Langkah 2 login: kirim account-proof dari FCL ('currentUser.services' tipe 'account-proof').\nSignature diverifikasi terhadap key on-chain akun (via access node). Jika valid, token sesi dikembalikan.\nGunakan token sebagai header 'Authorization: Bearer \u003ctoken\u003e'.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login dengan Wallet (FCL Account-Proof)",
+                "parameters": [
                     {
-                        "type": "string",
-                        "description": "Deskripsi untuk NFT Moment",
-                        "name": "description",
-                        "in": "formData"
+                        "description": "Account-proof dari FCL",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login sukses",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.SessionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Nonce / signature tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "description": "Menghapus sesi yang sedang dipakai.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "200": {
+                        "description": "Logout sukses",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
                     {
-                        "type": "file",
-                        "description": "File gambar (JPG/PNG/WEBP) untuk Momen UGC",
-                        "name": "thumbnail",
-                        "in": "formData",
-                        "required": true
+                        "BearerAuth": []
                     }
+                ]
+            }
+        },
+        "/auth/me": {
+            "get": {
+                "description": "Mengembalikan alamat wallet dari sesi yang sedang dipakai, dan apakah user adalah admin platform.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
                 ],
+                "summary": "Sesi Saat Ini",
                 "responses": {
-                    "201": {
-                        "description": "Minting sukses",
+                    "200": {
+                        "description": "Alamat user",
                         "schema": {
-                            "$ref": "#/definitions/swagdto.MintResponse"
+                            "$ref": "#/definitions/main.APIResponse"
                         }
                     },
-                    "400": {
-                        "description": "Input tidak valid (field wajib hilang)",
+                    "401": {
+                        "description": "Belum login",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/auth/nonce": {
+            "get": {
+                "description": "Langkah 1 login: ambil nonce untuk FCL account-proof ('fcl.config().put(\"fcl.accountProof.resolver\", ...)').",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Ambil Nonce Login",
+                "responses": {
+                    "200": {
+                        "description": "Nonce",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.AuthNonceResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error (upload/transaksi gagal)",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
@@ -368,9 +604,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/moments": {
+        "/claims/me": {
             "get": {
-                "description": "Mengambil daftar NFT Moment, mendukung filter berdasarkan pemilik dan pagination.\n'Eager loading' akan menyertakan data 'owner', 'equipped_accessories', dan 'minted_with_pass'.",
+                "description": "Menampilkan sisa kuota user yang login untuk satu jenis klaim \u0026 cakupan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Claims"
+                ],
+                "summary": "Sisa Kuota Klaim Saya",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Jenis klaim (default: free_mint)",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cakupan (default: global)",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sisa kuota",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.ClaimRemainingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Tidak ada kuota untuk cakupan ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/event-passes": {
+            "get": {
+                "description": "Mengambil daftar Event Pass (Proof of Attendance). Mendukung filter owner_address untuk melihat koleksi user tertentu.\nMengikuti privasi peserta: pengunjung anonim hanya mendapat 'pagination.totalItems', user yang login hanya\nmelihat pass miliknya, pass di event yang ia kelola, dan pass peserta lain sesuai 'attendee_visibility' event.\nDeprecated: tanpa login, 'owner_address' masih mengembalikan pass milik alamat tsb yang publik\n(event 'public' \u0026 pemilik tidak memilih disembunyikan).",
                 "consumes": [
                     "application/json"
                 ],
@@ -378,9 +671,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Moments"
+                    "EventPass"
                 ],
-                "summary": "Ambil Daftar NFT Moment (Paginated)",
+                "summary": "Ambil Daftar Event Pass (SBT)",
                 "parameters": [
                     {
                         "type": "string",
@@ -399,19 +692,31 @@ const docTemplate = `{
                         "description": "Jumlah item per halaman (default: 20)",
                         "name": "pageSize",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)",
+                        "name": "withTotal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan, misal: '-start_date,name' ('-' = DESC)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Daftar momen berhasil diambil",
-                        "schema": {
-                            "$ref": "#/definitions/swagdto.GetMomentsResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "User (pemilik) tidak ditemukan",
+                        "description": "Data berhasil diambil",
                         "schema": {
-                            "$ref": "#/definitions/swagdto.Response404"
+                            "$ref": "#/definitions/swagdto.GetEventPassesResponse"
                         }
                     },
                     "500": {
@@ -420,46 +725,299 @@ const docTemplate = `{
                             "$ref": "#/definitions/main.APIResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/profiles/{address}": {
-            "get": {
-                "description": "Mengambil semua data profil untuk satu 'address', termasuk semua aset yang di-'eager load'.",
+        "/event/check-in": {
+            "post": {
+                "description": "Mencatat check-in untuk seorang user di sebuah event. Hanya host, staff event, atau admin platform.\nMenerima 'application/json' ATAU 'multipart/form-data'.",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profiles"
+                    "Events"
                 ],
-                "summary": "Ambil Profil User (Lengkap)",
+                "summary": "Check-in User ke Event (Host/Staff)",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Alamat Flow pengguna (misal: 0x...)",
-                        "name": "address",
-                        "in": "path",
-                        "required": true
+                        "description": "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Alamat User dan ID Event",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.CheckInRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Profil pengguna berhasil diambil",
+                        "description": "User berhasil check-in",
                         "schema": {
-                            "$ref": "#/definitions/swagdto.GetUserProfileResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.CheckInDataResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Format alamat salah",
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin check-in",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "User (pemilik) tidak ditemukan",
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event sudah dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key sudah dipakai untuk request berbeda",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error (misal: tx gagal)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/event/check-in/batch": {
+            "post": {
+                "description": "Check-in banyak user sekaligus. Alamat dipecah menjadi beberapa transaksi (maks. 25 alamat per transaksi).\nAlamat dinormalisasi (boleh tanpa '0x'); alamat tidak valid, belum register, atau sudah check-in\n(menurut tabel Attendance) dilewati. Jika satu transaksi gagal (misal: satu alamat belum punya koleksi\nEventPass), alamat di transaksi tersebut dicoba ulang satu per satu sehingga hanya alamat bermasalah yang 'failed'.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Batch Check-in User ke Event (Host/Staff)",
+                "parameters": [
+                    {
+                        "description": "ID Event dan daftar alamat user",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.BatchCheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hasil check-in per alamat",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.BatchCheckInResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin check-in",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event sudah dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/event/check-in/queue": {
+            "get": {
+                "description": "Menampilkan jumlah intent per status (pending/processing/done/failed) untuk satu event,\nbeserta daftar intent yang gagal.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Progres Antrian Check-in",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "eventID",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Progres antrian",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.CheckInQueueProgress"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin check-in",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Menerima satu atau banyak 'check-in intent' yang ditandatangani kiosk (HMAC-SHA256 per device).\nKiosk didaftarkan host/staff lewat POST /events/{id}/kiosks dan hanya berlaku untuk event tersebut.\nIntent disimpan di database dan diproses oleh worker di background, sehingga kiosk tetap bisa\nmen-scan walaupun chain sedang tidak bisa diakses. Intent ganda (event + user sama) diabaikan,\nkecuali intent sebelumnya 'failed': scan ulang mengantrikannya lagi (status 'requeued').",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Antrikan Check-in dari Kiosk (Offline)",
+                "parameters": [
+                    {
+                        "description": "Daftar intent dari kiosk",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.QueueCheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Intent diterima",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.QueuedCheckInResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
                         "schema": {
                             "$ref": "#/definitions/main.APIResponse"
                         }
@@ -472,259 +1030,5676 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "main.APIResponse": {
+        },
+        "/event/check-in/token": {
+            "post": {
+                "description": "Staff men-scan QR registrant. Token diverifikasi (signature + masa berlaku),\nditolak jika sudah pernah dipakai, lalu transaksi check-in dijalankan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Check-in dengan Token QR (Staff)",
+                "parameters": [
+                    {
+                        "description": "Token hasil scan QR",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.TokenCheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User berhasil check-in",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.CheckInDataResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Token tidak valid / kedaluwarsa",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Belum login",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host/staff event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Token sudah pernah dipakai / event sudah dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error (misal: tx gagal)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events": {
+            "get": {
+                "description": "Mengambil daftar semua event yang ada di platform, dengan pagination dan filter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Ambil Daftar Event (Paginated)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Filter berdasarkan Tipe Event (0 = Online, 1 = Offline)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor Halaman (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah item per halaman (default: 20)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)",
+                        "name": "withTotal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan, misal: '-start_date,name' ('-' = DESC)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cari di sekitar titik 'lat,lng' (diurutkan dari yang terdekat, event online dikecualikan kecuali include_online=true)",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Radius untuk 'near' dalam km (default: 10, maks: 500)",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cari di dalam kotak 'minLng,minLat,maxLng,maxLat' (diurutkan dari titik tengah)",
+                        "name": "bbox",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan event online pada pencarian near/bbox (tanpa distance_km, di urutan akhir; default: false)",
+                        "name": "include_online",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event yang belum selesai pada waktu ini (RFC3339 atau YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event yang sudah mulai sebelum waktu ini (RFC3339 atau YYYY-MM-DD, tanggal = sampai akhir hari)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter status (dipisah koma): upcoming, registration_open, full, ongoing, ended",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar event berhasil diambil",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.GetEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Membuat event on-chain atas nama host. Gambar 'thumbnail' dan 'eventPassImg' di-upload ke IPFS (Pinata),\nlalu transaksi 'createEvent' dikirim oleh backend. Respon menunggu sampai event ter-indeks oleh indexer.\nJika indexer belum selesai dalam batas waktu, respon 202 tetap berisi 'event_id' on-chain.\nHanya untuk host terdaftar (admin platform atau env HOST_ADDRESSES), karena transaksi dibayar akun admin.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Buat Event (oleh Backend)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nama event",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deskripsi event",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tipe event (0 = Online, 1 = Offline)",
+                        "name": "eventType",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL (online) atau alamat (offline)",
+                        "name": "location",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude",
+                        "name": "lat",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude",
+                        "name": "long",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Waktu mulai (RFC3339, misal: 2025-01-31T19:00:00+07:00)",
+                        "name": "startDate",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Waktu selesai (RFC3339)",
+                        "name": "endDate",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Kuota peserta",
+                        "name": "quota",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Gambar thumbnail event",
+                        "name": "thumbnail",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Gambar Event Pass (SBT)",
+                        "name": "eventPassImg",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Event dibuat dan sudah ter-indeks",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.CreateEventResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "202": {
+                        "description": "Event dibuat, indexer belum selesai",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.CreateEventResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Belum login",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host terdaftar (HOST_ADDRESSES) / host belum punya profil",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error (upload/transaksi gagal)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/clusters": {
+            "get": {
+                "description": "Mengelompokkan event offline di dalam bbox menjadi cluster (jumlah, centroid, batas, dan contoh event ID).\nCluster dihitung dengan grid per tile zoom dan di-cache; cache otomatis diperbarui saat ada event baru.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Cluster Event untuk Peta",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kotak peta 'minLng,minLat,maxLng,maxLat'",
+                        "name": "bbox",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Level zoom peta (0-18)",
+                        "name": "zoom",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar cluster",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventClustersResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter tidak valid / bbox terlalu luas",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/import": {
+            "post": {
+                "description": "Membuat banyak event sekaligus dari file .ics atau CSV (maks. 100 event, 1 MB).\nSetiap baris divalidasi sesuai parameter 'create_event.cdc'; alamat event offline tanpa lat/long di-geocode.\nDefault 'dryRun=true': hanya preview per baris tanpa mengirim transaksi. Kirim 'dryRun=false' untuk membuat event:\nbaris valid masuk antrian (status 'queued', respon 202 berisi 'importID') dan dibuat on-chain oleh worker di background.\nStatus per baris dicek lewat GET /events/import/{id}.\nEvent yang sudah ada atau masih di antrian import (nama + waktu mulai sama, host sama) dilewati.\nKolom CSV: name, description, event_type, location, lat, long, start_date, end_date, quota, thumbnail_url, event_pass_img_url.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Import Event dari iCalendar / CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File .ics atau .csv",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format file: 'ics' atau 'csv' (default: dari ekstensi/isi file)",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "true = preview saja (default), false = kirim transaksi",
+                        "name": "dryRun",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Zona waktu untuk tanggal tanpa offset, misal 'Asia/Jakarta' (default: UTC)",
+                        "name": "timezone",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quota untuk baris tanpa quota (wajib untuk .ics)",
+                        "name": "defaultQuota",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Thumbnail default untuk baris tanpa thumbnail_url",
+                        "name": "thumbnail",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hasil preview per baris (dry run)",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventImportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "202": {
+                        "description": "Baris valid masuk antrian",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventImportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "File / parameter tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Belum login",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host terdaftar (HOST_ADDRESSES) / host belum punya profil",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key sudah dipakai untuk request berbeda",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/import/{id}": {
+            "get": {
+                "description": "Status per baris dari import yang sudah masuk antrian (queued, creating, created, failed).\nBaris yang invalid/dilewati hanya ada di respon POST /events/import.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Status Import Event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "importID dari POST /events/import",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status per baris",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventImportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Belum login",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Import tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}": {
+            "get": {
+                "description": "Mengambil satu event berdasarkan 'event_id' (ID on-chain), termasuk status terkini dan riwayat perubahan oleh host.\nLogin opsional: 'is_registered'/'is_checked_in' dihitung untuk user yang login, dan daftar peserta\n('edges.attendances') mengikuti 'attendee_visibility' event \u0026 pilihan privasi peserta.\nPengunjung anonim hanya mendapat jumlah peserta.\nDeprecated: '?viewer=' tanpa login hanya mengisi 'is_registered'/'is_checked_in' jika registrasinya publik.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Ambil Detail Event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated: alamat viewer untuk client yang belum login",
+                        "name": "viewer",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Detail event",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "patch": {
+                "description": "Host mengubah waktu, lokasi, deskripsi, atau kuota event. Field yang tidak dikirim tidak berubah.\nLokasi baru event offline tanpa lat/long akan di-geocode. Kuota diubah lewat transaksi on-chain.\nPerubahan dicatat di riwayat (lihat 'changes' di GET /events/{id}) dan semua attendee mendapat notifikasi.\nWaktu, lokasi, dan deskripsi hanya diubah di database: data on-chain (getEventDetails) tetap data awal.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Ubah Event (Host)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Perubahan event",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpdateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Perubahan tersimpan",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventChangeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid / tidak ada perubahan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin mengubah event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event sudah selesai atau dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}.ics": {
+            "get": {
+                "description": "Menghasilkan file .ics (RFC 5545) untuk satu event, bisa langsung diimpor ke aplikasi kalender.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Unduh Event sebagai iCalendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File iCalendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Event ID format",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/attendees/export": {
+            "get": {
+                "description": "Mengunduh daftar attendee event dalam format CSV (host/staff/admin).\nPartner bisa memakai header 'X-API-Key' (key dengan owner host/staff event ini) sebagai pengganti sesi login.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Export Attendee (CSV)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV: address,nickname,registration_time,checked_in",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/cancel": {
+            "post": {
+                "description": "Host membatalkan event. Status event menjadi 'cancelled', waitlist ditutup, dan semua attendee\n(serta user di waitlist) mendapat notifikasi. Pembatalan tidak bisa diurungkan.\nRegistrasi on-chain ditutup permanen lebih dulu (kontrak menolak 'registerEvent' untuk event ini);\njika transaksi itu gagal, event tidak dibatalkan dan request boleh diulang.\nSetelah dibatalkan, check-in (staff, token, kiosk, self check-in, join link) ditolak.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Batalkan Event (Host)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alasan pembatalan",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/main.CancelEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event dibatalkan",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventChangeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin mengubah event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event sudah selesai atau dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal menutup registrasi on-chain / Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/check-in-token": {
+            "get": {
+                "description": "Registrant mengambil token check-in bertanda tangan yang berlaku singkat (2 menit).\nDefault-nya mengembalikan gambar QR (PNG). Gunakan 'format=json' untuk mendapatkan token mentah.",
+                "produces": [
+                    "image/png",
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Ambil Token QR Check-in",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "'png' (default) atau 'json'",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Token (format=json) atau gambar QR PNG",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.CheckInTokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid / sudah check-in",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User belum register ke event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event sudah dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/geofence": {
+            "put": {
+                "description": "Host mengatur radius (meter) di sekitar koordinat event untuk self check-in. 0 = nonaktif.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Atur Radius Self Check-in (Host/Admin)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Radius (meter)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpdateGeofenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Radius tersimpan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin mengubah event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/join-link": {
+            "get": {
+                "description": "Registrant event online mendapatkan link join unik. Link tersebut me-redirect ke URL meeting host\ndan dipakai untuk memverifikasi kehadiran (klik + heartbeat).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Ambil Join Link (Event Online)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Join link",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.JoinLinkResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bukan event online / URL meeting tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan / user belum register",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event sudah dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/kiosks": {
+            "get": {
+                "description": "Menampilkan kiosk check-in (mode offline) yang terdaftar untuk event ini, termasuk yang sudah dicabut.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Daftar Kiosk Event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar kiosk",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.KioskDeviceResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin check-in",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Host/staff mendaftarkan kiosk check-in (mode offline) untuk event ini.\nSecret HMAC hanya ditampilkan sekali di respon ini; kiosk memakainya untuk menandatangani intent\nke POST /event/check-in/queue. Kiosk hanya bisa meng-check-in event ini, dan berhenti berlaku\njika dicabut atau pendaftarnya kehilangan izin check-in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Daftarkan Kiosk Event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label kiosk",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.ProvisionKioskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Kiosk terdaftar (beserta secret)",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.KioskDeviceResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin check-in",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/kiosks/{deviceId}": {
+            "delete": {
+                "description": "Menonaktifkan kiosk. Intent baru dari kiosk ini ditolak; intent yang sudah diantrikan tetap diproses.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Cabut Kiosk Event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID kiosk",
+                        "name": "deviceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Kiosk dicabut",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin check-in",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Kiosk tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/privacy": {
+            "put": {
+                "description": "Menentukan siapa yang boleh melihat daftar peserta di /events/{id} dan /event-passes:\n'public' (semua user yang login), 'attendees' (hanya peserta), atau 'host' (hanya host/staff).\nPengunjung anonim selalu hanya mendapat jumlah peserta.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Atur Privasi Daftar Peserta Event (Host)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pengaturan privasi",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpdateEventPrivacyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pengaturan tersimpan",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventPrivacyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "attendeeVisibility tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/quota": {
+            "put": {
+                "description": "Mengubah kuota event on-chain. Kuota tidak boleh lebih kecil dari jumlah attendee yang sudah register.\nJika kuota dinaikkan, slot baru langsung ditawarkan ke antrian waitlist. Sama dengan PATCH /events/{id} berisi 'quota' saja.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Ubah Kuota Event (Host)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Kuota baru",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpdateEventQuotaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Kuota tersimpan",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventChangeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Kuota tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin mengubah event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event sudah selesai atau dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Transaksi gagal",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/self-check-in": {
+            "post": {
+                "description": "Attendee event offline melakukan check-in sendiri dengan mengirim lokasi GPS.\nLokasi harus berada di dalam radius event, di dalam jendela waktu event\n(dibuka 30 menit sebelum mulai), dan lolos heuristik anti-spoofing\n(akurasi, selisih jam perangkat, kecepatan antar lokasi).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Self Check-in Berbasis Lokasi",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lokasi user",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.SelfCheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User berhasil check-in",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.SelfCheckInResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Lokasi/waktu ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan / user belum register",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event sudah dibatalkan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error (misal: tx gagal)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/staff": {
+            "get": {
+                "description": "Menampilkan staff yang didelegasikan untuk event ini (host/admin).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Daftar Staff Event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar staff",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.EventStaffResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Host mendelegasikan user sebagai staff event (check-in, export attendee, moderasi).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Tambah Staff Event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alamat staff",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.AddEventStaffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Staff ditambahkan",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventStaffResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid / user belum punya profil",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User sudah menjadi staff",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/staff/{address}": {
+            "delete": {
+                "description": "Host mencabut delegasi staff dari event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Hapus Staff Event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alamat staff",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Staff dihapus",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Staff tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/waitlist": {
+            "get": {
+                "description": "Menampilkan antrian aktif (waiting \u0026 offered) sesuai urutan. Host/admin saja.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Daftar Waitlist Event (Host)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Antrian waitlist",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.WaitlistEntryResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "User masuk antrian event yang kuotanya penuh. Saat slot kosong, user teratas mendapat notifikasi\ndan harus register on-chain dalam jendela klaim (24 jam, atau sampai event selesai).\nSelama jendela klaim slot ditahan on-chain untuk user tsb. (user lain ditolak kontrak).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Masuk Waitlist Event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Masuk waitlist",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.WaitlistEntryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Event sudah selesai/dibatalkan / user belum punya profil",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Sudah register / sudah di waitlist / kuota masih tersedia",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "User keluar dari waitlist. Jika sedang ditawari slot, slot langsung dialihkan ke antrian berikutnya.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Keluar dari Waitlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Keluar dari waitlist",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak berada di waitlist",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/waitlist/me": {
+            "get": {
+                "description": "Menampilkan posisi \u0026 status user di waitlist event (termasuk batas waktu klaim jika sedang ditawari slot).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Status Waitlist Saya",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status waitlist",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.WaitlistEntryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak berada di waitlist",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{id}/waitlist/order": {
+            "put": {
+                "description": "Alamat yang dikirim dipindah ke depan antrian sesuai urutan; sisanya mengikuti dengan urutan lama.\nHanya entry berstatus 'waiting' yang bisa diatur (slot yang sudah ditawarkan tidak berubah).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Atur Ulang Urutan Waitlist (Host)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID (On-Chain ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Urutan alamat",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.ReorderWaitlistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Antrian setelah diatur ulang",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.WaitlistEntryResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Alamat tidak ada di antrian / duplikat",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host event ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/join/{token}": {
+            "get": {
+                "description": "Mencatat waktu klik pertama (jika di dalam jendela waktu event) lalu me-redirect ke URL meeting host.",
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Redirect Join Link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token join link",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect ke URL meeting"
+                    },
+                    "404": {
+                        "description": "Link tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/join/{token}/heartbeat": {
+            "post": {
+                "description": "Dipanggil client secara berkala selama mengikuti event online. Jika selisih antara klik pertama\ndan heartbeat sudah mencapai durasi minimum (default 10 menit), check-in otomatis diantrikan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Heartbeat Join Link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token join link",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status kehadiran",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.JoinHeartbeatResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Di luar jendela waktu / belum klik link",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Link tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/listings": {
+            "get": {
+                "description": "Mengambil daftar semua NFT yang dijual di marketplace, mendukung pagination dan filter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Marketplace"
+                ],
+                "summary": "Ambil Daftar Penjualan (Listings)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter berdasarkan alamat penjual (misal: 0x...)",
+                        "name": "seller_address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor Halaman (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah item per halaman (default: 20)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)",
+                        "name": "withTotal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan, misal: '-start_date,name' ('-' = DESC)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar penjualan berhasil diambil",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.GetListingsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moment/free": {
+            "post": {
+                "description": "Minting 'NFTMoment' (UGC) gratis. Endpoint ini menerima 'multipart/form-data'.\nMemakai 1 kuota free mint (default: global, atau per event/campaign jika 'eventID'/'campaign' diisi).\nJika kuota global sudah habis, 1 mint credit (hadiah referral) dipakai.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moments"
+                ],
+                "summary": "Mint NFT Moment (Gratis)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nama untuk NFT Moment",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deskripsi untuk NFT Moment",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pakai kuota free mint event ini (harus terdaftar)",
+                        "name": "eventID",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pakai kuota free mint campaign ini",
+                        "name": "campaign",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "File gambar (JPG/PNG/WEBP) untuk Momen UGC",
+                        "name": "thumbnail",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Minting sukses",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.MintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid / kuota habis",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key sudah dipakai untuk request berbeda",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Terlalu banyak request (lihat header Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error (upload/transaksi gagal)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/moment/with-event-pass": {
+            "post": {
+                "description": "Minting 'NFTMoment' (UGC) dengan 'membakar' (menggunakan) 'EventPass' (SBT). Endpoint ini menerima 'multipart/form-data'.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moments"
+                ],
+                "summary": "Mint NFT Moment (dengan Event Pass)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID dari EventPass (SBT) yang akan digunakan",
+                        "name": "eventPassID",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tingkatan (tier) dari Momen 0 untuk community 1 untuk pro",
+                        "name": "tier",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nama untuk NFT Moment",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deskripsi untuk NFT Moment",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "File gambar (JPG/PNG/WEBP) untuk Momen UGC",
+                        "name": "thumbnail",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Minting sukses",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.MintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid (field wajib hilang)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key sudah dipakai untuk request berbeda",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Terlalu banyak request (lihat header Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error (upload/transaksi gagal)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/moments": {
+            "get": {
+                "description": "Mengambil daftar NFT Moment, mendukung filter berdasarkan pemilik dan pagination.\n'Eager loading' akan menyertakan data 'owner', 'equipped_accessories', dan 'minted_with_pass'.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moments"
+                ],
+                "summary": "Ambil Daftar NFT Moment (Paginated)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter berdasarkan alamat pemilik (misal: 0x...)",
+                        "name": "owner_address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor Halaman (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah item per halaman (default: 20)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)",
+                        "name": "withTotal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan, misal: '-start_date,name' ('-' = DESC)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar momen berhasil diambil",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.GetMomentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User (pemilik) tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moments/{id}/comments": {
+            "get": {
+                "description": "Mengambil daftar komentar untuk sebuah moment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Social"
+                ],
+                "summary": "Get Comments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Moment ID (Internal ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)",
+                        "name": "withTotal",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of comments",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Membuat komentar baru pada sebuah moment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Social"
+                ],
+                "summary": "Create Comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Moment ID (Internal ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment Content",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Comment created",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Belum login",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Terlalu banyak request (lihat header Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/moments/{id}/comments/{commentId}": {
+            "delete": {
+                "description": "Menghapus komentar. Diizinkan untuk penulis komentar, admin platform,\natau host/staff event tempat moment tersebut di-mint (via Event Pass).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Social"
+                ],
+                "summary": "Hapus Komentar (Moderasi)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Moment ID (Internal ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Komentar dihapus",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak punya izin",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Komentar tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/moments/{id}/like": {
+            "post": {
+                "description": "Like atau Unlike sebuah moment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Social"
+                ],
+                "summary": "Toggle Like Moment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Moment ID (Internal ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Belum login",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Terlalu banyak request (lihat header Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/notifications": {
+            "get": {
+                "description": "Menampilkan notifikasi user yang login, terbaru lebih dulu.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Notifikasi Saya",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "true = hanya yang belum dibaca",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah per halaman",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor pagination",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar notifikasi",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.NotificationResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Belum login",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/notifications/read-all": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Tandai Semua Notifikasi Dibaca",
+                "responses": {
+                    "200": {
+                        "description": "Jumlah notifikasi yang ditandai",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/notifications/{id}/read": {
+            "post": {
+                "description": "Menandai satu notifikasi milik user sebagai sudah dibaca.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Tandai Notifikasi Dibaca",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID notifikasi",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notifikasi",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.NotificationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notifikasi tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/profiles/{address}": {
+            "get": {
+                "description": "Mengambil semua data profil untuk satu 'address', termasuk semua aset yang di-'eager load'.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Ambil Profil User (Lengkap)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alamat Flow pengguna (misal: 0x...)",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profil pengguna berhasil diambil",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.GetUserProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Format alamat salah",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User (pemilik) tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referrals/codes": {
+            "get": {
+                "description": "Menampilkan kode referral milik user yang login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Kode Undangan Saya",
+                "responses": {
+                    "200": {
+                        "description": "Daftar kode",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.InviteCodeResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Membuat kode referral untuk dibagikan (maks. 5 kode per user).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Buat Kode Undangan",
+                "responses": {
+                    "201": {
+                        "description": "Kode dibuat",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.InviteCodeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Batas jumlah kode tercapai / belum punya profil",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/referrals/redeem": {
+            "post": {
+                "description": "User baru memakai kode referral. Mint credit untuk inviter \u0026 invitee diberikan\nsetelah check-in invitee di sebuah event diverifikasi host/staff (bukan self check-in), dan event\ntersebut tidak di-host/di-staff/diverifikasi oleh inviter atau akun yang terkait dengan inviter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Pakai Kode Undangan",
+                "parameters": [
+                    {
+                        "description": "Kode undangan",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.RedeemInviteCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Referral tercatat (pending)",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.ReferralResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Kode tidak valid / bukan user baru / referral sendiri",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Kode tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Sudah pernah memakai kode undangan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/referrals/stats": {
+            "get": {
+                "description": "Ringkasan kode, undangan (pending/qualified), dan mint credit user yang login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Statistik Referral Saya",
+                "responses": {
+                    "200": {
+                        "description": "Statistik referral",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.ReferralStatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Mencari event (nama, lokasi, deskripsi), moment \u0026 aksesori (nama, deskripsi), dan user (nickname, bio)\ndengan full-text search Postgres (bahasa Indonesia \u0026 Inggris). Hasil diurutkan berdasarkan relevansi,\ndilengkapi snippet dengan kata yang cocok dibungkus \u003cb\u003e, dan facet jumlah hasil per tipe.\nSintaks query: kata biasa, \"frasa\", OR, dan -kata (mengecualikan).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Pencarian Gabungan (Full-Text)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kata kunci pencarian",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Batasi tipe (dipisah koma): event, moment, accessory, user",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah hasil maksimum (default: 20, maks: 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hasil pencarian",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.SearchResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Query kosong / tipe tidak dikenal",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series": {
+            "get": {
+                "description": "Menampilkan event series (terbaru lebih dulu), bisa difilter per host \u0026 status.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Daftar Event Series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alamat host",
+                        "name": "host",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "active | paused | completed | stopped",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah item per halaman",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor keyset (kosong = halaman pertama)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar series",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/swagdto.EventSeriesResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Menyimpan template event + aturan pengulangan. Occurrence dibuat on-chain oleh scheduler\n'leadDays' hari sebelum jadwalnya (event biasa, bisa di-update/dibatalkan per sesi).\nAturan (subset RRULE): FREQ=DAILY|WEEKLY|MONTHLY, INTERVAL, BYDAY (WEEKLY), BYMONTHDAY (MONTHLY), COUNT atau UNTIL.\nJam lokal di 'timezone' tetap sama di setiap sesi (termasuk saat pergantian DST).",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Buat Event Series (Event Berulang)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nama event",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deskripsi event",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tipe event (0 = Online, 1 = Offline)",
+                        "name": "eventType",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL (online) atau alamat (offline)",
+                        "name": "location",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude (offline; default: geocode dari location)",
+                        "name": "lat",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude",
+                        "name": "long",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Waktu mulai sesi pertama (RFC3339)",
+                        "name": "startDate",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Waktu selesai sesi pertama (RFC3339), menentukan durasi setiap sesi",
+                        "name": "endDate",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Kuota peserta per sesi",
+                        "name": "quota",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Aturan pengulangan, misal: FREQ=WEEKLY;BYDAY=TU;COUNT=10",
+                        "name": "rrule",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Zona waktu IANA (default: UTC)",
+                        "name": "timezone",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Berapa hari sebelumnya sesi dibuat on-chain (1-90, default: 28)",
+                        "name": "leadDays",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Gambar thumbnail event",
+                        "name": "thumbnail",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Gambar Event Pass (SBT)",
+                        "name": "eventPassImg",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Series dibuat",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventSeriesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input / aturan tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host terdaftar (HOST_ADDRESSES) / host belum punya profil",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/series/{id}": {
+            "get": {
+                "description": "Menampilkan series beserta semua occurrence yang sudah dijadwalkan (dan event on-chain-nya).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Detail Event Series",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Series ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Detail series",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventSeriesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Series tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{id}/attendance": {
+            "get": {
+                "description": "Menghitung kehadiran setiap peserta di seluruh sesi series, misal \"hadir 8 dari 10 sesi\"\n('attended' dari 'sessionsHeld'). Hanya sesi yang sudah selesai yang dihitung; sesi yang dibatalkan tidak dihitung. Host/admin saja.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Rekap Kehadiran Event Series (Host)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Series ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rekap kehadiran",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.SeriesAttendanceResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host series ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Series tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/series/{id}/attendance/me": {
+            "get": {
+                "description": "Rekap kehadiran user yang login di seluruh sesi series ('me': hadir X dari Y sesi).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Kehadiran Saya di Event Series",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Series ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rekap kehadiran user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.SeriesAttendanceResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Series tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/series/{id}/status": {
+            "put": {
+                "description": "'paused' menghentikan sementara pembuatan sesi baru, 'active' melanjutkan (sesi yang terlewat tidak dibuat),\n'stopped' menghentikan series permanen. Sesi yang sudah dibuat on-chain tidak berubah\n(batalkan per sesi lewat POST /events/{id}/cancel).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Ubah Status Event Series (Host)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Series ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status baru",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpdateSeriesStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series setelah diubah",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.EventSeriesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Status tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bukan host series ini",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Series sudah selesai/dihentikan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/upload": {
+            "post": {
+                "description": "Generic endpoint untuk upload gambar ke IPFS (Pinata). Bisa digunakan untuk event thumbnail, profile picture, dll.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upload"
+                ],
+                "summary": "Upload Image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key unik per aksi; retry dengan key yang sama mendapat respon yang sama",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "file",
+                        "description": "File gambar (JPG/PNG/WEBP)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload sukses, returns URL",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "File tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key sudah dipakai untuk request berbeda",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Terlalu banyak request (lihat header Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Upload gagal",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Mengambil daftar pengguna di platform. Mendukung pagination dan pencarian sederhana by address.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Ambil Daftar User (Search People)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cari berdasarkan sebagian alamat (misal: 0x12...)",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor Halaman (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah item per halaman (default: 20)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)",
+                        "name": "withTotal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan, misal: '-start_date,name' ('-' = DESC)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Daftar user berhasil diambil",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.GetUsersResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/calendar-token": {
+            "post": {
+                "description": "Membuat (atau mengganti) token rahasia untuk feed kalender user. Token lama otomatis tidak berlaku.\nToken hanya ditampilkan sekali, simpan URL-nya untuk di-subscribe.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Buat Token Kalender",
+                "responses": {
+                    "201": {
+                        "description": "Token kalender",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.CalendarTokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Belum login",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/me/privacy": {
+            "get": {
+                "description": "Menampilkan apakah user disembunyikan dari daftar peserta publik.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Ambil Pengaturan Privasi Saya",
+                "responses": {
+                    "200": {
+                        "description": "Pengaturan privasi",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.UserPrivacyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "User belum punya profil",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "'hideFromAttendeeLists' = true: user tidak ditampilkan di daftar peserta event maupun /event-passes\nuntuk user lain (host/staff event tetap bisa melihat, jumlah peserta tetap dihitung).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Ubah Pengaturan Privasi Saya",
+                "parameters": [
+                    {
+                        "description": "Pengaturan privasi",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpdatePrivacyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pengaturan tersimpan",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/swagdto.UserPrivacyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Input tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User belum punya profil",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/search": {
+            "get": {
+                "description": "Mencari user berdasarkan sebagian Address ATAU Nickname. Cocok untuk fitur 'live search' dengan debounce.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Cari User (Search Bar)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kata kunci pencarian (0x... atau nama)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor Halaman (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah item per halaman (default: 10)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mode cursor: kosong = halaman pertama, lalu isi dengan nextCursor/prevCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Sertakan totalItems/totalPages (default: true di mode offset, false di mode cursor)",
+                        "name": "withTotal",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hasil pencarian",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.GetUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Query kosong",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{address}": {
+            "get": {
+                "description": "Mengambil detail profil pengguna berdasarkan alamat wallet.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Ambil Detail Profil User (By Address)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alamat Wallet User (0x...)",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/swagdto.GetUserProfileResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{address}/calendar.ics": {
+            "get": {
+                "description": "Feed .ics berisi semua event yang di-register user. Bisa di-subscribe di Google Calendar, Apple Calendar, dll.\nMembutuhkan token rahasia dari POST /users/me/calendar-token. Event online memakai link join milik user.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Feed Kalender User (iCalendar)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alamat user (misal: 0x...)",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token kalender",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Feed iCalendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.APIResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "main.APIResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/main.Pagination"
+                }
+            }
+        },
+        "main.AddEventStaffRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.BatchCheckInRequest": {
+            "type": "object",
+            "properties": {
+                "eventID": {
+                    "type": "string"
+                },
+                "userAddresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "main.CancelEventRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Pembicara berhalangan hadir"
+                }
+            }
+        },
+        "main.CheckInIntentRequest": {
+            "type": "object",
+            "properties": {
+                "deviceID": {
+                    "type": "string"
+                },
+                "eventID": {
+                    "type": "string"
+                },
+                "scannedAt": {
+                    "description": "Unix timestamp (detik)",
+                    "type": "integer"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "userAddress": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.CheckInRequest": {
+            "type": "object",
+            "properties": {
+                "eventID": {
+                    "type": "string"
+                },
+                "userAddress": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.CreateAPIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "dailyQuota": {
+                    "description": "0 = default (10000)",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ownerAddress": {
+                    "description": "Alamat yang diwakili key (wajib untuk route partner)",
+                    "type": "string"
+                },
+                "ratePerMinute": {
+                    "description": "0 = default (60)",
+                    "type": "integer"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "main.CreateCommentRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Keren banget!"
+                }
+            }
+        },
+        "main.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "nonce": {
+                    "type": "string"
+                },
+                "signatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.AccountProofSignature"
+                    }
+                }
+            }
+        },
+        "main.Pagination": {
+            "type": "object",
+            "properties": {
+                "currentPage": {
+                    "type": "integer"
+                },
+                "nextCursor": {
+                    "type": "string"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "prevCursor": {
+                    "type": "string"
+                },
+                "totalItems": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.ProvisionKioskRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Label bebas (misal: \"Pintu Utara\")",
+                    "type": "string"
+                }
+            }
+        },
+        "main.QueueCheckInRequest": {
+            "type": "object",
+            "properties": {
+                "intents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CheckInIntentRequest"
+                    }
+                }
+            }
+        },
+        "main.RedeemInviteCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "K3QZ7MNA"
+                }
+            }
+        },
+        "main.ReorderWaitlistRequest": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "0x1bb6b1e0a5170088",
+                        "0x2cc7c2f1b6281199"
+                    ]
+                }
+            }
+        },
+        "main.SelfCheckInRequest": {
+            "type": "object",
+            "properties": {
+                "accuracyM": {
+                    "description": "Akurasi yang dilaporkan perangkat (meter)",
+                    "type": "number"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "long": {
+                    "type": "number"
+                },
+                "timestamp": {
+                    "description": "Unix timestamp (detik) saat lokasi diambil",
+                    "type": "integer"
+                }
+            }
+        },
+        "main.TokenCheckInRequest": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.UpdateEventPrivacyRequest": {
+            "type": "object",
+            "properties": {
+                "attendeeVisibility": {
+                    "description": "public | attendees | host",
+                    "type": "string",
+                    "example": "attendees"
+                }
+            }
+        },
+        "main.UpdateEventQuotaRequest": {
+            "type": "object",
+            "properties": {
+                "quota": {
+                    "type": "integer",
+                    "example": 150
+                }
+            }
+        },
+        "main.UpdateEventRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "lat": {
+                    "description": "Opsional untuk event offline (default: geocode dari location)",
+                    "type": "number"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "long": {
+                    "type": "number"
+                },
+                "quota": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "Ditampilkan di notifikasi \u0026 riwayat",
+                    "type": "string",
+                    "example": "Venue dipindah"
+                },
+                "startDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.UpdateGeofenceRequest": {
+            "type": "object",
+            "properties": {
+                "radiusM": {
+                    "type": "number"
+                }
+            }
+        },
+        "main.UpdatePrivacyRequest": {
+            "type": "object",
+            "properties": {
+                "hideFromAttendeeLists": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "main.UpdateSeriesStatusRequest": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "active | paused | stopped",
+                    "type": "string",
+                    "example": "paused"
+                }
+            }
+        },
+        "main.UpsertClaimQuotaRequest": {
+            "type": "object",
+            "properties": {
+                "endsAt": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "free_mint"
+                },
+                "perSubjectLimit": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "campaign:launch"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "totalLimit": {
+                    "description": "0 = tanpa batas",
+                    "type": "integer",
+                    "example": 500
+                }
+            }
+        },
+        "swagdto.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "dailyQuota": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastUsedAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Partner Ticketing"
+                },
+                "ownerAddress": {
+                    "type": "string",
+                    "example": "0x1234567890abcdef"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "capt_1a2b3c"
+                },
+                "ratePerMinute": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "revokedAt": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "read"
+                    ]
+                },
+                "totalRequests": {
+                    "type": "integer",
+                    "example": 5230
+                },
+                "usedToday": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "swagdto.AdminReferralStatsResponse": {
+            "type": "object",
+            "properties": {
+                "creditsIssued": {
+                    "type": "integer"
+                },
+                "creditsSpent": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "qualified": {
+                    "type": "integer"
+                },
+                "topInviters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.ReferralInviterStat"
+                    }
+                },
+                "totalReferrals": {
+                    "type": "integer"
+                }
+            }
+        },
+        "swagdto.AttendanceResponse": {
+            "type": "object",
+            "properties": {
+                "checked_in": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "registration_time": {
+                    "type": "string"
+                },
+                "user_address": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.AuthNonceResponse": {
+            "type": "object",
+            "properties": {
+                "appIdentifier": {
+                    "type": "string",
+                    "example": "Capt.today"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "nonce": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.BatchCheckInResponse": {
+            "type": "object",
+            "properties": {
+                "checkedIn": {
+                    "type": "integer"
+                },
+                "eventID": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.BatchCheckInResult"
+                    }
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "transactions": {
+                    "type": "integer"
+                }
+            }
+        },
+        "swagdto.BatchCheckInResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "checked_in"
+                },
+                "userAddress": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
+                }
+            }
+        },
+        "swagdto.CalendarTokenResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://api.capt.today/users/0x1bb6b1e0a5170088/calendar.ics?token=9f86d081884c7d659a2feaa0c55ad015"
+                }
+            }
+        },
+        "swagdto.CheckInDataResponse": {
+            "type": "object",
+            "properties": {
+                "eventID": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "userAddress": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.CheckInQueueFailure": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "deviceID": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "scannedAt": {
+                    "type": "string"
+                },
+                "userAddress": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.CheckInQueueProgress": {
+            "type": "object",
+            "properties": {
+                "done": {
+                    "type": "integer"
+                },
+                "eventID": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.CheckInQueueFailure"
+                    }
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "processing": {
+                    "type": "integer"
+                }
+            }
+        },
+        "swagdto.CheckInTokenResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.ClaimQuotaResponse": {
+            "type": "object",
+            "properties": {
+                "endsAt": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "free_mint"
+                },
+                "perSubjectLimit": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "global"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "totalLimit": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "swagdto.ClaimRemainingResponse": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "example": "free_mint"
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "remaining": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "global"
+                }
+            }
+        },
+        "swagdto.CreateAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "dailyQuota": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "capt_1a2b3c..."
+                },
+                "lastUsedAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Partner Ticketing"
+                },
+                "ownerAddress": {
+                    "type": "string",
+                    "example": "0x1234567890abcdef"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "capt_1a2b3c"
+                },
+                "ratePerMinute": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "revokedAt": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "read"
+                    ]
+                },
+                "totalRequests": {
+                    "type": "integer",
+                    "example": 5230
+                },
+                "usedToday": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "swagdto.CreateEventResponse": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "event_pass_img": {
+                    "type": "string"
+                },
+                "indexed": {
+                    "type": "boolean"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Event created successfully!"
+                },
+                "thumbnail": {
+                    "type": "string",
+                    "example": "https://.../ipfs/bafy..."
+                }
+            }
+        },
+        "swagdto.DTOAccessory": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "\u003c-- Menggunakan struct 'edges' bersih",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/swagdto.DTOAccessoryEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nft_id": {
+                    "type": "integer"
+                },
+                "thumbnail": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.DTOAccessoryEdges": {
+            "type": "object",
+            "properties": {
+                "owner": {
+                    "$ref": "#/definitions/swagdto.DTOUser"
+                }
+            }
+        },
+        "swagdto.DTOEventPass": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "edges": {
+                    "$ref": "#/definitions/swagdto.DTOEventPassEdges"
+                },
+                "event_type": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_redeemed": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pass_id": {
+                    "type": "integer"
+                },
+                "thumbnail": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.DTOEventPassEdges": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/swagdto.EventResponse"
+                },
+                "moment": {
+                    "$ref": "#/definitions/swagdto.MomentResponse"
+                },
+                "owner": {
+                    "$ref": "#/definitions/swagdto.DTOUser"
+                }
+            }
+        },
+        "swagdto.DTOListing": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "$ref": "#/definitions/swagdto.DTOListingEdges"
+                },
+                "expiry": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "listing_id": {
+                    "type": "integer"
+                },
+                "payment_vault_type": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "swagdto.DTOListingEdges": {
+            "type": "object",
+            "properties": {
+                "nft_accessory": {
+                    "$ref": "#/definitions/swagdto.DTOAccessory"
+                },
+                "seller": {
+                    "$ref": "#/definitions/swagdto.DTOUser"
+                }
+            }
+        },
+        "swagdto.DTOUser": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "swagdto.DTOUserProfile": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "bg_image": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "edges": {
+                    "$ref": "#/definitions/swagdto.DTOUserProfileEdges"
+                },
+                "highlighted_eventPass_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "highlighted_moment_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_free_minted": {
+                    "type": "boolean"
+                },
+                "nickname": {
+                    "type": "string"
+                },
+                "pfp": {
+                    "type": "string"
+                },
+                "short_description": {
+                    "type": "string"
+                },
+                "socials": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "swagdto.DTOUserProfileEdges": {
+            "type": "object",
+            "properties": {
+                "accessories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.DTOAccessory"
+                    }
+                },
+                "event_passes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.DTOEventPass"
+                    }
+                },
+                "hosted_events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.EventResponse"
+                    }
+                },
+                "listings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.DTOListing"
+                    }
+                },
+                "moments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.MomentResponse"
+                    }
+                }
+            }
+        },
+        "swagdto.EquippedAccessoryResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nft_id": {
+                    "type": "integer"
+                },
+                "thumbnail": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.EventChangeResponse": {
+            "type": "object",
+            "properties": {
+                "changedBy": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.EventFieldChangeResponse"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "updated"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "revision": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "swagdto.EventClusterResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "eventIds": {
+                    "description": "Contoh event ID (maks. 5, yang paling baru)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "lat": {
+                    "description": "Centroid",
+                    "type": "number",
+                    "example": -6.2
+                },
+                "long": {
+                    "description": "Centroid",
+                    "type": "number",
+                    "example": 106.8
+                },
+                "maxLat": {
+                    "type": "number",
+                    "example": -6.1
+                },
+                "maxLong": {
+                    "type": "number",
+                    "example": 106.9
+                },
+                "minLat": {
+                    "description": "Batas cluster (untuk zoom-in)",
+                    "type": "number",
+                    "example": -6.3
+                },
+                "minLong": {
+                    "type": "number",
+                    "example": 106.7
+                }
+            }
+        },
+        "swagdto.EventClustersResponse": {
+            "type": "object",
+            "properties": {
+                "clusters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.EventClusterResponse"
+                    }
+                },
+                "totalEvents": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "zoom": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "swagdto.EventEdges": {
+            "type": "object",
+            "properties": {
+                "attendances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.AttendanceResponse"
+                    }
+                },
+                "host": {
+                    "$ref": "#/definitions/swagdto.HostResponse"
+                }
+            }
+        },
+        "swagdto.EventFieldChangeResponse": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "start_date"
+                },
+                "new": {},
+                "old": {}
+            }
+        },
+        "swagdto.EventImportPreview": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2026-11-01T12:00:00Z"
+                },
+                "eventPassImg": {
+                    "type": "string"
+                },
+                "eventType": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "geocoded": {
+                    "description": "true = lat/long hasil geocoding alamat",
+                    "type": "boolean"
+                },
+                "lat": {
+                    "type": "number",
+                    "example": -6.2183
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Senayan, Jakarta"
+                },
+                "long": {
+                    "type": "number",
+                    "example": 106.8027
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Flow Meetup Jakarta"
+                },
+                "quota": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2026-11-01T10:00:00Z"
+                },
+                "thumbnail": {
+                    "description": "Kosong = memakai file 'thumbnail' yang di-upload",
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.EventImportResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "dryRun": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "csv"
+                },
+                "importID": {
+                    "description": "Untuk GET /events/import/{id} (dryRun=false)",
+                    "type": "string",
+                    "example": "3f2a9c1e8b7d4a60b5e1c2d3f4a5b6c7"
+                },
+                "invalid": {
+                    "type": "integer"
+                },
+                "queued": {
+                    "description": "Masih antri / sedang dikirim",
+                    "type": "integer"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.EventImportRowResult"
+                    }
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "swagdto.EventImportRowResult": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "event": {
+                    "$ref": "#/definitions/swagdto.EventImportPreview"
+                },
+                "eventID": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Nomor baris CSV / urutan VEVENT",
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "example": "valid"
+                }
+            }
+        },
+        "swagdto.EventPrivacyResponse": {
+            "type": "object",
+            "properties": {
+                "attendeeVisibility": {
+                    "description": "public | attendees | host",
+                    "type": "string",
+                    "example": "attendees"
+                },
+                "eventID": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "swagdto.EventResponse": {
+            "type": "object",
+            "properties": {
+                "attendee_visibility": {
+                    "description": "public | attendees | host",
+                    "type": "string",
+                    "example": "public"
+                },
+                "cancel_reason": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "changes": {
+                    "description": "Riwayat perubahan, terbaru lebih dulu",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.EventChangeResponse"
+                    }
+                },
+                "checked_in_count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "checkin_radius_m": {
+                    "description": "Radius self check-in (meter), 0 = nonaktif",
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "distance_km": {
+                    "description": "Hanya untuk pencarian lokasi (near/bbox)",
+                    "type": "number"
+                },
+                "edges": {
+                    "description": "\u003c-- Menggunakan struct 'edges' bersih",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/swagdto.EventEdges"
+                        }
+                    ]
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_checked_in": {
+                    "type": "boolean"
+                },
+                "is_registered": {
+                    "type": "boolean"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "quota": {
+                    "type": "integer"
+                },
+                "registered_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "registration_status": {
+                    "description": "Terhitung: open | full | closed",
+                    "type": "string",
+                    "example": "open"
+                },
+                "revision": {
+                    "description": "Jumlah perubahan oleh host",
+                    "type": "integer"
+                },
+                "series_id": {
+                    "description": "Jika event adalah occurrence dari EventSeries",
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Terhitung: upcoming | ongoing | ended | cancelled",
+                    "type": "string",
+                    "example": "upcoming"
+                },
+                "thumbnail": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.EventSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "durationMinutes": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "eventPassImg": {
+                    "type": "string"
+                },
+                "eventType": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "hostAddress": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "leadDays": {
+                    "description": "Occurrence dibuat on-chain N hari sebelumnya",
+                    "type": "integer",
+                    "example": 28
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Senayan, Jakarta"
+                },
+                "long": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Flow Builders Meetup"
+                },
+                "nextOccurrences": {
+                    "description": "Jadwal berikutnya menurut aturan (pratinjau)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "occurrences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.SeriesOccurrenceResponse"
+                    }
+                },
+                "quota": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "rrule": {
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=TU;COUNT=10"
+                },
+                "startDate": {
+                    "description": "Occurrence pertama (DTSTART)",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "thumbnail": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Jakarta"
+                }
+            }
+        },
+        "swagdto.EventStaffResponse": {
+            "type": "object",
+            "properties": {
+                "addedBy": {
+                    "type": "string",
+                    "example": "0xe03daebed8ca0615"
+                },
+                "address": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
+                },
+                "createdAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "swagdto.GetAccessoriesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.DTOAccessory"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagdto.Pagination"
+                }
+            }
+        },
+        "swagdto.GetEventPassesResponse": {
             "type": "object",
             "properties": {
-                "data": {},
-                "error": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.DTOEventPass"
+                    }
                 },
                 "pagination": {
-                    "$ref": "#/definitions/main.Pagination"
+                    "$ref": "#/definitions/swagdto.Pagination"
                 }
             }
         },
-        "main.CheckInRequest": {
+        "swagdto.GetEventsResponse": {
             "type": "object",
             "properties": {
-                "eventID": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.EventResponse"
+                    }
                 },
-                "userAddress": {
-                    "type": "string"
+                "pagination": {
+                    "$ref": "#/definitions/swagdto.Pagination"
                 }
             }
         },
-        "main.Pagination": {
+        "swagdto.GetListingsResponse": {
             "type": "object",
             "properties": {
-                "currentPage": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
-                },
-                "totalItems": {
-                    "type": "integer"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.DTOListing"
+                    }
                 },
-                "totalPages": {
-                    "type": "integer"
+                "pagination": {
+                    "$ref": "#/definitions/swagdto.Pagination"
                 }
             }
         },
-        "swagdto.CheckInDataResponse": {
+        "swagdto.GetMomentsResponse": {
             "type": "object",
             "properties": {
-                "eventID": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.MomentResponse"
+                    }
                 },
-                "userAddress": {
-                    "type": "string"
+                "pagination": {
+                    "$ref": "#/definitions/swagdto.Pagination"
                 }
             }
         },
-        "swagdto.DTOAccessory": {
+        "swagdto.GetUserProfileResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "edges": {
-                    "description": "\u003c-- Menggunakan struct 'edges' bersih",
+                "data": {
+                    "description": "\u003c-- Sekarang 'data' adalah OBJEK, bukan array",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/swagdto.DTOAccessoryEdges"
+                            "$ref": "#/definitions/swagdto.DTOUserProfile"
                         }
                     ]
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "nft_id": {
-                    "type": "integer"
-                },
-                "thumbnail": {
-                    "type": "string"
                 }
             }
         },
-        "swagdto.DTOAccessoryEdges": {
+        "swagdto.GetUsersResponse": {
             "type": "object",
             "properties": {
-                "owner": {
-                    "$ref": "#/definitions/swagdto.DTOUser"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.DTOUserProfile"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/swagdto.Pagination"
                 }
             }
         },
-        "swagdto.DTOEventPass": {
+        "swagdto.HostResponse": {
             "type": "object",
             "properties": {
-                "edges": {
-                    "$ref": "#/definitions/swagdto.DTOEventPassEdges"
+                "address": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "integer"
-                },
-                "is_redeemed": {
-                    "type": "boolean"
-                },
-                "pass_id": {
-                    "type": "integer"
-                }
-            }
-        },
-        "swagdto.DTOEventPassEdges": {
-            "type": "object",
-            "properties": {
-                "event": {
-                    "$ref": "#/definitions/swagdto.EventResponse"
-                },
-                "owner": {
-                    "$ref": "#/definitions/swagdto.DTOUser"
                 }
             }
         },
-        "swagdto.DTOListing": {
+        "swagdto.InviteCodeResponse": {
             "type": "object",
             "properties": {
-                "edges": {
-                    "$ref": "#/definitions/swagdto.DTOListingEdges"
+                "code": {
+                    "type": "string",
+                    "example": "K3QZ7MNA"
                 },
-                "expiry": {
+                "createdAt": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "listing_id": {
-                    "type": "integer"
-                },
-                "payment_vault_type": {
-                    "type": "string"
+                "maxUses": {
+                    "type": "integer",
+                    "example": 10
                 },
-                "price": {
-                    "type": "number"
+                "uses": {
+                    "type": "integer",
+                    "example": 2
                 }
             }
         },
-        "swagdto.DTOListingEdges": {
+        "swagdto.JoinHeartbeatResponse": {
             "type": "object",
             "properties": {
-                "nft_accessory": {
-                    "$ref": "#/definitions/swagdto.DTOAccessory"
+                "presentMinutes": {
+                    "type": "integer"
                 },
-                "seller": {
-                    "$ref": "#/definitions/swagdto.DTOUser"
+                "qualified": {
+                    "type": "boolean"
+                },
+                "requiredMinutes": {
+                    "type": "integer"
                 }
             }
         },
-        "swagdto.DTOUser": {
+        "swagdto.JoinLinkResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "id": {
+                "eventID": {
                     "type": "integer"
+                },
+                "heartbeat": {
+                    "type": "string",
+                    "example": "https://api.capt.today/join/3f9a.../heartbeat"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://api.capt.today/join/3f9a..."
+                },
+                "userAddress": {
+                    "type": "string"
                 }
             }
         },
-        "swagdto.DTOUserProfile": {
+        "swagdto.KioskDeviceResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "bg_image": {
-                    "type": "string"
-                },
-                "bio": {
+                "createdAt": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "\u003c-- Menggunakan struct 'edges' bersih",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/swagdto.DTOUserProfileEdges"
-                        }
-                    ]
-                },
-                "highlighted_eventPass_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "deviceID": {
+                    "type": "string",
+                    "example": "kiosk_4f1c2a9b7e3d5f60a1b2c3d4e5f60718"
                 },
-                "highlighted_moment_id": {
+                "eventID": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "integer"
+                "name": {
+                    "type": "string",
+                    "example": "Pintu Utara"
                 },
-                "nickname": {
-                    "type": "string"
+                "provisionedBy": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
                 },
-                "pfp": {
+                "revokedAt": {
                     "type": "string"
                 },
-                "short_description": {
+                "secret": {
                     "type": "string"
+                }
+            }
+        },
+        "swagdto.MintPassResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
                 },
-                "socials": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "pass_id": {
+                    "type": "integer"
                 }
             }
         },
-        "swagdto.DTOUserProfileEdges": {
+        "swagdto.MintResponse": {
             "type": "object",
             "properties": {
-                "accessories": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagdto.DTOAccessory"
-                    }
+                "message": {
+                    "type": "string",
+                    "example": "NFT minted successfully!"
                 },
-                "event_passes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagdto.DTOEventPass"
-                    }
+                "name": {
+                    "type": "string",
+                    "example": "Momen Keren"
                 },
-                "hosted_events": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagdto.EventResponse"
-                    }
+                "recipient": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
                 },
-                "listings": {
+                "thumbnail": {
+                    "type": "string",
+                    "example": "ipfs://bafy..."
+                }
+            }
+        },
+        "swagdto.MomentEdges": {
+            "type": "object",
+            "properties": {
+                "equipped_accessories": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/swagdto.DTOListing"
+                        "$ref": "#/definitions/swagdto.EquippedAccessoryResponse"
                     }
                 },
-                "moments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagdto.MomentResponse"
-                    }
+                "minted_with_pass": {
+                    "$ref": "#/definitions/swagdto.MintPassResponse"
+                },
+                "owner": {
+                    "$ref": "#/definitions/swagdto.OwnerResponse"
                 }
             }
         },
-        "swagdto.EquippedAccessoryResponse": {
+        "swagdto.MomentResponse": {
             "type": "object",
             "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "\u003c-- Menggunakan struct 'edges' bersih",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/swagdto.MomentEdges"
+                        }
+                    ]
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -739,251 +6714,431 @@ const docTemplate = `{
                 }
             }
         },
-        "swagdto.EventEdges": {
+        "swagdto.NotificationResponse": {
             "type": "object",
             "properties": {
-                "host": {
-                    "$ref": "#/definitions/swagdto.HostResponse"
+                "body": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "readAt": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Slot event tersedia!"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "waitlist_offer"
                 }
             }
         },
-        "swagdto.EventResponse": {
+        "swagdto.OwnerResponse": {
             "type": "object",
             "properties": {
-                "description": {
+                "address": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "\u003c-- Menggunakan struct 'edges' bersih",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/swagdto.EventEdges"
-                        }
-                    ]
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "swagdto.Pagination": {
+            "type": "object",
+            "properties": {
+                "currentPage": {
+                    "type": "integer"
                 },
-                "end_date": {
+                "nextCursor": {
+                    "description": "Mode cursor: cursor halaman berikutnya",
                     "type": "string"
                 },
-                "event_id": {
-                    "type": "integer"
-                },
-                "id": {
+                "pageSize": {
                     "type": "integer"
                 },
-                "location": {
+                "prevCursor": {
+                    "description": "Mode cursor: cursor halaman sebelumnya",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "totalItems": {
+                    "type": "integer"
                 },
-                "quota": {
+                "totalPages": {
                     "type": "integer"
+                }
+            }
+        },
+        "swagdto.QueuedCheckInResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
                 },
-                "start_date": {
+                "eventID": {
                     "type": "string"
                 },
-                "thumbnail": {
+                "status": {
+                    "type": "string",
+                    "example": "queued"
+                },
+                "userAddress": {
                     "type": "string"
                 }
             }
         },
-        "swagdto.GetAccessoriesResponse": {
+        "swagdto.ReferralInviterStat": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagdto.DTOAccessory"
-                    }
+                "address": {
+                    "type": "string",
+                    "example": "0xe03daebed8ca0615"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/swagdto.Pagination"
+                "qualified": {
+                    "type": "integer",
+                    "example": 12
                 }
             }
         },
-        "swagdto.GetEventsResponse": {
+        "swagdto.ReferralResponse": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagdto.EventResponse"
-                    }
+                "code": {
+                    "type": "string",
+                    "example": "K3QZ7MNA"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/swagdto.Pagination"
+                "createdAt": {
+                    "type": "string"
+                },
+                "inviteeAddress": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
+                },
+                "inviterAddress": {
+                    "type": "string",
+                    "example": "0xe03daebed8ca0615"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
                 }
             }
         },
-        "swagdto.GetListingsResponse": {
+        "swagdto.ReferralStatsResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "codes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/swagdto.DTOListing"
+                        "$ref": "#/definitions/swagdto.InviteCodeResponse"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/swagdto.Pagination"
+                "creditsAvailable": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "creditsEarned": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "creditsSpent": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "invited": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "pending": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "qualified": {
+                    "type": "integer",
+                    "example": 2
                 }
             }
         },
-        "swagdto.GetMomentsResponse": {
+        "swagdto.Response404": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagdto.MomentResponse"
-                    }
+                    "items": {}
                 },
                 "pagination": {
                     "$ref": "#/definitions/swagdto.Pagination"
                 }
             }
         },
-        "swagdto.GetUserProfileResponse": {
+        "swagdto.SearchFacet": {
             "type": "object",
             "properties": {
-                "data": {
-                    "description": "\u003c-- Sekarang 'data' adalah OBJEK, bukan array",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/swagdto.DTOUserProfile"
-                        }
-                    ]
+                "count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "type": {
+                    "type": "string",
+                    "example": "event"
                 }
             }
         },
-        "swagdto.HostResponse": {
+        "swagdto.SearchResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
+                "facets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.SearchFacet"
+                    }
                 },
-                "id": {
-                    "type": "integer"
+                "query": {
+                    "type": "string",
+                    "example": "meetup jakarta"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagdto.SearchResult"
+                    }
                 }
             }
         },
-        "swagdto.MintPassResponse": {
+        "swagdto.SearchResult": {
             "type": "object",
             "properties": {
                 "id": {
-                    "type": "integer"
+                    "description": "event_id, nft_id, atau address (user)",
+                    "type": "string",
+                    "example": "12"
                 },
-                "pass_id": {
-                    "type": "integer"
+                "rank": {
+                    "type": "number",
+                    "example": 0.42
+                },
+                "snippet": {
+                    "description": "Kata yang cocok dibungkus \u003cb\u003e",
+                    "type": "string",
+                    "example": "... \u003cb\u003emeetup\u003c/b\u003e komunitas di Jakarta ..."
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Flow Jakarta Meetup"
+                },
+                "type": {
+                    "description": "event | moment | accessory | user",
+                    "type": "string",
+                    "example": "event"
                 }
             }
         },
-        "swagdto.MintResponse": {
+        "swagdto.SelfCheckInResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "NFT minted successfully!"
+                "distanceM": {
+                    "type": "number"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Momen Keren"
+                "eventID": {
+                    "type": "integer"
                 },
-                "recipient": {
-                    "type": "string",
-                    "example": "0x1bb6b1e0a5170088"
+                "message": {
+                    "type": "string"
                 },
-                "thumbnail": {
-                    "type": "string",
-                    "example": "ipfs://bafy..."
+                "userAddress": {
+                    "type": "string"
                 }
             }
         },
-        "swagdto.MomentEdges": {
+        "swagdto.SeriesAttendanceResponse": {
             "type": "object",
             "properties": {
-                "equipped_accessories": {
+                "attendees": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/swagdto.EquippedAccessoryResponse"
+                        "$ref": "#/definitions/swagdto.SeriesAttendeeResponse"
                     }
                 },
-                "minted_with_pass": {
-                    "$ref": "#/definitions/swagdto.MintPassResponse"
+                "me": {
+                    "$ref": "#/definitions/swagdto.SeriesAttendeeResponse"
                 },
-                "owner": {
-                    "$ref": "#/definitions/swagdto.OwnerResponse"
+                "seriesID": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sessionsCancelled": {
+                    "description": "Sesi yang dibatalkan host",
+                    "type": "integer",
+                    "example": 1
+                },
+                "sessionsHeld": {
+                    "description": "Sesi yang sudah selesai",
+                    "type": "integer",
+                    "example": 10
+                },
+                "sessionsTotal": {
+                    "description": "Sesi yang sudah dibuat on-chain (tidak termasuk yang dibatalkan)",
+                    "type": "integer",
+                    "example": 12
                 }
             }
         },
-        "swagdto.MomentResponse": {
+        "swagdto.SeriesAttendeeResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "address": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
                 },
-                "edges": {
-                    "description": "\u003c-- Menggunakan struct 'edges' bersih",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/swagdto.MomentEdges"
-                        }
-                    ]
+                "attendanceRate": {
+                    "description": "attended / sessionsHeld",
+                    "type": "number",
+                    "example": 0.8
                 },
-                "id": {
-                    "type": "integer"
+                "attended": {
+                    "description": "Jumlah sesi selesai yang dihadiri (check-in)",
+                    "type": "integer",
+                    "example": 8
                 },
-                "name": {
+                "registered": {
+                    "description": "Jumlah sesi selesai yang didaftari",
+                    "type": "integer",
+                    "example": 9
+                },
+                "sessionsHeld": {
+                    "description": "\"hadir 8 dari 10 sesi\"",
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "swagdto.SeriesOccurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "endDate": {
                     "type": "string"
                 },
-                "nft_id": {
-                    "type": "integer"
+                "error": {
+                    "type": "string"
                 },
-                "thumbnail": {
+                "eventID": {
+                    "description": "Event on-chain (jika sudah dibuat)",
+                    "type": "integer",
+                    "example": 42
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "startDate": {
                     "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "created"
                 }
             }
         },
-        "swagdto.OwnerResponse": {
+        "swagdto.SessionResponse": {
             "type": "object",
             "properties": {
                 "address": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
+                },
+                "expiresAt": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "token": {
+                    "type": "string"
                 }
             }
         },
-        "swagdto.Pagination": {
+        "swagdto.UserPrivacyResponse": {
             "type": "object",
             "properties": {
-                "currentPage": {
-                    "type": "integer"
+                "hideFromAttendeeLists": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "swagdto.WaitlistEntryResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "0x1bb6b1e0a5170088"
                 },
-                "pageSize": {
-                    "type": "integer"
+                "createdAt": {
+                    "type": "string"
                 },
-                "totalItems": {
-                    "type": "integer"
+                "eventID": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "totalPages": {
-                    "type": "integer"
+                "offerExpiresAt": {
+                    "description": "Batas waktu register on-chain (slot ditahan on-chain sampai saat ini)",
+                    "type": "string"
+                },
+                "offeredAt": {
+                    "type": "string"
+                },
+                "rank": {
+                    "description": "Urutan di antrian (hanya status 'waiting')",
+                    "type": "integer",
+                    "example": 3
+                },
+                "status": {
+                    "type": "string",
+                    "example": "waiting"
                 }
             }
         },
-        "swagdto.Response404": {
+        "utils.AccountProofSignature": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {}
+                "addr": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/swagdto.Pagination"
+                "keyId": {
+                    "type": "integer"
+                },
+                "signature": {
+                    "description": "hex",
+                    "type": "string"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "APIKeyAuth": {
+            "description": "API key partner (dibuat oleh admin)",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "Token sesi dari /auth/login, format: \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
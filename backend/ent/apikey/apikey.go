@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apikey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the apikey type in the database.
+	Label = "api_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPrefix holds the string denoting the prefix field in the database.
+	FieldPrefix = "prefix"
+	// FieldKeyHash holds the string denoting the key_hash field in the database.
+	FieldKeyHash = "key_hash"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldOwnerAddress holds the string denoting the owner_address field in the database.
+	FieldOwnerAddress = "owner_address"
+	// FieldRatePerMinute holds the string denoting the rate_per_minute field in the database.
+	FieldRatePerMinute = "rate_per_minute"
+	// FieldDailyQuota holds the string denoting the daily_quota field in the database.
+	FieldDailyQuota = "daily_quota"
+	// FieldTotalRequests holds the string denoting the total_requests field in the database.
+	FieldTotalRequests = "total_requests"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// Table holds the table name of the apikey in the database.
+	Table = "api_keys"
+)
+
+// Columns holds all SQL columns for apikey fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldPrefix,
+	FieldKeyHash,
+	FieldScopes,
+	FieldOwnerAddress,
+	FieldRatePerMinute,
+	FieldDailyQuota,
+	FieldTotalRequests,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldLastUsedAt,
+	FieldRevokedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultRatePerMinute holds the default value on creation for the "rate_per_minute" field.
+	DefaultRatePerMinute int
+	// DefaultDailyQuota holds the default value on creation for the "daily_quota" field.
+	DefaultDailyQuota int
+	// DefaultTotalRequests holds the default value on creation for the "total_requests" field.
+	DefaultTotalRequests int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the APIKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPrefix orders the results by the prefix field.
+func ByPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrefix, opts...).ToFunc()
+}
+
+// ByKeyHash orders the results by the key_hash field.
+func ByKeyHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyHash, opts...).ToFunc()
+}
+
+// ByOwnerAddress orders the results by the owner_address field.
+func ByOwnerAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerAddress, opts...).ToFunc()
+}
+
+// ByRatePerMinute orders the results by the rate_per_minute field.
+func ByRatePerMinute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRatePerMinute, opts...).ToFunc()
+}
+
+// ByDailyQuota orders the results by the daily_quota field.
+func ByDailyQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyQuota, opts...).ToFunc()
+}
+
+// ByTotalRequests orders the results by the total_requests field.
+func ByTotalRequests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalRequests, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLastUsedAt orders the results by the last_used_at field.
+func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
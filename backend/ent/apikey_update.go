@@ -0,0 +1,697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/apikey"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyUpdate is the builder for updating APIKey entities.
+type APIKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (_u *APIKeyUpdate) Where(ps ...predicate.APIKey) *APIKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *APIKeyUpdate) SetName(v string) *APIKeyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableName(v *string) *APIKeyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPrefix sets the "prefix" field.
+func (_u *APIKeyUpdate) SetPrefix(v string) *APIKeyUpdate {
+	_u.mutation.SetPrefix(v)
+	return _u
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillablePrefix(v *string) *APIKeyUpdate {
+	if v != nil {
+		_u.SetPrefix(*v)
+	}
+	return _u
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (_u *APIKeyUpdate) SetKeyHash(v string) *APIKeyUpdate {
+	_u.mutation.SetKeyHash(v)
+	return _u
+}
+
+// SetNillableKeyHash sets the "key_hash" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableKeyHash(v *string) *APIKeyUpdate {
+	if v != nil {
+		_u.SetKeyHash(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *APIKeyUpdate) SetScopes(v []string) *APIKeyUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *APIKeyUpdate) AppendScopes(v []string) *APIKeyUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetOwnerAddress sets the "owner_address" field.
+func (_u *APIKeyUpdate) SetOwnerAddress(v string) *APIKeyUpdate {
+	_u.mutation.SetOwnerAddress(v)
+	return _u
+}
+
+// SetNillableOwnerAddress sets the "owner_address" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableOwnerAddress(v *string) *APIKeyUpdate {
+	if v != nil {
+		_u.SetOwnerAddress(*v)
+	}
+	return _u
+}
+
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (_u *APIKeyUpdate) ClearOwnerAddress() *APIKeyUpdate {
+	_u.mutation.ClearOwnerAddress()
+	return _u
+}
+
+// SetRatePerMinute sets the "rate_per_minute" field.
+func (_u *APIKeyUpdate) SetRatePerMinute(v int) *APIKeyUpdate {
+	_u.mutation.ResetRatePerMinute()
+	_u.mutation.SetRatePerMinute(v)
+	return _u
+}
+
+// SetNillableRatePerMinute sets the "rate_per_minute" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableRatePerMinute(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetRatePerMinute(*v)
+	}
+	return _u
+}
+
+// AddRatePerMinute adds value to the "rate_per_minute" field.
+func (_u *APIKeyUpdate) AddRatePerMinute(v int) *APIKeyUpdate {
+	_u.mutation.AddRatePerMinute(v)
+	return _u
+}
+
+// SetDailyQuota sets the "daily_quota" field.
+func (_u *APIKeyUpdate) SetDailyQuota(v int) *APIKeyUpdate {
+	_u.mutation.ResetDailyQuota()
+	_u.mutation.SetDailyQuota(v)
+	return _u
+}
+
+// SetNillableDailyQuota sets the "daily_quota" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableDailyQuota(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetDailyQuota(*v)
+	}
+	return _u
+}
+
+// AddDailyQuota adds value to the "daily_quota" field.
+func (_u *APIKeyUpdate) AddDailyQuota(v int) *APIKeyUpdate {
+	_u.mutation.AddDailyQuota(v)
+	return _u
+}
+
+// SetTotalRequests sets the "total_requests" field.
+func (_u *APIKeyUpdate) SetTotalRequests(v int64) *APIKeyUpdate {
+	_u.mutation.ResetTotalRequests()
+	_u.mutation.SetTotalRequests(v)
+	return _u
+}
+
+// SetNillableTotalRequests sets the "total_requests" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableTotalRequests(v *int64) *APIKeyUpdate {
+	if v != nil {
+		_u.SetTotalRequests(*v)
+	}
+	return _u
+}
+
+// AddTotalRequests adds value to the "total_requests" field.
+func (_u *APIKeyUpdate) AddTotalRequests(v int64) *APIKeyUpdate {
+	_u.mutation.AddTotalRequests(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *APIKeyUpdate) SetCreatedBy(v string) *APIKeyUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableCreatedBy(v *string) *APIKeyUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *APIKeyUpdate) SetLastUsedAt(v time.Time) *APIKeyUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableLastUsedAt(v *time.Time) *APIKeyUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *APIKeyUpdate) ClearLastUsedAt() *APIKeyUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *APIKeyUpdate) SetRevokedAt(v time.Time) *APIKeyUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableRevokedAt(v *time.Time) *APIKeyUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *APIKeyUpdate) ClearRevokedAt() *APIKeyUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (_u *APIKeyUpdate) Mutation() *APIKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *APIKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *APIKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *APIKeyUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Prefix(); ok {
+		_spec.SetField(apikey.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.KeyHash(); ok {
+		_spec.SetField(apikey.FieldKeyHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.OwnerAddress(); ok {
+		_spec.SetField(apikey.FieldOwnerAddress, field.TypeString, value)
+	}
+	if _u.mutation.OwnerAddressCleared() {
+		_spec.ClearField(apikey.FieldOwnerAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.RatePerMinute(); ok {
+		_spec.SetField(apikey.FieldRatePerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRatePerMinute(); ok {
+		_spec.AddField(apikey.FieldRatePerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DailyQuota(); ok {
+		_spec.SetField(apikey.FieldDailyQuota, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDailyQuota(); ok {
+		_spec.AddField(apikey.FieldDailyQuota, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalRequests(); ok {
+		_spec.SetField(apikey.FieldTotalRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalRequests(); ok {
+		_spec.AddField(apikey.FieldTotalRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(apikey.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikey.FieldRevokedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// APIKeyUpdateOne is the builder for updating a single APIKey entity.
+type APIKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// SetName sets the "name" field.
+func (_u *APIKeyUpdateOne) SetName(v string) *APIKeyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableName(v *string) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPrefix sets the "prefix" field.
+func (_u *APIKeyUpdateOne) SetPrefix(v string) *APIKeyUpdateOne {
+	_u.mutation.SetPrefix(v)
+	return _u
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillablePrefix(v *string) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetPrefix(*v)
+	}
+	return _u
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (_u *APIKeyUpdateOne) SetKeyHash(v string) *APIKeyUpdateOne {
+	_u.mutation.SetKeyHash(v)
+	return _u
+}
+
+// SetNillableKeyHash sets the "key_hash" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableKeyHash(v *string) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetKeyHash(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *APIKeyUpdateOne) SetScopes(v []string) *APIKeyUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *APIKeyUpdateOne) AppendScopes(v []string) *APIKeyUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetOwnerAddress sets the "owner_address" field.
+func (_u *APIKeyUpdateOne) SetOwnerAddress(v string) *APIKeyUpdateOne {
+	_u.mutation.SetOwnerAddress(v)
+	return _u
+}
+
+// SetNillableOwnerAddress sets the "owner_address" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableOwnerAddress(v *string) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetOwnerAddress(*v)
+	}
+	return _u
+}
+
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (_u *APIKeyUpdateOne) ClearOwnerAddress() *APIKeyUpdateOne {
+	_u.mutation.ClearOwnerAddress()
+	return _u
+}
+
+// SetRatePerMinute sets the "rate_per_minute" field.
+func (_u *APIKeyUpdateOne) SetRatePerMinute(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetRatePerMinute()
+	_u.mutation.SetRatePerMinute(v)
+	return _u
+}
+
+// SetNillableRatePerMinute sets the "rate_per_minute" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableRatePerMinute(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetRatePerMinute(*v)
+	}
+	return _u
+}
+
+// AddRatePerMinute adds value to the "rate_per_minute" field.
+func (_u *APIKeyUpdateOne) AddRatePerMinute(v int) *APIKeyUpdateOne {
+	_u.mutation.AddRatePerMinute(v)
+	return _u
+}
+
+// SetDailyQuota sets the "daily_quota" field.
+func (_u *APIKeyUpdateOne) SetDailyQuota(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetDailyQuota()
+	_u.mutation.SetDailyQuota(v)
+	return _u
+}
+
+// SetNillableDailyQuota sets the "daily_quota" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableDailyQuota(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetDailyQuota(*v)
+	}
+	return _u
+}
+
+// AddDailyQuota adds value to the "daily_quota" field.
+func (_u *APIKeyUpdateOne) AddDailyQuota(v int) *APIKeyUpdateOne {
+	_u.mutation.AddDailyQuota(v)
+	return _u
+}
+
+// SetTotalRequests sets the "total_requests" field.
+func (_u *APIKeyUpdateOne) SetTotalRequests(v int64) *APIKeyUpdateOne {
+	_u.mutation.ResetTotalRequests()
+	_u.mutation.SetTotalRequests(v)
+	return _u
+}
+
+// SetNillableTotalRequests sets the "total_requests" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableTotalRequests(v *int64) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetTotalRequests(*v)
+	}
+	return _u
+}
+
+// AddTotalRequests adds value to the "total_requests" field.
+func (_u *APIKeyUpdateOne) AddTotalRequests(v int64) *APIKeyUpdateOne {
+	_u.mutation.AddTotalRequests(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *APIKeyUpdateOne) SetCreatedBy(v string) *APIKeyUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableCreatedBy(v *string) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *APIKeyUpdateOne) SetLastUsedAt(v time.Time) *APIKeyUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableLastUsedAt(v *time.Time) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *APIKeyUpdateOne) ClearLastUsedAt() *APIKeyUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *APIKeyUpdateOne) SetRevokedAt(v time.Time) *APIKeyUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableRevokedAt(v *time.Time) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *APIKeyUpdateOne) ClearRevokedAt() *APIKeyUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (_u *APIKeyUpdateOne) Mutation() *APIKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (_u *APIKeyUpdateOne) Where(ps ...predicate.APIKey) *APIKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *APIKeyUpdateOne) Select(field string, fields ...string) *APIKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated APIKey entity.
+func (_u *APIKeyUpdateOne) Save(ctx context.Context) (*APIKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIKeyUpdateOne) SaveX(ctx context.Context) *APIKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *APIKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *APIKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikey.FieldID)
+		for _, f := range fields {
+			if !apikey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Prefix(); ok {
+		_spec.SetField(apikey.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.KeyHash(); ok {
+		_spec.SetField(apikey.FieldKeyHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.OwnerAddress(); ok {
+		_spec.SetField(apikey.FieldOwnerAddress, field.TypeString, value)
+	}
+	if _u.mutation.OwnerAddressCleared() {
+		_spec.ClearField(apikey.FieldOwnerAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.RatePerMinute(); ok {
+		_spec.SetField(apikey.FieldRatePerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRatePerMinute(); ok {
+		_spec.AddField(apikey.FieldRatePerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DailyQuota(); ok {
+		_spec.SetField(apikey.FieldDailyQuota, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDailyQuota(); ok {
+		_spec.AddField(apikey.FieldDailyQuota, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalRequests(); ok {
+		_spec.SetField(apikey.FieldTotalRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalRequests(); ok {
+		_spec.AddField(apikey.FieldTotalRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(apikey.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikey.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &APIKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/apikeyusage"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// APIKeyUsage is the model entity for the APIKeyUsage schema.
+type APIKeyUsage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID int `json:"key_id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day string `json:"day,omitempty"`
+	// Count holds the value of the "count" field.
+	Count        int `json:"count,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APIKeyUsage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apikeyusage.FieldID, apikeyusage.FieldKeyID, apikeyusage.FieldCount:
+			values[i] = new(sql.NullInt64)
+		case apikeyusage.FieldDay:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APIKeyUsage fields.
+func (_m *APIKeyUsage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apikeyusage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case apikeyusage.FieldKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				_m.KeyID = int(value.Int64)
+			}
+		case apikeyusage.FieldDay:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				_m.Day = value.String
+			}
+		case apikeyusage.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				_m.Count = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the APIKeyUsage.
+// This includes values selected through modifiers, order, etc.
+func (_m *APIKeyUsage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this APIKeyUsage.
+// Note that you need to call APIKeyUsage.Unwrap() before calling this method if this APIKeyUsage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *APIKeyUsage) Update() *APIKeyUsageUpdateOne {
+	return NewAPIKeyUsageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the APIKeyUsage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *APIKeyUsage) Unwrap() *APIKeyUsage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: APIKeyUsage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *APIKeyUsage) String() string {
+	var builder strings.Builder
+	builder.WriteString("APIKeyUsage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeyID))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(_m.Day)
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Count))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APIKeyUsages is a parsable slice of APIKeyUsage.
+type APIKeyUsages []*APIKeyUsage
@@ -0,0 +1,68 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apikeyusage
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the apikeyusage type in the database.
+	Label = "api_key_usage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKeyID holds the string denoting the key_id field in the database.
+	FieldKeyID = "key_id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// Table holds the table name of the apikeyusage in the database.
+	Table = "api_key_usages"
+)
+
+// Columns holds all SQL columns for apikeyusage fields.
+var Columns = []string{
+	FieldID,
+	FieldKeyID,
+	FieldDay,
+	FieldCount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCount holds the default value on creation for the "count" field.
+	DefaultCount int
+)
+
+// OrderOption defines the ordering options for the APIKeyUsage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKeyID orders the results by the key_id field.
+func ByKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyID, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByCount orders the results by the count field.
+func ByCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCount, opts...).ToFunc()
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apikeyusage
+
+import (
+	"backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldID, id))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldKeyID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldDay, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldCount, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldKeyID, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldDay, v))
+}
+
+// DayContains applies the Contains predicate on the "day" field.
+func DayContains(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldContains(FieldDay, v))
+}
+
+// DayHasPrefix applies the HasPrefix predicate on the "day" field.
+func DayHasPrefix(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldHasPrefix(FieldDay, v))
+}
+
+// DayHasSuffix applies the HasSuffix predicate on the "day" field.
+func DayHasSuffix(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldHasSuffix(FieldDay, v))
+}
+
+// DayEqualFold applies the EqualFold predicate on the "day" field.
+func DayEqualFold(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEqualFold(FieldDay, v))
+}
+
+// DayContainsFold applies the ContainsFold predicate on the "day" field.
+func DayContainsFold(v string) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldContainsFold(FieldDay, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldCount, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.APIKeyUsage) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.APIKeyUsage) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.APIKeyUsage) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.NotPredicates(p))
+}
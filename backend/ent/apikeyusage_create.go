@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/apikeyusage"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyUsageCreate is the builder for creating a APIKeyUsage entity.
+type APIKeyUsageCreate struct {
+	config
+	mutation *APIKeyUsageMutation
+	hooks    []Hook
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *APIKeyUsageCreate) SetKeyID(v int) *APIKeyUsageCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetDay sets the "day" field.
+func (_c *APIKeyUsageCreate) SetDay(v string) *APIKeyUsageCreate {
+	_c.mutation.SetDay(v)
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *APIKeyUsageCreate) SetCount(v int) *APIKeyUsageCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_c *APIKeyUsageCreate) SetNillableCount(v *int) *APIKeyUsageCreate {
+	if v != nil {
+		_c.SetCount(*v)
+	}
+	return _c
+}
+
+// Mutation returns the APIKeyUsageMutation object of the builder.
+func (_c *APIKeyUsageCreate) Mutation() *APIKeyUsageMutation {
+	return _c.mutation
+}
+
+// Save creates the APIKeyUsage in the database.
+func (_c *APIKeyUsageCreate) Save(ctx context.Context) (*APIKeyUsage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *APIKeyUsageCreate) SaveX(ctx context.Context) *APIKeyUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *APIKeyUsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *APIKeyUsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *APIKeyUsageCreate) defaults() {
+	if _, ok := _c.mutation.Count(); !ok {
+		v := apikeyusage.DefaultCount
+		_c.mutation.SetCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *APIKeyUsageCreate) check() error {
+	if _, ok := _c.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "APIKeyUsage.key_id"`)}
+	}
+	if _, ok := _c.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "APIKeyUsage.day"`)}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "APIKeyUsage.count"`)}
+	}
+	return nil
+}
+
+func (_c *APIKeyUsageCreate) sqlSave(ctx context.Context) (*APIKeyUsage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *APIKeyUsageCreate) createSpec() (*APIKeyUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIKeyUsage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(apikeyusage.Table, sqlgraph.NewFieldSpec(apikeyusage.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(apikeyusage.FieldKeyID, field.TypeInt, value)
+		_node.KeyID = value
+	}
+	if value, ok := _c.mutation.Day(); ok {
+		_spec.SetField(apikeyusage.FieldDay, field.TypeString, value)
+		_node.Day = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(apikeyusage.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	return _node, _spec
+}
+
+// APIKeyUsageCreateBulk is the builder for creating many APIKeyUsage entities in bulk.
+type APIKeyUsageCreateBulk struct {
+	config
+	err      error
+	builders []*APIKeyUsageCreate
+}
+
+// Save creates the APIKeyUsage entities in the database.
+func (_c *APIKeyUsageCreateBulk) Save(ctx context.Context) ([]*APIKeyUsage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*APIKeyUsage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APIKeyUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *APIKeyUsageCreateBulk) SaveX(ctx context.Context) []*APIKeyUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *APIKeyUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *APIKeyUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
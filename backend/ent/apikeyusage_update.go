@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/apikeyusage"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyUsageUpdate is the builder for updating APIKeyUsage entities.
+type APIKeyUsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIKeyUsageMutation
+}
+
+// Where appends a list predicates to the APIKeyUsageUpdate builder.
+func (_u *APIKeyUsageUpdate) Where(ps ...predicate.APIKeyUsage) *APIKeyUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *APIKeyUsageUpdate) SetKeyID(v int) *APIKeyUsageUpdate {
+	_u.mutation.ResetKeyID()
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *APIKeyUsageUpdate) SetNillableKeyID(v *int) *APIKeyUsageUpdate {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// AddKeyID adds value to the "key_id" field.
+func (_u *APIKeyUsageUpdate) AddKeyID(v int) *APIKeyUsageUpdate {
+	_u.mutation.AddKeyID(v)
+	return _u
+}
+
+// SetDay sets the "day" field.
+func (_u *APIKeyUsageUpdate) SetDay(v string) *APIKeyUsageUpdate {
+	_u.mutation.SetDay(v)
+	return _u
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_u *APIKeyUsageUpdate) SetNillableDay(v *string) *APIKeyUsageUpdate {
+	if v != nil {
+		_u.SetDay(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *APIKeyUsageUpdate) SetCount(v int) *APIKeyUsageUpdate {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *APIKeyUsageUpdate) SetNillableCount(v *int) *APIKeyUsageUpdate {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *APIKeyUsageUpdate) AddCount(v int) *APIKeyUsageUpdate {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// Mutation returns the APIKeyUsageMutation object of the builder.
+func (_u *APIKeyUsageUpdate) Mutation() *APIKeyUsageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *APIKeyUsageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIKeyUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *APIKeyUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIKeyUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *APIKeyUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(apikeyusage.Table, apikeyusage.Columns, sqlgraph.NewFieldSpec(apikeyusage.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(apikeyusage.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeyID(); ok {
+		_spec.AddField(apikeyusage.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Day(); ok {
+		_spec.SetField(apikeyusage.FieldDay, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(apikeyusage.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(apikeyusage.FieldCount, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikeyusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// APIKeyUsageUpdateOne is the builder for updating a single APIKeyUsage entity.
+type APIKeyUsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIKeyUsageMutation
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *APIKeyUsageUpdateOne) SetKeyID(v int) *APIKeyUsageUpdateOne {
+	_u.mutation.ResetKeyID()
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *APIKeyUsageUpdateOne) SetNillableKeyID(v *int) *APIKeyUsageUpdateOne {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// AddKeyID adds value to the "key_id" field.
+func (_u *APIKeyUsageUpdateOne) AddKeyID(v int) *APIKeyUsageUpdateOne {
+	_u.mutation.AddKeyID(v)
+	return _u
+}
+
+// SetDay sets the "day" field.
+func (_u *APIKeyUsageUpdateOne) SetDay(v string) *APIKeyUsageUpdateOne {
+	_u.mutation.SetDay(v)
+	return _u
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_u *APIKeyUsageUpdateOne) SetNillableDay(v *string) *APIKeyUsageUpdateOne {
+	if v != nil {
+		_u.SetDay(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *APIKeyUsageUpdateOne) SetCount(v int) *APIKeyUsageUpdateOne {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *APIKeyUsageUpdateOne) SetNillableCount(v *int) *APIKeyUsageUpdateOne {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *APIKeyUsageUpdateOne) AddCount(v int) *APIKeyUsageUpdateOne {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// Mutation returns the APIKeyUsageMutation object of the builder.
+func (_u *APIKeyUsageUpdateOne) Mutation() *APIKeyUsageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the APIKeyUsageUpdate builder.
+func (_u *APIKeyUsageUpdateOne) Where(ps ...predicate.APIKeyUsage) *APIKeyUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *APIKeyUsageUpdateOne) Select(field string, fields ...string) *APIKeyUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated APIKeyUsage entity.
+func (_u *APIKeyUsageUpdateOne) Save(ctx context.Context) (*APIKeyUsage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIKeyUsageUpdateOne) SaveX(ctx context.Context) *APIKeyUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *APIKeyUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIKeyUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *APIKeyUsageUpdateOne) sqlSave(ctx context.Context) (_node *APIKeyUsage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(apikeyusage.Table, apikeyusage.Columns, sqlgraph.NewFieldSpec(apikeyusage.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIKeyUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikeyusage.FieldID)
+		for _, f := range fields {
+			if !apikeyusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikeyusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(apikeyusage.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeyID(); ok {
+		_spec.AddField(apikeyusage.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Day(); ok {
+		_spec.SetField(apikeyusage.FieldDay, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(apikeyusage.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(apikeyusage.FieldCount, field.TypeInt, value)
+	}
+	_node = &APIKeyUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikeyusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
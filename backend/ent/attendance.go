@@ -23,6 +23,10 @@ type Attendance struct {
 	CheckedIn bool `json:"checked_in,omitempty"`
 	// RegistrationTime holds the value of the "registration_time" field.
 	RegistrationTime time.Time `json:"registration_time,omitempty"`
+	// CheckInMethod holds the value of the "check_in_method" field.
+	CheckInMethod *attendance.CheckInMethod `json:"check_in_method,omitempty"`
+	// VerifiedBy holds the value of the "verified_by" field.
+	VerifiedBy string `json:"verified_by,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AttendanceQuery when eager-loading is set.
 	Edges             AttendanceEdges `json:"edges"`
@@ -73,6 +77,8 @@ func (*Attendance) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case attendance.FieldID:
 			values[i] = new(sql.NullInt64)
+		case attendance.FieldCheckInMethod, attendance.FieldVerifiedBy:
+			values[i] = new(sql.NullString)
 		case attendance.FieldRegistrationTime:
 			values[i] = new(sql.NullTime)
 		case attendance.ForeignKeys[0]: // event_attendances
@@ -112,6 +118,19 @@ func (_m *Attendance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RegistrationTime = value.Time
 			}
+		case attendance.FieldCheckInMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field check_in_method", values[i])
+			} else if value.Valid {
+				_m.CheckInMethod = new(attendance.CheckInMethod)
+				*_m.CheckInMethod = attendance.CheckInMethod(value.String)
+			}
+		case attendance.FieldVerifiedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_by", values[i])
+			} else if value.Valid {
+				_m.VerifiedBy = value.String
+			}
 		case attendance.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field event_attendances", value)
@@ -177,6 +196,14 @@ func (_m *Attendance) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("registration_time=")
 	builder.WriteString(_m.RegistrationTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CheckInMethod; v != nil {
+		builder.WriteString("check_in_method=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("verified_by=")
+	builder.WriteString(_m.VerifiedBy)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package attendance
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -18,6 +19,10 @@ const (
 	FieldCheckedIn = "checked_in"
 	// FieldRegistrationTime holds the string denoting the registration_time field in the database.
 	FieldRegistrationTime = "registration_time"
+	// FieldCheckInMethod holds the string denoting the check_in_method field in the database.
+	FieldCheckInMethod = "check_in_method"
+	// FieldVerifiedBy holds the string denoting the verified_by field in the database.
+	FieldVerifiedBy = "verified_by"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeEvent holds the string denoting the event edge name in mutations.
@@ -45,6 +50,8 @@ var Columns = []string{
 	FieldID,
 	FieldCheckedIn,
 	FieldRegistrationTime,
+	FieldCheckInMethod,
+	FieldVerifiedBy,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "attendances"
@@ -76,6 +83,31 @@ var (
 	DefaultRegistrationTime func() time.Time
 )
 
+// CheckInMethod defines the type for the "check_in_method" enum field.
+type CheckInMethod string
+
+// CheckInMethod values.
+const (
+	CheckInMethodStaff    CheckInMethod = "staff"
+	CheckInMethodKiosk    CheckInMethod = "kiosk"
+	CheckInMethodSelf     CheckInMethod = "self"
+	CheckInMethodJoinLink CheckInMethod = "join_link"
+)
+
+func (cim CheckInMethod) String() string {
+	return string(cim)
+}
+
+// CheckInMethodValidator is a validator for the "check_in_method" field enum values. It is called by the builders before save.
+func CheckInMethodValidator(cim CheckInMethod) error {
+	switch cim {
+	case CheckInMethodStaff, CheckInMethodKiosk, CheckInMethodSelf, CheckInMethodJoinLink:
+		return nil
+	default:
+		return fmt.Errorf("attendance: invalid enum value for check_in_method field: %q", cim)
+	}
+}
+
 // OrderOption defines the ordering options for the Attendance queries.
 type OrderOption func(*sql.Selector)
 
@@ -94,6 +126,16 @@ func ByRegistrationTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRegistrationTime, opts...).ToFunc()
 }
 
+// ByCheckInMethod orders the results by the check_in_method field.
+func ByCheckInMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckInMethod, opts...).ToFunc()
+}
+
+// ByVerifiedBy orders the results by the verified_by field.
+func ByVerifiedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedBy, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -65,6 +65,11 @@ func RegistrationTime(v time.Time) predicate.Attendance {
 	return predicate.Attendance(sql.FieldEQ(FieldRegistrationTime, v))
 }
 
+// VerifiedBy applies equality check predicate on the "verified_by" field. It's identical to VerifiedByEQ.
+func VerifiedBy(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldVerifiedBy, v))
+}
+
 // CheckedInEQ applies the EQ predicate on the "checked_in" field.
 func CheckedInEQ(v bool) predicate.Attendance {
 	return predicate.Attendance(sql.FieldEQ(FieldCheckedIn, v))
@@ -115,6 +120,111 @@ func RegistrationTimeLTE(v time.Time) predicate.Attendance {
 	return predicate.Attendance(sql.FieldLTE(FieldRegistrationTime, v))
 }
 
+// CheckInMethodEQ applies the EQ predicate on the "check_in_method" field.
+func CheckInMethodEQ(v CheckInMethod) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInMethod, v))
+}
+
+// CheckInMethodNEQ applies the NEQ predicate on the "check_in_method" field.
+func CheckInMethodNEQ(v CheckInMethod) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldCheckInMethod, v))
+}
+
+// CheckInMethodIn applies the In predicate on the "check_in_method" field.
+func CheckInMethodIn(vs ...CheckInMethod) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldCheckInMethod, vs...))
+}
+
+// CheckInMethodNotIn applies the NotIn predicate on the "check_in_method" field.
+func CheckInMethodNotIn(vs ...CheckInMethod) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldCheckInMethod, vs...))
+}
+
+// CheckInMethodIsNil applies the IsNil predicate on the "check_in_method" field.
+func CheckInMethodIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldCheckInMethod))
+}
+
+// CheckInMethodNotNil applies the NotNil predicate on the "check_in_method" field.
+func CheckInMethodNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldCheckInMethod))
+}
+
+// VerifiedByEQ applies the EQ predicate on the "verified_by" field.
+func VerifiedByEQ(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldVerifiedBy, v))
+}
+
+// VerifiedByNEQ applies the NEQ predicate on the "verified_by" field.
+func VerifiedByNEQ(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldVerifiedBy, v))
+}
+
+// VerifiedByIn applies the In predicate on the "verified_by" field.
+func VerifiedByIn(vs ...string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldVerifiedBy, vs...))
+}
+
+// VerifiedByNotIn applies the NotIn predicate on the "verified_by" field.
+func VerifiedByNotIn(vs ...string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldVerifiedBy, vs...))
+}
+
+// VerifiedByGT applies the GT predicate on the "verified_by" field.
+func VerifiedByGT(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldVerifiedBy, v))
+}
+
+// VerifiedByGTE applies the GTE predicate on the "verified_by" field.
+func VerifiedByGTE(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldVerifiedBy, v))
+}
+
+// VerifiedByLT applies the LT predicate on the "verified_by" field.
+func VerifiedByLT(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldVerifiedBy, v))
+}
+
+// VerifiedByLTE applies the LTE predicate on the "verified_by" field.
+func VerifiedByLTE(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldVerifiedBy, v))
+}
+
+// VerifiedByContains applies the Contains predicate on the "verified_by" field.
+func VerifiedByContains(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldContains(FieldVerifiedBy, v))
+}
+
+// VerifiedByHasPrefix applies the HasPrefix predicate on the "verified_by" field.
+func VerifiedByHasPrefix(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldHasPrefix(FieldVerifiedBy, v))
+}
+
+// VerifiedByHasSuffix applies the HasSuffix predicate on the "verified_by" field.
+func VerifiedByHasSuffix(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldHasSuffix(FieldVerifiedBy, v))
+}
+
+// VerifiedByIsNil applies the IsNil predicate on the "verified_by" field.
+func VerifiedByIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldVerifiedBy))
+}
+
+// VerifiedByNotNil applies the NotNil predicate on the "verified_by" field.
+func VerifiedByNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldVerifiedBy))
+}
+
+// VerifiedByEqualFold applies the EqualFold predicate on the "verified_by" field.
+func VerifiedByEqualFold(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEqualFold(FieldVerifiedBy, v))
+}
+
+// VerifiedByContainsFold applies the ContainsFold predicate on the "verified_by" field.
+func VerifiedByContainsFold(v string) predicate.Attendance {
+	return predicate.Attendance(sql.FieldContainsFold(FieldVerifiedBy, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Attendance {
 	return predicate.Attendance(func(s *sql.Selector) {
@@ -50,6 +50,34 @@ func (_c *AttendanceCreate) SetNillableRegistrationTime(v *time.Time) *Attendanc
 	return _c
 }
 
+// SetCheckInMethod sets the "check_in_method" field.
+func (_c *AttendanceCreate) SetCheckInMethod(v attendance.CheckInMethod) *AttendanceCreate {
+	_c.mutation.SetCheckInMethod(v)
+	return _c
+}
+
+// SetNillableCheckInMethod sets the "check_in_method" field if the given value is not nil.
+func (_c *AttendanceCreate) SetNillableCheckInMethod(v *attendance.CheckInMethod) *AttendanceCreate {
+	if v != nil {
+		_c.SetCheckInMethod(*v)
+	}
+	return _c
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (_c *AttendanceCreate) SetVerifiedBy(v string) *AttendanceCreate {
+	_c.mutation.SetVerifiedBy(v)
+	return _c
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (_c *AttendanceCreate) SetNillableVerifiedBy(v *string) *AttendanceCreate {
+	if v != nil {
+		_c.SetVerifiedBy(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_c *AttendanceCreate) SetUserID(id int) *AttendanceCreate {
 	_c.mutation.SetUserID(id)
@@ -125,6 +153,11 @@ func (_c *AttendanceCreate) check() error {
 	if _, ok := _c.mutation.RegistrationTime(); !ok {
 		return &ValidationError{Name: "registration_time", err: errors.New(`ent: missing required field "Attendance.registration_time"`)}
 	}
+	if v, ok := _c.mutation.CheckInMethod(); ok {
+		if err := attendance.CheckInMethodValidator(v); err != nil {
+			return &ValidationError{Name: "check_in_method", err: fmt.Errorf(`ent: validator failed for field "Attendance.check_in_method": %w`, err)}
+		}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Attendance.user"`)}
 	}
@@ -165,6 +198,14 @@ func (_c *AttendanceCreate) createSpec() (*Attendance, *sqlgraph.CreateSpec) {
 		_spec.SetField(attendance.FieldRegistrationTime, field.TypeTime, value)
 		_node.RegistrationTime = value
 	}
+	if value, ok := _c.mutation.CheckInMethod(); ok {
+		_spec.SetField(attendance.FieldCheckInMethod, field.TypeEnum, value)
+		_node.CheckInMethod = &value
+	}
+	if value, ok := _c.mutation.VerifiedBy(); ok {
+		_spec.SetField(attendance.FieldVerifiedBy, field.TypeString, value)
+		_node.VerifiedBy = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -58,6 +58,46 @@ func (_u *AttendanceUpdate) SetNillableRegistrationTime(v *time.Time) *Attendanc
 	return _u
 }
 
+// SetCheckInMethod sets the "check_in_method" field.
+func (_u *AttendanceUpdate) SetCheckInMethod(v attendance.CheckInMethod) *AttendanceUpdate {
+	_u.mutation.SetCheckInMethod(v)
+	return _u
+}
+
+// SetNillableCheckInMethod sets the "check_in_method" field if the given value is not nil.
+func (_u *AttendanceUpdate) SetNillableCheckInMethod(v *attendance.CheckInMethod) *AttendanceUpdate {
+	if v != nil {
+		_u.SetCheckInMethod(*v)
+	}
+	return _u
+}
+
+// ClearCheckInMethod clears the value of the "check_in_method" field.
+func (_u *AttendanceUpdate) ClearCheckInMethod() *AttendanceUpdate {
+	_u.mutation.ClearCheckInMethod()
+	return _u
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (_u *AttendanceUpdate) SetVerifiedBy(v string) *AttendanceUpdate {
+	_u.mutation.SetVerifiedBy(v)
+	return _u
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (_u *AttendanceUpdate) SetNillableVerifiedBy(v *string) *AttendanceUpdate {
+	if v != nil {
+		_u.SetVerifiedBy(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedBy clears the value of the "verified_by" field.
+func (_u *AttendanceUpdate) ClearVerifiedBy() *AttendanceUpdate {
+	_u.mutation.ClearVerifiedBy()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *AttendanceUpdate) SetUserID(id int) *AttendanceUpdate {
 	_u.mutation.SetUserID(id)
@@ -126,6 +166,11 @@ func (_u *AttendanceUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *AttendanceUpdate) check() error {
+	if v, ok := _u.mutation.CheckInMethod(); ok {
+		if err := attendance.CheckInMethodValidator(v); err != nil {
+			return &ValidationError{Name: "check_in_method", err: fmt.Errorf(`ent: validator failed for field "Attendance.check_in_method": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Attendance.user"`)
 	}
@@ -153,6 +198,18 @@ func (_u *AttendanceUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.RegistrationTime(); ok {
 		_spec.SetField(attendance.FieldRegistrationTime, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.CheckInMethod(); ok {
+		_spec.SetField(attendance.FieldCheckInMethod, field.TypeEnum, value)
+	}
+	if _u.mutation.CheckInMethodCleared() {
+		_spec.ClearField(attendance.FieldCheckInMethod, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.VerifiedBy(); ok {
+		_spec.SetField(attendance.FieldVerifiedBy, field.TypeString, value)
+	}
+	if _u.mutation.VerifiedByCleared() {
+		_spec.ClearField(attendance.FieldVerifiedBy, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -259,6 +316,46 @@ func (_u *AttendanceUpdateOne) SetNillableRegistrationTime(v *time.Time) *Attend
 	return _u
 }
 
+// SetCheckInMethod sets the "check_in_method" field.
+func (_u *AttendanceUpdateOne) SetCheckInMethod(v attendance.CheckInMethod) *AttendanceUpdateOne {
+	_u.mutation.SetCheckInMethod(v)
+	return _u
+}
+
+// SetNillableCheckInMethod sets the "check_in_method" field if the given value is not nil.
+func (_u *AttendanceUpdateOne) SetNillableCheckInMethod(v *attendance.CheckInMethod) *AttendanceUpdateOne {
+	if v != nil {
+		_u.SetCheckInMethod(*v)
+	}
+	return _u
+}
+
+// ClearCheckInMethod clears the value of the "check_in_method" field.
+func (_u *AttendanceUpdateOne) ClearCheckInMethod() *AttendanceUpdateOne {
+	_u.mutation.ClearCheckInMethod()
+	return _u
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (_u *AttendanceUpdateOne) SetVerifiedBy(v string) *AttendanceUpdateOne {
+	_u.mutation.SetVerifiedBy(v)
+	return _u
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (_u *AttendanceUpdateOne) SetNillableVerifiedBy(v *string) *AttendanceUpdateOne {
+	if v != nil {
+		_u.SetVerifiedBy(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedBy clears the value of the "verified_by" field.
+func (_u *AttendanceUpdateOne) ClearVerifiedBy() *AttendanceUpdateOne {
+	_u.mutation.ClearVerifiedBy()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *AttendanceUpdateOne) SetUserID(id int) *AttendanceUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -340,6 +437,11 @@ func (_u *AttendanceUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *AttendanceUpdateOne) check() error {
+	if v, ok := _u.mutation.CheckInMethod(); ok {
+		if err := attendance.CheckInMethodValidator(v); err != nil {
+			return &ValidationError{Name: "check_in_method", err: fmt.Errorf(`ent: validator failed for field "Attendance.check_in_method": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Attendance.user"`)
 	}
@@ -384,6 +486,18 @@ func (_u *AttendanceUpdateOne) sqlSave(ctx context.Context) (_node *Attendance,
 	if value, ok := _u.mutation.RegistrationTime(); ok {
 		_spec.SetField(attendance.FieldRegistrationTime, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.CheckInMethod(); ok {
+		_spec.SetField(attendance.FieldCheckInMethod, field.TypeEnum, value)
+	}
+	if _u.mutation.CheckInMethodCleared() {
+		_spec.ClearField(attendance.FieldCheckInMethod, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.VerifiedBy(); ok {
+		_spec.SetField(attendance.FieldVerifiedBy, field.TypeString, value)
+	}
+	if _u.mutation.VerifiedByCleared() {
+		_spec.ClearField(attendance.FieldVerifiedBy, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
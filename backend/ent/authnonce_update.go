@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/authnonce"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthNonceUpdate is the builder for updating AuthNonce entities.
+type AuthNonceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthNonceMutation
+}
+
+// Where appends a list predicates to the AuthNonceUpdate builder.
+func (_u *AuthNonceUpdate) Where(ps ...predicate.AuthNonce) *AuthNonceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *AuthNonceUpdate) SetNonce(v string) *AuthNonceUpdate {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *AuthNonceUpdate) SetNillableNonce(v *string) *AuthNonceUpdate {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuthNonceUpdate) SetExpiresAt(v time.Time) *AuthNonceUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuthNonceUpdate) SetNillableExpiresAt(v *time.Time) *AuthNonceUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuthNonceMutation object of the builder.
+func (_u *AuthNonceUpdate) Mutation() *AuthNonceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuthNonceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthNonceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuthNonceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthNonceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuthNonceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authnonce.Table, authnonce.Columns, sqlgraph.NewFieldSpec(authnonce.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(authnonce.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(authnonce.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authnonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuthNonceUpdateOne is the builder for updating a single AuthNonce entity.
+type AuthNonceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuthNonceMutation
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *AuthNonceUpdateOne) SetNonce(v string) *AuthNonceUpdateOne {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *AuthNonceUpdateOne) SetNillableNonce(v *string) *AuthNonceUpdateOne {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuthNonceUpdateOne) SetExpiresAt(v time.Time) *AuthNonceUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuthNonceUpdateOne) SetNillableExpiresAt(v *time.Time) *AuthNonceUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuthNonceMutation object of the builder.
+func (_u *AuthNonceUpdateOne) Mutation() *AuthNonceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuthNonceUpdate builder.
+func (_u *AuthNonceUpdateOne) Where(ps ...predicate.AuthNonce) *AuthNonceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuthNonceUpdateOne) Select(field string, fields ...string) *AuthNonceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuthNonce entity.
+func (_u *AuthNonceUpdateOne) Save(ctx context.Context) (*AuthNonce, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthNonceUpdateOne) SaveX(ctx context.Context) *AuthNonce {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuthNonceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthNonceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuthNonceUpdateOne) sqlSave(ctx context.Context) (_node *AuthNonce, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authnonce.Table, authnonce.Columns, sqlgraph.NewFieldSpec(authnonce.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthNonce.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authnonce.FieldID)
+		for _, f := range fields {
+			if !authnonce.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authnonce.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(authnonce.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(authnonce.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &AuthNonce{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authnonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/calendartoken"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CalendarTokenUpdate is the builder for updating CalendarToken entities.
+type CalendarTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CalendarTokenMutation
+}
+
+// Where appends a list predicates to the CalendarTokenUpdate builder.
+func (_u *CalendarTokenUpdate) Where(ps ...predicate.CalendarToken) *CalendarTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *CalendarTokenUpdate) SetAddress(v string) *CalendarTokenUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *CalendarTokenUpdate) SetNillableAddress(v *string) *CalendarTokenUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *CalendarTokenUpdate) SetTokenHash(v string) *CalendarTokenUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *CalendarTokenUpdate) SetNillableTokenHash(v *string) *CalendarTokenUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CalendarTokenUpdate) SetCreatedAt(v time.Time) *CalendarTokenUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CalendarTokenUpdate) SetNillableCreatedAt(v *time.Time) *CalendarTokenUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CalendarTokenMutation object of the builder.
+func (_u *CalendarTokenUpdate) Mutation() *CalendarTokenMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CalendarTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CalendarTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CalendarTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CalendarTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CalendarTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(calendartoken.Table, calendartoken.Columns, sqlgraph.NewFieldSpec(calendartoken.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(calendartoken.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(calendartoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(calendartoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{calendartoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CalendarTokenUpdateOne is the builder for updating a single CalendarToken entity.
+type CalendarTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CalendarTokenMutation
+}
+
+// SetAddress sets the "address" field.
+func (_u *CalendarTokenUpdateOne) SetAddress(v string) *CalendarTokenUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *CalendarTokenUpdateOne) SetNillableAddress(v *string) *CalendarTokenUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *CalendarTokenUpdateOne) SetTokenHash(v string) *CalendarTokenUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *CalendarTokenUpdateOne) SetNillableTokenHash(v *string) *CalendarTokenUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CalendarTokenUpdateOne) SetCreatedAt(v time.Time) *CalendarTokenUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CalendarTokenUpdateOne) SetNillableCreatedAt(v *time.Time) *CalendarTokenUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CalendarTokenMutation object of the builder.
+func (_u *CalendarTokenUpdateOne) Mutation() *CalendarTokenMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CalendarTokenUpdate builder.
+func (_u *CalendarTokenUpdateOne) Where(ps ...predicate.CalendarToken) *CalendarTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CalendarTokenUpdateOne) Select(field string, fields ...string) *CalendarTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CalendarToken entity.
+func (_u *CalendarTokenUpdateOne) Save(ctx context.Context) (*CalendarToken, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CalendarTokenUpdateOne) SaveX(ctx context.Context) *CalendarToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CalendarTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CalendarTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CalendarTokenUpdateOne) sqlSave(ctx context.Context) (_node *CalendarToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(calendartoken.Table, calendartoken.Columns, sqlgraph.NewFieldSpec(calendartoken.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CalendarToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, calendartoken.FieldID)
+		for _, f := range fields {
+			if !calendartoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != calendartoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(calendartoken.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(calendartoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(calendartoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &CalendarToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{calendartoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
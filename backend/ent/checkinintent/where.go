@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkinintent
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldID, id))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldEventID, v))
+}
+
+// UserAddress applies equality check predicate on the "user_address" field. It's identical to UserAddressEQ.
+func UserAddress(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldUserAddress, v))
+}
+
+// ScannedAt applies equality check predicate on the "scanned_at" field. It's identical to ScannedAtEQ.
+func ScannedAt(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldLastError, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProcessedAt applies equality check predicate on the "processed_at" field. It's identical to ProcessedAtEQ.
+func ProcessedAt(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldProcessedAt, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uint64) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldEventID, v))
+}
+
+// UserAddressEQ applies the EQ predicate on the "user_address" field.
+func UserAddressEQ(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldUserAddress, v))
+}
+
+// UserAddressNEQ applies the NEQ predicate on the "user_address" field.
+func UserAddressNEQ(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldUserAddress, v))
+}
+
+// UserAddressIn applies the In predicate on the "user_address" field.
+func UserAddressIn(vs ...string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldUserAddress, vs...))
+}
+
+// UserAddressNotIn applies the NotIn predicate on the "user_address" field.
+func UserAddressNotIn(vs ...string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldUserAddress, vs...))
+}
+
+// UserAddressGT applies the GT predicate on the "user_address" field.
+func UserAddressGT(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldUserAddress, v))
+}
+
+// UserAddressGTE applies the GTE predicate on the "user_address" field.
+func UserAddressGTE(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldUserAddress, v))
+}
+
+// UserAddressLT applies the LT predicate on the "user_address" field.
+func UserAddressLT(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldUserAddress, v))
+}
+
+// UserAddressLTE applies the LTE predicate on the "user_address" field.
+func UserAddressLTE(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldUserAddress, v))
+}
+
+// UserAddressContains applies the Contains predicate on the "user_address" field.
+func UserAddressContains(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldContains(FieldUserAddress, v))
+}
+
+// UserAddressHasPrefix applies the HasPrefix predicate on the "user_address" field.
+func UserAddressHasPrefix(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldHasPrefix(FieldUserAddress, v))
+}
+
+// UserAddressHasSuffix applies the HasSuffix predicate on the "user_address" field.
+func UserAddressHasSuffix(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldHasSuffix(FieldUserAddress, v))
+}
+
+// UserAddressEqualFold applies the EqualFold predicate on the "user_address" field.
+func UserAddressEqualFold(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEqualFold(FieldUserAddress, v))
+}
+
+// UserAddressContainsFold applies the ContainsFold predicate on the "user_address" field.
+func UserAddressContainsFold(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldContainsFold(FieldUserAddress, v))
+}
+
+// ScannedAtEQ applies the EQ predicate on the "scanned_at" field.
+func ScannedAtEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// ScannedAtNEQ applies the NEQ predicate on the "scanned_at" field.
+func ScannedAtNEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldScannedAt, v))
+}
+
+// ScannedAtIn applies the In predicate on the "scanned_at" field.
+func ScannedAtIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtNotIn applies the NotIn predicate on the "scanned_at" field.
+func ScannedAtNotIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtGT applies the GT predicate on the "scanned_at" field.
+func ScannedAtGT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldScannedAt, v))
+}
+
+// ScannedAtGTE applies the GTE predicate on the "scanned_at" field.
+func ScannedAtGTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldScannedAt, v))
+}
+
+// ScannedAtLT applies the LT predicate on the "scanned_at" field.
+func ScannedAtLT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldScannedAt, v))
+}
+
+// ScannedAtLTE applies the LTE predicate on the "scanned_at" field.
+func ScannedAtLTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldScannedAt, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldAttempts, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ProcessedAtEQ applies the EQ predicate on the "processed_at" field.
+func ProcessedAtEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldEQ(FieldProcessedAt, v))
+}
+
+// ProcessedAtNEQ applies the NEQ predicate on the "processed_at" field.
+func ProcessedAtNEQ(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNEQ(FieldProcessedAt, v))
+}
+
+// ProcessedAtIn applies the In predicate on the "processed_at" field.
+func ProcessedAtIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIn(FieldProcessedAt, vs...))
+}
+
+// ProcessedAtNotIn applies the NotIn predicate on the "processed_at" field.
+func ProcessedAtNotIn(vs ...time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotIn(FieldProcessedAt, vs...))
+}
+
+// ProcessedAtGT applies the GT predicate on the "processed_at" field.
+func ProcessedAtGT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGT(FieldProcessedAt, v))
+}
+
+// ProcessedAtGTE applies the GTE predicate on the "processed_at" field.
+func ProcessedAtGTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldGTE(FieldProcessedAt, v))
+}
+
+// ProcessedAtLT applies the LT predicate on the "processed_at" field.
+func ProcessedAtLT(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLT(FieldProcessedAt, v))
+}
+
+// ProcessedAtLTE applies the LTE predicate on the "processed_at" field.
+func ProcessedAtLTE(v time.Time) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldLTE(FieldProcessedAt, v))
+}
+
+// ProcessedAtIsNil applies the IsNil predicate on the "processed_at" field.
+func ProcessedAtIsNil() predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldIsNull(FieldProcessedAt))
+}
+
+// ProcessedAtNotNil applies the NotNil predicate on the "processed_at" field.
+func ProcessedAtNotNil() predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.FieldNotNull(FieldProcessedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CheckInIntent) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CheckInIntent) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CheckInIntent) predicate.CheckInIntent {
+	return predicate.CheckInIntent(sql.NotPredicates(p))
+}
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkinintent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckInIntentCreate is the builder for creating a CheckInIntent entity.
+type CheckInIntentCreate struct {
+	config
+	mutation *CheckInIntentMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *CheckInIntentCreate) SetEventID(v uint64) *CheckInIntentCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_c *CheckInIntentCreate) SetUserAddress(v string) *CheckInIntentCreate {
+	_c.mutation.SetUserAddress(v)
+	return _c
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_c *CheckInIntentCreate) SetScannedAt(v time.Time) *CheckInIntentCreate {
+	_c.mutation.SetScannedAt(v)
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *CheckInIntentCreate) SetDeviceID(v string) *CheckInIntentCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CheckInIntentCreate) SetStatus(v checkinintent.Status) *CheckInIntentCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CheckInIntentCreate) SetNillableStatus(v *checkinintent.Status) *CheckInIntentCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *CheckInIntentCreate) SetAttempts(v int) *CheckInIntentCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *CheckInIntentCreate) SetNillableAttempts(v *int) *CheckInIntentCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *CheckInIntentCreate) SetLastError(v string) *CheckInIntentCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *CheckInIntentCreate) SetNillableLastError(v *string) *CheckInIntentCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *CheckInIntentCreate) SetNextAttemptAt(v time.Time) *CheckInIntentCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *CheckInIntentCreate) SetNillableNextAttemptAt(v *time.Time) *CheckInIntentCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CheckInIntentCreate) SetCreatedAt(v time.Time) *CheckInIntentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CheckInIntentCreate) SetNillableCreatedAt(v *time.Time) *CheckInIntentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CheckInIntentCreate) SetUpdatedAt(v time.Time) *CheckInIntentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CheckInIntentCreate) SetNillableUpdatedAt(v *time.Time) *CheckInIntentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_c *CheckInIntentCreate) SetProcessedAt(v time.Time) *CheckInIntentCreate {
+	_c.mutation.SetProcessedAt(v)
+	return _c
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_c *CheckInIntentCreate) SetNillableProcessedAt(v *time.Time) *CheckInIntentCreate {
+	if v != nil {
+		_c.SetProcessedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CheckInIntentMutation object of the builder.
+func (_c *CheckInIntentCreate) Mutation() *CheckInIntentMutation {
+	return _c.mutation
+}
+
+// Save creates the CheckInIntent in the database.
+func (_c *CheckInIntentCreate) Save(ctx context.Context) (*CheckInIntent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CheckInIntentCreate) SaveX(ctx context.Context) *CheckInIntent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CheckInIntentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CheckInIntentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CheckInIntentCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := checkinintent.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := checkinintent.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := checkinintent.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := checkinintent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := checkinintent.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CheckInIntentCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "CheckInIntent.event_id"`)}
+	}
+	if _, ok := _c.mutation.UserAddress(); !ok {
+		return &ValidationError{Name: "user_address", err: errors.New(`ent: missing required field "CheckInIntent.user_address"`)}
+	}
+	if _, ok := _c.mutation.ScannedAt(); !ok {
+		return &ValidationError{Name: "scanned_at", err: errors.New(`ent: missing required field "CheckInIntent.scanned_at"`)}
+	}
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "CheckInIntent.device_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CheckInIntent.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := checkinintent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CheckInIntent.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "CheckInIntent.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "CheckInIntent.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CheckInIntent.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CheckInIntent.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CheckInIntentCreate) sqlSave(ctx context.Context) (*CheckInIntent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CheckInIntentCreate) createSpec() (*CheckInIntent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CheckInIntent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(checkinintent.Table, sqlgraph.NewFieldSpec(checkinintent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(checkinintent.FieldEventID, field.TypeUint64, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.UserAddress(); ok {
+		_spec.SetField(checkinintent.FieldUserAddress, field.TypeString, value)
+		_node.UserAddress = value
+	}
+	if value, ok := _c.mutation.ScannedAt(); ok {
+		_spec.SetField(checkinintent.FieldScannedAt, field.TypeTime, value)
+		_node.ScannedAt = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(checkinintent.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(checkinintent.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(checkinintent.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(checkinintent.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(checkinintent.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(checkinintent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkinintent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ProcessedAt(); ok {
+		_spec.SetField(checkinintent.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	return _node, _spec
+}
+
+// CheckInIntentCreateBulk is the builder for creating many CheckInIntent entities in bulk.
+type CheckInIntentCreateBulk struct {
+	config
+	err      error
+	builders []*CheckInIntentCreate
+}
+
+// Save creates the CheckInIntent entities in the database.
+func (_c *CheckInIntentCreateBulk) Save(ctx context.Context) ([]*CheckInIntent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CheckInIntent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CheckInIntentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CheckInIntentCreateBulk) SaveX(ctx context.Context) []*CheckInIntent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CheckInIntentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CheckInIntentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
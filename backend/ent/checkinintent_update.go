@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkinintent"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckInIntentUpdate is the builder for updating CheckInIntent entities.
+type CheckInIntentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CheckInIntentMutation
+}
+
+// Where appends a list predicates to the CheckInIntentUpdate builder.
+func (_u *CheckInIntentUpdate) Where(ps ...predicate.CheckInIntent) *CheckInIntentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *CheckInIntentUpdate) SetEventID(v uint64) *CheckInIntentUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableEventID(v *uint64) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *CheckInIntentUpdate) AddEventID(v int64) *CheckInIntentUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_u *CheckInIntentUpdate) SetUserAddress(v string) *CheckInIntentUpdate {
+	_u.mutation.SetUserAddress(v)
+	return _u
+}
+
+// SetNillableUserAddress sets the "user_address" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableUserAddress(v *string) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetUserAddress(*v)
+	}
+	return _u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_u *CheckInIntentUpdate) SetScannedAt(v time.Time) *CheckInIntentUpdate {
+	_u.mutation.SetScannedAt(v)
+	return _u
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableScannedAt(v *time.Time) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetScannedAt(*v)
+	}
+	return _u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_u *CheckInIntentUpdate) SetDeviceID(v string) *CheckInIntentUpdate {
+	_u.mutation.SetDeviceID(v)
+	return _u
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableDeviceID(v *string) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetDeviceID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CheckInIntentUpdate) SetStatus(v checkinintent.Status) *CheckInIntentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableStatus(v *checkinintent.Status) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *CheckInIntentUpdate) SetAttempts(v int) *CheckInIntentUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableAttempts(v *int) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *CheckInIntentUpdate) AddAttempts(v int) *CheckInIntentUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CheckInIntentUpdate) SetLastError(v string) *CheckInIntentUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableLastError(v *string) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CheckInIntentUpdate) ClearLastError() *CheckInIntentUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *CheckInIntentUpdate) SetNextAttemptAt(v time.Time) *CheckInIntentUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableNextAttemptAt(v *time.Time) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CheckInIntentUpdate) SetUpdatedAt(v time.Time) *CheckInIntentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *CheckInIntentUpdate) SetProcessedAt(v time.Time) *CheckInIntentUpdate {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *CheckInIntentUpdate) SetNillableProcessedAt(v *time.Time) *CheckInIntentUpdate {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *CheckInIntentUpdate) ClearProcessedAt() *CheckInIntentUpdate {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// Mutation returns the CheckInIntentMutation object of the builder.
+func (_u *CheckInIntentUpdate) Mutation() *CheckInIntentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CheckInIntentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CheckInIntentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CheckInIntentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CheckInIntentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CheckInIntentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := checkinintent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CheckInIntentUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := checkinintent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CheckInIntent.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CheckInIntentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checkinintent.Table, checkinintent.Columns, sqlgraph.NewFieldSpec(checkinintent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(checkinintent.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(checkinintent.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.UserAddress(); ok {
+		_spec.SetField(checkinintent.FieldUserAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScannedAt(); ok {
+		_spec.SetField(checkinintent.FieldScannedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeviceID(); ok {
+		_spec.SetField(checkinintent.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(checkinintent.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(checkinintent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(checkinintent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(checkinintent.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(checkinintent.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(checkinintent.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkinintent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(checkinintent.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(checkinintent.FieldProcessedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkinintent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CheckInIntentUpdateOne is the builder for updating a single CheckInIntent entity.
+type CheckInIntentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CheckInIntentMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *CheckInIntentUpdateOne) SetEventID(v uint64) *CheckInIntentUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableEventID(v *uint64) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *CheckInIntentUpdateOne) AddEventID(v int64) *CheckInIntentUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_u *CheckInIntentUpdateOne) SetUserAddress(v string) *CheckInIntentUpdateOne {
+	_u.mutation.SetUserAddress(v)
+	return _u
+}
+
+// SetNillableUserAddress sets the "user_address" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableUserAddress(v *string) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetUserAddress(*v)
+	}
+	return _u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_u *CheckInIntentUpdateOne) SetScannedAt(v time.Time) *CheckInIntentUpdateOne {
+	_u.mutation.SetScannedAt(v)
+	return _u
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableScannedAt(v *time.Time) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetScannedAt(*v)
+	}
+	return _u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_u *CheckInIntentUpdateOne) SetDeviceID(v string) *CheckInIntentUpdateOne {
+	_u.mutation.SetDeviceID(v)
+	return _u
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableDeviceID(v *string) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetDeviceID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CheckInIntentUpdateOne) SetStatus(v checkinintent.Status) *CheckInIntentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableStatus(v *checkinintent.Status) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *CheckInIntentUpdateOne) SetAttempts(v int) *CheckInIntentUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableAttempts(v *int) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *CheckInIntentUpdateOne) AddAttempts(v int) *CheckInIntentUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CheckInIntentUpdateOne) SetLastError(v string) *CheckInIntentUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableLastError(v *string) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CheckInIntentUpdateOne) ClearLastError() *CheckInIntentUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *CheckInIntentUpdateOne) SetNextAttemptAt(v time.Time) *CheckInIntentUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableNextAttemptAt(v *time.Time) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CheckInIntentUpdateOne) SetUpdatedAt(v time.Time) *CheckInIntentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *CheckInIntentUpdateOne) SetProcessedAt(v time.Time) *CheckInIntentUpdateOne {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *CheckInIntentUpdateOne) SetNillableProcessedAt(v *time.Time) *CheckInIntentUpdateOne {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *CheckInIntentUpdateOne) ClearProcessedAt() *CheckInIntentUpdateOne {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// Mutation returns the CheckInIntentMutation object of the builder.
+func (_u *CheckInIntentUpdateOne) Mutation() *CheckInIntentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CheckInIntentUpdate builder.
+func (_u *CheckInIntentUpdateOne) Where(ps ...predicate.CheckInIntent) *CheckInIntentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CheckInIntentUpdateOne) Select(field string, fields ...string) *CheckInIntentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CheckInIntent entity.
+func (_u *CheckInIntentUpdateOne) Save(ctx context.Context) (*CheckInIntent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CheckInIntentUpdateOne) SaveX(ctx context.Context) *CheckInIntent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CheckInIntentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CheckInIntentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CheckInIntentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := checkinintent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CheckInIntentUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := checkinintent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CheckInIntent.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CheckInIntentUpdateOne) sqlSave(ctx context.Context) (_node *CheckInIntent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checkinintent.Table, checkinintent.Columns, sqlgraph.NewFieldSpec(checkinintent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CheckInIntent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checkinintent.FieldID)
+		for _, f := range fields {
+			if !checkinintent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != checkinintent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(checkinintent.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(checkinintent.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.UserAddress(); ok {
+		_spec.SetField(checkinintent.FieldUserAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScannedAt(); ok {
+		_spec.SetField(checkinintent.FieldScannedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeviceID(); ok {
+		_spec.SetField(checkinintent.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(checkinintent.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(checkinintent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(checkinintent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(checkinintent.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(checkinintent.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(checkinintent.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkinintent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(checkinintent.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(checkinintent.FieldProcessedAt, field.TypeTime)
+	}
+	_node = &CheckInIntent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkinintent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkintokenuse"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CheckInTokenUse is the model entity for the CheckInTokenUse schema.
+type CheckInTokenUse struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"nonce,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID uint64 `json:"event_id,omitempty"`
+	// UserAddress holds the value of the "user_address" field.
+	UserAddress string `json:"user_address,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt time.Time `json:"used_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CheckInTokenUse) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checkintokenuse.FieldID, checkintokenuse.FieldEventID:
+			values[i] = new(sql.NullInt64)
+		case checkintokenuse.FieldNonce, checkintokenuse.FieldUserAddress:
+			values[i] = new(sql.NullString)
+		case checkintokenuse.FieldUsedAt, checkintokenuse.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CheckInTokenUse fields.
+func (_m *CheckInTokenUse) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checkintokenuse.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case checkintokenuse.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				_m.Nonce = value.String
+			}
+		case checkintokenuse.FieldEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = uint64(value.Int64)
+			}
+		case checkintokenuse.FieldUserAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_address", values[i])
+			} else if value.Valid {
+				_m.UserAddress = value.String
+			}
+		case checkintokenuse.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = value.Time
+			}
+		case checkintokenuse.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CheckInTokenUse.
+// This includes values selected through modifiers, order, etc.
+func (_m *CheckInTokenUse) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CheckInTokenUse.
+// Note that you need to call CheckInTokenUse.Unwrap() before calling this method if this CheckInTokenUse
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CheckInTokenUse) Update() *CheckInTokenUseUpdateOne {
+	return NewCheckInTokenUseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CheckInTokenUse entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CheckInTokenUse) Unwrap() *CheckInTokenUse {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CheckInTokenUse is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CheckInTokenUse) String() string {
+	var builder strings.Builder
+	builder.WriteString("CheckInTokenUse(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("nonce=")
+	builder.WriteString(_m.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("user_address=")
+	builder.WriteString(_m.UserAddress)
+	builder.WriteString(", ")
+	builder.WriteString("used_at=")
+	builder.WriteString(_m.UsedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CheckInTokenUses is a parsable slice of CheckInTokenUse.
+type CheckInTokenUses []*CheckInTokenUse
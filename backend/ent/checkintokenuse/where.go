@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkintokenuse
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLTE(FieldID, id))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldNonce, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldEventID, v))
+}
+
+// UserAddress applies equality check predicate on the "user_address" field. It's identical to UserAddressEQ.
+func UserAddress(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldUserAddress, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uint64) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLTE(FieldEventID, v))
+}
+
+// UserAddressEQ applies the EQ predicate on the "user_address" field.
+func UserAddressEQ(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldUserAddress, v))
+}
+
+// UserAddressNEQ applies the NEQ predicate on the "user_address" field.
+func UserAddressNEQ(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNEQ(FieldUserAddress, v))
+}
+
+// UserAddressIn applies the In predicate on the "user_address" field.
+func UserAddressIn(vs ...string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldIn(FieldUserAddress, vs...))
+}
+
+// UserAddressNotIn applies the NotIn predicate on the "user_address" field.
+func UserAddressNotIn(vs ...string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNotIn(FieldUserAddress, vs...))
+}
+
+// UserAddressGT applies the GT predicate on the "user_address" field.
+func UserAddressGT(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGT(FieldUserAddress, v))
+}
+
+// UserAddressGTE applies the GTE predicate on the "user_address" field.
+func UserAddressGTE(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGTE(FieldUserAddress, v))
+}
+
+// UserAddressLT applies the LT predicate on the "user_address" field.
+func UserAddressLT(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLT(FieldUserAddress, v))
+}
+
+// UserAddressLTE applies the LTE predicate on the "user_address" field.
+func UserAddressLTE(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLTE(FieldUserAddress, v))
+}
+
+// UserAddressContains applies the Contains predicate on the "user_address" field.
+func UserAddressContains(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldContains(FieldUserAddress, v))
+}
+
+// UserAddressHasPrefix applies the HasPrefix predicate on the "user_address" field.
+func UserAddressHasPrefix(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldHasPrefix(FieldUserAddress, v))
+}
+
+// UserAddressHasSuffix applies the HasSuffix predicate on the "user_address" field.
+func UserAddressHasSuffix(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldHasSuffix(FieldUserAddress, v))
+}
+
+// UserAddressEqualFold applies the EqualFold predicate on the "user_address" field.
+func UserAddressEqualFold(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEqualFold(FieldUserAddress, v))
+}
+
+// UserAddressContainsFold applies the ContainsFold predicate on the "user_address" field.
+func UserAddressContainsFold(v string) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldContainsFold(FieldUserAddress, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CheckInTokenUse) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CheckInTokenUse) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CheckInTokenUse) predicate.CheckInTokenUse {
+	return predicate.CheckInTokenUse(sql.NotPredicates(p))
+}
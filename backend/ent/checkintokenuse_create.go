@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkintokenuse"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckInTokenUseCreate is the builder for creating a CheckInTokenUse entity.
+type CheckInTokenUseCreate struct {
+	config
+	mutation *CheckInTokenUseMutation
+	hooks    []Hook
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *CheckInTokenUseCreate) SetNonce(v string) *CheckInTokenUseCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *CheckInTokenUseCreate) SetEventID(v uint64) *CheckInTokenUseCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_c *CheckInTokenUseCreate) SetUserAddress(v string) *CheckInTokenUseCreate {
+	_c.mutation.SetUserAddress(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *CheckInTokenUseCreate) SetUsedAt(v time.Time) *CheckInTokenUseCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *CheckInTokenUseCreate) SetNillableUsedAt(v *time.Time) *CheckInTokenUseCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *CheckInTokenUseCreate) SetExpiresAt(v time.Time) *CheckInTokenUseCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// Mutation returns the CheckInTokenUseMutation object of the builder.
+func (_c *CheckInTokenUseCreate) Mutation() *CheckInTokenUseMutation {
+	return _c.mutation
+}
+
+// Save creates the CheckInTokenUse in the database.
+func (_c *CheckInTokenUseCreate) Save(ctx context.Context) (*CheckInTokenUse, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CheckInTokenUseCreate) SaveX(ctx context.Context) *CheckInTokenUse {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CheckInTokenUseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CheckInTokenUseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CheckInTokenUseCreate) defaults() {
+	if _, ok := _c.mutation.UsedAt(); !ok {
+		v := checkintokenuse.DefaultUsedAt()
+		_c.mutation.SetUsedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CheckInTokenUseCreate) check() error {
+	if _, ok := _c.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "CheckInTokenUse.nonce"`)}
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "CheckInTokenUse.event_id"`)}
+	}
+	if _, ok := _c.mutation.UserAddress(); !ok {
+		return &ValidationError{Name: "user_address", err: errors.New(`ent: missing required field "CheckInTokenUse.user_address"`)}
+	}
+	if _, ok := _c.mutation.UsedAt(); !ok {
+		return &ValidationError{Name: "used_at", err: errors.New(`ent: missing required field "CheckInTokenUse.used_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "CheckInTokenUse.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *CheckInTokenUseCreate) sqlSave(ctx context.Context) (*CheckInTokenUse, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CheckInTokenUseCreate) createSpec() (*CheckInTokenUse, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CheckInTokenUse{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(checkintokenuse.Table, sqlgraph.NewFieldSpec(checkintokenuse.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(checkintokenuse.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(checkintokenuse.FieldEventID, field.TypeUint64, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.UserAddress(); ok {
+		_spec.SetField(checkintokenuse.FieldUserAddress, field.TypeString, value)
+		_node.UserAddress = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(checkintokenuse.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(checkintokenuse.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// CheckInTokenUseCreateBulk is the builder for creating many CheckInTokenUse entities in bulk.
+type CheckInTokenUseCreateBulk struct {
+	config
+	err      error
+	builders []*CheckInTokenUseCreate
+}
+
+// Save creates the CheckInTokenUse entities in the database.
+func (_c *CheckInTokenUseCreateBulk) Save(ctx context.Context) ([]*CheckInTokenUse, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CheckInTokenUse, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CheckInTokenUseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CheckInTokenUseCreateBulk) SaveX(ctx context.Context) []*CheckInTokenUse {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CheckInTokenUseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CheckInTokenUseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
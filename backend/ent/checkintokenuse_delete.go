@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkintokenuse"
+	"backend/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckInTokenUseDelete is the builder for deleting a CheckInTokenUse entity.
+type CheckInTokenUseDelete struct {
+	config
+	hooks    []Hook
+	mutation *CheckInTokenUseMutation
+}
+
+// Where appends a list predicates to the CheckInTokenUseDelete builder.
+func (_d *CheckInTokenUseDelete) Where(ps ...predicate.CheckInTokenUse) *CheckInTokenUseDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CheckInTokenUseDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CheckInTokenUseDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CheckInTokenUseDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(checkintokenuse.Table, sqlgraph.NewFieldSpec(checkintokenuse.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CheckInTokenUseDeleteOne is the builder for deleting a single CheckInTokenUse entity.
+type CheckInTokenUseDeleteOne struct {
+	_d *CheckInTokenUseDelete
+}
+
+// Where appends a list predicates to the CheckInTokenUseDelete builder.
+func (_d *CheckInTokenUseDeleteOne) Where(ps ...predicate.CheckInTokenUse) *CheckInTokenUseDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CheckInTokenUseDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{checkintokenuse.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CheckInTokenUseDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
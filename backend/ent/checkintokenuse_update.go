@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkintokenuse"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckInTokenUseUpdate is the builder for updating CheckInTokenUse entities.
+type CheckInTokenUseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CheckInTokenUseMutation
+}
+
+// Where appends a list predicates to the CheckInTokenUseUpdate builder.
+func (_u *CheckInTokenUseUpdate) Where(ps ...predicate.CheckInTokenUse) *CheckInTokenUseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *CheckInTokenUseUpdate) SetNonce(v string) *CheckInTokenUseUpdate {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *CheckInTokenUseUpdate) SetNillableNonce(v *string) *CheckInTokenUseUpdate {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *CheckInTokenUseUpdate) SetEventID(v uint64) *CheckInTokenUseUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *CheckInTokenUseUpdate) SetNillableEventID(v *uint64) *CheckInTokenUseUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *CheckInTokenUseUpdate) AddEventID(v int64) *CheckInTokenUseUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_u *CheckInTokenUseUpdate) SetUserAddress(v string) *CheckInTokenUseUpdate {
+	_u.mutation.SetUserAddress(v)
+	return _u
+}
+
+// SetNillableUserAddress sets the "user_address" field if the given value is not nil.
+func (_u *CheckInTokenUseUpdate) SetNillableUserAddress(v *string) *CheckInTokenUseUpdate {
+	if v != nil {
+		_u.SetUserAddress(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *CheckInTokenUseUpdate) SetExpiresAt(v time.Time) *CheckInTokenUseUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *CheckInTokenUseUpdate) SetNillableExpiresAt(v *time.Time) *CheckInTokenUseUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CheckInTokenUseMutation object of the builder.
+func (_u *CheckInTokenUseUpdate) Mutation() *CheckInTokenUseMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CheckInTokenUseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CheckInTokenUseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CheckInTokenUseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CheckInTokenUseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CheckInTokenUseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(checkintokenuse.Table, checkintokenuse.Columns, sqlgraph.NewFieldSpec(checkintokenuse.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(checkintokenuse.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(checkintokenuse.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(checkintokenuse.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.UserAddress(); ok {
+		_spec.SetField(checkintokenuse.FieldUserAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(checkintokenuse.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkintokenuse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CheckInTokenUseUpdateOne is the builder for updating a single CheckInTokenUse entity.
+type CheckInTokenUseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CheckInTokenUseMutation
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *CheckInTokenUseUpdateOne) SetNonce(v string) *CheckInTokenUseUpdateOne {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *CheckInTokenUseUpdateOne) SetNillableNonce(v *string) *CheckInTokenUseUpdateOne {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *CheckInTokenUseUpdateOne) SetEventID(v uint64) *CheckInTokenUseUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *CheckInTokenUseUpdateOne) SetNillableEventID(v *uint64) *CheckInTokenUseUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *CheckInTokenUseUpdateOne) AddEventID(v int64) *CheckInTokenUseUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_u *CheckInTokenUseUpdateOne) SetUserAddress(v string) *CheckInTokenUseUpdateOne {
+	_u.mutation.SetUserAddress(v)
+	return _u
+}
+
+// SetNillableUserAddress sets the "user_address" field if the given value is not nil.
+func (_u *CheckInTokenUseUpdateOne) SetNillableUserAddress(v *string) *CheckInTokenUseUpdateOne {
+	if v != nil {
+		_u.SetUserAddress(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *CheckInTokenUseUpdateOne) SetExpiresAt(v time.Time) *CheckInTokenUseUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *CheckInTokenUseUpdateOne) SetNillableExpiresAt(v *time.Time) *CheckInTokenUseUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CheckInTokenUseMutation object of the builder.
+func (_u *CheckInTokenUseUpdateOne) Mutation() *CheckInTokenUseMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CheckInTokenUseUpdate builder.
+func (_u *CheckInTokenUseUpdateOne) Where(ps ...predicate.CheckInTokenUse) *CheckInTokenUseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CheckInTokenUseUpdateOne) Select(field string, fields ...string) *CheckInTokenUseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CheckInTokenUse entity.
+func (_u *CheckInTokenUseUpdateOne) Save(ctx context.Context) (*CheckInTokenUse, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CheckInTokenUseUpdateOne) SaveX(ctx context.Context) *CheckInTokenUse {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CheckInTokenUseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CheckInTokenUseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CheckInTokenUseUpdateOne) sqlSave(ctx context.Context) (_node *CheckInTokenUse, err error) {
+	_spec := sqlgraph.NewUpdateSpec(checkintokenuse.Table, checkintokenuse.Columns, sqlgraph.NewFieldSpec(checkintokenuse.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CheckInTokenUse.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checkintokenuse.FieldID)
+		for _, f := range fields {
+			if !checkintokenuse.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != checkintokenuse.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(checkintokenuse.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(checkintokenuse.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(checkintokenuse.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.UserAddress(); ok {
+		_spec.SetField(checkintokenuse.FieldUserAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(checkintokenuse.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &CheckInTokenUse{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkintokenuse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package claim
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the claim type in the database.
+	Label = "claim"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldSlot holds the string denoting the slot field in the database.
+	FieldSlot = "slot"
+	// FieldPoolSlot holds the string denoting the pool_slot field in the database.
+	FieldPoolSlot = "pool_slot"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCommittedAt holds the string denoting the committed_at field in the database.
+	FieldCommittedAt = "committed_at"
+	// Table holds the table name of the claim in the database.
+	Table = "claims"
+)
+
+// Columns holds all SQL columns for claim fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldScope,
+	FieldSubject,
+	FieldSlot,
+	FieldPoolSlot,
+	FieldStatus,
+	FieldReference,
+	FieldCreatedAt,
+	FieldExpiresAt,
+	FieldCommittedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusReserved is the default value of the Status enum.
+const DefaultStatus = StatusReserved
+
+// Status values.
+const (
+	StatusReserved  Status = "reserved"
+	StatusCommitted Status = "committed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusReserved, StatusCommitted:
+		return nil
+	default:
+		return fmt.Errorf("claim: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Claim queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// BySlot orders the results by the slot field.
+func BySlot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlot, opts...).ToFunc()
+}
+
+// ByPoolSlot orders the results by the pool_slot field.
+func ByPoolSlot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoolSlot, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCommittedAt orders the results by the committed_at field.
+func ByCommittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommittedAt, opts...).ToFunc()
+}
@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package claim
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldID, id))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldKind, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldScope, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldSubject, v))
+}
+
+// Slot applies equality check predicate on the "slot" field. It's identical to SlotEQ.
+func Slot(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldSlot, v))
+}
+
+// PoolSlot applies equality check predicate on the "pool_slot" field. It's identical to PoolSlotEQ.
+func PoolSlot(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldPoolSlot, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldReference, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CommittedAt applies equality check predicate on the "committed_at" field. It's identical to CommittedAtEQ.
+func CommittedAt(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldCommittedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldContainsFold(FieldScope, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// SlotEQ applies the EQ predicate on the "slot" field.
+func SlotEQ(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldSlot, v))
+}
+
+// SlotNEQ applies the NEQ predicate on the "slot" field.
+func SlotNEQ(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldSlot, v))
+}
+
+// SlotIn applies the In predicate on the "slot" field.
+func SlotIn(vs ...int) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldSlot, vs...))
+}
+
+// SlotNotIn applies the NotIn predicate on the "slot" field.
+func SlotNotIn(vs ...int) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldSlot, vs...))
+}
+
+// SlotGT applies the GT predicate on the "slot" field.
+func SlotGT(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldSlot, v))
+}
+
+// SlotGTE applies the GTE predicate on the "slot" field.
+func SlotGTE(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldSlot, v))
+}
+
+// SlotLT applies the LT predicate on the "slot" field.
+func SlotLT(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldSlot, v))
+}
+
+// SlotLTE applies the LTE predicate on the "slot" field.
+func SlotLTE(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldSlot, v))
+}
+
+// PoolSlotEQ applies the EQ predicate on the "pool_slot" field.
+func PoolSlotEQ(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldPoolSlot, v))
+}
+
+// PoolSlotNEQ applies the NEQ predicate on the "pool_slot" field.
+func PoolSlotNEQ(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldPoolSlot, v))
+}
+
+// PoolSlotIn applies the In predicate on the "pool_slot" field.
+func PoolSlotIn(vs ...int) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldPoolSlot, vs...))
+}
+
+// PoolSlotNotIn applies the NotIn predicate on the "pool_slot" field.
+func PoolSlotNotIn(vs ...int) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldPoolSlot, vs...))
+}
+
+// PoolSlotGT applies the GT predicate on the "pool_slot" field.
+func PoolSlotGT(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldPoolSlot, v))
+}
+
+// PoolSlotGTE applies the GTE predicate on the "pool_slot" field.
+func PoolSlotGTE(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldPoolSlot, v))
+}
+
+// PoolSlotLT applies the LT predicate on the "pool_slot" field.
+func PoolSlotLT(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldPoolSlot, v))
+}
+
+// PoolSlotLTE applies the LTE predicate on the "pool_slot" field.
+func PoolSlotLTE(v int) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldPoolSlot, v))
+}
+
+// PoolSlotIsNil applies the IsNil predicate on the "pool_slot" field.
+func PoolSlotIsNil() predicate.Claim {
+	return predicate.Claim(sql.FieldIsNull(FieldPoolSlot))
+}
+
+// PoolSlotNotNil applies the NotNil predicate on the "pool_slot" field.
+func PoolSlotNotNil() predicate.Claim {
+	return predicate.Claim(sql.FieldNotNull(FieldPoolSlot))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.Claim {
+	return predicate.Claim(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.Claim {
+	return predicate.Claim(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.Claim {
+	return predicate.Claim(sql.FieldContainsFold(FieldReference, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CommittedAtEQ applies the EQ predicate on the "committed_at" field.
+func CommittedAtEQ(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldEQ(FieldCommittedAt, v))
+}
+
+// CommittedAtNEQ applies the NEQ predicate on the "committed_at" field.
+func CommittedAtNEQ(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldNEQ(FieldCommittedAt, v))
+}
+
+// CommittedAtIn applies the In predicate on the "committed_at" field.
+func CommittedAtIn(vs ...time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldIn(FieldCommittedAt, vs...))
+}
+
+// CommittedAtNotIn applies the NotIn predicate on the "committed_at" field.
+func CommittedAtNotIn(vs ...time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldNotIn(FieldCommittedAt, vs...))
+}
+
+// CommittedAtGT applies the GT predicate on the "committed_at" field.
+func CommittedAtGT(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldGT(FieldCommittedAt, v))
+}
+
+// CommittedAtGTE applies the GTE predicate on the "committed_at" field.
+func CommittedAtGTE(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldGTE(FieldCommittedAt, v))
+}
+
+// CommittedAtLT applies the LT predicate on the "committed_at" field.
+func CommittedAtLT(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldLT(FieldCommittedAt, v))
+}
+
+// CommittedAtLTE applies the LTE predicate on the "committed_at" field.
+func CommittedAtLTE(v time.Time) predicate.Claim {
+	return predicate.Claim(sql.FieldLTE(FieldCommittedAt, v))
+}
+
+// CommittedAtIsNil applies the IsNil predicate on the "committed_at" field.
+func CommittedAtIsNil() predicate.Claim {
+	return predicate.Claim(sql.FieldIsNull(FieldCommittedAt))
+}
+
+// CommittedAtNotNil applies the NotNil predicate on the "committed_at" field.
+func CommittedAtNotNil() predicate.Claim {
+	return predicate.Claim(sql.FieldNotNull(FieldCommittedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Claim) predicate.Claim {
+	return predicate.Claim(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Claim) predicate.Claim {
+	return predicate.Claim(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Claim) predicate.Claim {
+	return predicate.Claim(sql.NotPredicates(p))
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/claim"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClaimCreate is the builder for creating a Claim entity.
+type ClaimCreate struct {
+	config
+	mutation *ClaimMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *ClaimCreate) SetKind(v string) *ClaimCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *ClaimCreate) SetScope(v string) *ClaimCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *ClaimCreate) SetSubject(v string) *ClaimCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetSlot sets the "slot" field.
+func (_c *ClaimCreate) SetSlot(v int) *ClaimCreate {
+	_c.mutation.SetSlot(v)
+	return _c
+}
+
+// SetPoolSlot sets the "pool_slot" field.
+func (_c *ClaimCreate) SetPoolSlot(v int) *ClaimCreate {
+	_c.mutation.SetPoolSlot(v)
+	return _c
+}
+
+// SetNillablePoolSlot sets the "pool_slot" field if the given value is not nil.
+func (_c *ClaimCreate) SetNillablePoolSlot(v *int) *ClaimCreate {
+	if v != nil {
+		_c.SetPoolSlot(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ClaimCreate) SetStatus(v claim.Status) *ClaimCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ClaimCreate) SetNillableStatus(v *claim.Status) *ClaimCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *ClaimCreate) SetReference(v string) *ClaimCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *ClaimCreate) SetNillableReference(v *string) *ClaimCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ClaimCreate) SetCreatedAt(v time.Time) *ClaimCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ClaimCreate) SetNillableCreatedAt(v *time.Time) *ClaimCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ClaimCreate) SetExpiresAt(v time.Time) *ClaimCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCommittedAt sets the "committed_at" field.
+func (_c *ClaimCreate) SetCommittedAt(v time.Time) *ClaimCreate {
+	_c.mutation.SetCommittedAt(v)
+	return _c
+}
+
+// SetNillableCommittedAt sets the "committed_at" field if the given value is not nil.
+func (_c *ClaimCreate) SetNillableCommittedAt(v *time.Time) *ClaimCreate {
+	if v != nil {
+		_c.SetCommittedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ClaimMutation object of the builder.
+func (_c *ClaimCreate) Mutation() *ClaimMutation {
+	return _c.mutation
+}
+
+// Save creates the Claim in the database.
+func (_c *ClaimCreate) Save(ctx context.Context) (*Claim, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClaimCreate) SaveX(ctx context.Context) *Claim {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClaimCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClaimCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClaimCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := claim.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := claim.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClaimCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Claim.kind"`)}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "Claim.scope"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "Claim.subject"`)}
+	}
+	if _, ok := _c.mutation.Slot(); !ok {
+		return &ValidationError{Name: "slot", err: errors.New(`ent: missing required field "Claim.slot"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Claim.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := claim.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Claim.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Claim.created_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Claim.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *ClaimCreate) sqlSave(ctx context.Context) (*Claim, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClaimCreate) createSpec() (*Claim, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Claim{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(claim.Table, sqlgraph.NewFieldSpec(claim.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(claim.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(claim.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(claim.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Slot(); ok {
+		_spec.SetField(claim.FieldSlot, field.TypeInt, value)
+		_node.Slot = value
+	}
+	if value, ok := _c.mutation.PoolSlot(); ok {
+		_spec.SetField(claim.FieldPoolSlot, field.TypeInt, value)
+		_node.PoolSlot = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(claim.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(claim.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(claim.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(claim.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CommittedAt(); ok {
+		_spec.SetField(claim.FieldCommittedAt, field.TypeTime, value)
+		_node.CommittedAt = &value
+	}
+	return _node, _spec
+}
+
+// ClaimCreateBulk is the builder for creating many Claim entities in bulk.
+type ClaimCreateBulk struct {
+	config
+	err      error
+	builders []*ClaimCreate
+}
+
+// Save creates the Claim entities in the database.
+func (_c *ClaimCreateBulk) Save(ctx context.Context) ([]*Claim, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Claim, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClaimMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClaimCreateBulk) SaveX(ctx context.Context) []*Claim {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClaimCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClaimCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/claim"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClaimUpdate is the builder for updating Claim entities.
+type ClaimUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClaimMutation
+}
+
+// Where appends a list predicates to the ClaimUpdate builder.
+func (_u *ClaimUpdate) Where(ps ...predicate.Claim) *ClaimUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ClaimUpdate) SetKind(v string) *ClaimUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillableKind(v *string) *ClaimUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *ClaimUpdate) SetScope(v string) *ClaimUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillableScope(v *string) *ClaimUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ClaimUpdate) SetSubject(v string) *ClaimUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillableSubject(v *string) *ClaimUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetSlot sets the "slot" field.
+func (_u *ClaimUpdate) SetSlot(v int) *ClaimUpdate {
+	_u.mutation.ResetSlot()
+	_u.mutation.SetSlot(v)
+	return _u
+}
+
+// SetNillableSlot sets the "slot" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillableSlot(v *int) *ClaimUpdate {
+	if v != nil {
+		_u.SetSlot(*v)
+	}
+	return _u
+}
+
+// AddSlot adds value to the "slot" field.
+func (_u *ClaimUpdate) AddSlot(v int) *ClaimUpdate {
+	_u.mutation.AddSlot(v)
+	return _u
+}
+
+// SetPoolSlot sets the "pool_slot" field.
+func (_u *ClaimUpdate) SetPoolSlot(v int) *ClaimUpdate {
+	_u.mutation.ResetPoolSlot()
+	_u.mutation.SetPoolSlot(v)
+	return _u
+}
+
+// SetNillablePoolSlot sets the "pool_slot" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillablePoolSlot(v *int) *ClaimUpdate {
+	if v != nil {
+		_u.SetPoolSlot(*v)
+	}
+	return _u
+}
+
+// AddPoolSlot adds value to the "pool_slot" field.
+func (_u *ClaimUpdate) AddPoolSlot(v int) *ClaimUpdate {
+	_u.mutation.AddPoolSlot(v)
+	return _u
+}
+
+// ClearPoolSlot clears the value of the "pool_slot" field.
+func (_u *ClaimUpdate) ClearPoolSlot() *ClaimUpdate {
+	_u.mutation.ClearPoolSlot()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ClaimUpdate) SetStatus(v claim.Status) *ClaimUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillableStatus(v *claim.Status) *ClaimUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *ClaimUpdate) SetReference(v string) *ClaimUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillableReference(v *string) *ClaimUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *ClaimUpdate) ClearReference() *ClaimUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ClaimUpdate) SetExpiresAt(v time.Time) *ClaimUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillableExpiresAt(v *time.Time) *ClaimUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCommittedAt sets the "committed_at" field.
+func (_u *ClaimUpdate) SetCommittedAt(v time.Time) *ClaimUpdate {
+	_u.mutation.SetCommittedAt(v)
+	return _u
+}
+
+// SetNillableCommittedAt sets the "committed_at" field if the given value is not nil.
+func (_u *ClaimUpdate) SetNillableCommittedAt(v *time.Time) *ClaimUpdate {
+	if v != nil {
+		_u.SetCommittedAt(*v)
+	}
+	return _u
+}
+
+// ClearCommittedAt clears the value of the "committed_at" field.
+func (_u *ClaimUpdate) ClearCommittedAt() *ClaimUpdate {
+	_u.mutation.ClearCommittedAt()
+	return _u
+}
+
+// Mutation returns the ClaimMutation object of the builder.
+func (_u *ClaimUpdate) Mutation() *ClaimMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClaimUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClaimUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClaimUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClaimUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClaimUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := claim.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Claim.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClaimUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(claim.Table, claim.Columns, sqlgraph.NewFieldSpec(claim.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(claim.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(claim.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(claim.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Slot(); ok {
+		_spec.SetField(claim.FieldSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlot(); ok {
+		_spec.AddField(claim.FieldSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PoolSlot(); ok {
+		_spec.SetField(claim.FieldPoolSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPoolSlot(); ok {
+		_spec.AddField(claim.FieldPoolSlot, field.TypeInt, value)
+	}
+	if _u.mutation.PoolSlotCleared() {
+		_spec.ClearField(claim.FieldPoolSlot, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(claim.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(claim.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(claim.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(claim.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CommittedAt(); ok {
+		_spec.SetField(claim.FieldCommittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CommittedAtCleared() {
+		_spec.ClearField(claim.FieldCommittedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{claim.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClaimUpdateOne is the builder for updating a single Claim entity.
+type ClaimUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClaimMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *ClaimUpdateOne) SetKind(v string) *ClaimUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillableKind(v *string) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *ClaimUpdateOne) SetScope(v string) *ClaimUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillableScope(v *string) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ClaimUpdateOne) SetSubject(v string) *ClaimUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillableSubject(v *string) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetSlot sets the "slot" field.
+func (_u *ClaimUpdateOne) SetSlot(v int) *ClaimUpdateOne {
+	_u.mutation.ResetSlot()
+	_u.mutation.SetSlot(v)
+	return _u
+}
+
+// SetNillableSlot sets the "slot" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillableSlot(v *int) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetSlot(*v)
+	}
+	return _u
+}
+
+// AddSlot adds value to the "slot" field.
+func (_u *ClaimUpdateOne) AddSlot(v int) *ClaimUpdateOne {
+	_u.mutation.AddSlot(v)
+	return _u
+}
+
+// SetPoolSlot sets the "pool_slot" field.
+func (_u *ClaimUpdateOne) SetPoolSlot(v int) *ClaimUpdateOne {
+	_u.mutation.ResetPoolSlot()
+	_u.mutation.SetPoolSlot(v)
+	return _u
+}
+
+// SetNillablePoolSlot sets the "pool_slot" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillablePoolSlot(v *int) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetPoolSlot(*v)
+	}
+	return _u
+}
+
+// AddPoolSlot adds value to the "pool_slot" field.
+func (_u *ClaimUpdateOne) AddPoolSlot(v int) *ClaimUpdateOne {
+	_u.mutation.AddPoolSlot(v)
+	return _u
+}
+
+// ClearPoolSlot clears the value of the "pool_slot" field.
+func (_u *ClaimUpdateOne) ClearPoolSlot() *ClaimUpdateOne {
+	_u.mutation.ClearPoolSlot()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ClaimUpdateOne) SetStatus(v claim.Status) *ClaimUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillableStatus(v *claim.Status) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *ClaimUpdateOne) SetReference(v string) *ClaimUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillableReference(v *string) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *ClaimUpdateOne) ClearReference() *ClaimUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ClaimUpdateOne) SetExpiresAt(v time.Time) *ClaimUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillableExpiresAt(v *time.Time) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCommittedAt sets the "committed_at" field.
+func (_u *ClaimUpdateOne) SetCommittedAt(v time.Time) *ClaimUpdateOne {
+	_u.mutation.SetCommittedAt(v)
+	return _u
+}
+
+// SetNillableCommittedAt sets the "committed_at" field if the given value is not nil.
+func (_u *ClaimUpdateOne) SetNillableCommittedAt(v *time.Time) *ClaimUpdateOne {
+	if v != nil {
+		_u.SetCommittedAt(*v)
+	}
+	return _u
+}
+
+// ClearCommittedAt clears the value of the "committed_at" field.
+func (_u *ClaimUpdateOne) ClearCommittedAt() *ClaimUpdateOne {
+	_u.mutation.ClearCommittedAt()
+	return _u
+}
+
+// Mutation returns the ClaimMutation object of the builder.
+func (_u *ClaimUpdateOne) Mutation() *ClaimMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ClaimUpdate builder.
+func (_u *ClaimUpdateOne) Where(ps ...predicate.Claim) *ClaimUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClaimUpdateOne) Select(field string, fields ...string) *ClaimUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Claim entity.
+func (_u *ClaimUpdateOne) Save(ctx context.Context) (*Claim, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClaimUpdateOne) SaveX(ctx context.Context) *Claim {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClaimUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClaimUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClaimUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := claim.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Claim.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClaimUpdateOne) sqlSave(ctx context.Context) (_node *Claim, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(claim.Table, claim.Columns, sqlgraph.NewFieldSpec(claim.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Claim.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, claim.FieldID)
+		for _, f := range fields {
+			if !claim.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != claim.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(claim.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(claim.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(claim.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Slot(); ok {
+		_spec.SetField(claim.FieldSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlot(); ok {
+		_spec.AddField(claim.FieldSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PoolSlot(); ok {
+		_spec.SetField(claim.FieldPoolSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPoolSlot(); ok {
+		_spec.AddField(claim.FieldPoolSlot, field.TypeInt, value)
+	}
+	if _u.mutation.PoolSlotCleared() {
+		_spec.ClearField(claim.FieldPoolSlot, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(claim.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(claim.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(claim.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(claim.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CommittedAt(); ok {
+		_spec.SetField(claim.FieldCommittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CommittedAtCleared() {
+		_spec.ClearField(claim.FieldCommittedAt, field.TypeTime)
+	}
+	_node = &Claim{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{claim.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
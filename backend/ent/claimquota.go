@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/claimquota"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ClaimQuota is the model entity for the ClaimQuota schema.
+type ClaimQuota struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope string `json:"scope,omitempty"`
+	// PerSubjectLimit holds the value of the "per_subject_limit" field.
+	PerSubjectLimit int `json:"per_subject_limit,omitempty"`
+	// TotalLimit holds the value of the "total_limit" field.
+	TotalLimit int `json:"total_limit,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClaimQuota) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case claimquota.FieldID, claimquota.FieldPerSubjectLimit, claimquota.FieldTotalLimit:
+			values[i] = new(sql.NullInt64)
+		case claimquota.FieldKind, claimquota.FieldScope:
+			values[i] = new(sql.NullString)
+		case claimquota.FieldStartsAt, claimquota.FieldEndsAt, claimquota.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClaimQuota fields.
+func (_m *ClaimQuota) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case claimquota.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case claimquota.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case claimquota.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = value.String
+			}
+		case claimquota.FieldPerSubjectLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field per_subject_limit", values[i])
+			} else if value.Valid {
+				_m.PerSubjectLimit = int(value.Int64)
+			}
+		case claimquota.FieldTotalLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_limit", values[i])
+			} else if value.Valid {
+				_m.TotalLimit = int(value.Int64)
+			}
+		case claimquota.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = new(time.Time)
+				*_m.StartsAt = value.Time
+			}
+		case claimquota.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				_m.EndsAt = new(time.Time)
+				*_m.EndsAt = value.Time
+			}
+		case claimquota.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClaimQuota.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClaimQuota) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClaimQuota.
+// Note that you need to call ClaimQuota.Unwrap() before calling this method if this ClaimQuota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClaimQuota) Update() *ClaimQuotaUpdateOne {
+	return NewClaimQuotaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClaimQuota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClaimQuota) Unwrap() *ClaimQuota {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClaimQuota is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClaimQuota) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClaimQuota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(_m.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("per_subject_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PerSubjectLimit))
+	builder.WriteString(", ")
+	builder.WriteString("total_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalLimit))
+	builder.WriteString(", ")
+	if v := _m.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClaimQuotaSlice is a parsable slice of ClaimQuota.
+type ClaimQuotaSlice []*ClaimQuota
@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package claimquota
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the claimquota type in the database.
+	Label = "claim_quota"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldPerSubjectLimit holds the string denoting the per_subject_limit field in the database.
+	FieldPerSubjectLimit = "per_subject_limit"
+	// FieldTotalLimit holds the string denoting the total_limit field in the database.
+	FieldTotalLimit = "total_limit"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the claimquota in the database.
+	Table = "claim_quota"
+)
+
+// Columns holds all SQL columns for claimquota fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldScope,
+	FieldPerSubjectLimit,
+	FieldTotalLimit,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPerSubjectLimit holds the default value on creation for the "per_subject_limit" field.
+	DefaultPerSubjectLimit int
+	// DefaultTotalLimit holds the default value on creation for the "total_limit" field.
+	DefaultTotalLimit int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ClaimQuota queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// ByPerSubjectLimit orders the results by the per_subject_limit field.
+func ByPerSubjectLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPerSubjectLimit, opts...).ToFunc()
+}
+
+// ByTotalLimit orders the results by the total_limit field.
+func ByTotalLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalLimit, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
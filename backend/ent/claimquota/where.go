@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package claimquota
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLTE(FieldID, id))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldKind, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldScope, v))
+}
+
+// PerSubjectLimit applies equality check predicate on the "per_subject_limit" field. It's identical to PerSubjectLimitEQ.
+func PerSubjectLimit(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldPerSubjectLimit, v))
+}
+
+// TotalLimit applies equality check predicate on the "total_limit" field. It's identical to TotalLimitEQ.
+func TotalLimit(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldTotalLimit, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldContainsFold(FieldScope, v))
+}
+
+// PerSubjectLimitEQ applies the EQ predicate on the "per_subject_limit" field.
+func PerSubjectLimitEQ(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldPerSubjectLimit, v))
+}
+
+// PerSubjectLimitNEQ applies the NEQ predicate on the "per_subject_limit" field.
+func PerSubjectLimitNEQ(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNEQ(FieldPerSubjectLimit, v))
+}
+
+// PerSubjectLimitIn applies the In predicate on the "per_subject_limit" field.
+func PerSubjectLimitIn(vs ...int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIn(FieldPerSubjectLimit, vs...))
+}
+
+// PerSubjectLimitNotIn applies the NotIn predicate on the "per_subject_limit" field.
+func PerSubjectLimitNotIn(vs ...int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotIn(FieldPerSubjectLimit, vs...))
+}
+
+// PerSubjectLimitGT applies the GT predicate on the "per_subject_limit" field.
+func PerSubjectLimitGT(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGT(FieldPerSubjectLimit, v))
+}
+
+// PerSubjectLimitGTE applies the GTE predicate on the "per_subject_limit" field.
+func PerSubjectLimitGTE(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGTE(FieldPerSubjectLimit, v))
+}
+
+// PerSubjectLimitLT applies the LT predicate on the "per_subject_limit" field.
+func PerSubjectLimitLT(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLT(FieldPerSubjectLimit, v))
+}
+
+// PerSubjectLimitLTE applies the LTE predicate on the "per_subject_limit" field.
+func PerSubjectLimitLTE(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLTE(FieldPerSubjectLimit, v))
+}
+
+// TotalLimitEQ applies the EQ predicate on the "total_limit" field.
+func TotalLimitEQ(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldTotalLimit, v))
+}
+
+// TotalLimitNEQ applies the NEQ predicate on the "total_limit" field.
+func TotalLimitNEQ(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNEQ(FieldTotalLimit, v))
+}
+
+// TotalLimitIn applies the In predicate on the "total_limit" field.
+func TotalLimitIn(vs ...int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIn(FieldTotalLimit, vs...))
+}
+
+// TotalLimitNotIn applies the NotIn predicate on the "total_limit" field.
+func TotalLimitNotIn(vs ...int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotIn(FieldTotalLimit, vs...))
+}
+
+// TotalLimitGT applies the GT predicate on the "total_limit" field.
+func TotalLimitGT(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGT(FieldTotalLimit, v))
+}
+
+// TotalLimitGTE applies the GTE predicate on the "total_limit" field.
+func TotalLimitGTE(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGTE(FieldTotalLimit, v))
+}
+
+// TotalLimitLT applies the LT predicate on the "total_limit" field.
+func TotalLimitLT(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLT(FieldTotalLimit, v))
+}
+
+// TotalLimitLTE applies the LTE predicate on the "total_limit" field.
+func TotalLimitLTE(v int) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLTE(FieldTotalLimit, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIsNull(FieldStartsAt))
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotNull(FieldStartsAt))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIsNull(FieldEndsAt))
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotNull(FieldEndsAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClaimQuota) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClaimQuota) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClaimQuota) predicate.ClaimQuota {
+	return predicate.ClaimQuota(sql.NotPredicates(p))
+}
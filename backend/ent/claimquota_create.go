@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/claimquota"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClaimQuotaCreate is the builder for creating a ClaimQuota entity.
+type ClaimQuotaCreate struct {
+	config
+	mutation *ClaimQuotaMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *ClaimQuotaCreate) SetKind(v string) *ClaimQuotaCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *ClaimQuotaCreate) SetScope(v string) *ClaimQuotaCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetPerSubjectLimit sets the "per_subject_limit" field.
+func (_c *ClaimQuotaCreate) SetPerSubjectLimit(v int) *ClaimQuotaCreate {
+	_c.mutation.SetPerSubjectLimit(v)
+	return _c
+}
+
+// SetNillablePerSubjectLimit sets the "per_subject_limit" field if the given value is not nil.
+func (_c *ClaimQuotaCreate) SetNillablePerSubjectLimit(v *int) *ClaimQuotaCreate {
+	if v != nil {
+		_c.SetPerSubjectLimit(*v)
+	}
+	return _c
+}
+
+// SetTotalLimit sets the "total_limit" field.
+func (_c *ClaimQuotaCreate) SetTotalLimit(v int) *ClaimQuotaCreate {
+	_c.mutation.SetTotalLimit(v)
+	return _c
+}
+
+// SetNillableTotalLimit sets the "total_limit" field if the given value is not nil.
+func (_c *ClaimQuotaCreate) SetNillableTotalLimit(v *int) *ClaimQuotaCreate {
+	if v != nil {
+		_c.SetTotalLimit(*v)
+	}
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *ClaimQuotaCreate) SetStartsAt(v time.Time) *ClaimQuotaCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_c *ClaimQuotaCreate) SetNillableStartsAt(v *time.Time) *ClaimQuotaCreate {
+	if v != nil {
+		_c.SetStartsAt(*v)
+	}
+	return _c
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_c *ClaimQuotaCreate) SetEndsAt(v time.Time) *ClaimQuotaCreate {
+	_c.mutation.SetEndsAt(v)
+	return _c
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_c *ClaimQuotaCreate) SetNillableEndsAt(v *time.Time) *ClaimQuotaCreate {
+	if v != nil {
+		_c.SetEndsAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ClaimQuotaCreate) SetUpdatedAt(v time.Time) *ClaimQuotaCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ClaimQuotaCreate) SetNillableUpdatedAt(v *time.Time) *ClaimQuotaCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ClaimQuotaMutation object of the builder.
+func (_c *ClaimQuotaCreate) Mutation() *ClaimQuotaMutation {
+	return _c.mutation
+}
+
+// Save creates the ClaimQuota in the database.
+func (_c *ClaimQuotaCreate) Save(ctx context.Context) (*ClaimQuota, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClaimQuotaCreate) SaveX(ctx context.Context) *ClaimQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClaimQuotaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClaimQuotaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClaimQuotaCreate) defaults() {
+	if _, ok := _c.mutation.PerSubjectLimit(); !ok {
+		v := claimquota.DefaultPerSubjectLimit
+		_c.mutation.SetPerSubjectLimit(v)
+	}
+	if _, ok := _c.mutation.TotalLimit(); !ok {
+		v := claimquota.DefaultTotalLimit
+		_c.mutation.SetTotalLimit(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := claimquota.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClaimQuotaCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ClaimQuota.kind"`)}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "ClaimQuota.scope"`)}
+	}
+	if _, ok := _c.mutation.PerSubjectLimit(); !ok {
+		return &ValidationError{Name: "per_subject_limit", err: errors.New(`ent: missing required field "ClaimQuota.per_subject_limit"`)}
+	}
+	if _, ok := _c.mutation.TotalLimit(); !ok {
+		return &ValidationError{Name: "total_limit", err: errors.New(`ent: missing required field "ClaimQuota.total_limit"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ClaimQuota.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ClaimQuotaCreate) sqlSave(ctx context.Context) (*ClaimQuota, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClaimQuotaCreate) createSpec() (*ClaimQuota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClaimQuota{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(claimquota.Table, sqlgraph.NewFieldSpec(claimquota.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(claimquota.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(claimquota.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.PerSubjectLimit(); ok {
+		_spec.SetField(claimquota.FieldPerSubjectLimit, field.TypeInt, value)
+		_node.PerSubjectLimit = value
+	}
+	if value, ok := _c.mutation.TotalLimit(); ok {
+		_spec.SetField(claimquota.FieldTotalLimit, field.TypeInt, value)
+		_node.TotalLimit = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(claimquota.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := _c.mutation.EndsAt(); ok {
+		_spec.SetField(claimquota.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(claimquota.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ClaimQuotaCreateBulk is the builder for creating many ClaimQuota entities in bulk.
+type ClaimQuotaCreateBulk struct {
+	config
+	err      error
+	builders []*ClaimQuotaCreate
+}
+
+// Save creates the ClaimQuota entities in the database.
+func (_c *ClaimQuotaCreateBulk) Save(ctx context.Context) ([]*ClaimQuota, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClaimQuota, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClaimQuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClaimQuotaCreateBulk) SaveX(ctx context.Context) []*ClaimQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClaimQuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClaimQuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/claimquota"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClaimQuotaUpdate is the builder for updating ClaimQuota entities.
+type ClaimQuotaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClaimQuotaMutation
+}
+
+// Where appends a list predicates to the ClaimQuotaUpdate builder.
+func (_u *ClaimQuotaUpdate) Where(ps ...predicate.ClaimQuota) *ClaimQuotaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ClaimQuotaUpdate) SetKind(v string) *ClaimQuotaUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ClaimQuotaUpdate) SetNillableKind(v *string) *ClaimQuotaUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *ClaimQuotaUpdate) SetScope(v string) *ClaimQuotaUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ClaimQuotaUpdate) SetNillableScope(v *string) *ClaimQuotaUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetPerSubjectLimit sets the "per_subject_limit" field.
+func (_u *ClaimQuotaUpdate) SetPerSubjectLimit(v int) *ClaimQuotaUpdate {
+	_u.mutation.ResetPerSubjectLimit()
+	_u.mutation.SetPerSubjectLimit(v)
+	return _u
+}
+
+// SetNillablePerSubjectLimit sets the "per_subject_limit" field if the given value is not nil.
+func (_u *ClaimQuotaUpdate) SetNillablePerSubjectLimit(v *int) *ClaimQuotaUpdate {
+	if v != nil {
+		_u.SetPerSubjectLimit(*v)
+	}
+	return _u
+}
+
+// AddPerSubjectLimit adds value to the "per_subject_limit" field.
+func (_u *ClaimQuotaUpdate) AddPerSubjectLimit(v int) *ClaimQuotaUpdate {
+	_u.mutation.AddPerSubjectLimit(v)
+	return _u
+}
+
+// SetTotalLimit sets the "total_limit" field.
+func (_u *ClaimQuotaUpdate) SetTotalLimit(v int) *ClaimQuotaUpdate {
+	_u.mutation.ResetTotalLimit()
+	_u.mutation.SetTotalLimit(v)
+	return _u
+}
+
+// SetNillableTotalLimit sets the "total_limit" field if the given value is not nil.
+func (_u *ClaimQuotaUpdate) SetNillableTotalLimit(v *int) *ClaimQuotaUpdate {
+	if v != nil {
+		_u.SetTotalLimit(*v)
+	}
+	return _u
+}
+
+// AddTotalLimit adds value to the "total_limit" field.
+func (_u *ClaimQuotaUpdate) AddTotalLimit(v int) *ClaimQuotaUpdate {
+	_u.mutation.AddTotalLimit(v)
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *ClaimQuotaUpdate) SetStartsAt(v time.Time) *ClaimQuotaUpdate {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *ClaimQuotaUpdate) SetNillableStartsAt(v *time.Time) *ClaimQuotaUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *ClaimQuotaUpdate) ClearStartsAt() *ClaimQuotaUpdate {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *ClaimQuotaUpdate) SetEndsAt(v time.Time) *ClaimQuotaUpdate {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *ClaimQuotaUpdate) SetNillableEndsAt(v *time.Time) *ClaimQuotaUpdate {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *ClaimQuotaUpdate) ClearEndsAt() *ClaimQuotaUpdate {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClaimQuotaUpdate) SetUpdatedAt(v time.Time) *ClaimQuotaUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ClaimQuotaMutation object of the builder.
+func (_u *ClaimQuotaUpdate) Mutation() *ClaimQuotaMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClaimQuotaUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClaimQuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClaimQuotaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClaimQuotaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ClaimQuotaUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := claimquota.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ClaimQuotaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(claimquota.Table, claimquota.Columns, sqlgraph.NewFieldSpec(claimquota.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(claimquota.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(claimquota.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PerSubjectLimit(); ok {
+		_spec.SetField(claimquota.FieldPerSubjectLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPerSubjectLimit(); ok {
+		_spec.AddField(claimquota.FieldPerSubjectLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalLimit(); ok {
+		_spec.SetField(claimquota.FieldTotalLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLimit(); ok {
+		_spec.AddField(claimquota.FieldTotalLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(claimquota.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(claimquota.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(claimquota.FieldEndsAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(claimquota.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(claimquota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{claimquota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClaimQuotaUpdateOne is the builder for updating a single ClaimQuota entity.
+type ClaimQuotaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClaimQuotaMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *ClaimQuotaUpdateOne) SetKind(v string) *ClaimQuotaUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ClaimQuotaUpdateOne) SetNillableKind(v *string) *ClaimQuotaUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *ClaimQuotaUpdateOne) SetScope(v string) *ClaimQuotaUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ClaimQuotaUpdateOne) SetNillableScope(v *string) *ClaimQuotaUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetPerSubjectLimit sets the "per_subject_limit" field.
+func (_u *ClaimQuotaUpdateOne) SetPerSubjectLimit(v int) *ClaimQuotaUpdateOne {
+	_u.mutation.ResetPerSubjectLimit()
+	_u.mutation.SetPerSubjectLimit(v)
+	return _u
+}
+
+// SetNillablePerSubjectLimit sets the "per_subject_limit" field if the given value is not nil.
+func (_u *ClaimQuotaUpdateOne) SetNillablePerSubjectLimit(v *int) *ClaimQuotaUpdateOne {
+	if v != nil {
+		_u.SetPerSubjectLimit(*v)
+	}
+	return _u
+}
+
+// AddPerSubjectLimit adds value to the "per_subject_limit" field.
+func (_u *ClaimQuotaUpdateOne) AddPerSubjectLimit(v int) *ClaimQuotaUpdateOne {
+	_u.mutation.AddPerSubjectLimit(v)
+	return _u
+}
+
+// SetTotalLimit sets the "total_limit" field.
+func (_u *ClaimQuotaUpdateOne) SetTotalLimit(v int) *ClaimQuotaUpdateOne {
+	_u.mutation.ResetTotalLimit()
+	_u.mutation.SetTotalLimit(v)
+	return _u
+}
+
+// SetNillableTotalLimit sets the "total_limit" field if the given value is not nil.
+func (_u *ClaimQuotaUpdateOne) SetNillableTotalLimit(v *int) *ClaimQuotaUpdateOne {
+	if v != nil {
+		_u.SetTotalLimit(*v)
+	}
+	return _u
+}
+
+// AddTotalLimit adds value to the "total_limit" field.
+func (_u *ClaimQuotaUpdateOne) AddTotalLimit(v int) *ClaimQuotaUpdateOne {
+	_u.mutation.AddTotalLimit(v)
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *ClaimQuotaUpdateOne) SetStartsAt(v time.Time) *ClaimQuotaUpdateOne {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *ClaimQuotaUpdateOne) SetNillableStartsAt(v *time.Time) *ClaimQuotaUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *ClaimQuotaUpdateOne) ClearStartsAt() *ClaimQuotaUpdateOne {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *ClaimQuotaUpdateOne) SetEndsAt(v time.Time) *ClaimQuotaUpdateOne {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *ClaimQuotaUpdateOne) SetNillableEndsAt(v *time.Time) *ClaimQuotaUpdateOne {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *ClaimQuotaUpdateOne) ClearEndsAt() *ClaimQuotaUpdateOne {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClaimQuotaUpdateOne) SetUpdatedAt(v time.Time) *ClaimQuotaUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ClaimQuotaMutation object of the builder.
+func (_u *ClaimQuotaUpdateOne) Mutation() *ClaimQuotaMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ClaimQuotaUpdate builder.
+func (_u *ClaimQuotaUpdateOne) Where(ps ...predicate.ClaimQuota) *ClaimQuotaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClaimQuotaUpdateOne) Select(field string, fields ...string) *ClaimQuotaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClaimQuota entity.
+func (_u *ClaimQuotaUpdateOne) Save(ctx context.Context) (*ClaimQuota, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClaimQuotaUpdateOne) SaveX(ctx context.Context) *ClaimQuota {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClaimQuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClaimQuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ClaimQuotaUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := claimquota.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ClaimQuotaUpdateOne) sqlSave(ctx context.Context) (_node *ClaimQuota, err error) {
+	_spec := sqlgraph.NewUpdateSpec(claimquota.Table, claimquota.Columns, sqlgraph.NewFieldSpec(claimquota.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClaimQuota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, claimquota.FieldID)
+		for _, f := range fields {
+			if !claimquota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != claimquota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(claimquota.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(claimquota.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PerSubjectLimit(); ok {
+		_spec.SetField(claimquota.FieldPerSubjectLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPerSubjectLimit(); ok {
+		_spec.AddField(claimquota.FieldPerSubjectLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalLimit(); ok {
+		_spec.SetField(claimquota.FieldTotalLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLimit(); ok {
+		_spec.AddField(claimquota.FieldTotalLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(claimquota.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(claimquota.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(claimquota.FieldEndsAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(claimquota.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(claimquota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ClaimQuota{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{claimquota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
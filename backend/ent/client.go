@@ -11,15 +11,39 @@ import (
 
 	"backend/ent/migrate"
 
+	"backend/ent/apikey"
+	"backend/ent/apikeyusage"
 	"backend/ent/attendance"
+	"backend/ent/authnonce"
+	"backend/ent/calendartoken"
+	"backend/ent/checkinintent"
+	"backend/ent/checkintokenuse"
+	"backend/ent/claim"
+	"backend/ent/claimquota"
 	"backend/ent/comment"
+	"backend/ent/dataversion"
 	"backend/ent/event"
+	"backend/ent/eventchange"
+	"backend/ent/eventimportrow"
 	"backend/ent/eventpass"
+	"backend/ent/eventseries"
+	"backend/ent/eventstaff"
+	"backend/ent/idempotencykey"
+	"backend/ent/invitecode"
+	"backend/ent/joinlink"
+	"backend/ent/kioskdevice"
 	"backend/ent/like"
 	"backend/ent/listing"
+	"backend/ent/locationfix"
+	"backend/ent/mintcredit"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
+	"backend/ent/notification"
+	"backend/ent/referral"
+	"backend/ent/seriesoccurrence"
+	"backend/ent/session"
 	"backend/ent/user"
+	"backend/ent/waitlistentry"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -32,24 +56,72 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// APIKeyUsage is the client for interacting with the APIKeyUsage builders.
+	APIKeyUsage *APIKeyUsageClient
 	// Attendance is the client for interacting with the Attendance builders.
 	Attendance *AttendanceClient
+	// AuthNonce is the client for interacting with the AuthNonce builders.
+	AuthNonce *AuthNonceClient
+	// CalendarToken is the client for interacting with the CalendarToken builders.
+	CalendarToken *CalendarTokenClient
+	// CheckInIntent is the client for interacting with the CheckInIntent builders.
+	CheckInIntent *CheckInIntentClient
+	// CheckInTokenUse is the client for interacting with the CheckInTokenUse builders.
+	CheckInTokenUse *CheckInTokenUseClient
+	// Claim is the client for interacting with the Claim builders.
+	Claim *ClaimClient
+	// ClaimQuota is the client for interacting with the ClaimQuota builders.
+	ClaimQuota *ClaimQuotaClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// DataVersion is the client for interacting with the DataVersion builders.
+	DataVersion *DataVersionClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
+	// EventChange is the client for interacting with the EventChange builders.
+	EventChange *EventChangeClient
+	// EventImportRow is the client for interacting with the EventImportRow builders.
+	EventImportRow *EventImportRowClient
 	// EventPass is the client for interacting with the EventPass builders.
 	EventPass *EventPassClient
+	// EventSeries is the client for interacting with the EventSeries builders.
+	EventSeries *EventSeriesClient
+	// EventStaff is the client for interacting with the EventStaff builders.
+	EventStaff *EventStaffClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// InviteCode is the client for interacting with the InviteCode builders.
+	InviteCode *InviteCodeClient
+	// JoinLink is the client for interacting with the JoinLink builders.
+	JoinLink *JoinLinkClient
+	// KioskDevice is the client for interacting with the KioskDevice builders.
+	KioskDevice *KioskDeviceClient
 	// Like is the client for interacting with the Like builders.
 	Like *LikeClient
 	// Listing is the client for interacting with the Listing builders.
 	Listing *ListingClient
+	// LocationFix is the client for interacting with the LocationFix builders.
+	LocationFix *LocationFixClient
+	// MintCredit is the client for interacting with the MintCredit builders.
+	MintCredit *MintCreditClient
 	// NFTAccessory is the client for interacting with the NFTAccessory builders.
 	NFTAccessory *NFTAccessoryClient
 	// NFTMoment is the client for interacting with the NFTMoment builders.
 	NFTMoment *NFTMomentClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// SeriesOccurrence is the client for interacting with the SeriesOccurrence builders.
+	SeriesOccurrence *SeriesOccurrenceClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -61,15 +133,39 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
+	c.APIKeyUsage = NewAPIKeyUsageClient(c.config)
 	c.Attendance = NewAttendanceClient(c.config)
+	c.AuthNonce = NewAuthNonceClient(c.config)
+	c.CalendarToken = NewCalendarTokenClient(c.config)
+	c.CheckInIntent = NewCheckInIntentClient(c.config)
+	c.CheckInTokenUse = NewCheckInTokenUseClient(c.config)
+	c.Claim = NewClaimClient(c.config)
+	c.ClaimQuota = NewClaimQuotaClient(c.config)
 	c.Comment = NewCommentClient(c.config)
+	c.DataVersion = NewDataVersionClient(c.config)
 	c.Event = NewEventClient(c.config)
+	c.EventChange = NewEventChangeClient(c.config)
+	c.EventImportRow = NewEventImportRowClient(c.config)
 	c.EventPass = NewEventPassClient(c.config)
+	c.EventSeries = NewEventSeriesClient(c.config)
+	c.EventStaff = NewEventStaffClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.InviteCode = NewInviteCodeClient(c.config)
+	c.JoinLink = NewJoinLinkClient(c.config)
+	c.KioskDevice = NewKioskDeviceClient(c.config)
 	c.Like = NewLikeClient(c.config)
 	c.Listing = NewListingClient(c.config)
+	c.LocationFix = NewLocationFixClient(c.config)
+	c.MintCredit = NewMintCreditClient(c.config)
 	c.NFTAccessory = NewNFTAccessoryClient(c.config)
 	c.NFTMoment = NewNFTMomentClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
+	c.Referral = NewReferralClient(c.config)
+	c.SeriesOccurrence = NewSeriesOccurrenceClient(c.config)
+	c.Session = NewSessionClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WaitlistEntry = NewWaitlistEntryClient(c.config)
 }
 
 type (
@@ -160,17 +256,41 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Attendance:   NewAttendanceClient(cfg),
-		Comment:      NewCommentClient(cfg),
-		Event:        NewEventClient(cfg),
-		EventPass:    NewEventPassClient(cfg),
-		Like:         NewLikeClient(cfg),
-		Listing:      NewListingClient(cfg),
-		NFTAccessory: NewNFTAccessoryClient(cfg),
-		NFTMoment:    NewNFTMomentClient(cfg),
-		User:         NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		APIKey:           NewAPIKeyClient(cfg),
+		APIKeyUsage:      NewAPIKeyUsageClient(cfg),
+		Attendance:       NewAttendanceClient(cfg),
+		AuthNonce:        NewAuthNonceClient(cfg),
+		CalendarToken:    NewCalendarTokenClient(cfg),
+		CheckInIntent:    NewCheckInIntentClient(cfg),
+		CheckInTokenUse:  NewCheckInTokenUseClient(cfg),
+		Claim:            NewClaimClient(cfg),
+		ClaimQuota:       NewClaimQuotaClient(cfg),
+		Comment:          NewCommentClient(cfg),
+		DataVersion:      NewDataVersionClient(cfg),
+		Event:            NewEventClient(cfg),
+		EventChange:      NewEventChangeClient(cfg),
+		EventImportRow:   NewEventImportRowClient(cfg),
+		EventPass:        NewEventPassClient(cfg),
+		EventSeries:      NewEventSeriesClient(cfg),
+		EventStaff:       NewEventStaffClient(cfg),
+		IdempotencyKey:   NewIdempotencyKeyClient(cfg),
+		InviteCode:       NewInviteCodeClient(cfg),
+		JoinLink:         NewJoinLinkClient(cfg),
+		KioskDevice:      NewKioskDeviceClient(cfg),
+		Like:             NewLikeClient(cfg),
+		Listing:          NewListingClient(cfg),
+		LocationFix:      NewLocationFixClient(cfg),
+		MintCredit:       NewMintCreditClient(cfg),
+		NFTAccessory:     NewNFTAccessoryClient(cfg),
+		NFTMoment:        NewNFTMomentClient(cfg),
+		Notification:     NewNotificationClient(cfg),
+		Referral:         NewReferralClient(cfg),
+		SeriesOccurrence: NewSeriesOccurrenceClient(cfg),
+		Session:          NewSessionClient(cfg),
+		User:             NewUserClient(cfg),
+		WaitlistEntry:    NewWaitlistEntryClient(cfg),
 	}, nil
 }
 
@@ -188,24 +308,48 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Attendance:   NewAttendanceClient(cfg),
-		Comment:      NewCommentClient(cfg),
-		Event:        NewEventClient(cfg),
-		EventPass:    NewEventPassClient(cfg),
-		Like:         NewLikeClient(cfg),
-		Listing:      NewListingClient(cfg),
-		NFTAccessory: NewNFTAccessoryClient(cfg),
-		NFTMoment:    NewNFTMomentClient(cfg),
-		User:         NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		APIKey:           NewAPIKeyClient(cfg),
+		APIKeyUsage:      NewAPIKeyUsageClient(cfg),
+		Attendance:       NewAttendanceClient(cfg),
+		AuthNonce:        NewAuthNonceClient(cfg),
+		CalendarToken:    NewCalendarTokenClient(cfg),
+		CheckInIntent:    NewCheckInIntentClient(cfg),
+		CheckInTokenUse:  NewCheckInTokenUseClient(cfg),
+		Claim:            NewClaimClient(cfg),
+		ClaimQuota:       NewClaimQuotaClient(cfg),
+		Comment:          NewCommentClient(cfg),
+		DataVersion:      NewDataVersionClient(cfg),
+		Event:            NewEventClient(cfg),
+		EventChange:      NewEventChangeClient(cfg),
+		EventImportRow:   NewEventImportRowClient(cfg),
+		EventPass:        NewEventPassClient(cfg),
+		EventSeries:      NewEventSeriesClient(cfg),
+		EventStaff:       NewEventStaffClient(cfg),
+		IdempotencyKey:   NewIdempotencyKeyClient(cfg),
+		InviteCode:       NewInviteCodeClient(cfg),
+		JoinLink:         NewJoinLinkClient(cfg),
+		KioskDevice:      NewKioskDeviceClient(cfg),
+		Like:             NewLikeClient(cfg),
+		Listing:          NewListingClient(cfg),
+		LocationFix:      NewLocationFixClient(cfg),
+		MintCredit:       NewMintCreditClient(cfg),
+		NFTAccessory:     NewNFTAccessoryClient(cfg),
+		NFTMoment:        NewNFTMomentClient(cfg),
+		Notification:     NewNotificationClient(cfg),
+		Referral:         NewReferralClient(cfg),
+		SeriesOccurrence: NewSeriesOccurrenceClient(cfg),
+		Session:          NewSessionClient(cfg),
+		User:             NewUserClient(cfg),
+		WaitlistEntry:    NewWaitlistEntryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Attendance.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -228,8 +372,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Attendance, c.Comment, c.Event, c.EventPass, c.Like, c.Listing,
-		c.NFTAccessory, c.NFTMoment, c.User,
+		c.APIKey, c.APIKeyUsage, c.Attendance, c.AuthNonce, c.CalendarToken,
+		c.CheckInIntent, c.CheckInTokenUse, c.Claim, c.ClaimQuota, c.Comment,
+		c.DataVersion, c.Event, c.EventChange, c.EventImportRow, c.EventPass,
+		c.EventSeries, c.EventStaff, c.IdempotencyKey, c.InviteCode, c.JoinLink,
+		c.KioskDevice, c.Like, c.Listing, c.LocationFix, c.MintCredit, c.NFTAccessory,
+		c.NFTMoment, c.Notification, c.Referral, c.SeriesOccurrence, c.Session, c.User,
+		c.WaitlistEntry,
 	} {
 		n.Use(hooks...)
 	}
@@ -239,8 +388,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Attendance, c.Comment, c.Event, c.EventPass, c.Like, c.Listing,
-		c.NFTAccessory, c.NFTMoment, c.User,
+		c.APIKey, c.APIKeyUsage, c.Attendance, c.AuthNonce, c.CalendarToken,
+		c.CheckInIntent, c.CheckInTokenUse, c.Claim, c.ClaimQuota, c.Comment,
+		c.DataVersion, c.Event, c.EventChange, c.EventImportRow, c.EventPass,
+		c.EventSeries, c.EventStaff, c.IdempotencyKey, c.InviteCode, c.JoinLink,
+		c.KioskDevice, c.Like, c.Listing, c.LocationFix, c.MintCredit, c.NFTAccessory,
+		c.NFTMoment, c.Notification, c.Referral, c.SeriesOccurrence, c.Session, c.User,
+		c.WaitlistEntry,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -249,29 +403,343 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
+	case *APIKeyUsageMutation:
+		return c.APIKeyUsage.mutate(ctx, m)
 	case *AttendanceMutation:
 		return c.Attendance.mutate(ctx, m)
+	case *AuthNonceMutation:
+		return c.AuthNonce.mutate(ctx, m)
+	case *CalendarTokenMutation:
+		return c.CalendarToken.mutate(ctx, m)
+	case *CheckInIntentMutation:
+		return c.CheckInIntent.mutate(ctx, m)
+	case *CheckInTokenUseMutation:
+		return c.CheckInTokenUse.mutate(ctx, m)
+	case *ClaimMutation:
+		return c.Claim.mutate(ctx, m)
+	case *ClaimQuotaMutation:
+		return c.ClaimQuota.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
+	case *DataVersionMutation:
+		return c.DataVersion.mutate(ctx, m)
 	case *EventMutation:
 		return c.Event.mutate(ctx, m)
+	case *EventChangeMutation:
+		return c.EventChange.mutate(ctx, m)
+	case *EventImportRowMutation:
+		return c.EventImportRow.mutate(ctx, m)
 	case *EventPassMutation:
 		return c.EventPass.mutate(ctx, m)
+	case *EventSeriesMutation:
+		return c.EventSeries.mutate(ctx, m)
+	case *EventStaffMutation:
+		return c.EventStaff.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *InviteCodeMutation:
+		return c.InviteCode.mutate(ctx, m)
+	case *JoinLinkMutation:
+		return c.JoinLink.mutate(ctx, m)
+	case *KioskDeviceMutation:
+		return c.KioskDevice.mutate(ctx, m)
 	case *LikeMutation:
 		return c.Like.mutate(ctx, m)
 	case *ListingMutation:
 		return c.Listing.mutate(ctx, m)
+	case *LocationFixMutation:
+		return c.LocationFix.mutate(ctx, m)
+	case *MintCreditMutation:
+		return c.MintCredit.mutate(ctx, m)
 	case *NFTAccessoryMutation:
 		return c.NFTAccessory.mutate(ctx, m)
 	case *NFTMomentMutation:
 		return c.NFTMoment.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
+	case *ReferralMutation:
+		return c.Referral.mutate(ctx, m)
+	case *SeriesOccurrenceMutation:
+		return c.SeriesOccurrence.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *WaitlistEntryMutation:
+		return c.WaitlistEntry.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(_m *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(_m))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id int) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(_m *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id int) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id int) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id int) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
+// APIKeyUsageClient is a client for the APIKeyUsage schema.
+type APIKeyUsageClient struct {
+	config
+}
+
+// NewAPIKeyUsageClient returns a client for the APIKeyUsage from the given config.
+func NewAPIKeyUsageClient(c config) *APIKeyUsageClient {
+	return &APIKeyUsageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikeyusage.Hooks(f(g(h())))`.
+func (c *APIKeyUsageClient) Use(hooks ...Hook) {
+	c.hooks.APIKeyUsage = append(c.hooks.APIKeyUsage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikeyusage.Intercept(f(g(h())))`.
+func (c *APIKeyUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKeyUsage = append(c.inters.APIKeyUsage, interceptors...)
+}
+
+// Create returns a builder for creating a APIKeyUsage entity.
+func (c *APIKeyUsageClient) Create() *APIKeyUsageCreate {
+	mutation := newAPIKeyUsageMutation(c.config, OpCreate)
+	return &APIKeyUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKeyUsage entities.
+func (c *APIKeyUsageClient) CreateBulk(builders ...*APIKeyUsageCreate) *APIKeyUsageCreateBulk {
+	return &APIKeyUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyUsageClient) MapCreateBulk(slice any, setFunc func(*APIKeyUsageCreate, int)) *APIKeyUsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyUsageCreateBulk{err: fmt.Errorf("calling to APIKeyUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyUsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKeyUsage.
+func (c *APIKeyUsageClient) Update() *APIKeyUsageUpdate {
+	mutation := newAPIKeyUsageMutation(c.config, OpUpdate)
+	return &APIKeyUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyUsageClient) UpdateOne(_m *APIKeyUsage) *APIKeyUsageUpdateOne {
+	mutation := newAPIKeyUsageMutation(c.config, OpUpdateOne, withAPIKeyUsage(_m))
+	return &APIKeyUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyUsageClient) UpdateOneID(id int) *APIKeyUsageUpdateOne {
+	mutation := newAPIKeyUsageMutation(c.config, OpUpdateOne, withAPIKeyUsageID(id))
+	return &APIKeyUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKeyUsage.
+func (c *APIKeyUsageClient) Delete() *APIKeyUsageDelete {
+	mutation := newAPIKeyUsageMutation(c.config, OpDelete)
+	return &APIKeyUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyUsageClient) DeleteOne(_m *APIKeyUsage) *APIKeyUsageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyUsageClient) DeleteOneID(id int) *APIKeyUsageDeleteOne {
+	builder := c.Delete().Where(apikeyusage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyUsageDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKeyUsage.
+func (c *APIKeyUsageClient) Query() *APIKeyUsageQuery {
+	return &APIKeyUsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKeyUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKeyUsage entity by its id.
+func (c *APIKeyUsageClient) Get(ctx context.Context, id int) (*APIKeyUsage, error) {
+	return c.Query().Where(apikeyusage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyUsageClient) GetX(ctx context.Context, id int) *APIKeyUsage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyUsageClient) Hooks() []Hook {
+	return c.hooks.APIKeyUsage
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyUsageClient) Interceptors() []Interceptor {
+	return c.inters.APIKeyUsage
+}
+
+func (c *APIKeyUsageClient) mutate(ctx context.Context, m *APIKeyUsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIKeyUsage mutation op: %q", m.Op())
+	}
+}
+
 // AttendanceClient is a client for the Attendance schema.
 type AttendanceClient struct {
 	config
@@ -437,107 +905,107 @@ func (c *AttendanceClient) mutate(ctx context.Context, m *AttendanceMutation) (V
 	}
 }
 
-// CommentClient is a client for the Comment schema.
-type CommentClient struct {
+// AuthNonceClient is a client for the AuthNonce schema.
+type AuthNonceClient struct {
 	config
 }
 
-// NewCommentClient returns a client for the Comment from the given config.
-func NewCommentClient(c config) *CommentClient {
-	return &CommentClient{config: c}
+// NewAuthNonceClient returns a client for the AuthNonce from the given config.
+func NewAuthNonceClient(c config) *AuthNonceClient {
+	return &AuthNonceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
-func (c *CommentClient) Use(hooks ...Hook) {
-	c.hooks.Comment = append(c.hooks.Comment, hooks...)
+// A call to `Use(f, g, h)` equals to `authnonce.Hooks(f(g(h())))`.
+func (c *AuthNonceClient) Use(hooks ...Hook) {
+	c.hooks.AuthNonce = append(c.hooks.AuthNonce, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
-func (c *CommentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Comment = append(c.inters.Comment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `authnonce.Intercept(f(g(h())))`.
+func (c *AuthNonceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuthNonce = append(c.inters.AuthNonce, interceptors...)
 }
 
-// Create returns a builder for creating a Comment entity.
-func (c *CommentClient) Create() *CommentCreate {
-	mutation := newCommentMutation(c.config, OpCreate)
-	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuthNonce entity.
+func (c *AuthNonceClient) Create() *AuthNonceCreate {
+	mutation := newAuthNonceMutation(c.config, OpCreate)
+	return &AuthNonceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Comment entities.
-func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
-	return &CommentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuthNonce entities.
+func (c *AuthNonceClient) CreateBulk(builders ...*AuthNonceCreate) *AuthNonceCreateBulk {
+	return &AuthNonceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
+func (c *AuthNonceClient) MapCreateBulk(slice any, setFunc func(*AuthNonceCreate, int)) *AuthNonceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuthNonceCreateBulk{err: fmt.Errorf("calling to AuthNonceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CommentCreate, rv.Len())
+	builders := make([]*AuthNonceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CommentCreateBulk{config: c.config, builders: builders}
+	return &AuthNonceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Comment.
-func (c *CommentClient) Update() *CommentUpdate {
-	mutation := newCommentMutation(c.config, OpUpdate)
-	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuthNonce.
+func (c *AuthNonceClient) Update() *AuthNonceUpdate {
+	mutation := newAuthNonceMutation(c.config, OpUpdate)
+	return &AuthNonceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CommentClient) UpdateOne(_m *Comment) *CommentUpdateOne {
-	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(_m))
-	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuthNonceClient) UpdateOne(_m *AuthNonce) *AuthNonceUpdateOne {
+	mutation := newAuthNonceMutation(c.config, OpUpdateOne, withAuthNonce(_m))
+	return &AuthNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
-	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
-	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuthNonceClient) UpdateOneID(id int) *AuthNonceUpdateOne {
+	mutation := newAuthNonceMutation(c.config, OpUpdateOne, withAuthNonceID(id))
+	return &AuthNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Comment.
-func (c *CommentClient) Delete() *CommentDelete {
-	mutation := newCommentMutation(c.config, OpDelete)
-	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuthNonce.
+func (c *AuthNonceClient) Delete() *AuthNonceDelete {
+	mutation := newAuthNonceMutation(c.config, OpDelete)
+	return &AuthNonceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CommentClient) DeleteOne(_m *Comment) *CommentDeleteOne {
+func (c *AuthNonceClient) DeleteOne(_m *AuthNonce) *AuthNonceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CommentClient) DeleteOneID(id int) *CommentDeleteOne {
-	builder := c.Delete().Where(comment.ID(id))
+func (c *AuthNonceClient) DeleteOneID(id int) *AuthNonceDeleteOne {
+	builder := c.Delete().Where(authnonce.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CommentDeleteOne{builder}
+	return &AuthNonceDeleteOne{builder}
 }
 
-// Query returns a query builder for Comment.
-func (c *CommentClient) Query() *CommentQuery {
-	return &CommentQuery{
+// Query returns a query builder for AuthNonce.
+func (c *AuthNonceClient) Query() *AuthNonceQuery {
+	return &AuthNonceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeComment},
+		ctx:    &QueryContext{Type: TypeAuthNonce},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Comment entity by its id.
-func (c *CommentClient) Get(ctx context.Context, id int) (*Comment, error) {
-	return c.Query().Where(comment.ID(id)).Only(ctx)
+// Get returns a AuthNonce entity by its id.
+func (c *AuthNonceClient) Get(ctx context.Context, id int) (*AuthNonce, error) {
+	return c.Query().Where(authnonce.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
+func (c *AuthNonceClient) GetX(ctx context.Context, id int) *AuthNonce {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -545,164 +1013,3117 @@ func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
 	return obj
 }
 
-// QueryUser queries the user edge of a Comment.
-func (c *CommentClient) QueryUser(_m *Comment) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(comment.Table, comment.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, comment.UserTable, comment.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMoment queries the moment edge of a Comment.
-func (c *CommentClient) QueryMoment(_m *Comment) *NFTMomentQuery {
-	query := (&NFTMomentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(comment.Table, comment.FieldID, id),
-			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, comment.MomentTable, comment.MomentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *CommentClient) Hooks() []Hook {
-	return c.hooks.Comment
+func (c *AuthNonceClient) Hooks() []Hook {
+	return c.hooks.AuthNonce
 }
 
 // Interceptors returns the client interceptors.
-func (c *CommentClient) Interceptors() []Interceptor {
-	return c.inters.Comment
+func (c *AuthNonceClient) Interceptors() []Interceptor {
+	return c.inters.AuthNonce
 }
 
-func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
+func (c *AuthNonceClient) mutate(ctx context.Context, m *AuthNonceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuthNonceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuthNonceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuthNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuthNonceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuthNonce mutation op: %q", m.Op())
 	}
 }
 
-// EventClient is a client for the Event schema.
-type EventClient struct {
+// CalendarTokenClient is a client for the CalendarToken schema.
+type CalendarTokenClient struct {
 	config
 }
 
-// NewEventClient returns a client for the Event from the given config.
-func NewEventClient(c config) *EventClient {
-	return &EventClient{config: c}
+// NewCalendarTokenClient returns a client for the CalendarToken from the given config.
+func NewCalendarTokenClient(c config) *CalendarTokenClient {
+	return &CalendarTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `event.Hooks(f(g(h())))`.
-func (c *EventClient) Use(hooks ...Hook) {
-	c.hooks.Event = append(c.hooks.Event, hooks...)
+// A call to `Use(f, g, h)` equals to `calendartoken.Hooks(f(g(h())))`.
+func (c *CalendarTokenClient) Use(hooks ...Hook) {
+	c.hooks.CalendarToken = append(c.hooks.CalendarToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `event.Intercept(f(g(h())))`.
-func (c *EventClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Event = append(c.inters.Event, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `calendartoken.Intercept(f(g(h())))`.
+func (c *CalendarTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CalendarToken = append(c.inters.CalendarToken, interceptors...)
 }
 
-// Create returns a builder for creating a Event entity.
-func (c *EventClient) Create() *EventCreate {
-	mutation := newEventMutation(c.config, OpCreate)
-	return &EventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CalendarToken entity.
+func (c *CalendarTokenClient) Create() *CalendarTokenCreate {
+	mutation := newCalendarTokenMutation(c.config, OpCreate)
+	return &CalendarTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Event entities.
+// CreateBulk returns a builder for creating a bulk of CalendarToken entities.
+func (c *CalendarTokenClient) CreateBulk(builders ...*CalendarTokenCreate) *CalendarTokenCreateBulk {
+	return &CalendarTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CalendarTokenClient) MapCreateBulk(slice any, setFunc func(*CalendarTokenCreate, int)) *CalendarTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CalendarTokenCreateBulk{err: fmt.Errorf("calling to CalendarTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CalendarTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CalendarTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CalendarToken.
+func (c *CalendarTokenClient) Update() *CalendarTokenUpdate {
+	mutation := newCalendarTokenMutation(c.config, OpUpdate)
+	return &CalendarTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CalendarTokenClient) UpdateOne(_m *CalendarToken) *CalendarTokenUpdateOne {
+	mutation := newCalendarTokenMutation(c.config, OpUpdateOne, withCalendarToken(_m))
+	return &CalendarTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CalendarTokenClient) UpdateOneID(id int) *CalendarTokenUpdateOne {
+	mutation := newCalendarTokenMutation(c.config, OpUpdateOne, withCalendarTokenID(id))
+	return &CalendarTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CalendarToken.
+func (c *CalendarTokenClient) Delete() *CalendarTokenDelete {
+	mutation := newCalendarTokenMutation(c.config, OpDelete)
+	return &CalendarTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CalendarTokenClient) DeleteOne(_m *CalendarToken) *CalendarTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CalendarTokenClient) DeleteOneID(id int) *CalendarTokenDeleteOne {
+	builder := c.Delete().Where(calendartoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CalendarTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for CalendarToken.
+func (c *CalendarTokenClient) Query() *CalendarTokenQuery {
+	return &CalendarTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCalendarToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CalendarToken entity by its id.
+func (c *CalendarTokenClient) Get(ctx context.Context, id int) (*CalendarToken, error) {
+	return c.Query().Where(calendartoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CalendarTokenClient) GetX(ctx context.Context, id int) *CalendarToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CalendarTokenClient) Hooks() []Hook {
+	return c.hooks.CalendarToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *CalendarTokenClient) Interceptors() []Interceptor {
+	return c.inters.CalendarToken
+}
+
+func (c *CalendarTokenClient) mutate(ctx context.Context, m *CalendarTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CalendarTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CalendarTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CalendarTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CalendarTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CalendarToken mutation op: %q", m.Op())
+	}
+}
+
+// CheckInIntentClient is a client for the CheckInIntent schema.
+type CheckInIntentClient struct {
+	config
+}
+
+// NewCheckInIntentClient returns a client for the CheckInIntent from the given config.
+func NewCheckInIntentClient(c config) *CheckInIntentClient {
+	return &CheckInIntentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `checkinintent.Hooks(f(g(h())))`.
+func (c *CheckInIntentClient) Use(hooks ...Hook) {
+	c.hooks.CheckInIntent = append(c.hooks.CheckInIntent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `checkinintent.Intercept(f(g(h())))`.
+func (c *CheckInIntentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CheckInIntent = append(c.inters.CheckInIntent, interceptors...)
+}
+
+// Create returns a builder for creating a CheckInIntent entity.
+func (c *CheckInIntentClient) Create() *CheckInIntentCreate {
+	mutation := newCheckInIntentMutation(c.config, OpCreate)
+	return &CheckInIntentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CheckInIntent entities.
+func (c *CheckInIntentClient) CreateBulk(builders ...*CheckInIntentCreate) *CheckInIntentCreateBulk {
+	return &CheckInIntentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CheckInIntentClient) MapCreateBulk(slice any, setFunc func(*CheckInIntentCreate, int)) *CheckInIntentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CheckInIntentCreateBulk{err: fmt.Errorf("calling to CheckInIntentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CheckInIntentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CheckInIntentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CheckInIntent.
+func (c *CheckInIntentClient) Update() *CheckInIntentUpdate {
+	mutation := newCheckInIntentMutation(c.config, OpUpdate)
+	return &CheckInIntentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CheckInIntentClient) UpdateOne(_m *CheckInIntent) *CheckInIntentUpdateOne {
+	mutation := newCheckInIntentMutation(c.config, OpUpdateOne, withCheckInIntent(_m))
+	return &CheckInIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CheckInIntentClient) UpdateOneID(id int) *CheckInIntentUpdateOne {
+	mutation := newCheckInIntentMutation(c.config, OpUpdateOne, withCheckInIntentID(id))
+	return &CheckInIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CheckInIntent.
+func (c *CheckInIntentClient) Delete() *CheckInIntentDelete {
+	mutation := newCheckInIntentMutation(c.config, OpDelete)
+	return &CheckInIntentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CheckInIntentClient) DeleteOne(_m *CheckInIntent) *CheckInIntentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CheckInIntentClient) DeleteOneID(id int) *CheckInIntentDeleteOne {
+	builder := c.Delete().Where(checkinintent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CheckInIntentDeleteOne{builder}
+}
+
+// Query returns a query builder for CheckInIntent.
+func (c *CheckInIntentClient) Query() *CheckInIntentQuery {
+	return &CheckInIntentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCheckInIntent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CheckInIntent entity by its id.
+func (c *CheckInIntentClient) Get(ctx context.Context, id int) (*CheckInIntent, error) {
+	return c.Query().Where(checkinintent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CheckInIntentClient) GetX(ctx context.Context, id int) *CheckInIntent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CheckInIntentClient) Hooks() []Hook {
+	return c.hooks.CheckInIntent
+}
+
+// Interceptors returns the client interceptors.
+func (c *CheckInIntentClient) Interceptors() []Interceptor {
+	return c.inters.CheckInIntent
+}
+
+func (c *CheckInIntentClient) mutate(ctx context.Context, m *CheckInIntentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CheckInIntentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CheckInIntentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CheckInIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CheckInIntentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CheckInIntent mutation op: %q", m.Op())
+	}
+}
+
+// CheckInTokenUseClient is a client for the CheckInTokenUse schema.
+type CheckInTokenUseClient struct {
+	config
+}
+
+// NewCheckInTokenUseClient returns a client for the CheckInTokenUse from the given config.
+func NewCheckInTokenUseClient(c config) *CheckInTokenUseClient {
+	return &CheckInTokenUseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `checkintokenuse.Hooks(f(g(h())))`.
+func (c *CheckInTokenUseClient) Use(hooks ...Hook) {
+	c.hooks.CheckInTokenUse = append(c.hooks.CheckInTokenUse, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `checkintokenuse.Intercept(f(g(h())))`.
+func (c *CheckInTokenUseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CheckInTokenUse = append(c.inters.CheckInTokenUse, interceptors...)
+}
+
+// Create returns a builder for creating a CheckInTokenUse entity.
+func (c *CheckInTokenUseClient) Create() *CheckInTokenUseCreate {
+	mutation := newCheckInTokenUseMutation(c.config, OpCreate)
+	return &CheckInTokenUseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CheckInTokenUse entities.
+func (c *CheckInTokenUseClient) CreateBulk(builders ...*CheckInTokenUseCreate) *CheckInTokenUseCreateBulk {
+	return &CheckInTokenUseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CheckInTokenUseClient) MapCreateBulk(slice any, setFunc func(*CheckInTokenUseCreate, int)) *CheckInTokenUseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CheckInTokenUseCreateBulk{err: fmt.Errorf("calling to CheckInTokenUseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CheckInTokenUseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CheckInTokenUseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CheckInTokenUse.
+func (c *CheckInTokenUseClient) Update() *CheckInTokenUseUpdate {
+	mutation := newCheckInTokenUseMutation(c.config, OpUpdate)
+	return &CheckInTokenUseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CheckInTokenUseClient) UpdateOne(_m *CheckInTokenUse) *CheckInTokenUseUpdateOne {
+	mutation := newCheckInTokenUseMutation(c.config, OpUpdateOne, withCheckInTokenUse(_m))
+	return &CheckInTokenUseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CheckInTokenUseClient) UpdateOneID(id int) *CheckInTokenUseUpdateOne {
+	mutation := newCheckInTokenUseMutation(c.config, OpUpdateOne, withCheckInTokenUseID(id))
+	return &CheckInTokenUseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CheckInTokenUse.
+func (c *CheckInTokenUseClient) Delete() *CheckInTokenUseDelete {
+	mutation := newCheckInTokenUseMutation(c.config, OpDelete)
+	return &CheckInTokenUseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CheckInTokenUseClient) DeleteOne(_m *CheckInTokenUse) *CheckInTokenUseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CheckInTokenUseClient) DeleteOneID(id int) *CheckInTokenUseDeleteOne {
+	builder := c.Delete().Where(checkintokenuse.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CheckInTokenUseDeleteOne{builder}
+}
+
+// Query returns a query builder for CheckInTokenUse.
+func (c *CheckInTokenUseClient) Query() *CheckInTokenUseQuery {
+	return &CheckInTokenUseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCheckInTokenUse},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CheckInTokenUse entity by its id.
+func (c *CheckInTokenUseClient) Get(ctx context.Context, id int) (*CheckInTokenUse, error) {
+	return c.Query().Where(checkintokenuse.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CheckInTokenUseClient) GetX(ctx context.Context, id int) *CheckInTokenUse {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CheckInTokenUseClient) Hooks() []Hook {
+	return c.hooks.CheckInTokenUse
+}
+
+// Interceptors returns the client interceptors.
+func (c *CheckInTokenUseClient) Interceptors() []Interceptor {
+	return c.inters.CheckInTokenUse
+}
+
+func (c *CheckInTokenUseClient) mutate(ctx context.Context, m *CheckInTokenUseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CheckInTokenUseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CheckInTokenUseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CheckInTokenUseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CheckInTokenUseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CheckInTokenUse mutation op: %q", m.Op())
+	}
+}
+
+// ClaimClient is a client for the Claim schema.
+type ClaimClient struct {
+	config
+}
+
+// NewClaimClient returns a client for the Claim from the given config.
+func NewClaimClient(c config) *ClaimClient {
+	return &ClaimClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `claim.Hooks(f(g(h())))`.
+func (c *ClaimClient) Use(hooks ...Hook) {
+	c.hooks.Claim = append(c.hooks.Claim, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `claim.Intercept(f(g(h())))`.
+func (c *ClaimClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Claim = append(c.inters.Claim, interceptors...)
+}
+
+// Create returns a builder for creating a Claim entity.
+func (c *ClaimClient) Create() *ClaimCreate {
+	mutation := newClaimMutation(c.config, OpCreate)
+	return &ClaimCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Claim entities.
+func (c *ClaimClient) CreateBulk(builders ...*ClaimCreate) *ClaimCreateBulk {
+	return &ClaimCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClaimClient) MapCreateBulk(slice any, setFunc func(*ClaimCreate, int)) *ClaimCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClaimCreateBulk{err: fmt.Errorf("calling to ClaimClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClaimCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClaimCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Claim.
+func (c *ClaimClient) Update() *ClaimUpdate {
+	mutation := newClaimMutation(c.config, OpUpdate)
+	return &ClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClaimClient) UpdateOne(_m *Claim) *ClaimUpdateOne {
+	mutation := newClaimMutation(c.config, OpUpdateOne, withClaim(_m))
+	return &ClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClaimClient) UpdateOneID(id int) *ClaimUpdateOne {
+	mutation := newClaimMutation(c.config, OpUpdateOne, withClaimID(id))
+	return &ClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Claim.
+func (c *ClaimClient) Delete() *ClaimDelete {
+	mutation := newClaimMutation(c.config, OpDelete)
+	return &ClaimDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClaimClient) DeleteOne(_m *Claim) *ClaimDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClaimClient) DeleteOneID(id int) *ClaimDeleteOne {
+	builder := c.Delete().Where(claim.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClaimDeleteOne{builder}
+}
+
+// Query returns a query builder for Claim.
+func (c *ClaimClient) Query() *ClaimQuery {
+	return &ClaimQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClaim},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Claim entity by its id.
+func (c *ClaimClient) Get(ctx context.Context, id int) (*Claim, error) {
+	return c.Query().Where(claim.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClaimClient) GetX(ctx context.Context, id int) *Claim {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ClaimClient) Hooks() []Hook {
+	return c.hooks.Claim
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClaimClient) Interceptors() []Interceptor {
+	return c.inters.Claim
+}
+
+func (c *ClaimClient) mutate(ctx context.Context, m *ClaimMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClaimCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClaimDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Claim mutation op: %q", m.Op())
+	}
+}
+
+// ClaimQuotaClient is a client for the ClaimQuota schema.
+type ClaimQuotaClient struct {
+	config
+}
+
+// NewClaimQuotaClient returns a client for the ClaimQuota from the given config.
+func NewClaimQuotaClient(c config) *ClaimQuotaClient {
+	return &ClaimQuotaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `claimquota.Hooks(f(g(h())))`.
+func (c *ClaimQuotaClient) Use(hooks ...Hook) {
+	c.hooks.ClaimQuota = append(c.hooks.ClaimQuota, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `claimquota.Intercept(f(g(h())))`.
+func (c *ClaimQuotaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClaimQuota = append(c.inters.ClaimQuota, interceptors...)
+}
+
+// Create returns a builder for creating a ClaimQuota entity.
+func (c *ClaimQuotaClient) Create() *ClaimQuotaCreate {
+	mutation := newClaimQuotaMutation(c.config, OpCreate)
+	return &ClaimQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClaimQuota entities.
+func (c *ClaimQuotaClient) CreateBulk(builders ...*ClaimQuotaCreate) *ClaimQuotaCreateBulk {
+	return &ClaimQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClaimQuotaClient) MapCreateBulk(slice any, setFunc func(*ClaimQuotaCreate, int)) *ClaimQuotaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClaimQuotaCreateBulk{err: fmt.Errorf("calling to ClaimQuotaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClaimQuotaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClaimQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClaimQuota.
+func (c *ClaimQuotaClient) Update() *ClaimQuotaUpdate {
+	mutation := newClaimQuotaMutation(c.config, OpUpdate)
+	return &ClaimQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClaimQuotaClient) UpdateOne(_m *ClaimQuota) *ClaimQuotaUpdateOne {
+	mutation := newClaimQuotaMutation(c.config, OpUpdateOne, withClaimQuota(_m))
+	return &ClaimQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClaimQuotaClient) UpdateOneID(id int) *ClaimQuotaUpdateOne {
+	mutation := newClaimQuotaMutation(c.config, OpUpdateOne, withClaimQuotaID(id))
+	return &ClaimQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClaimQuota.
+func (c *ClaimQuotaClient) Delete() *ClaimQuotaDelete {
+	mutation := newClaimQuotaMutation(c.config, OpDelete)
+	return &ClaimQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClaimQuotaClient) DeleteOne(_m *ClaimQuota) *ClaimQuotaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClaimQuotaClient) DeleteOneID(id int) *ClaimQuotaDeleteOne {
+	builder := c.Delete().Where(claimquota.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClaimQuotaDeleteOne{builder}
+}
+
+// Query returns a query builder for ClaimQuota.
+func (c *ClaimQuotaClient) Query() *ClaimQuotaQuery {
+	return &ClaimQuotaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClaimQuota},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClaimQuota entity by its id.
+func (c *ClaimQuotaClient) Get(ctx context.Context, id int) (*ClaimQuota, error) {
+	return c.Query().Where(claimquota.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClaimQuotaClient) GetX(ctx context.Context, id int) *ClaimQuota {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ClaimQuotaClient) Hooks() []Hook {
+	return c.hooks.ClaimQuota
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClaimQuotaClient) Interceptors() []Interceptor {
+	return c.inters.ClaimQuota
+}
+
+func (c *ClaimQuotaClient) mutate(ctx context.Context, m *ClaimQuotaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClaimQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClaimQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClaimQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClaimQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ClaimQuota mutation op: %q", m.Op())
+	}
+}
+
+// CommentClient is a client for the Comment schema.
+type CommentClient struct {
+	config
+}
+
+// NewCommentClient returns a client for the Comment from the given config.
+func NewCommentClient(c config) *CommentClient {
+	return &CommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
+func (c *CommentClient) Use(hooks ...Hook) {
+	c.hooks.Comment = append(c.hooks.Comment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
+}
+
+// Create returns a builder for creating a Comment entity.
+func (c *CommentClient) Create() *CommentCreate {
+	mutation := newCommentMutation(c.config, OpCreate)
+	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Comment entities.
+func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Comment.
+func (c *CommentClient) Update() *CommentUpdate {
+	mutation := newCommentMutation(c.config, OpUpdate)
+	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentClient) UpdateOne(_m *Comment) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(_m))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Comment.
+func (c *CommentClient) Delete() *CommentDelete {
+	mutation := newCommentMutation(c.config, OpDelete)
+	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentClient) DeleteOne(_m *Comment) *CommentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentClient) DeleteOneID(id int) *CommentDeleteOne {
+	builder := c.Delete().Where(comment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentDeleteOne{builder}
+}
+
+// Query returns a query builder for Comment.
+func (c *CommentClient) Query() *CommentQuery {
+	return &CommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Comment entity by its id.
+func (c *CommentClient) Get(ctx context.Context, id int) (*Comment, error) {
+	return c.Query().Where(comment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Comment.
+func (c *CommentClient) QueryUser(_m *Comment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.UserTable, comment.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMoment queries the moment edge of a Comment.
+func (c *CommentClient) QueryMoment(_m *Comment) *NFTMomentQuery {
+	query := (&NFTMomentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.MomentTable, comment.MomentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CommentClient) Hooks() []Hook {
+	return c.hooks.Comment
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
+}
+
+func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
+	}
+}
+
+// DataVersionClient is a client for the DataVersion schema.
+type DataVersionClient struct {
+	config
+}
+
+// NewDataVersionClient returns a client for the DataVersion from the given config.
+func NewDataVersionClient(c config) *DataVersionClient {
+	return &DataVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dataversion.Hooks(f(g(h())))`.
+func (c *DataVersionClient) Use(hooks ...Hook) {
+	c.hooks.DataVersion = append(c.hooks.DataVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dataversion.Intercept(f(g(h())))`.
+func (c *DataVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataVersion = append(c.inters.DataVersion, interceptors...)
+}
+
+// Create returns a builder for creating a DataVersion entity.
+func (c *DataVersionClient) Create() *DataVersionCreate {
+	mutation := newDataVersionMutation(c.config, OpCreate)
+	return &DataVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DataVersion entities.
+func (c *DataVersionClient) CreateBulk(builders ...*DataVersionCreate) *DataVersionCreateBulk {
+	return &DataVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DataVersionClient) MapCreateBulk(slice any, setFunc func(*DataVersionCreate, int)) *DataVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DataVersionCreateBulk{err: fmt.Errorf("calling to DataVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DataVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DataVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DataVersion.
+func (c *DataVersionClient) Update() *DataVersionUpdate {
+	mutation := newDataVersionMutation(c.config, OpUpdate)
+	return &DataVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DataVersionClient) UpdateOne(_m *DataVersion) *DataVersionUpdateOne {
+	mutation := newDataVersionMutation(c.config, OpUpdateOne, withDataVersion(_m))
+	return &DataVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DataVersionClient) UpdateOneID(id int) *DataVersionUpdateOne {
+	mutation := newDataVersionMutation(c.config, OpUpdateOne, withDataVersionID(id))
+	return &DataVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DataVersion.
+func (c *DataVersionClient) Delete() *DataVersionDelete {
+	mutation := newDataVersionMutation(c.config, OpDelete)
+	return &DataVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DataVersionClient) DeleteOne(_m *DataVersion) *DataVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DataVersionClient) DeleteOneID(id int) *DataVersionDeleteOne {
+	builder := c.Delete().Where(dataversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DataVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for DataVersion.
+func (c *DataVersionClient) Query() *DataVersionQuery {
+	return &DataVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDataVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DataVersion entity by its id.
+func (c *DataVersionClient) Get(ctx context.Context, id int) (*DataVersion, error) {
+	return c.Query().Where(dataversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DataVersionClient) GetX(ctx context.Context, id int) *DataVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DataVersionClient) Hooks() []Hook {
+	return c.hooks.DataVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *DataVersionClient) Interceptors() []Interceptor {
+	return c.inters.DataVersion
+}
+
+func (c *DataVersionClient) mutate(ctx context.Context, m *DataVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DataVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DataVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DataVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DataVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DataVersion mutation op: %q", m.Op())
+	}
+}
+
+// EventClient is a client for the Event schema.
+type EventClient struct {
+	config
+}
+
+// NewEventClient returns a client for the Event from the given config.
+func NewEventClient(c config) *EventClient {
+	return &EventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `event.Hooks(f(g(h())))`.
+func (c *EventClient) Use(hooks ...Hook) {
+	c.hooks.Event = append(c.hooks.Event, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `event.Intercept(f(g(h())))`.
+func (c *EventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Event = append(c.inters.Event, interceptors...)
+}
+
+// Create returns a builder for creating a Event entity.
+func (c *EventClient) Create() *EventCreate {
+	mutation := newEventMutation(c.config, OpCreate)
+	return &EventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Event entities.
 func (c *EventClient) CreateBulk(builders ...*EventCreate) *EventCreateBulk {
 	return &EventCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EventClient) MapCreateBulk(slice any, setFunc func(*EventCreate, int)) *EventCreateBulk {
+func (c *EventClient) MapCreateBulk(slice any, setFunc func(*EventCreate, int)) *EventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventCreateBulk{err: fmt.Errorf("calling to EventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Event.
+func (c *EventClient) Update() *EventUpdate {
+	mutation := newEventMutation(c.config, OpUpdate)
+	return &EventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventClient) UpdateOne(_m *Event) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEvent(_m))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventClient) UpdateOneID(id int) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEventID(id))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Event.
+func (c *EventClient) Delete() *EventDelete {
+	mutation := newEventMutation(c.config, OpDelete)
+	return &EventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventClient) DeleteOne(_m *Event) *EventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventClient) DeleteOneID(id int) *EventDeleteOne {
+	builder := c.Delete().Where(event.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventDeleteOne{builder}
+}
+
+// Query returns a query builder for Event.
+func (c *EventClient) Query() *EventQuery {
+	return &EventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Event entity by its id.
+func (c *EventClient) Get(ctx context.Context, id int) (*Event, error) {
+	return c.Query().Where(event.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventClient) GetX(ctx context.Context, id int) *Event {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHost queries the host edge of a Event.
+func (c *EventClient) QueryHost(_m *Event) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, event.HostTable, event.HostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPassesIssued queries the passes_issued edge of a Event.
+func (c *EventClient) QueryPassesIssued(_m *Event) *EventPassQuery {
+	query := (&EventPassClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(eventpass.Table, eventpass.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.PassesIssuedTable, event.PassesIssuedColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendances queries the attendances edge of a Event.
+func (c *EventClient) QueryAttendances(_m *Event) *AttendanceQuery {
+	query := (&AttendanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.AttendancesTable, event.AttendancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EventClient) Hooks() []Hook {
+	return c.hooks.Event
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventClient) Interceptors() []Interceptor {
+	return c.inters.Event
+}
+
+func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Event mutation op: %q", m.Op())
+	}
+}
+
+// EventChangeClient is a client for the EventChange schema.
+type EventChangeClient struct {
+	config
+}
+
+// NewEventChangeClient returns a client for the EventChange from the given config.
+func NewEventChangeClient(c config) *EventChangeClient {
+	return &EventChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventchange.Hooks(f(g(h())))`.
+func (c *EventChangeClient) Use(hooks ...Hook) {
+	c.hooks.EventChange = append(c.hooks.EventChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventchange.Intercept(f(g(h())))`.
+func (c *EventChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventChange = append(c.inters.EventChange, interceptors...)
+}
+
+// Create returns a builder for creating a EventChange entity.
+func (c *EventChangeClient) Create() *EventChangeCreate {
+	mutation := newEventChangeMutation(c.config, OpCreate)
+	return &EventChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventChange entities.
+func (c *EventChangeClient) CreateBulk(builders ...*EventChangeCreate) *EventChangeCreateBulk {
+	return &EventChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventChangeClient) MapCreateBulk(slice any, setFunc func(*EventChangeCreate, int)) *EventChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventChangeCreateBulk{err: fmt.Errorf("calling to EventChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventChange.
+func (c *EventChangeClient) Update() *EventChangeUpdate {
+	mutation := newEventChangeMutation(c.config, OpUpdate)
+	return &EventChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventChangeClient) UpdateOne(_m *EventChange) *EventChangeUpdateOne {
+	mutation := newEventChangeMutation(c.config, OpUpdateOne, withEventChange(_m))
+	return &EventChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventChangeClient) UpdateOneID(id int) *EventChangeUpdateOne {
+	mutation := newEventChangeMutation(c.config, OpUpdateOne, withEventChangeID(id))
+	return &EventChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventChange.
+func (c *EventChangeClient) Delete() *EventChangeDelete {
+	mutation := newEventChangeMutation(c.config, OpDelete)
+	return &EventChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventChangeClient) DeleteOne(_m *EventChange) *EventChangeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventChangeClient) DeleteOneID(id int) *EventChangeDeleteOne {
+	builder := c.Delete().Where(eventchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for EventChange.
+func (c *EventChangeClient) Query() *EventChangeQuery {
+	return &EventChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventChange entity by its id.
+func (c *EventChangeClient) Get(ctx context.Context, id int) (*EventChange, error) {
+	return c.Query().Where(eventchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventChangeClient) GetX(ctx context.Context, id int) *EventChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventChangeClient) Hooks() []Hook {
+	return c.hooks.EventChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventChangeClient) Interceptors() []Interceptor {
+	return c.inters.EventChange
+}
+
+func (c *EventChangeClient) mutate(ctx context.Context, m *EventChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventChange mutation op: %q", m.Op())
+	}
+}
+
+// EventImportRowClient is a client for the EventImportRow schema.
+type EventImportRowClient struct {
+	config
+}
+
+// NewEventImportRowClient returns a client for the EventImportRow from the given config.
+func NewEventImportRowClient(c config) *EventImportRowClient {
+	return &EventImportRowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventimportrow.Hooks(f(g(h())))`.
+func (c *EventImportRowClient) Use(hooks ...Hook) {
+	c.hooks.EventImportRow = append(c.hooks.EventImportRow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventimportrow.Intercept(f(g(h())))`.
+func (c *EventImportRowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventImportRow = append(c.inters.EventImportRow, interceptors...)
+}
+
+// Create returns a builder for creating a EventImportRow entity.
+func (c *EventImportRowClient) Create() *EventImportRowCreate {
+	mutation := newEventImportRowMutation(c.config, OpCreate)
+	return &EventImportRowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventImportRow entities.
+func (c *EventImportRowClient) CreateBulk(builders ...*EventImportRowCreate) *EventImportRowCreateBulk {
+	return &EventImportRowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventImportRowClient) MapCreateBulk(slice any, setFunc func(*EventImportRowCreate, int)) *EventImportRowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventImportRowCreateBulk{err: fmt.Errorf("calling to EventImportRowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventImportRowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventImportRowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventImportRow.
+func (c *EventImportRowClient) Update() *EventImportRowUpdate {
+	mutation := newEventImportRowMutation(c.config, OpUpdate)
+	return &EventImportRowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventImportRowClient) UpdateOne(_m *EventImportRow) *EventImportRowUpdateOne {
+	mutation := newEventImportRowMutation(c.config, OpUpdateOne, withEventImportRow(_m))
+	return &EventImportRowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventImportRowClient) UpdateOneID(id int) *EventImportRowUpdateOne {
+	mutation := newEventImportRowMutation(c.config, OpUpdateOne, withEventImportRowID(id))
+	return &EventImportRowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventImportRow.
+func (c *EventImportRowClient) Delete() *EventImportRowDelete {
+	mutation := newEventImportRowMutation(c.config, OpDelete)
+	return &EventImportRowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventImportRowClient) DeleteOne(_m *EventImportRow) *EventImportRowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventImportRowClient) DeleteOneID(id int) *EventImportRowDeleteOne {
+	builder := c.Delete().Where(eventimportrow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventImportRowDeleteOne{builder}
+}
+
+// Query returns a query builder for EventImportRow.
+func (c *EventImportRowClient) Query() *EventImportRowQuery {
+	return &EventImportRowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventImportRow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventImportRow entity by its id.
+func (c *EventImportRowClient) Get(ctx context.Context, id int) (*EventImportRow, error) {
+	return c.Query().Where(eventimportrow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventImportRowClient) GetX(ctx context.Context, id int) *EventImportRow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventImportRowClient) Hooks() []Hook {
+	return c.hooks.EventImportRow
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventImportRowClient) Interceptors() []Interceptor {
+	return c.inters.EventImportRow
+}
+
+func (c *EventImportRowClient) mutate(ctx context.Context, m *EventImportRowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventImportRowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventImportRowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventImportRowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventImportRowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventImportRow mutation op: %q", m.Op())
+	}
+}
+
+// EventPassClient is a client for the EventPass schema.
+type EventPassClient struct {
+	config
+}
+
+// NewEventPassClient returns a client for the EventPass from the given config.
+func NewEventPassClient(c config) *EventPassClient {
+	return &EventPassClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventpass.Hooks(f(g(h())))`.
+func (c *EventPassClient) Use(hooks ...Hook) {
+	c.hooks.EventPass = append(c.hooks.EventPass, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventpass.Intercept(f(g(h())))`.
+func (c *EventPassClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventPass = append(c.inters.EventPass, interceptors...)
+}
+
+// Create returns a builder for creating a EventPass entity.
+func (c *EventPassClient) Create() *EventPassCreate {
+	mutation := newEventPassMutation(c.config, OpCreate)
+	return &EventPassCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventPass entities.
+func (c *EventPassClient) CreateBulk(builders ...*EventPassCreate) *EventPassCreateBulk {
+	return &EventPassCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventPassClient) MapCreateBulk(slice any, setFunc func(*EventPassCreate, int)) *EventPassCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventPassCreateBulk{err: fmt.Errorf("calling to EventPassClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventPassCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventPassCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventPass.
+func (c *EventPassClient) Update() *EventPassUpdate {
+	mutation := newEventPassMutation(c.config, OpUpdate)
+	return &EventPassUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventPassClient) UpdateOne(_m *EventPass) *EventPassUpdateOne {
+	mutation := newEventPassMutation(c.config, OpUpdateOne, withEventPass(_m))
+	return &EventPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventPassClient) UpdateOneID(id int) *EventPassUpdateOne {
+	mutation := newEventPassMutation(c.config, OpUpdateOne, withEventPassID(id))
+	return &EventPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventPass.
+func (c *EventPassClient) Delete() *EventPassDelete {
+	mutation := newEventPassMutation(c.config, OpDelete)
+	return &EventPassDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventPassClient) DeleteOne(_m *EventPass) *EventPassDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventPassClient) DeleteOneID(id int) *EventPassDeleteOne {
+	builder := c.Delete().Where(eventpass.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventPassDeleteOne{builder}
+}
+
+// Query returns a query builder for EventPass.
+func (c *EventPassClient) Query() *EventPassQuery {
+	return &EventPassQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventPass},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventPass entity by its id.
+func (c *EventPassClient) Get(ctx context.Context, id int) (*EventPass, error) {
+	return c.Query().Where(eventpass.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventPassClient) GetX(ctx context.Context, id int) *EventPass {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a EventPass.
+func (c *EventPassClient) QueryOwner(_m *EventPass) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(eventpass.Table, eventpass.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, eventpass.OwnerTable, eventpass.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvent queries the event edge of a EventPass.
+func (c *EventPassClient) QueryEvent(_m *EventPass) *EventQuery {
+	query := (&EventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(eventpass.Table, eventpass.FieldID, id),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, eventpass.EventTable, eventpass.EventColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMoment queries the moment edge of a EventPass.
+func (c *EventPassClient) QueryMoment(_m *EventPass) *NFTMomentQuery {
+	query := (&NFTMomentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(eventpass.Table, eventpass.FieldID, id),
+			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, eventpass.MomentTable, eventpass.MomentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EventPassClient) Hooks() []Hook {
+	return c.hooks.EventPass
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventPassClient) Interceptors() []Interceptor {
+	return c.inters.EventPass
+}
+
+func (c *EventPassClient) mutate(ctx context.Context, m *EventPassMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventPassCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventPassUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventPassDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventPass mutation op: %q", m.Op())
+	}
+}
+
+// EventSeriesClient is a client for the EventSeries schema.
+type EventSeriesClient struct {
+	config
+}
+
+// NewEventSeriesClient returns a client for the EventSeries from the given config.
+func NewEventSeriesClient(c config) *EventSeriesClient {
+	return &EventSeriesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventseries.Hooks(f(g(h())))`.
+func (c *EventSeriesClient) Use(hooks ...Hook) {
+	c.hooks.EventSeries = append(c.hooks.EventSeries, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventseries.Intercept(f(g(h())))`.
+func (c *EventSeriesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventSeries = append(c.inters.EventSeries, interceptors...)
+}
+
+// Create returns a builder for creating a EventSeries entity.
+func (c *EventSeriesClient) Create() *EventSeriesCreate {
+	mutation := newEventSeriesMutation(c.config, OpCreate)
+	return &EventSeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventSeries entities.
+func (c *EventSeriesClient) CreateBulk(builders ...*EventSeriesCreate) *EventSeriesCreateBulk {
+	return &EventSeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventSeriesClient) MapCreateBulk(slice any, setFunc func(*EventSeriesCreate, int)) *EventSeriesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventSeriesCreateBulk{err: fmt.Errorf("calling to EventSeriesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventSeriesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventSeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventSeries.
+func (c *EventSeriesClient) Update() *EventSeriesUpdate {
+	mutation := newEventSeriesMutation(c.config, OpUpdate)
+	return &EventSeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventSeriesClient) UpdateOne(_m *EventSeries) *EventSeriesUpdateOne {
+	mutation := newEventSeriesMutation(c.config, OpUpdateOne, withEventSeries(_m))
+	return &EventSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventSeriesClient) UpdateOneID(id int) *EventSeriesUpdateOne {
+	mutation := newEventSeriesMutation(c.config, OpUpdateOne, withEventSeriesID(id))
+	return &EventSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventSeries.
+func (c *EventSeriesClient) Delete() *EventSeriesDelete {
+	mutation := newEventSeriesMutation(c.config, OpDelete)
+	return &EventSeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventSeriesClient) DeleteOne(_m *EventSeries) *EventSeriesDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventSeriesClient) DeleteOneID(id int) *EventSeriesDeleteOne {
+	builder := c.Delete().Where(eventseries.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventSeriesDeleteOne{builder}
+}
+
+// Query returns a query builder for EventSeries.
+func (c *EventSeriesClient) Query() *EventSeriesQuery {
+	return &EventSeriesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventSeries},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventSeries entity by its id.
+func (c *EventSeriesClient) Get(ctx context.Context, id int) (*EventSeries, error) {
+	return c.Query().Where(eventseries.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventSeriesClient) GetX(ctx context.Context, id int) *EventSeries {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventSeriesClient) Hooks() []Hook {
+	return c.hooks.EventSeries
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventSeriesClient) Interceptors() []Interceptor {
+	return c.inters.EventSeries
+}
+
+func (c *EventSeriesClient) mutate(ctx context.Context, m *EventSeriesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventSeriesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventSeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventSeriesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventSeries mutation op: %q", m.Op())
+	}
+}
+
+// EventStaffClient is a client for the EventStaff schema.
+type EventStaffClient struct {
+	config
+}
+
+// NewEventStaffClient returns a client for the EventStaff from the given config.
+func NewEventStaffClient(c config) *EventStaffClient {
+	return &EventStaffClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventstaff.Hooks(f(g(h())))`.
+func (c *EventStaffClient) Use(hooks ...Hook) {
+	c.hooks.EventStaff = append(c.hooks.EventStaff, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventstaff.Intercept(f(g(h())))`.
+func (c *EventStaffClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventStaff = append(c.inters.EventStaff, interceptors...)
+}
+
+// Create returns a builder for creating a EventStaff entity.
+func (c *EventStaffClient) Create() *EventStaffCreate {
+	mutation := newEventStaffMutation(c.config, OpCreate)
+	return &EventStaffCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventStaff entities.
+func (c *EventStaffClient) CreateBulk(builders ...*EventStaffCreate) *EventStaffCreateBulk {
+	return &EventStaffCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventStaffClient) MapCreateBulk(slice any, setFunc func(*EventStaffCreate, int)) *EventStaffCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventStaffCreateBulk{err: fmt.Errorf("calling to EventStaffClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventStaffCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventStaffCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventStaff.
+func (c *EventStaffClient) Update() *EventStaffUpdate {
+	mutation := newEventStaffMutation(c.config, OpUpdate)
+	return &EventStaffUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventStaffClient) UpdateOne(_m *EventStaff) *EventStaffUpdateOne {
+	mutation := newEventStaffMutation(c.config, OpUpdateOne, withEventStaff(_m))
+	return &EventStaffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventStaffClient) UpdateOneID(id int) *EventStaffUpdateOne {
+	mutation := newEventStaffMutation(c.config, OpUpdateOne, withEventStaffID(id))
+	return &EventStaffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventStaff.
+func (c *EventStaffClient) Delete() *EventStaffDelete {
+	mutation := newEventStaffMutation(c.config, OpDelete)
+	return &EventStaffDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventStaffClient) DeleteOne(_m *EventStaff) *EventStaffDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventStaffClient) DeleteOneID(id int) *EventStaffDeleteOne {
+	builder := c.Delete().Where(eventstaff.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventStaffDeleteOne{builder}
+}
+
+// Query returns a query builder for EventStaff.
+func (c *EventStaffClient) Query() *EventStaffQuery {
+	return &EventStaffQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventStaff},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventStaff entity by its id.
+func (c *EventStaffClient) Get(ctx context.Context, id int) (*EventStaff, error) {
+	return c.Query().Where(eventstaff.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventStaffClient) GetX(ctx context.Context, id int) *EventStaff {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventStaffClient) Hooks() []Hook {
+	return c.hooks.EventStaff
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventStaffClient) Interceptors() []Interceptor {
+	return c.inters.EventStaff
+}
+
+func (c *EventStaffClient) mutate(ctx context.Context, m *EventStaffMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventStaffCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventStaffUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventStaffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventStaffDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EventStaff mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(_m *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(_m))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id int) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(_m *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id int) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id int) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id int) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// InviteCodeClient is a client for the InviteCode schema.
+type InviteCodeClient struct {
+	config
+}
+
+// NewInviteCodeClient returns a client for the InviteCode from the given config.
+func NewInviteCodeClient(c config) *InviteCodeClient {
+	return &InviteCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitecode.Hooks(f(g(h())))`.
+func (c *InviteCodeClient) Use(hooks ...Hook) {
+	c.hooks.InviteCode = append(c.hooks.InviteCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitecode.Intercept(f(g(h())))`.
+func (c *InviteCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InviteCode = append(c.inters.InviteCode, interceptors...)
+}
+
+// Create returns a builder for creating a InviteCode entity.
+func (c *InviteCodeClient) Create() *InviteCodeCreate {
+	mutation := newInviteCodeMutation(c.config, OpCreate)
+	return &InviteCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InviteCode entities.
+func (c *InviteCodeClient) CreateBulk(builders ...*InviteCodeCreate) *InviteCodeCreateBulk {
+	return &InviteCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InviteCodeClient) MapCreateBulk(slice any, setFunc func(*InviteCodeCreate, int)) *InviteCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InviteCodeCreateBulk{err: fmt.Errorf("calling to InviteCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InviteCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InviteCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InviteCode.
+func (c *InviteCodeClient) Update() *InviteCodeUpdate {
+	mutation := newInviteCodeMutation(c.config, OpUpdate)
+	return &InviteCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InviteCodeClient) UpdateOne(_m *InviteCode) *InviteCodeUpdateOne {
+	mutation := newInviteCodeMutation(c.config, OpUpdateOne, withInviteCode(_m))
+	return &InviteCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InviteCodeClient) UpdateOneID(id int) *InviteCodeUpdateOne {
+	mutation := newInviteCodeMutation(c.config, OpUpdateOne, withInviteCodeID(id))
+	return &InviteCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InviteCode.
+func (c *InviteCodeClient) Delete() *InviteCodeDelete {
+	mutation := newInviteCodeMutation(c.config, OpDelete)
+	return &InviteCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InviteCodeClient) DeleteOne(_m *InviteCode) *InviteCodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InviteCodeClient) DeleteOneID(id int) *InviteCodeDeleteOne {
+	builder := c.Delete().Where(invitecode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InviteCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for InviteCode.
+func (c *InviteCodeClient) Query() *InviteCodeQuery {
+	return &InviteCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInviteCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InviteCode entity by its id.
+func (c *InviteCodeClient) Get(ctx context.Context, id int) (*InviteCode, error) {
+	return c.Query().Where(invitecode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InviteCodeClient) GetX(ctx context.Context, id int) *InviteCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InviteCodeClient) Hooks() []Hook {
+	return c.hooks.InviteCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *InviteCodeClient) Interceptors() []Interceptor {
+	return c.inters.InviteCode
+}
+
+func (c *InviteCodeClient) mutate(ctx context.Context, m *InviteCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InviteCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InviteCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InviteCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InviteCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InviteCode mutation op: %q", m.Op())
+	}
+}
+
+// JoinLinkClient is a client for the JoinLink schema.
+type JoinLinkClient struct {
+	config
+}
+
+// NewJoinLinkClient returns a client for the JoinLink from the given config.
+func NewJoinLinkClient(c config) *JoinLinkClient {
+	return &JoinLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `joinlink.Hooks(f(g(h())))`.
+func (c *JoinLinkClient) Use(hooks ...Hook) {
+	c.hooks.JoinLink = append(c.hooks.JoinLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `joinlink.Intercept(f(g(h())))`.
+func (c *JoinLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JoinLink = append(c.inters.JoinLink, interceptors...)
+}
+
+// Create returns a builder for creating a JoinLink entity.
+func (c *JoinLinkClient) Create() *JoinLinkCreate {
+	mutation := newJoinLinkMutation(c.config, OpCreate)
+	return &JoinLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JoinLink entities.
+func (c *JoinLinkClient) CreateBulk(builders ...*JoinLinkCreate) *JoinLinkCreateBulk {
+	return &JoinLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JoinLinkClient) MapCreateBulk(slice any, setFunc func(*JoinLinkCreate, int)) *JoinLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JoinLinkCreateBulk{err: fmt.Errorf("calling to JoinLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JoinLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JoinLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JoinLink.
+func (c *JoinLinkClient) Update() *JoinLinkUpdate {
+	mutation := newJoinLinkMutation(c.config, OpUpdate)
+	return &JoinLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JoinLinkClient) UpdateOne(_m *JoinLink) *JoinLinkUpdateOne {
+	mutation := newJoinLinkMutation(c.config, OpUpdateOne, withJoinLink(_m))
+	return &JoinLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JoinLinkClient) UpdateOneID(id int) *JoinLinkUpdateOne {
+	mutation := newJoinLinkMutation(c.config, OpUpdateOne, withJoinLinkID(id))
+	return &JoinLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JoinLink.
+func (c *JoinLinkClient) Delete() *JoinLinkDelete {
+	mutation := newJoinLinkMutation(c.config, OpDelete)
+	return &JoinLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JoinLinkClient) DeleteOne(_m *JoinLink) *JoinLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JoinLinkClient) DeleteOneID(id int) *JoinLinkDeleteOne {
+	builder := c.Delete().Where(joinlink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JoinLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for JoinLink.
+func (c *JoinLinkClient) Query() *JoinLinkQuery {
+	return &JoinLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJoinLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JoinLink entity by its id.
+func (c *JoinLinkClient) Get(ctx context.Context, id int) (*JoinLink, error) {
+	return c.Query().Where(joinlink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JoinLinkClient) GetX(ctx context.Context, id int) *JoinLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JoinLinkClient) Hooks() []Hook {
+	return c.hooks.JoinLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *JoinLinkClient) Interceptors() []Interceptor {
+	return c.inters.JoinLink
+}
+
+func (c *JoinLinkClient) mutate(ctx context.Context, m *JoinLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JoinLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JoinLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JoinLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JoinLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JoinLink mutation op: %q", m.Op())
+	}
+}
+
+// KioskDeviceClient is a client for the KioskDevice schema.
+type KioskDeviceClient struct {
+	config
+}
+
+// NewKioskDeviceClient returns a client for the KioskDevice from the given config.
+func NewKioskDeviceClient(c config) *KioskDeviceClient {
+	return &KioskDeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `kioskdevice.Hooks(f(g(h())))`.
+func (c *KioskDeviceClient) Use(hooks ...Hook) {
+	c.hooks.KioskDevice = append(c.hooks.KioskDevice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `kioskdevice.Intercept(f(g(h())))`.
+func (c *KioskDeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KioskDevice = append(c.inters.KioskDevice, interceptors...)
+}
+
+// Create returns a builder for creating a KioskDevice entity.
+func (c *KioskDeviceClient) Create() *KioskDeviceCreate {
+	mutation := newKioskDeviceMutation(c.config, OpCreate)
+	return &KioskDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KioskDevice entities.
+func (c *KioskDeviceClient) CreateBulk(builders ...*KioskDeviceCreate) *KioskDeviceCreateBulk {
+	return &KioskDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KioskDeviceClient) MapCreateBulk(slice any, setFunc func(*KioskDeviceCreate, int)) *KioskDeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KioskDeviceCreateBulk{err: fmt.Errorf("calling to KioskDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KioskDeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KioskDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KioskDevice.
+func (c *KioskDeviceClient) Update() *KioskDeviceUpdate {
+	mutation := newKioskDeviceMutation(c.config, OpUpdate)
+	return &KioskDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KioskDeviceClient) UpdateOne(_m *KioskDevice) *KioskDeviceUpdateOne {
+	mutation := newKioskDeviceMutation(c.config, OpUpdateOne, withKioskDevice(_m))
+	return &KioskDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KioskDeviceClient) UpdateOneID(id int) *KioskDeviceUpdateOne {
+	mutation := newKioskDeviceMutation(c.config, OpUpdateOne, withKioskDeviceID(id))
+	return &KioskDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KioskDevice.
+func (c *KioskDeviceClient) Delete() *KioskDeviceDelete {
+	mutation := newKioskDeviceMutation(c.config, OpDelete)
+	return &KioskDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KioskDeviceClient) DeleteOne(_m *KioskDevice) *KioskDeviceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KioskDeviceClient) DeleteOneID(id int) *KioskDeviceDeleteOne {
+	builder := c.Delete().Where(kioskdevice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KioskDeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for KioskDevice.
+func (c *KioskDeviceClient) Query() *KioskDeviceQuery {
+	return &KioskDeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKioskDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KioskDevice entity by its id.
+func (c *KioskDeviceClient) Get(ctx context.Context, id int) (*KioskDevice, error) {
+	return c.Query().Where(kioskdevice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KioskDeviceClient) GetX(ctx context.Context, id int) *KioskDevice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KioskDeviceClient) Hooks() []Hook {
+	return c.hooks.KioskDevice
+}
+
+// Interceptors returns the client interceptors.
+func (c *KioskDeviceClient) Interceptors() []Interceptor {
+	return c.inters.KioskDevice
+}
+
+func (c *KioskDeviceClient) mutate(ctx context.Context, m *KioskDeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KioskDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KioskDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KioskDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KioskDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KioskDevice mutation op: %q", m.Op())
+	}
+}
+
+// LikeClient is a client for the Like schema.
+type LikeClient struct {
+	config
+}
+
+// NewLikeClient returns a client for the Like from the given config.
+func NewLikeClient(c config) *LikeClient {
+	return &LikeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `like.Hooks(f(g(h())))`.
+func (c *LikeClient) Use(hooks ...Hook) {
+	c.hooks.Like = append(c.hooks.Like, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `like.Intercept(f(g(h())))`.
+func (c *LikeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Like = append(c.inters.Like, interceptors...)
+}
+
+// Create returns a builder for creating a Like entity.
+func (c *LikeClient) Create() *LikeCreate {
+	mutation := newLikeMutation(c.config, OpCreate)
+	return &LikeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Like entities.
+func (c *LikeClient) CreateBulk(builders ...*LikeCreate) *LikeCreateBulk {
+	return &LikeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LikeClient) MapCreateBulk(slice any, setFunc func(*LikeCreate, int)) *LikeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LikeCreateBulk{err: fmt.Errorf("calling to LikeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LikeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LikeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Like.
+func (c *LikeClient) Update() *LikeUpdate {
+	mutation := newLikeMutation(c.config, OpUpdate)
+	return &LikeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LikeClient) UpdateOne(_m *Like) *LikeUpdateOne {
+	mutation := newLikeMutation(c.config, OpUpdateOne, withLike(_m))
+	return &LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LikeClient) UpdateOneID(id int) *LikeUpdateOne {
+	mutation := newLikeMutation(c.config, OpUpdateOne, withLikeID(id))
+	return &LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Like.
+func (c *LikeClient) Delete() *LikeDelete {
+	mutation := newLikeMutation(c.config, OpDelete)
+	return &LikeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LikeClient) DeleteOne(_m *Like) *LikeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LikeClient) DeleteOneID(id int) *LikeDeleteOne {
+	builder := c.Delete().Where(like.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LikeDeleteOne{builder}
+}
+
+// Query returns a query builder for Like.
+func (c *LikeClient) Query() *LikeQuery {
+	return &LikeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLike},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Like entity by its id.
+func (c *LikeClient) Get(ctx context.Context, id int) (*Like, error) {
+	return c.Query().Where(like.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LikeClient) GetX(ctx context.Context, id int) *Like {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Like.
+func (c *LikeClient) QueryUser(_m *Like) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(like.Table, like.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, like.UserTable, like.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMoment queries the moment edge of a Like.
+func (c *LikeClient) QueryMoment(_m *Like) *NFTMomentQuery {
+	query := (&NFTMomentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(like.Table, like.FieldID, id),
+			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, like.MomentTable, like.MomentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LikeClient) Hooks() []Hook {
+	return c.hooks.Like
+}
+
+// Interceptors returns the client interceptors.
+func (c *LikeClient) Interceptors() []Interceptor {
+	return c.inters.Like
+}
+
+func (c *LikeClient) mutate(ctx context.Context, m *LikeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LikeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LikeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LikeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Like mutation op: %q", m.Op())
+	}
+}
+
+// ListingClient is a client for the Listing schema.
+type ListingClient struct {
+	config
+}
+
+// NewListingClient returns a client for the Listing from the given config.
+func NewListingClient(c config) *ListingClient {
+	return &ListingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listing.Hooks(f(g(h())))`.
+func (c *ListingClient) Use(hooks ...Hook) {
+	c.hooks.Listing = append(c.hooks.Listing, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `listing.Intercept(f(g(h())))`.
+func (c *ListingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Listing = append(c.inters.Listing, interceptors...)
+}
+
+// Create returns a builder for creating a Listing entity.
+func (c *ListingClient) Create() *ListingCreate {
+	mutation := newListingMutation(c.config, OpCreate)
+	return &ListingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Listing entities.
+func (c *ListingClient) CreateBulk(builders ...*ListingCreate) *ListingCreateBulk {
+	return &ListingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ListingClient) MapCreateBulk(slice any, setFunc func(*ListingCreate, int)) *ListingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ListingCreateBulk{err: fmt.Errorf("calling to ListingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ListingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ListingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Listing.
+func (c *ListingClient) Update() *ListingUpdate {
+	mutation := newListingMutation(c.config, OpUpdate)
+	return &ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingClient) UpdateOne(_m *Listing) *ListingUpdateOne {
+	mutation := newListingMutation(c.config, OpUpdateOne, withListing(_m))
+	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingClient) UpdateOneID(id int) *ListingUpdateOne {
+	mutation := newListingMutation(c.config, OpUpdateOne, withListingID(id))
+	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Listing.
+func (c *ListingClient) Delete() *ListingDelete {
+	mutation := newListingMutation(c.config, OpDelete)
+	return &ListingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingClient) DeleteOne(_m *Listing) *ListingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ListingClient) DeleteOneID(id int) *ListingDeleteOne {
+	builder := c.Delete().Where(listing.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingDeleteOne{builder}
+}
+
+// Query returns a query builder for Listing.
+func (c *ListingClient) Query() *ListingQuery {
+	return &ListingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeListing},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Listing entity by its id.
+func (c *ListingClient) Get(ctx context.Context, id int) (*Listing, error) {
+	return c.Query().Where(listing.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingClient) GetX(ctx context.Context, id int) *Listing {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySeller queries the seller edge of a Listing.
+func (c *ListingClient) QuerySeller(_m *Listing) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.SellerTable, listing.SellerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNftAccessory queries the nft_accessory edge of a Listing.
+func (c *ListingClient) QueryNftAccessory(_m *Listing) *NFTAccessoryQuery {
+	query := (&NFTAccessoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(nftaccessory.Table, nftaccessory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, listing.NftAccessoryTable, listing.NftAccessoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ListingClient) Hooks() []Hook {
+	return c.hooks.Listing
+}
+
+// Interceptors returns the client interceptors.
+func (c *ListingClient) Interceptors() []Interceptor {
+	return c.inters.Listing
+}
+
+func (c *ListingClient) mutate(ctx context.Context, m *ListingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ListingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ListingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Listing mutation op: %q", m.Op())
+	}
+}
+
+// LocationFixClient is a client for the LocationFix schema.
+type LocationFixClient struct {
+	config
+}
+
+// NewLocationFixClient returns a client for the LocationFix from the given config.
+func NewLocationFixClient(c config) *LocationFixClient {
+	return &LocationFixClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `locationfix.Hooks(f(g(h())))`.
+func (c *LocationFixClient) Use(hooks ...Hook) {
+	c.hooks.LocationFix = append(c.hooks.LocationFix, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `locationfix.Intercept(f(g(h())))`.
+func (c *LocationFixClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LocationFix = append(c.inters.LocationFix, interceptors...)
+}
+
+// Create returns a builder for creating a LocationFix entity.
+func (c *LocationFixClient) Create() *LocationFixCreate {
+	mutation := newLocationFixMutation(c.config, OpCreate)
+	return &LocationFixCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LocationFix entities.
+func (c *LocationFixClient) CreateBulk(builders ...*LocationFixCreate) *LocationFixCreateBulk {
+	return &LocationFixCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocationFixClient) MapCreateBulk(slice any, setFunc func(*LocationFixCreate, int)) *LocationFixCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EventCreateBulk{err: fmt.Errorf("calling to EventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LocationFixCreateBulk{err: fmt.Errorf("calling to LocationFixClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EventCreate, rv.Len())
+	builders := make([]*LocationFixCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EventCreateBulk{config: c.config, builders: builders}
+	return &LocationFixCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Event.
-func (c *EventClient) Update() *EventUpdate {
-	mutation := newEventMutation(c.config, OpUpdate)
-	return &EventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LocationFix.
+func (c *LocationFixClient) Update() *LocationFixUpdate {
+	mutation := newLocationFixMutation(c.config, OpUpdate)
+	return &LocationFixUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EventClient) UpdateOne(_m *Event) *EventUpdateOne {
-	mutation := newEventMutation(c.config, OpUpdateOne, withEvent(_m))
-	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LocationFixClient) UpdateOne(_m *LocationFix) *LocationFixUpdateOne {
+	mutation := newLocationFixMutation(c.config, OpUpdateOne, withLocationFix(_m))
+	return &LocationFixUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EventClient) UpdateOneID(id int) *EventUpdateOne {
-	mutation := newEventMutation(c.config, OpUpdateOne, withEventID(id))
-	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LocationFixClient) UpdateOneID(id int) *LocationFixUpdateOne {
+	mutation := newLocationFixMutation(c.config, OpUpdateOne, withLocationFixID(id))
+	return &LocationFixUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Event.
-func (c *EventClient) Delete() *EventDelete {
-	mutation := newEventMutation(c.config, OpDelete)
-	return &EventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LocationFix.
+func (c *LocationFixClient) Delete() *LocationFixDelete {
+	mutation := newLocationFixMutation(c.config, OpDelete)
+	return &LocationFixDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocationFixClient) DeleteOne(_m *LocationFix) *LocationFixDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocationFixClient) DeleteOneID(id int) *LocationFixDeleteOne {
+	builder := c.Delete().Where(locationfix.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocationFixDeleteOne{builder}
+}
+
+// Query returns a query builder for LocationFix.
+func (c *LocationFixClient) Query() *LocationFixQuery {
+	return &LocationFixQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocationFix},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LocationFix entity by its id.
+func (c *LocationFixClient) Get(ctx context.Context, id int) (*LocationFix, error) {
+	return c.Query().Where(locationfix.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocationFixClient) GetX(ctx context.Context, id int) *LocationFix {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LocationFixClient) Hooks() []Hook {
+	return c.hooks.LocationFix
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocationFixClient) Interceptors() []Interceptor {
+	return c.inters.LocationFix
+}
+
+func (c *LocationFixClient) mutate(ctx context.Context, m *LocationFixMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocationFixCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocationFixUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocationFixUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocationFixDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LocationFix mutation op: %q", m.Op())
+	}
+}
+
+// MintCreditClient is a client for the MintCredit schema.
+type MintCreditClient struct {
+	config
+}
+
+// NewMintCreditClient returns a client for the MintCredit from the given config.
+func NewMintCreditClient(c config) *MintCreditClient {
+	return &MintCreditClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mintcredit.Hooks(f(g(h())))`.
+func (c *MintCreditClient) Use(hooks ...Hook) {
+	c.hooks.MintCredit = append(c.hooks.MintCredit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mintcredit.Intercept(f(g(h())))`.
+func (c *MintCreditClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MintCredit = append(c.inters.MintCredit, interceptors...)
+}
+
+// Create returns a builder for creating a MintCredit entity.
+func (c *MintCreditClient) Create() *MintCreditCreate {
+	mutation := newMintCreditMutation(c.config, OpCreate)
+	return &MintCreditCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MintCredit entities.
+func (c *MintCreditClient) CreateBulk(builders ...*MintCreditCreate) *MintCreditCreateBulk {
+	return &MintCreditCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MintCreditClient) MapCreateBulk(slice any, setFunc func(*MintCreditCreate, int)) *MintCreditCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MintCreditCreateBulk{err: fmt.Errorf("calling to MintCreditClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MintCreditCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MintCreditCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MintCredit.
+func (c *MintCreditClient) Update() *MintCreditUpdate {
+	mutation := newMintCreditMutation(c.config, OpUpdate)
+	return &MintCreditUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MintCreditClient) UpdateOne(_m *MintCredit) *MintCreditUpdateOne {
+	mutation := newMintCreditMutation(c.config, OpUpdateOne, withMintCredit(_m))
+	return &MintCreditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MintCreditClient) UpdateOneID(id int) *MintCreditUpdateOne {
+	mutation := newMintCreditMutation(c.config, OpUpdateOne, withMintCreditID(id))
+	return &MintCreditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MintCredit.
+func (c *MintCreditClient) Delete() *MintCreditDelete {
+	mutation := newMintCreditMutation(c.config, OpDelete)
+	return &MintCreditDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MintCreditClient) DeleteOne(_m *MintCredit) *MintCreditDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MintCreditClient) DeleteOneID(id int) *MintCreditDeleteOne {
+	builder := c.Delete().Where(mintcredit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MintCreditDeleteOne{builder}
+}
+
+// Query returns a query builder for MintCredit.
+func (c *MintCreditClient) Query() *MintCreditQuery {
+	return &MintCreditQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMintCredit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MintCredit entity by its id.
+func (c *MintCreditClient) Get(ctx context.Context, id int) (*MintCredit, error) {
+	return c.Query().Where(mintcredit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MintCreditClient) GetX(ctx context.Context, id int) *MintCredit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MintCreditClient) Hooks() []Hook {
+	return c.hooks.MintCredit
+}
+
+// Interceptors returns the client interceptors.
+func (c *MintCreditClient) Interceptors() []Interceptor {
+	return c.inters.MintCredit
+}
+
+func (c *MintCreditClient) mutate(ctx context.Context, m *MintCreditMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MintCreditCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MintCreditUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MintCreditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MintCreditDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MintCredit mutation op: %q", m.Op())
+	}
+}
+
+// NFTAccessoryClient is a client for the NFTAccessory schema.
+type NFTAccessoryClient struct {
+	config
+}
+
+// NewNFTAccessoryClient returns a client for the NFTAccessory from the given config.
+func NewNFTAccessoryClient(c config) *NFTAccessoryClient {
+	return &NFTAccessoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nftaccessory.Hooks(f(g(h())))`.
+func (c *NFTAccessoryClient) Use(hooks ...Hook) {
+	c.hooks.NFTAccessory = append(c.hooks.NFTAccessory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nftaccessory.Intercept(f(g(h())))`.
+func (c *NFTAccessoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NFTAccessory = append(c.inters.NFTAccessory, interceptors...)
+}
+
+// Create returns a builder for creating a NFTAccessory entity.
+func (c *NFTAccessoryClient) Create() *NFTAccessoryCreate {
+	mutation := newNFTAccessoryMutation(c.config, OpCreate)
+	return &NFTAccessoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NFTAccessory entities.
+func (c *NFTAccessoryClient) CreateBulk(builders ...*NFTAccessoryCreate) *NFTAccessoryCreateBulk {
+	return &NFTAccessoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NFTAccessoryClient) MapCreateBulk(slice any, setFunc func(*NFTAccessoryCreate, int)) *NFTAccessoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NFTAccessoryCreateBulk{err: fmt.Errorf("calling to NFTAccessoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NFTAccessoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NFTAccessoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NFTAccessory.
+func (c *NFTAccessoryClient) Update() *NFTAccessoryUpdate {
+	mutation := newNFTAccessoryMutation(c.config, OpUpdate)
+	return &NFTAccessoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NFTAccessoryClient) UpdateOne(_m *NFTAccessory) *NFTAccessoryUpdateOne {
+	mutation := newNFTAccessoryMutation(c.config, OpUpdateOne, withNFTAccessory(_m))
+	return &NFTAccessoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NFTAccessoryClient) UpdateOneID(id int) *NFTAccessoryUpdateOne {
+	mutation := newNFTAccessoryMutation(c.config, OpUpdateOne, withNFTAccessoryID(id))
+	return &NFTAccessoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NFTAccessory.
+func (c *NFTAccessoryClient) Delete() *NFTAccessoryDelete {
+	mutation := newNFTAccessoryMutation(c.config, OpDelete)
+	return &NFTAccessoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EventClient) DeleteOne(_m *Event) *EventDeleteOne {
+func (c *NFTAccessoryClient) DeleteOne(_m *NFTAccessory) *NFTAccessoryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EventClient) DeleteOneID(id int) *EventDeleteOne {
-	builder := c.Delete().Where(event.ID(id))
+func (c *NFTAccessoryClient) DeleteOneID(id int) *NFTAccessoryDeleteOne {
+	builder := c.Delete().Where(nftaccessory.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EventDeleteOne{builder}
+	return &NFTAccessoryDeleteOne{builder}
 }
 
-// Query returns a query builder for Event.
-func (c *EventClient) Query() *EventQuery {
-	return &EventQuery{
+// Query returns a query builder for NFTAccessory.
+func (c *NFTAccessoryClient) Query() *NFTAccessoryQuery {
+	return &NFTAccessoryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEvent},
+		ctx:    &QueryContext{Type: TypeNFTAccessory},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Event entity by its id.
-func (c *EventClient) Get(ctx context.Context, id int) (*Event, error) {
-	return c.Query().Where(event.ID(id)).Only(ctx)
+// Get returns a NFTAccessory entity by its id.
+func (c *NFTAccessoryClient) Get(ctx context.Context, id int) (*NFTAccessory, error) {
+	return c.Query().Where(nftaccessory.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EventClient) GetX(ctx context.Context, id int) *Event {
+func (c *NFTAccessoryClient) GetX(ctx context.Context, id int) *NFTAccessory {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -710,15 +4131,15 @@ func (c *EventClient) GetX(ctx context.Context, id int) *Event {
 	return obj
 }
 
-// QueryHost queries the host edge of a Event.
-func (c *EventClient) QueryHost(_m *Event) *UserQuery {
+// QueryOwner queries the owner edge of a NFTAccessory.
+func (c *NFTAccessoryClient) QueryOwner(_m *NFTAccessory) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.From(nftaccessory.Table, nftaccessory.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, event.HostTable, event.HostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, nftaccessory.OwnerTable, nftaccessory.OwnerColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -726,15 +4147,15 @@ func (c *EventClient) QueryHost(_m *Event) *UserQuery {
 	return query
 }
 
-// QueryPassesIssued queries the passes_issued edge of a Event.
-func (c *EventClient) QueryPassesIssued(_m *Event) *EventPassQuery {
-	query := (&EventPassClient{config: c.config}).Query()
+// QueryEquippedOnMoment queries the equipped_on_moment edge of a NFTAccessory.
+func (c *NFTAccessoryClient) QueryEquippedOnMoment(_m *NFTAccessory) *NFTMomentQuery {
+	query := (&NFTMomentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(event.Table, event.FieldID, id),
-			sqlgraph.To(eventpass.Table, eventpass.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, event.PassesIssuedTable, event.PassesIssuedColumn),
+			sqlgraph.From(nftaccessory.Table, nftaccessory.FieldID, id),
+			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nftaccessory.EquippedOnMomentTable, nftaccessory.EquippedOnMomentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -742,15 +4163,15 @@ func (c *EventClient) QueryPassesIssued(_m *Event) *EventPassQuery {
 	return query
 }
 
-// QueryAttendances queries the attendances edge of a Event.
-func (c *EventClient) QueryAttendances(_m *Event) *AttendanceQuery {
-	query := (&AttendanceClient{config: c.config}).Query()
+// QueryListing queries the listing edge of a NFTAccessory.
+func (c *NFTAccessoryClient) QueryListing(_m *NFTAccessory) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(event.Table, event.FieldID, id),
-			sqlgraph.To(attendance.Table, attendance.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, event.AttendancesTable, event.AttendancesColumn),
+			sqlgraph.From(nftaccessory.Table, nftaccessory.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, nftaccessory.ListingTable, nftaccessory.ListingColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -759,131 +4180,131 @@ func (c *EventClient) QueryAttendances(_m *Event) *AttendanceQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EventClient) Hooks() []Hook {
-	return c.hooks.Event
+func (c *NFTAccessoryClient) Hooks() []Hook {
+	return c.hooks.NFTAccessory
 }
 
 // Interceptors returns the client interceptors.
-func (c *EventClient) Interceptors() []Interceptor {
-	return c.inters.Event
+func (c *NFTAccessoryClient) Interceptors() []Interceptor {
+	return c.inters.NFTAccessory
 }
 
-func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, error) {
+func (c *NFTAccessoryClient) mutate(ctx context.Context, m *NFTAccessoryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NFTAccessoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NFTAccessoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NFTAccessoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NFTAccessoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Event mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NFTAccessory mutation op: %q", m.Op())
 	}
 }
 
-// EventPassClient is a client for the EventPass schema.
-type EventPassClient struct {
+// NFTMomentClient is a client for the NFTMoment schema.
+type NFTMomentClient struct {
 	config
 }
 
-// NewEventPassClient returns a client for the EventPass from the given config.
-func NewEventPassClient(c config) *EventPassClient {
-	return &EventPassClient{config: c}
+// NewNFTMomentClient returns a client for the NFTMoment from the given config.
+func NewNFTMomentClient(c config) *NFTMomentClient {
+	return &NFTMomentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `eventpass.Hooks(f(g(h())))`.
-func (c *EventPassClient) Use(hooks ...Hook) {
-	c.hooks.EventPass = append(c.hooks.EventPass, hooks...)
+// A call to `Use(f, g, h)` equals to `nftmoment.Hooks(f(g(h())))`.
+func (c *NFTMomentClient) Use(hooks ...Hook) {
+	c.hooks.NFTMoment = append(c.hooks.NFTMoment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `eventpass.Intercept(f(g(h())))`.
-func (c *EventPassClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EventPass = append(c.inters.EventPass, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `nftmoment.Intercept(f(g(h())))`.
+func (c *NFTMomentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NFTMoment = append(c.inters.NFTMoment, interceptors...)
 }
 
-// Create returns a builder for creating a EventPass entity.
-func (c *EventPassClient) Create() *EventPassCreate {
-	mutation := newEventPassMutation(c.config, OpCreate)
-	return &EventPassCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NFTMoment entity.
+func (c *NFTMomentClient) Create() *NFTMomentCreate {
+	mutation := newNFTMomentMutation(c.config, OpCreate)
+	return &NFTMomentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EventPass entities.
-func (c *EventPassClient) CreateBulk(builders ...*EventPassCreate) *EventPassCreateBulk {
-	return &EventPassCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NFTMoment entities.
+func (c *NFTMomentClient) CreateBulk(builders ...*NFTMomentCreate) *NFTMomentCreateBulk {
+	return &NFTMomentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EventPassClient) MapCreateBulk(slice any, setFunc func(*EventPassCreate, int)) *EventPassCreateBulk {
+func (c *NFTMomentClient) MapCreateBulk(slice any, setFunc func(*NFTMomentCreate, int)) *NFTMomentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EventPassCreateBulk{err: fmt.Errorf("calling to EventPassClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NFTMomentCreateBulk{err: fmt.Errorf("calling to NFTMomentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EventPassCreate, rv.Len())
+	builders := make([]*NFTMomentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EventPassCreateBulk{config: c.config, builders: builders}
+	return &NFTMomentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EventPass.
-func (c *EventPassClient) Update() *EventPassUpdate {
-	mutation := newEventPassMutation(c.config, OpUpdate)
-	return &EventPassUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NFTMoment.
+func (c *NFTMomentClient) Update() *NFTMomentUpdate {
+	mutation := newNFTMomentMutation(c.config, OpUpdate)
+	return &NFTMomentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EventPassClient) UpdateOne(_m *EventPass) *EventPassUpdateOne {
-	mutation := newEventPassMutation(c.config, OpUpdateOne, withEventPass(_m))
-	return &EventPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NFTMomentClient) UpdateOne(_m *NFTMoment) *NFTMomentUpdateOne {
+	mutation := newNFTMomentMutation(c.config, OpUpdateOne, withNFTMoment(_m))
+	return &NFTMomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EventPassClient) UpdateOneID(id int) *EventPassUpdateOne {
-	mutation := newEventPassMutation(c.config, OpUpdateOne, withEventPassID(id))
-	return &EventPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NFTMomentClient) UpdateOneID(id int) *NFTMomentUpdateOne {
+	mutation := newNFTMomentMutation(c.config, OpUpdateOne, withNFTMomentID(id))
+	return &NFTMomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EventPass.
-func (c *EventPassClient) Delete() *EventPassDelete {
-	mutation := newEventPassMutation(c.config, OpDelete)
-	return &EventPassDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NFTMoment.
+func (c *NFTMomentClient) Delete() *NFTMomentDelete {
+	mutation := newNFTMomentMutation(c.config, OpDelete)
+	return &NFTMomentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EventPassClient) DeleteOne(_m *EventPass) *EventPassDeleteOne {
+func (c *NFTMomentClient) DeleteOne(_m *NFTMoment) *NFTMomentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EventPassClient) DeleteOneID(id int) *EventPassDeleteOne {
-	builder := c.Delete().Where(eventpass.ID(id))
+func (c *NFTMomentClient) DeleteOneID(id int) *NFTMomentDeleteOne {
+	builder := c.Delete().Where(nftmoment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EventPassDeleteOne{builder}
+	return &NFTMomentDeleteOne{builder}
 }
 
-// Query returns a query builder for EventPass.
-func (c *EventPassClient) Query() *EventPassQuery {
-	return &EventPassQuery{
+// Query returns a query builder for NFTMoment.
+func (c *NFTMomentClient) Query() *NFTMomentQuery {
+	return &NFTMomentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEventPass},
+		ctx:    &QueryContext{Type: TypeNFTMoment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EventPass entity by its id.
-func (c *EventPassClient) Get(ctx context.Context, id int) (*EventPass, error) {
-	return c.Query().Where(eventpass.ID(id)).Only(ctx)
+// Get returns a NFTMoment entity by its id.
+func (c *NFTMomentClient) Get(ctx context.Context, id int) (*NFTMoment, error) {
+	return c.Query().Where(nftmoment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EventPassClient) GetX(ctx context.Context, id int) *EventPass {
+func (c *NFTMomentClient) GetX(ctx context.Context, id int) *NFTMoment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -891,15 +4312,15 @@ func (c *EventPassClient) GetX(ctx context.Context, id int) *EventPass {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a EventPass.
-func (c *EventPassClient) QueryOwner(_m *EventPass) *UserQuery {
+// QueryOwner queries the owner edge of a NFTMoment.
+func (c *NFTMomentClient) QueryOwner(_m *NFTMoment) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(eventpass.Table, eventpass.FieldID, id),
+			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, eventpass.OwnerTable, eventpass.OwnerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, nftmoment.OwnerTable, nftmoment.OwnerColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -907,15 +4328,15 @@ func (c *EventPassClient) QueryOwner(_m *EventPass) *UserQuery {
 	return query
 }
 
-// QueryEvent queries the event edge of a EventPass.
-func (c *EventPassClient) QueryEvent(_m *EventPass) *EventQuery {
-	query := (&EventClient{config: c.config}).Query()
+// QueryEquippedAccessories queries the equipped_accessories edge of a NFTMoment.
+func (c *NFTMomentClient) QueryEquippedAccessories(_m *NFTMoment) *NFTAccessoryQuery {
+	query := (&NFTAccessoryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(eventpass.Table, eventpass.FieldID, id),
-			sqlgraph.To(event.Table, event.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, eventpass.EventTable, eventpass.EventColumn),
+			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
+			sqlgraph.To(nftaccessory.Table, nftaccessory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, nftmoment.EquippedAccessoriesTable, nftmoment.EquippedAccessoriesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -923,15 +4344,47 @@ func (c *EventPassClient) QueryEvent(_m *EventPass) *EventQuery {
 	return query
 }
 
-// QueryMoment queries the moment edge of a EventPass.
-func (c *EventPassClient) QueryMoment(_m *EventPass) *NFTMomentQuery {
-	query := (&NFTMomentClient{config: c.config}).Query()
+// QueryMintedWithPass queries the minted_with_pass edge of a NFTMoment.
+func (c *NFTMomentClient) QueryMintedWithPass(_m *NFTMoment) *EventPassQuery {
+	query := (&EventPassClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(eventpass.Table, eventpass.FieldID, id),
-			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, eventpass.MomentTable, eventpass.MomentColumn),
+			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
+			sqlgraph.To(eventpass.Table, eventpass.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, nftmoment.MintedWithPassTable, nftmoment.MintedWithPassColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLikes queries the likes edge of a NFTMoment.
+func (c *NFTMomentClient) QueryLikes(_m *NFTMoment) *LikeQuery {
+	query := (&LikeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
+			sqlgraph.To(like.Table, like.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, nftmoment.LikesTable, nftmoment.LikesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a NFTMoment.
+func (c *NFTMomentClient) QueryComments(_m *NFTMoment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, nftmoment.CommentsTable, nftmoment.CommentsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -940,131 +4393,131 @@ func (c *EventPassClient) QueryMoment(_m *EventPass) *NFTMomentQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EventPassClient) Hooks() []Hook {
-	return c.hooks.EventPass
+func (c *NFTMomentClient) Hooks() []Hook {
+	return c.hooks.NFTMoment
 }
 
 // Interceptors returns the client interceptors.
-func (c *EventPassClient) Interceptors() []Interceptor {
-	return c.inters.EventPass
+func (c *NFTMomentClient) Interceptors() []Interceptor {
+	return c.inters.NFTMoment
 }
 
-func (c *EventPassClient) mutate(ctx context.Context, m *EventPassMutation) (Value, error) {
+func (c *NFTMomentClient) mutate(ctx context.Context, m *NFTMomentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EventPassCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NFTMomentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EventPassUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NFTMomentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EventPassUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NFTMomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EventPassDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NFTMomentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EventPass mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NFTMoment mutation op: %q", m.Op())
 	}
 }
 
-// LikeClient is a client for the Like schema.
-type LikeClient struct {
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
 	config
 }
 
-// NewLikeClient returns a client for the Like from the given config.
-func NewLikeClient(c config) *LikeClient {
-	return &LikeClient{config: c}
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `like.Hooks(f(g(h())))`.
-func (c *LikeClient) Use(hooks ...Hook) {
-	c.hooks.Like = append(c.hooks.Like, hooks...)
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `like.Intercept(f(g(h())))`.
-func (c *LikeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Like = append(c.inters.Like, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
 }
 
-// Create returns a builder for creating a Like entity.
-func (c *LikeClient) Create() *LikeCreate {
-	mutation := newLikeMutation(c.config, OpCreate)
-	return &LikeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Like entities.
-func (c *LikeClient) CreateBulk(builders ...*LikeCreate) *LikeCreateBulk {
-	return &LikeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LikeClient) MapCreateBulk(slice any, setFunc func(*LikeCreate, int)) *LikeCreateBulk {
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LikeCreateBulk{err: fmt.Errorf("calling to LikeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LikeCreate, rv.Len())
+	builders := make([]*NotificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LikeCreateBulk{config: c.config, builders: builders}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Like.
-func (c *LikeClient) Update() *LikeUpdate {
-	mutation := newLikeMutation(c.config, OpUpdate)
-	return &LikeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LikeClient) UpdateOne(_m *Like) *LikeUpdateOne {
-	mutation := newLikeMutation(c.config, OpUpdateOne, withLike(_m))
-	return &LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LikeClient) UpdateOneID(id int) *LikeUpdateOne {
-	mutation := newLikeMutation(c.config, OpUpdateOne, withLikeID(id))
-	return &LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Like.
-func (c *LikeClient) Delete() *LikeDelete {
-	mutation := newLikeMutation(c.config, OpDelete)
-	return &LikeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LikeClient) DeleteOne(_m *Like) *LikeDeleteOne {
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LikeClient) DeleteOneID(id int) *LikeDeleteOne {
-	builder := c.Delete().Where(like.ID(id))
+func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LikeDeleteOne{builder}
+	return &NotificationDeleteOne{builder}
 }
 
-// Query returns a query builder for Like.
-func (c *LikeClient) Query() *LikeQuery {
-	return &LikeQuery{
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLike},
+		ctx:    &QueryContext{Type: TypeNotification},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Like entity by its id.
-func (c *LikeClient) Get(ctx context.Context, id int) (*Like, error) {
-	return c.Query().Where(like.ID(id)).Only(ctx)
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LikeClient) GetX(ctx context.Context, id int) *Like {
+func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1072,164 +4525,132 @@ func (c *LikeClient) GetX(ctx context.Context, id int) *Like {
 	return obj
 }
 
-// QueryUser queries the user edge of a Like.
-func (c *LikeClient) QueryUser(_m *Like) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(like.Table, like.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, like.UserTable, like.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMoment queries the moment edge of a Like.
-func (c *LikeClient) QueryMoment(_m *Like) *NFTMomentQuery {
-	query := (&NFTMomentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(like.Table, like.FieldID, id),
-			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, like.MomentTable, like.MomentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LikeClient) Hooks() []Hook {
-	return c.hooks.Like
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
 }
 
 // Interceptors returns the client interceptors.
-func (c *LikeClient) Interceptors() []Interceptor {
-	return c.inters.Like
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
 }
 
-func (c *LikeClient) mutate(ctx context.Context, m *LikeMutation) (Value, error) {
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LikeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LikeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LikeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Like mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
 	}
 }
 
-// ListingClient is a client for the Listing schema.
-type ListingClient struct {
+// ReferralClient is a client for the Referral schema.
+type ReferralClient struct {
 	config
 }
 
-// NewListingClient returns a client for the Listing from the given config.
-func NewListingClient(c config) *ListingClient {
-	return &ListingClient{config: c}
+// NewReferralClient returns a client for the Referral from the given config.
+func NewReferralClient(c config) *ReferralClient {
+	return &ReferralClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `listing.Hooks(f(g(h())))`.
-func (c *ListingClient) Use(hooks ...Hook) {
-	c.hooks.Listing = append(c.hooks.Listing, hooks...)
+// A call to `Use(f, g, h)` equals to `referral.Hooks(f(g(h())))`.
+func (c *ReferralClient) Use(hooks ...Hook) {
+	c.hooks.Referral = append(c.hooks.Referral, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `listing.Intercept(f(g(h())))`.
-func (c *ListingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Listing = append(c.inters.Listing, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `referral.Intercept(f(g(h())))`.
+func (c *ReferralClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Referral = append(c.inters.Referral, interceptors...)
 }
 
-// Create returns a builder for creating a Listing entity.
-func (c *ListingClient) Create() *ListingCreate {
-	mutation := newListingMutation(c.config, OpCreate)
-	return &ListingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Referral entity.
+func (c *ReferralClient) Create() *ReferralCreate {
+	mutation := newReferralMutation(c.config, OpCreate)
+	return &ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Listing entities.
-func (c *ListingClient) CreateBulk(builders ...*ListingCreate) *ListingCreateBulk {
-	return &ListingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Referral entities.
+func (c *ReferralClient) CreateBulk(builders ...*ReferralCreate) *ReferralCreateBulk {
+	return &ReferralCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ListingClient) MapCreateBulk(slice any, setFunc func(*ListingCreate, int)) *ListingCreateBulk {
+func (c *ReferralClient) MapCreateBulk(slice any, setFunc func(*ReferralCreate, int)) *ReferralCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ListingCreateBulk{err: fmt.Errorf("calling to ListingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReferralCreateBulk{err: fmt.Errorf("calling to ReferralClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ListingCreate, rv.Len())
+	builders := make([]*ReferralCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ListingCreateBulk{config: c.config, builders: builders}
+	return &ReferralCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Listing.
-func (c *ListingClient) Update() *ListingUpdate {
-	mutation := newListingMutation(c.config, OpUpdate)
-	return &ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Referral.
+func (c *ReferralClient) Update() *ReferralUpdate {
+	mutation := newReferralMutation(c.config, OpUpdate)
+	return &ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ListingClient) UpdateOne(_m *Listing) *ListingUpdateOne {
-	mutation := newListingMutation(c.config, OpUpdateOne, withListing(_m))
-	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReferralClient) UpdateOne(_m *Referral) *ReferralUpdateOne {
+	mutation := newReferralMutation(c.config, OpUpdateOne, withReferral(_m))
+	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ListingClient) UpdateOneID(id int) *ListingUpdateOne {
-	mutation := newListingMutation(c.config, OpUpdateOne, withListingID(id))
-	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReferralClient) UpdateOneID(id int) *ReferralUpdateOne {
+	mutation := newReferralMutation(c.config, OpUpdateOne, withReferralID(id))
+	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Listing.
-func (c *ListingClient) Delete() *ListingDelete {
-	mutation := newListingMutation(c.config, OpDelete)
-	return &ListingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Referral.
+func (c *ReferralClient) Delete() *ReferralDelete {
+	mutation := newReferralMutation(c.config, OpDelete)
+	return &ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ListingClient) DeleteOne(_m *Listing) *ListingDeleteOne {
+func (c *ReferralClient) DeleteOne(_m *Referral) *ReferralDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ListingClient) DeleteOneID(id int) *ListingDeleteOne {
-	builder := c.Delete().Where(listing.ID(id))
+func (c *ReferralClient) DeleteOneID(id int) *ReferralDeleteOne {
+	builder := c.Delete().Where(referral.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ListingDeleteOne{builder}
+	return &ReferralDeleteOne{builder}
 }
 
-// Query returns a query builder for Listing.
-func (c *ListingClient) Query() *ListingQuery {
-	return &ListingQuery{
+// Query returns a query builder for Referral.
+func (c *ReferralClient) Query() *ReferralQuery {
+	return &ReferralQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeListing},
+		ctx:    &QueryContext{Type: TypeReferral},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Listing entity by its id.
-func (c *ListingClient) Get(ctx context.Context, id int) (*Listing, error) {
-	return c.Query().Where(listing.ID(id)).Only(ctx)
+// Get returns a Referral entity by its id.
+func (c *ReferralClient) Get(ctx context.Context, id int) (*Referral, error) {
+	return c.Query().Where(referral.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ListingClient) GetX(ctx context.Context, id int) *Listing {
+func (c *ReferralClient) GetX(ctx context.Context, id int) *Referral {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1237,345 +4658,265 @@ func (c *ListingClient) GetX(ctx context.Context, id int) *Listing {
 	return obj
 }
 
-// QuerySeller queries the seller edge of a Listing.
-func (c *ListingClient) QuerySeller(_m *Listing) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(listing.Table, listing.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, listing.SellerTable, listing.SellerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryNftAccessory queries the nft_accessory edge of a Listing.
-func (c *ListingClient) QueryNftAccessory(_m *Listing) *NFTAccessoryQuery {
-	query := (&NFTAccessoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(listing.Table, listing.FieldID, id),
-			sqlgraph.To(nftaccessory.Table, nftaccessory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, listing.NftAccessoryTable, listing.NftAccessoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ListingClient) Hooks() []Hook {
-	return c.hooks.Listing
+func (c *ReferralClient) Hooks() []Hook {
+	return c.hooks.Referral
 }
 
 // Interceptors returns the client interceptors.
-func (c *ListingClient) Interceptors() []Interceptor {
-	return c.inters.Listing
+func (c *ReferralClient) Interceptors() []Interceptor {
+	return c.inters.Referral
 }
 
-func (c *ListingClient) mutate(ctx context.Context, m *ListingMutation) (Value, error) {
+func (c *ReferralClient) mutate(ctx context.Context, m *ReferralMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ListingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ListingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Listing mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Referral mutation op: %q", m.Op())
 	}
 }
 
-// NFTAccessoryClient is a client for the NFTAccessory schema.
-type NFTAccessoryClient struct {
+// SeriesOccurrenceClient is a client for the SeriesOccurrence schema.
+type SeriesOccurrenceClient struct {
 	config
 }
 
-// NewNFTAccessoryClient returns a client for the NFTAccessory from the given config.
-func NewNFTAccessoryClient(c config) *NFTAccessoryClient {
-	return &NFTAccessoryClient{config: c}
+// NewSeriesOccurrenceClient returns a client for the SeriesOccurrence from the given config.
+func NewSeriesOccurrenceClient(c config) *SeriesOccurrenceClient {
+	return &SeriesOccurrenceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `nftaccessory.Hooks(f(g(h())))`.
-func (c *NFTAccessoryClient) Use(hooks ...Hook) {
-	c.hooks.NFTAccessory = append(c.hooks.NFTAccessory, hooks...)
+// A call to `Use(f, g, h)` equals to `seriesoccurrence.Hooks(f(g(h())))`.
+func (c *SeriesOccurrenceClient) Use(hooks ...Hook) {
+	c.hooks.SeriesOccurrence = append(c.hooks.SeriesOccurrence, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `nftaccessory.Intercept(f(g(h())))`.
-func (c *NFTAccessoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NFTAccessory = append(c.inters.NFTAccessory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `seriesoccurrence.Intercept(f(g(h())))`.
+func (c *SeriesOccurrenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SeriesOccurrence = append(c.inters.SeriesOccurrence, interceptors...)
 }
 
-// Create returns a builder for creating a NFTAccessory entity.
-func (c *NFTAccessoryClient) Create() *NFTAccessoryCreate {
-	mutation := newNFTAccessoryMutation(c.config, OpCreate)
-	return &NFTAccessoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SeriesOccurrence entity.
+func (c *SeriesOccurrenceClient) Create() *SeriesOccurrenceCreate {
+	mutation := newSeriesOccurrenceMutation(c.config, OpCreate)
+	return &SeriesOccurrenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NFTAccessory entities.
-func (c *NFTAccessoryClient) CreateBulk(builders ...*NFTAccessoryCreate) *NFTAccessoryCreateBulk {
-	return &NFTAccessoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SeriesOccurrence entities.
+func (c *SeriesOccurrenceClient) CreateBulk(builders ...*SeriesOccurrenceCreate) *SeriesOccurrenceCreateBulk {
+	return &SeriesOccurrenceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NFTAccessoryClient) MapCreateBulk(slice any, setFunc func(*NFTAccessoryCreate, int)) *NFTAccessoryCreateBulk {
+func (c *SeriesOccurrenceClient) MapCreateBulk(slice any, setFunc func(*SeriesOccurrenceCreate, int)) *SeriesOccurrenceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NFTAccessoryCreateBulk{err: fmt.Errorf("calling to NFTAccessoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SeriesOccurrenceCreateBulk{err: fmt.Errorf("calling to SeriesOccurrenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NFTAccessoryCreate, rv.Len())
+	builders := make([]*SeriesOccurrenceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NFTAccessoryCreateBulk{config: c.config, builders: builders}
+	return &SeriesOccurrenceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NFTAccessory.
-func (c *NFTAccessoryClient) Update() *NFTAccessoryUpdate {
-	mutation := newNFTAccessoryMutation(c.config, OpUpdate)
-	return &NFTAccessoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SeriesOccurrence.
+func (c *SeriesOccurrenceClient) Update() *SeriesOccurrenceUpdate {
+	mutation := newSeriesOccurrenceMutation(c.config, OpUpdate)
+	return &SeriesOccurrenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NFTAccessoryClient) UpdateOne(_m *NFTAccessory) *NFTAccessoryUpdateOne {
-	mutation := newNFTAccessoryMutation(c.config, OpUpdateOne, withNFTAccessory(_m))
-	return &NFTAccessoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SeriesOccurrenceClient) UpdateOne(_m *SeriesOccurrence) *SeriesOccurrenceUpdateOne {
+	mutation := newSeriesOccurrenceMutation(c.config, OpUpdateOne, withSeriesOccurrence(_m))
+	return &SeriesOccurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NFTAccessoryClient) UpdateOneID(id int) *NFTAccessoryUpdateOne {
-	mutation := newNFTAccessoryMutation(c.config, OpUpdateOne, withNFTAccessoryID(id))
-	return &NFTAccessoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SeriesOccurrenceClient) UpdateOneID(id int) *SeriesOccurrenceUpdateOne {
+	mutation := newSeriesOccurrenceMutation(c.config, OpUpdateOne, withSeriesOccurrenceID(id))
+	return &SeriesOccurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NFTAccessory.
-func (c *NFTAccessoryClient) Delete() *NFTAccessoryDelete {
-	mutation := newNFTAccessoryMutation(c.config, OpDelete)
-	return &NFTAccessoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SeriesOccurrence.
+func (c *SeriesOccurrenceClient) Delete() *SeriesOccurrenceDelete {
+	mutation := newSeriesOccurrenceMutation(c.config, OpDelete)
+	return &SeriesOccurrenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NFTAccessoryClient) DeleteOne(_m *NFTAccessory) *NFTAccessoryDeleteOne {
+func (c *SeriesOccurrenceClient) DeleteOne(_m *SeriesOccurrence) *SeriesOccurrenceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NFTAccessoryClient) DeleteOneID(id int) *NFTAccessoryDeleteOne {
-	builder := c.Delete().Where(nftaccessory.ID(id))
+func (c *SeriesOccurrenceClient) DeleteOneID(id int) *SeriesOccurrenceDeleteOne {
+	builder := c.Delete().Where(seriesoccurrence.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NFTAccessoryDeleteOne{builder}
+	return &SeriesOccurrenceDeleteOne{builder}
 }
 
-// Query returns a query builder for NFTAccessory.
-func (c *NFTAccessoryClient) Query() *NFTAccessoryQuery {
-	return &NFTAccessoryQuery{
+// Query returns a query builder for SeriesOccurrence.
+func (c *SeriesOccurrenceClient) Query() *SeriesOccurrenceQuery {
+	return &SeriesOccurrenceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNFTAccessory},
+		ctx:    &QueryContext{Type: TypeSeriesOccurrence},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NFTAccessory entity by its id.
-func (c *NFTAccessoryClient) Get(ctx context.Context, id int) (*NFTAccessory, error) {
-	return c.Query().Where(nftaccessory.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *NFTAccessoryClient) GetX(ctx context.Context, id int) *NFTAccessory {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryOwner queries the owner edge of a NFTAccessory.
-func (c *NFTAccessoryClient) QueryOwner(_m *NFTAccessory) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nftaccessory.Table, nftaccessory.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, nftaccessory.OwnerTable, nftaccessory.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEquippedOnMoment queries the equipped_on_moment edge of a NFTAccessory.
-func (c *NFTAccessoryClient) QueryEquippedOnMoment(_m *NFTAccessory) *NFTMomentQuery {
-	query := (&NFTMomentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nftaccessory.Table, nftaccessory.FieldID, id),
-			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, nftaccessory.EquippedOnMomentTable, nftaccessory.EquippedOnMomentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Get returns a SeriesOccurrence entity by its id.
+func (c *SeriesOccurrenceClient) Get(ctx context.Context, id int) (*SeriesOccurrence, error) {
+	return c.Query().Where(seriesoccurrence.ID(id)).Only(ctx)
 }
 
-// QueryListing queries the listing edge of a NFTAccessory.
-func (c *NFTAccessoryClient) QueryListing(_m *NFTAccessory) *ListingQuery {
-	query := (&ListingClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nftaccessory.Table, nftaccessory.FieldID, id),
-			sqlgraph.To(listing.Table, listing.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, nftaccessory.ListingTable, nftaccessory.ListingColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// GetX is like Get, but panics if an error occurs.
+func (c *SeriesOccurrenceClient) GetX(ctx context.Context, id int) *SeriesOccurrence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *NFTAccessoryClient) Hooks() []Hook {
-	return c.hooks.NFTAccessory
+func (c *SeriesOccurrenceClient) Hooks() []Hook {
+	return c.hooks.SeriesOccurrence
 }
 
 // Interceptors returns the client interceptors.
-func (c *NFTAccessoryClient) Interceptors() []Interceptor {
-	return c.inters.NFTAccessory
+func (c *SeriesOccurrenceClient) Interceptors() []Interceptor {
+	return c.inters.SeriesOccurrence
 }
 
-func (c *NFTAccessoryClient) mutate(ctx context.Context, m *NFTAccessoryMutation) (Value, error) {
+func (c *SeriesOccurrenceClient) mutate(ctx context.Context, m *SeriesOccurrenceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NFTAccessoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeriesOccurrenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NFTAccessoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeriesOccurrenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NFTAccessoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SeriesOccurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NFTAccessoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SeriesOccurrenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NFTAccessory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SeriesOccurrence mutation op: %q", m.Op())
 	}
 }
 
-// NFTMomentClient is a client for the NFTMoment schema.
-type NFTMomentClient struct {
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
 	config
 }
 
-// NewNFTMomentClient returns a client for the NFTMoment from the given config.
-func NewNFTMomentClient(c config) *NFTMomentClient {
-	return &NFTMomentClient{config: c}
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `nftmoment.Hooks(f(g(h())))`.
-func (c *NFTMomentClient) Use(hooks ...Hook) {
-	c.hooks.NFTMoment = append(c.hooks.NFTMoment, hooks...)
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `nftmoment.Intercept(f(g(h())))`.
-func (c *NFTMomentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NFTMoment = append(c.inters.NFTMoment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
 }
 
-// Create returns a builder for creating a NFTMoment entity.
-func (c *NFTMomentClient) Create() *NFTMomentCreate {
-	mutation := newNFTMomentMutation(c.config, OpCreate)
-	return &NFTMomentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NFTMoment entities.
-func (c *NFTMomentClient) CreateBulk(builders ...*NFTMomentCreate) *NFTMomentCreateBulk {
-	return &NFTMomentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NFTMomentClient) MapCreateBulk(slice any, setFunc func(*NFTMomentCreate, int)) *NFTMomentCreateBulk {
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NFTMomentCreateBulk{err: fmt.Errorf("calling to NFTMomentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NFTMomentCreate, rv.Len())
+	builders := make([]*SessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NFTMomentCreateBulk{config: c.config, builders: builders}
+	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NFTMoment.
-func (c *NFTMomentClient) Update() *NFTMomentUpdate {
-	mutation := newNFTMomentMutation(c.config, OpUpdate)
-	return &NFTMomentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NFTMomentClient) UpdateOne(_m *NFTMoment) *NFTMomentUpdateOne {
-	mutation := newNFTMomentMutation(c.config, OpUpdateOne, withNFTMoment(_m))
-	return &NFTMomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOne(_m *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(_m))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NFTMomentClient) UpdateOneID(id int) *NFTMomentUpdateOne {
-	mutation := newNFTMomentMutation(c.config, OpUpdateOne, withNFTMomentID(id))
-	return &NFTMomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOneID(id int) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NFTMoment.
-func (c *NFTMomentClient) Delete() *NFTMomentDelete {
-	mutation := newNFTMomentMutation(c.config, OpDelete)
-	return &NFTMomentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NFTMomentClient) DeleteOne(_m *NFTMoment) *NFTMomentDeleteOne {
+func (c *SessionClient) DeleteOne(_m *Session) *SessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NFTMomentClient) DeleteOneID(id int) *NFTMomentDeleteOne {
-	builder := c.Delete().Where(nftmoment.ID(id))
+func (c *SessionClient) DeleteOneID(id int) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NFTMomentDeleteOne{builder}
+	return &SessionDeleteOne{builder}
 }
 
-// Query returns a query builder for NFTMoment.
-func (c *NFTMomentClient) Query() *NFTMomentQuery {
-	return &NFTMomentQuery{
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNFTMoment},
+		ctx:    &QueryContext{Type: TypeSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NFTMoment entity by its id.
-func (c *NFTMomentClient) Get(ctx context.Context, id int) (*NFTMoment, error) {
-	return c.Query().Where(nftmoment.ID(id)).Only(ctx)
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id int) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NFTMomentClient) GetX(ctx context.Context, id int) *NFTMoment {
+func (c *SessionClient) GetX(ctx context.Context, id int) *Session {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1583,108 +4924,28 @@ func (c *NFTMomentClient) GetX(ctx context.Context, id int) *NFTMoment {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a NFTMoment.
-func (c *NFTMomentClient) QueryOwner(_m *NFTMoment) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, nftmoment.OwnerTable, nftmoment.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEquippedAccessories queries the equipped_accessories edge of a NFTMoment.
-func (c *NFTMomentClient) QueryEquippedAccessories(_m *NFTMoment) *NFTAccessoryQuery {
-	query := (&NFTAccessoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
-			sqlgraph.To(nftaccessory.Table, nftaccessory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, nftmoment.EquippedAccessoriesTable, nftmoment.EquippedAccessoriesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMintedWithPass queries the minted_with_pass edge of a NFTMoment.
-func (c *NFTMomentClient) QueryMintedWithPass(_m *NFTMoment) *EventPassQuery {
-	query := (&EventPassClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
-			sqlgraph.To(eventpass.Table, eventpass.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, nftmoment.MintedWithPassTable, nftmoment.MintedWithPassColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLikes queries the likes edge of a NFTMoment.
-func (c *NFTMomentClient) QueryLikes(_m *NFTMoment) *LikeQuery {
-	query := (&LikeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
-			sqlgraph.To(like.Table, like.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, nftmoment.LikesTable, nftmoment.LikesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryComments queries the comments edge of a NFTMoment.
-func (c *NFTMomentClient) QueryComments(_m *NFTMoment) *CommentQuery {
-	query := (&CommentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, id),
-			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, nftmoment.CommentsTable, nftmoment.CommentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *NFTMomentClient) Hooks() []Hook {
-	return c.hooks.NFTMoment
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
 }
 
 // Interceptors returns the client interceptors.
-func (c *NFTMomentClient) Interceptors() []Interceptor {
-	return c.inters.NFTMoment
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
 }
 
-func (c *NFTMomentClient) mutate(ctx context.Context, m *NFTMomentMutation) (Value, error) {
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NFTMomentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NFTMomentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NFTMomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NFTMomentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NFTMoment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
 	}
 }
 
@@ -1949,14 +5210,155 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// WaitlistEntryClient is a client for the WaitlistEntry schema.
+type WaitlistEntryClient struct {
+	config
+}
+
+// NewWaitlistEntryClient returns a client for the WaitlistEntry from the given config.
+func NewWaitlistEntryClient(c config) *WaitlistEntryClient {
+	return &WaitlistEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `waitlistentry.Hooks(f(g(h())))`.
+func (c *WaitlistEntryClient) Use(hooks ...Hook) {
+	c.hooks.WaitlistEntry = append(c.hooks.WaitlistEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `waitlistentry.Intercept(f(g(h())))`.
+func (c *WaitlistEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WaitlistEntry = append(c.inters.WaitlistEntry, interceptors...)
+}
+
+// Create returns a builder for creating a WaitlistEntry entity.
+func (c *WaitlistEntryClient) Create() *WaitlistEntryCreate {
+	mutation := newWaitlistEntryMutation(c.config, OpCreate)
+	return &WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WaitlistEntry entities.
+func (c *WaitlistEntryClient) CreateBulk(builders ...*WaitlistEntryCreate) *WaitlistEntryCreateBulk {
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WaitlistEntryClient) MapCreateBulk(slice any, setFunc func(*WaitlistEntryCreate, int)) *WaitlistEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WaitlistEntryCreateBulk{err: fmt.Errorf("calling to WaitlistEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WaitlistEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Update() *WaitlistEntryUpdate {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdate)
+	return &WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WaitlistEntryClient) UpdateOne(_m *WaitlistEntry) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntry(_m))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WaitlistEntryClient) UpdateOneID(id int) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntryID(id))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Delete() *WaitlistEntryDelete {
+	mutation := newWaitlistEntryMutation(c.config, OpDelete)
+	return &WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WaitlistEntryClient) DeleteOne(_m *WaitlistEntry) *WaitlistEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WaitlistEntryClient) DeleteOneID(id int) *WaitlistEntryDeleteOne {
+	builder := c.Delete().Where(waitlistentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WaitlistEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Query() *WaitlistEntryQuery {
+	return &WaitlistEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWaitlistEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WaitlistEntry entity by its id.
+func (c *WaitlistEntryClient) Get(ctx context.Context, id int) (*WaitlistEntry, error) {
+	return c.Query().Where(waitlistentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WaitlistEntryClient) GetX(ctx context.Context, id int) *WaitlistEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WaitlistEntryClient) Hooks() []Hook {
+	return c.hooks.WaitlistEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *WaitlistEntryClient) Interceptors() []Interceptor {
+	return c.inters.WaitlistEntry
+}
+
+func (c *WaitlistEntryClient) mutate(ctx context.Context, m *WaitlistEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WaitlistEntry mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Attendance, Comment, Event, EventPass, Like, Listing, NFTAccessory, NFTMoment,
-		User []ent.Hook
+		APIKey, APIKeyUsage, Attendance, AuthNonce, CalendarToken, CheckInIntent,
+		CheckInTokenUse, Claim, ClaimQuota, Comment, DataVersion, Event, EventChange,
+		EventImportRow, EventPass, EventSeries, EventStaff, IdempotencyKey, InviteCode,
+		JoinLink, KioskDevice, Like, Listing, LocationFix, MintCredit, NFTAccessory,
+		NFTMoment, Notification, Referral, SeriesOccurrence, Session, User,
+		WaitlistEntry []ent.Hook
 	}
 	inters struct {
-		Attendance, Comment, Event, EventPass, Like, Listing, NFTAccessory, NFTMoment,
-		User []ent.Interceptor
+		APIKey, APIKeyUsage, Attendance, AuthNonce, CalendarToken, CheckInIntent,
+		CheckInTokenUse, Claim, ClaimQuota, Comment, DataVersion, Event, EventChange,
+		EventImportRow, EventPass, EventSeries, EventStaff, IdempotencyKey, InviteCode,
+		JoinLink, KioskDevice, Like, Listing, LocationFix, MintCredit, NFTAccessory,
+		NFTMoment, Notification, Referral, SeriesOccurrence, Session, User,
+		WaitlistEntry []ent.Interceptor
 	}
 )
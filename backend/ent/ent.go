@@ -3,15 +3,39 @@
 package ent
 
 import (
+	"backend/ent/apikey"
+	"backend/ent/apikeyusage"
 	"backend/ent/attendance"
+	"backend/ent/authnonce"
+	"backend/ent/calendartoken"
+	"backend/ent/checkinintent"
+	"backend/ent/checkintokenuse"
+	"backend/ent/claim"
+	"backend/ent/claimquota"
 	"backend/ent/comment"
+	"backend/ent/dataversion"
 	"backend/ent/event"
+	"backend/ent/eventchange"
+	"backend/ent/eventimportrow"
 	"backend/ent/eventpass"
+	"backend/ent/eventseries"
+	"backend/ent/eventstaff"
+	"backend/ent/idempotencykey"
+	"backend/ent/invitecode"
+	"backend/ent/joinlink"
+	"backend/ent/kioskdevice"
 	"backend/ent/like"
 	"backend/ent/listing"
+	"backend/ent/locationfix"
+	"backend/ent/mintcredit"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
+	"backend/ent/notification"
+	"backend/ent/referral"
+	"backend/ent/seriesoccurrence"
+	"backend/ent/session"
 	"backend/ent/user"
+	"backend/ent/waitlistentry"
 	"context"
 	"errors"
 	"fmt"
@@ -81,15 +105,39 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			attendance.Table:   attendance.ValidColumn,
-			comment.Table:      comment.ValidColumn,
-			event.Table:        event.ValidColumn,
-			eventpass.Table:    eventpass.ValidColumn,
-			like.Table:         like.ValidColumn,
-			listing.Table:      listing.ValidColumn,
-			nftaccessory.Table: nftaccessory.ValidColumn,
-			nftmoment.Table:    nftmoment.ValidColumn,
-			user.Table:         user.ValidColumn,
+			apikey.Table:           apikey.ValidColumn,
+			apikeyusage.Table:      apikeyusage.ValidColumn,
+			attendance.Table:       attendance.ValidColumn,
+			authnonce.Table:        authnonce.ValidColumn,
+			calendartoken.Table:    calendartoken.ValidColumn,
+			checkinintent.Table:    checkinintent.ValidColumn,
+			checkintokenuse.Table:  checkintokenuse.ValidColumn,
+			claim.Table:            claim.ValidColumn,
+			claimquota.Table:       claimquota.ValidColumn,
+			comment.Table:          comment.ValidColumn,
+			dataversion.Table:      dataversion.ValidColumn,
+			event.Table:            event.ValidColumn,
+			eventchange.Table:      eventchange.ValidColumn,
+			eventimportrow.Table:   eventimportrow.ValidColumn,
+			eventpass.Table:        eventpass.ValidColumn,
+			eventseries.Table:      eventseries.ValidColumn,
+			eventstaff.Table:       eventstaff.ValidColumn,
+			idempotencykey.Table:   idempotencykey.ValidColumn,
+			invitecode.Table:       invitecode.ValidColumn,
+			joinlink.Table:         joinlink.ValidColumn,
+			kioskdevice.Table:      kioskdevice.ValidColumn,
+			like.Table:             like.ValidColumn,
+			listing.Table:          listing.ValidColumn,
+			locationfix.Table:      locationfix.ValidColumn,
+			mintcredit.Table:       mintcredit.ValidColumn,
+			nftaccessory.Table:     nftaccessory.ValidColumn,
+			nftmoment.Table:        nftmoment.ValidColumn,
+			notification.Table:     notification.ValidColumn,
+			referral.Table:         referral.ValidColumn,
+			seriesoccurrence.Table: seriesoccurrence.ValidColumn,
+			session.Table:          session.ValidColumn,
+			user.Table:             user.ValidColumn,
+			waitlistentry.Table:    waitlistentry.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -40,6 +40,16 @@ type Event struct {
 	EndDate time.Time `json:"end_date,omitempty"`
 	// Quota holds the value of the "quota" field.
 	Quota uint64 `json:"quota,omitempty"`
+	// CheckinRadiusM holds the value of the "checkin_radius_m" field.
+	CheckinRadiusM float64 `json:"checkin_radius_m,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CancelReason holds the value of the "cancel_reason" field.
+	CancelReason string `json:"cancel_reason,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
+	// AttendeeVisibility holds the value of the "attendee_visibility" field.
+	AttendeeVisibility event.AttendeeVisibility `json:"attendee_visibility,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EventQuery when eager-loading is set.
 	Edges              EventEdges `json:"edges"`
@@ -94,13 +104,13 @@ func (*Event) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case event.FieldLat, event.FieldLong:
+		case event.FieldLat, event.FieldLong, event.FieldCheckinRadiusM:
 			values[i] = new(sql.NullFloat64)
-		case event.FieldID, event.FieldEventID, event.FieldEventType, event.FieldQuota:
+		case event.FieldID, event.FieldEventID, event.FieldEventType, event.FieldQuota, event.FieldRevision:
 			values[i] = new(sql.NullInt64)
-		case event.FieldName, event.FieldDescription, event.FieldThumbnail, event.FieldLocation:
+		case event.FieldName, event.FieldDescription, event.FieldThumbnail, event.FieldLocation, event.FieldCancelReason, event.FieldAttendeeVisibility:
 			values[i] = new(sql.NullString)
-		case event.FieldStartDate, event.FieldEndDate:
+		case event.FieldStartDate, event.FieldEndDate, event.FieldCancelledAt:
 			values[i] = new(sql.NullTime)
 		case event.ForeignKeys[0]: // user_hosted_events
 			values[i] = new(sql.NullInt64)
@@ -191,6 +201,37 @@ func (_m *Event) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Quota = uint64(value.Int64)
 			}
+		case event.FieldCheckinRadiusM:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field checkin_radius_m", values[i])
+			} else if value.Valid {
+				_m.CheckinRadiusM = value.Float64
+			}
+		case event.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case event.FieldCancelReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_reason", values[i])
+			} else if value.Valid {
+				_m.CancelReason = value.String
+			}
+		case event.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case event.FieldAttendeeVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attendee_visibility", values[i])
+			} else if value.Valid {
+				_m.AttendeeVisibility = event.AttendeeVisibility(value.String)
+			}
 		case event.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_hosted_events", value)
@@ -281,6 +322,23 @@ func (_m *Event) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("quota=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Quota))
+	builder.WriteString(", ")
+	builder.WriteString("checkin_radius_m=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CheckinRadiusM))
+	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancel_reason=")
+	builder.WriteString(_m.CancelReason)
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("attendee_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttendeeVisibility))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package event
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -34,6 +36,16 @@ const (
 	FieldEndDate = "end_date"
 	// FieldQuota holds the string denoting the quota field in the database.
 	FieldQuota = "quota"
+	// FieldCheckinRadiusM holds the string denoting the checkin_radius_m field in the database.
+	FieldCheckinRadiusM = "checkin_radius_m"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCancelReason holds the string denoting the cancel_reason field in the database.
+	FieldCancelReason = "cancel_reason"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldAttendeeVisibility holds the string denoting the attendee_visibility field in the database.
+	FieldAttendeeVisibility = "attendee_visibility"
 	// EdgeHost holds the string denoting the host edge name in mutations.
 	EdgeHost = "host"
 	// EdgePassesIssued holds the string denoting the passes_issued edge name in mutations.
@@ -79,6 +91,11 @@ var Columns = []string{
 	FieldStartDate,
 	FieldEndDate,
 	FieldQuota,
+	FieldCheckinRadiusM,
+	FieldCancelledAt,
+	FieldCancelReason,
+	FieldRevision,
+	FieldAttendeeVisibility,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "events"
@@ -102,6 +119,40 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultCheckinRadiusM holds the default value on creation for the "checkin_radius_m" field.
+	DefaultCheckinRadiusM float64
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int
+)
+
+// AttendeeVisibility defines the type for the "attendee_visibility" enum field.
+type AttendeeVisibility string
+
+// AttendeeVisibilityPublic is the default value of the AttendeeVisibility enum.
+const DefaultAttendeeVisibility = AttendeeVisibilityPublic
+
+// AttendeeVisibility values.
+const (
+	AttendeeVisibilityPublic    AttendeeVisibility = "public"
+	AttendeeVisibilityAttendees AttendeeVisibility = "attendees"
+	AttendeeVisibilityHost      AttendeeVisibility = "host"
+)
+
+func (av AttendeeVisibility) String() string {
+	return string(av)
+}
+
+// AttendeeVisibilityValidator is a validator for the "attendee_visibility" field enum values. It is called by the builders before save.
+func AttendeeVisibilityValidator(av AttendeeVisibility) error {
+	switch av {
+	case AttendeeVisibilityPublic, AttendeeVisibilityAttendees, AttendeeVisibilityHost:
+		return nil
+	default:
+		return fmt.Errorf("event: invalid enum value for attendee_visibility field: %q", av)
+	}
+}
+
 // OrderOption defines the ordering options for the Event queries.
 type OrderOption func(*sql.Selector)
 
@@ -165,6 +216,31 @@ func ByQuota(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuota, opts...).ToFunc()
 }
 
+// ByCheckinRadiusM orders the results by the checkin_radius_m field.
+func ByCheckinRadiusM(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckinRadiusM, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCancelReason orders the results by the cancel_reason field.
+func ByCancelReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelReason, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByAttendeeVisibility orders the results by the attendee_visibility field.
+func ByAttendeeVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendeeVisibility, opts...).ToFunc()
+}
+
 // ByHostField orders the results by host field.
 func ByHostField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
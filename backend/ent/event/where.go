@@ -110,6 +110,26 @@ func Quota(v uint64) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldQuota, v))
 }
 
+// CheckinRadiusM applies equality check predicate on the "checkin_radius_m" field. It's identical to CheckinRadiusMEQ.
+func CheckinRadiusM(v float64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCheckinRadiusM, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelReason applies equality check predicate on the "cancel_reason" field. It's identical to CancelReasonEQ.
+func CancelReason(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRevision, v))
+}
+
 // EventIDEQ applies the EQ predicate on the "event_id" field.
 func EventIDEQ(v uint64) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldEventID, v))
@@ -650,6 +670,231 @@ func QuotaLTE(v uint64) predicate.Event {
 	return predicate.Event(sql.FieldLTE(FieldQuota, v))
 }
 
+// CheckinRadiusMEQ applies the EQ predicate on the "checkin_radius_m" field.
+func CheckinRadiusMEQ(v float64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCheckinRadiusM, v))
+}
+
+// CheckinRadiusMNEQ applies the NEQ predicate on the "checkin_radius_m" field.
+func CheckinRadiusMNEQ(v float64) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldCheckinRadiusM, v))
+}
+
+// CheckinRadiusMIn applies the In predicate on the "checkin_radius_m" field.
+func CheckinRadiusMIn(vs ...float64) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldCheckinRadiusM, vs...))
+}
+
+// CheckinRadiusMNotIn applies the NotIn predicate on the "checkin_radius_m" field.
+func CheckinRadiusMNotIn(vs ...float64) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldCheckinRadiusM, vs...))
+}
+
+// CheckinRadiusMGT applies the GT predicate on the "checkin_radius_m" field.
+func CheckinRadiusMGT(v float64) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldCheckinRadiusM, v))
+}
+
+// CheckinRadiusMGTE applies the GTE predicate on the "checkin_radius_m" field.
+func CheckinRadiusMGTE(v float64) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldCheckinRadiusM, v))
+}
+
+// CheckinRadiusMLT applies the LT predicate on the "checkin_radius_m" field.
+func CheckinRadiusMLT(v float64) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldCheckinRadiusM, v))
+}
+
+// CheckinRadiusMLTE applies the LTE predicate on the "checkin_radius_m" field.
+func CheckinRadiusMLTE(v float64) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldCheckinRadiusM, v))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CancelReasonEQ applies the EQ predicate on the "cancel_reason" field.
+func CancelReasonEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// CancelReasonNEQ applies the NEQ predicate on the "cancel_reason" field.
+func CancelReasonNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldCancelReason, v))
+}
+
+// CancelReasonIn applies the In predicate on the "cancel_reason" field.
+func CancelReasonIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonNotIn applies the NotIn predicate on the "cancel_reason" field.
+func CancelReasonNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonGT applies the GT predicate on the "cancel_reason" field.
+func CancelReasonGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldCancelReason, v))
+}
+
+// CancelReasonGTE applies the GTE predicate on the "cancel_reason" field.
+func CancelReasonGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldCancelReason, v))
+}
+
+// CancelReasonLT applies the LT predicate on the "cancel_reason" field.
+func CancelReasonLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldCancelReason, v))
+}
+
+// CancelReasonLTE applies the LTE predicate on the "cancel_reason" field.
+func CancelReasonLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldCancelReason, v))
+}
+
+// CancelReasonContains applies the Contains predicate on the "cancel_reason" field.
+func CancelReasonContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldCancelReason, v))
+}
+
+// CancelReasonHasPrefix applies the HasPrefix predicate on the "cancel_reason" field.
+func CancelReasonHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldCancelReason, v))
+}
+
+// CancelReasonHasSuffix applies the HasSuffix predicate on the "cancel_reason" field.
+func CancelReasonHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldCancelReason, v))
+}
+
+// CancelReasonIsNil applies the IsNil predicate on the "cancel_reason" field.
+func CancelReasonIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldCancelReason))
+}
+
+// CancelReasonNotNil applies the NotNil predicate on the "cancel_reason" field.
+func CancelReasonNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldCancelReason))
+}
+
+// CancelReasonEqualFold applies the EqualFold predicate on the "cancel_reason" field.
+func CancelReasonEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldCancelReason, v))
+}
+
+// CancelReasonContainsFold applies the ContainsFold predicate on the "cancel_reason" field.
+func CancelReasonContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldCancelReason, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldRevision, v))
+}
+
+// AttendeeVisibilityEQ applies the EQ predicate on the "attendee_visibility" field.
+func AttendeeVisibilityEQ(v AttendeeVisibility) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAttendeeVisibility, v))
+}
+
+// AttendeeVisibilityNEQ applies the NEQ predicate on the "attendee_visibility" field.
+func AttendeeVisibilityNEQ(v AttendeeVisibility) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldAttendeeVisibility, v))
+}
+
+// AttendeeVisibilityIn applies the In predicate on the "attendee_visibility" field.
+func AttendeeVisibilityIn(vs ...AttendeeVisibility) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldAttendeeVisibility, vs...))
+}
+
+// AttendeeVisibilityNotIn applies the NotIn predicate on the "attendee_visibility" field.
+func AttendeeVisibilityNotIn(vs ...AttendeeVisibility) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldAttendeeVisibility, vs...))
+}
+
 // HasHost applies the HasEdge predicate on the "host" edge.
 func HasHost() predicate.Event {
 	return predicate.Event(func(s *sql.Selector) {
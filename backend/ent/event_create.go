@@ -89,6 +89,76 @@ func (_c *EventCreate) SetQuota(v uint64) *EventCreate {
 	return _c
 }
 
+// SetCheckinRadiusM sets the "checkin_radius_m" field.
+func (_c *EventCreate) SetCheckinRadiusM(v float64) *EventCreate {
+	_c.mutation.SetCheckinRadiusM(v)
+	return _c
+}
+
+// SetNillableCheckinRadiusM sets the "checkin_radius_m" field if the given value is not nil.
+func (_c *EventCreate) SetNillableCheckinRadiusM(v *float64) *EventCreate {
+	if v != nil {
+		_c.SetCheckinRadiusM(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *EventCreate) SetCancelledAt(v time.Time) *EventCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *EventCreate) SetNillableCancelledAt(v *time.Time) *EventCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_c *EventCreate) SetCancelReason(v string) *EventCreate {
+	_c.mutation.SetCancelReason(v)
+	return _c
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_c *EventCreate) SetNillableCancelReason(v *string) *EventCreate {
+	if v != nil {
+		_c.SetCancelReason(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *EventCreate) SetRevision(v int) *EventCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *EventCreate) SetNillableRevision(v *int) *EventCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetAttendeeVisibility sets the "attendee_visibility" field.
+func (_c *EventCreate) SetAttendeeVisibility(v event.AttendeeVisibility) *EventCreate {
+	_c.mutation.SetAttendeeVisibility(v)
+	return _c
+}
+
+// SetNillableAttendeeVisibility sets the "attendee_visibility" field if the given value is not nil.
+func (_c *EventCreate) SetNillableAttendeeVisibility(v *event.AttendeeVisibility) *EventCreate {
+	if v != nil {
+		_c.SetAttendeeVisibility(*v)
+	}
+	return _c
+}
+
 // SetHostID sets the "host" edge to the User entity by ID.
 func (_c *EventCreate) SetHostID(id int) *EventCreate {
 	_c.mutation.SetHostID(id)
@@ -137,6 +207,7 @@ func (_c *EventCreate) Mutation() *EventMutation {
 
 // Save creates the Event in the database.
 func (_c *EventCreate) Save(ctx context.Context) (*Event, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -162,6 +233,22 @@ func (_c *EventCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *EventCreate) defaults() {
+	if _, ok := _c.mutation.CheckinRadiusM(); !ok {
+		v := event.DefaultCheckinRadiusM
+		_c.mutation.SetCheckinRadiusM(v)
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := event.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
+	if _, ok := _c.mutation.AttendeeVisibility(); !ok {
+		v := event.DefaultAttendeeVisibility
+		_c.mutation.SetAttendeeVisibility(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *EventCreate) check() error {
 	if _, ok := _c.mutation.EventID(); !ok {
@@ -197,6 +284,20 @@ func (_c *EventCreate) check() error {
 	if _, ok := _c.mutation.Quota(); !ok {
 		return &ValidationError{Name: "quota", err: errors.New(`ent: missing required field "Event.quota"`)}
 	}
+	if _, ok := _c.mutation.CheckinRadiusM(); !ok {
+		return &ValidationError{Name: "checkin_radius_m", err: errors.New(`ent: missing required field "Event.checkin_radius_m"`)}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "Event.revision"`)}
+	}
+	if _, ok := _c.mutation.AttendeeVisibility(); !ok {
+		return &ValidationError{Name: "attendee_visibility", err: errors.New(`ent: missing required field "Event.attendee_visibility"`)}
+	}
+	if v, ok := _c.mutation.AttendeeVisibility(); ok {
+		if err := event.AttendeeVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "attendee_visibility", err: fmt.Errorf(`ent: validator failed for field "Event.attendee_visibility": %w`, err)}
+		}
+	}
 	if len(_c.mutation.HostIDs()) == 0 {
 		return &ValidationError{Name: "host", err: errors.New(`ent: missing required edge "Event.host"`)}
 	}
@@ -270,6 +371,26 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_spec.SetField(event.FieldQuota, field.TypeUint64, value)
 		_node.Quota = value
 	}
+	if value, ok := _c.mutation.CheckinRadiusM(); ok {
+		_spec.SetField(event.FieldCheckinRadiusM, field.TypeFloat64, value)
+		_node.CheckinRadiusM = value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(event.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CancelReason(); ok {
+		_spec.SetField(event.FieldCancelReason, field.TypeString, value)
+		_node.CancelReason = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(event.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.AttendeeVisibility(); ok {
+		_spec.SetField(event.FieldAttendeeVisibility, field.TypeEnum, value)
+		_node.AttendeeVisibility = value
+	}
 	if nodes := _c.mutation.HostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -340,6 +461,7 @@ func (_c *EventCreateBulk) Save(ctx context.Context) ([]*Event, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*EventMutation)
 				if !ok {
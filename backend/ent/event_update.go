@@ -220,6 +220,102 @@ func (_u *EventUpdate) AddQuota(v int64) *EventUpdate {
 	return _u
 }
 
+// SetCheckinRadiusM sets the "checkin_radius_m" field.
+func (_u *EventUpdate) SetCheckinRadiusM(v float64) *EventUpdate {
+	_u.mutation.ResetCheckinRadiusM()
+	_u.mutation.SetCheckinRadiusM(v)
+	return _u
+}
+
+// SetNillableCheckinRadiusM sets the "checkin_radius_m" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableCheckinRadiusM(v *float64) *EventUpdate {
+	if v != nil {
+		_u.SetCheckinRadiusM(*v)
+	}
+	return _u
+}
+
+// AddCheckinRadiusM adds value to the "checkin_radius_m" field.
+func (_u *EventUpdate) AddCheckinRadiusM(v float64) *EventUpdate {
+	_u.mutation.AddCheckinRadiusM(v)
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *EventUpdate) SetCancelledAt(v time.Time) *EventUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableCancelledAt(v *time.Time) *EventUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *EventUpdate) ClearCancelledAt() *EventUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_u *EventUpdate) SetCancelReason(v string) *EventUpdate {
+	_u.mutation.SetCancelReason(v)
+	return _u
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableCancelReason(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetCancelReason(*v)
+	}
+	return _u
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (_u *EventUpdate) ClearCancelReason() *EventUpdate {
+	_u.mutation.ClearCancelReason()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *EventUpdate) SetRevision(v int) *EventUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableRevision(v *int) *EventUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *EventUpdate) AddRevision(v int) *EventUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetAttendeeVisibility sets the "attendee_visibility" field.
+func (_u *EventUpdate) SetAttendeeVisibility(v event.AttendeeVisibility) *EventUpdate {
+	_u.mutation.SetAttendeeVisibility(v)
+	return _u
+}
+
+// SetNillableAttendeeVisibility sets the "attendee_visibility" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableAttendeeVisibility(v *event.AttendeeVisibility) *EventUpdate {
+	if v != nil {
+		_u.SetAttendeeVisibility(*v)
+	}
+	return _u
+}
+
 // SetHostID sets the "host" edge to the User entity by ID.
 func (_u *EventUpdate) SetHostID(id int) *EventUpdate {
 	_u.mutation.SetHostID(id)
@@ -343,6 +439,11 @@ func (_u *EventUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *EventUpdate) check() error {
+	if v, ok := _u.mutation.AttendeeVisibility(); ok {
+		if err := event.AttendeeVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "attendee_visibility", err: fmt.Errorf(`ent: validator failed for field "Event.attendee_visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.HostCleared() && len(_u.mutation.HostIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Event.host"`)
 	}
@@ -409,6 +510,33 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedQuota(); ok {
 		_spec.AddField(event.FieldQuota, field.TypeUint64, value)
 	}
+	if value, ok := _u.mutation.CheckinRadiusM(); ok {
+		_spec.SetField(event.FieldCheckinRadiusM, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCheckinRadiusM(); ok {
+		_spec.AddField(event.FieldCheckinRadiusM, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(event.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(event.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelReason(); ok {
+		_spec.SetField(event.FieldCancelReason, field.TypeString, value)
+	}
+	if _u.mutation.CancelReasonCleared() {
+		_spec.ClearField(event.FieldCancelReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(event.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(event.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AttendeeVisibility(); ok {
+		_spec.SetField(event.FieldAttendeeVisibility, field.TypeEnum, value)
+	}
 	if _u.mutation.HostCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -737,6 +865,102 @@ func (_u *EventUpdateOne) AddQuota(v int64) *EventUpdateOne {
 	return _u
 }
 
+// SetCheckinRadiusM sets the "checkin_radius_m" field.
+func (_u *EventUpdateOne) SetCheckinRadiusM(v float64) *EventUpdateOne {
+	_u.mutation.ResetCheckinRadiusM()
+	_u.mutation.SetCheckinRadiusM(v)
+	return _u
+}
+
+// SetNillableCheckinRadiusM sets the "checkin_radius_m" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableCheckinRadiusM(v *float64) *EventUpdateOne {
+	if v != nil {
+		_u.SetCheckinRadiusM(*v)
+	}
+	return _u
+}
+
+// AddCheckinRadiusM adds value to the "checkin_radius_m" field.
+func (_u *EventUpdateOne) AddCheckinRadiusM(v float64) *EventUpdateOne {
+	_u.mutation.AddCheckinRadiusM(v)
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *EventUpdateOne) SetCancelledAt(v time.Time) *EventUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableCancelledAt(v *time.Time) *EventUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *EventUpdateOne) ClearCancelledAt() *EventUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_u *EventUpdateOne) SetCancelReason(v string) *EventUpdateOne {
+	_u.mutation.SetCancelReason(v)
+	return _u
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableCancelReason(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetCancelReason(*v)
+	}
+	return _u
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (_u *EventUpdateOne) ClearCancelReason() *EventUpdateOne {
+	_u.mutation.ClearCancelReason()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *EventUpdateOne) SetRevision(v int) *EventUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableRevision(v *int) *EventUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *EventUpdateOne) AddRevision(v int) *EventUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetAttendeeVisibility sets the "attendee_visibility" field.
+func (_u *EventUpdateOne) SetAttendeeVisibility(v event.AttendeeVisibility) *EventUpdateOne {
+	_u.mutation.SetAttendeeVisibility(v)
+	return _u
+}
+
+// SetNillableAttendeeVisibility sets the "attendee_visibility" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableAttendeeVisibility(v *event.AttendeeVisibility) *EventUpdateOne {
+	if v != nil {
+		_u.SetAttendeeVisibility(*v)
+	}
+	return _u
+}
+
 // SetHostID sets the "host" edge to the User entity by ID.
 func (_u *EventUpdateOne) SetHostID(id int) *EventUpdateOne {
 	_u.mutation.SetHostID(id)
@@ -873,6 +1097,11 @@ func (_u *EventUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *EventUpdateOne) check() error {
+	if v, ok := _u.mutation.AttendeeVisibility(); ok {
+		if err := event.AttendeeVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "attendee_visibility", err: fmt.Errorf(`ent: validator failed for field "Event.attendee_visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.HostCleared() && len(_u.mutation.HostIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Event.host"`)
 	}
@@ -956,6 +1185,33 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 	if value, ok := _u.mutation.AddedQuota(); ok {
 		_spec.AddField(event.FieldQuota, field.TypeUint64, value)
 	}
+	if value, ok := _u.mutation.CheckinRadiusM(); ok {
+		_spec.SetField(event.FieldCheckinRadiusM, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCheckinRadiusM(); ok {
+		_spec.AddField(event.FieldCheckinRadiusM, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(event.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(event.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelReason(); ok {
+		_spec.SetField(event.FieldCancelReason, field.TypeString, value)
+	}
+	if _u.mutation.CancelReasonCleared() {
+		_spec.ClearField(event.FieldCancelReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(event.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(event.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AttendeeVisibility(); ok {
+		_spec.SetField(event.FieldAttendeeVisibility, field.TypeEnum, value)
+	}
 	if _u.mutation.HostCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
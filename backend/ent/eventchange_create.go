@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventchange"
+	"backend/ent/schema"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventChangeCreate is the builder for creating a EventChange entity.
+type EventChangeCreate struct {
+	config
+	mutation *EventChangeMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *EventChangeCreate) SetEventID(v uint64) *EventChangeCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *EventChangeCreate) SetRevision(v int) *EventChangeCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *EventChangeCreate) SetKind(v eventchange.Kind) *EventChangeCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *EventChangeCreate) SetChanges(v []schema.EventFieldChange) *EventChangeCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *EventChangeCreate) SetReason(v string) *EventChangeCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *EventChangeCreate) SetNillableReason(v *string) *EventChangeCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_c *EventChangeCreate) SetChangedBy(v string) *EventChangeCreate {
+	_c.mutation.SetChangedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventChangeCreate) SetCreatedAt(v time.Time) *EventChangeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventChangeCreate) SetNillableCreatedAt(v *time.Time) *EventChangeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EventChangeMutation object of the builder.
+func (_c *EventChangeCreate) Mutation() *EventChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the EventChange in the database.
+func (_c *EventChangeCreate) Save(ctx context.Context) (*EventChange, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventChangeCreate) SaveX(ctx context.Context) *EventChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventChangeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventchange.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventChangeCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "EventChange.event_id"`)}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "EventChange.revision"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "EventChange.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := eventchange.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EventChange.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "EventChange.changes"`)}
+	}
+	if _, ok := _c.mutation.ChangedBy(); !ok {
+		return &ValidationError{Name: "changed_by", err: errors.New(`ent: missing required field "EventChange.changed_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventChange.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventChangeCreate) sqlSave(ctx context.Context) (*EventChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventChangeCreate) createSpec() (*EventChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventchange.Table, sqlgraph.NewFieldSpec(eventchange.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(eventchange.FieldEventID, field.TypeUint64, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(eventchange.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(eventchange.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(eventchange.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(eventchange.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ChangedBy(); ok {
+		_spec.SetField(eventchange.FieldChangedBy, field.TypeString, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EventChangeCreateBulk is the builder for creating many EventChange entities in bulk.
+type EventChangeCreateBulk struct {
+	config
+	err      error
+	builders []*EventChangeCreate
+}
+
+// Save creates the EventChange entities in the database.
+func (_c *EventChangeCreateBulk) Save(ctx context.Context) ([]*EventChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventChangeCreateBulk) SaveX(ctx context.Context) []*EventChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventchange"
+	"backend/ent/predicate"
+	"backend/ent/schema"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// EventChangeUpdate is the builder for updating EventChange entities.
+type EventChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventChangeMutation
+}
+
+// Where appends a list predicates to the EventChangeUpdate builder.
+func (_u *EventChangeUpdate) Where(ps ...predicate.EventChange) *EventChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *EventChangeUpdate) SetEventID(v uint64) *EventChangeUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *EventChangeUpdate) SetNillableEventID(v *uint64) *EventChangeUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *EventChangeUpdate) AddEventID(v int64) *EventChangeUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *EventChangeUpdate) SetRevision(v int) *EventChangeUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *EventChangeUpdate) SetNillableRevision(v *int) *EventChangeUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *EventChangeUpdate) AddRevision(v int) *EventChangeUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EventChangeUpdate) SetKind(v eventchange.Kind) *EventChangeUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EventChangeUpdate) SetNillableKind(v *eventchange.Kind) *EventChangeUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *EventChangeUpdate) SetChanges(v []schema.EventFieldChange) *EventChangeUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// AppendChanges appends value to the "changes" field.
+func (_u *EventChangeUpdate) AppendChanges(v []schema.EventFieldChange) *EventChangeUpdate {
+	_u.mutation.AppendChanges(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *EventChangeUpdate) SetReason(v string) *EventChangeUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *EventChangeUpdate) SetNillableReason(v *string) *EventChangeUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *EventChangeUpdate) ClearReason() *EventChangeUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_u *EventChangeUpdate) SetChangedBy(v string) *EventChangeUpdate {
+	_u.mutation.SetChangedBy(v)
+	return _u
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_u *EventChangeUpdate) SetNillableChangedBy(v *string) *EventChangeUpdate {
+	if v != nil {
+		_u.SetChangedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EventChangeMutation object of the builder.
+func (_u *EventChangeUpdate) Mutation() *EventChangeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventChangeUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := eventchange.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EventChange.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EventChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventchange.Table, eventchange.Columns, sqlgraph.NewFieldSpec(eventchange.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(eventchange.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(eventchange.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(eventchange.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(eventchange.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(eventchange.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(eventchange.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, eventchange.FieldChanges, value)
+		})
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(eventchange.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(eventchange.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChangedBy(); ok {
+		_spec.SetField(eventchange.FieldChangedBy, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventChangeUpdateOne is the builder for updating a single EventChange entity.
+type EventChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventChangeMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *EventChangeUpdateOne) SetEventID(v uint64) *EventChangeUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *EventChangeUpdateOne) SetNillableEventID(v *uint64) *EventChangeUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *EventChangeUpdateOne) AddEventID(v int64) *EventChangeUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *EventChangeUpdateOne) SetRevision(v int) *EventChangeUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *EventChangeUpdateOne) SetNillableRevision(v *int) *EventChangeUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *EventChangeUpdateOne) AddRevision(v int) *EventChangeUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EventChangeUpdateOne) SetKind(v eventchange.Kind) *EventChangeUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EventChangeUpdateOne) SetNillableKind(v *eventchange.Kind) *EventChangeUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *EventChangeUpdateOne) SetChanges(v []schema.EventFieldChange) *EventChangeUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// AppendChanges appends value to the "changes" field.
+func (_u *EventChangeUpdateOne) AppendChanges(v []schema.EventFieldChange) *EventChangeUpdateOne {
+	_u.mutation.AppendChanges(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *EventChangeUpdateOne) SetReason(v string) *EventChangeUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *EventChangeUpdateOne) SetNillableReason(v *string) *EventChangeUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *EventChangeUpdateOne) ClearReason() *EventChangeUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_u *EventChangeUpdateOne) SetChangedBy(v string) *EventChangeUpdateOne {
+	_u.mutation.SetChangedBy(v)
+	return _u
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_u *EventChangeUpdateOne) SetNillableChangedBy(v *string) *EventChangeUpdateOne {
+	if v != nil {
+		_u.SetChangedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EventChangeMutation object of the builder.
+func (_u *EventChangeUpdateOne) Mutation() *EventChangeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventChangeUpdate builder.
+func (_u *EventChangeUpdateOne) Where(ps ...predicate.EventChange) *EventChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventChangeUpdateOne) Select(field string, fields ...string) *EventChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventChange entity.
+func (_u *EventChangeUpdateOne) Save(ctx context.Context) (*EventChange, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventChangeUpdateOne) SaveX(ctx context.Context) *EventChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventChangeUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := eventchange.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EventChange.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EventChangeUpdateOne) sqlSave(ctx context.Context) (_node *EventChange, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventchange.Table, eventchange.Columns, sqlgraph.NewFieldSpec(eventchange.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventchange.FieldID)
+		for _, f := range fields {
+			if !eventchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(eventchange.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(eventchange.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(eventchange.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(eventchange.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(eventchange.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(eventchange.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, eventchange.FieldChanges, value)
+		})
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(eventchange.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(eventchange.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChangedBy(); ok {
+		_spec.SetField(eventchange.FieldChangedBy, field.TypeString, value)
+	}
+	_node = &EventChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventimportrow"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EventImportRow is the model entity for the EventImportRow schema.
+type EventImportRow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ImportID holds the value of the "import_id" field.
+	ImportID string `json:"import_id,omitempty"`
+	// HostAddress holds the value of the "host_address" field.
+	HostAddress string `json:"host_address,omitempty"`
+	// Format holds the value of the "format" field.
+	Format string `json:"format,omitempty"`
+	// Row holds the value of the "row" field.
+	Row int `json:"row,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// ThumbnailURL holds the value of the "thumbnail_url" field.
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// EventPassImg holds the value of the "event_pass_img" field.
+	EventPassImg string `json:"event_pass_img,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType uint8 `json:"event_type,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// Lat holds the value of the "lat" field.
+	Lat *float64 `json:"lat,omitempty"`
+	// Long holds the value of the "long" field.
+	Long *float64 `json:"long,omitempty"`
+	// Geocoded holds the value of the "geocoded" field.
+	Geocoded bool `json:"geocoded,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate time.Time `json:"start_date,omitempty"`
+	// EndDate holds the value of the "end_date" field.
+	EndDate time.Time `json:"end_date,omitempty"`
+	// Quota holds the value of the "quota" field.
+	Quota uint64 `json:"quota,omitempty"`
+	// Status holds the value of the "status" field.
+	Status eventimportrow.Status `json:"status,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID *uint64 `json:"event_id,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventImportRow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventimportrow.FieldGeocoded:
+			values[i] = new(sql.NullBool)
+		case eventimportrow.FieldLat, eventimportrow.FieldLong:
+			values[i] = new(sql.NullFloat64)
+		case eventimportrow.FieldID, eventimportrow.FieldRow, eventimportrow.FieldEventType, eventimportrow.FieldQuota, eventimportrow.FieldEventID, eventimportrow.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case eventimportrow.FieldImportID, eventimportrow.FieldHostAddress, eventimportrow.FieldFormat, eventimportrow.FieldName, eventimportrow.FieldDescription, eventimportrow.FieldThumbnailURL, eventimportrow.FieldEventPassImg, eventimportrow.FieldLocation, eventimportrow.FieldStatus, eventimportrow.FieldError:
+			values[i] = new(sql.NullString)
+		case eventimportrow.FieldStartDate, eventimportrow.FieldEndDate, eventimportrow.FieldCreatedAt, eventimportrow.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventImportRow fields.
+func (_m *EventImportRow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventimportrow.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case eventimportrow.FieldImportID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field import_id", values[i])
+			} else if value.Valid {
+				_m.ImportID = value.String
+			}
+		case eventimportrow.FieldHostAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_address", values[i])
+			} else if value.Valid {
+				_m.HostAddress = value.String
+			}
+		case eventimportrow.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = value.String
+			}
+		case eventimportrow.FieldRow:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field row", values[i])
+			} else if value.Valid {
+				_m.Row = int(value.Int64)
+			}
+		case eventimportrow.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case eventimportrow.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case eventimportrow.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.ThumbnailURL = value.String
+			}
+		case eventimportrow.FieldEventPassImg:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_pass_img", values[i])
+			} else if value.Valid {
+				_m.EventPassImg = value.String
+			}
+		case eventimportrow.FieldEventType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = uint8(value.Int64)
+			}
+		case eventimportrow.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = value.String
+			}
+		case eventimportrow.FieldLat:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field lat", values[i])
+			} else if value.Valid {
+				_m.Lat = new(float64)
+				*_m.Lat = value.Float64
+			}
+		case eventimportrow.FieldLong:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field long", values[i])
+			} else if value.Valid {
+				_m.Long = new(float64)
+				*_m.Long = value.Float64
+			}
+		case eventimportrow.FieldGeocoded:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field geocoded", values[i])
+			} else if value.Valid {
+				_m.Geocoded = value.Bool
+			}
+		case eventimportrow.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				_m.StartDate = value.Time
+			}
+		case eventimportrow.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				_m.EndDate = value.Time
+			}
+		case eventimportrow.FieldQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota", values[i])
+			} else if value.Valid {
+				_m.Quota = uint64(value.Int64)
+			}
+		case eventimportrow.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = eventimportrow.Status(value.String)
+			}
+		case eventimportrow.FieldEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = new(uint64)
+				*_m.EventID = uint64(value.Int64)
+			}
+		case eventimportrow.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case eventimportrow.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case eventimportrow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case eventimportrow.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventImportRow.
+// This includes values selected through modifiers, order, etc.
+func (_m *EventImportRow) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventImportRow.
+// Note that you need to call EventImportRow.Unwrap() before calling this method if this EventImportRow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EventImportRow) Update() *EventImportRowUpdateOne {
+	return NewEventImportRowClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EventImportRow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EventImportRow) Unwrap() *EventImportRow {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventImportRow is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EventImportRow) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventImportRow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("import_id=")
+	builder.WriteString(_m.ImportID)
+	builder.WriteString(", ")
+	builder.WriteString("host_address=")
+	builder.WriteString(_m.HostAddress)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(_m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("row=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Row))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(_m.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("event_pass_img=")
+	builder.WriteString(_m.EventPassImg)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventType))
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(_m.Location)
+	builder.WriteString(", ")
+	if v := _m.Lat; v != nil {
+		builder.WriteString("lat=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Long; v != nil {
+		builder.WriteString("long=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("geocoded=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Geocoded))
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(_m.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(_m.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("quota=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quota))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.EventID; v != nil {
+		builder.WriteString("event_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventImportRows is a parsable slice of EventImportRow.
+type EventImportRows []*EventImportRow
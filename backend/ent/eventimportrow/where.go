@@ -0,0 +1,1280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventimportrow
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldID, id))
+}
+
+// ImportID applies equality check predicate on the "import_id" field. It's identical to ImportIDEQ.
+func ImportID(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldImportID, v))
+}
+
+// HostAddress applies equality check predicate on the "host_address" field. It's identical to HostAddressEQ.
+func HostAddress(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldHostAddress, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldFormat, v))
+}
+
+// Row applies equality check predicate on the "row" field. It's identical to RowEQ.
+func Row(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldRow, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldDescription, v))
+}
+
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// EventPassImg applies equality check predicate on the "event_pass_img" field. It's identical to EventPassImgEQ.
+func EventPassImg(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldEventPassImg, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldEventType, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldLocation, v))
+}
+
+// Lat applies equality check predicate on the "lat" field. It's identical to LatEQ.
+func Lat(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldLat, v))
+}
+
+// Long applies equality check predicate on the "long" field. It's identical to LongEQ.
+func Long(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldLong, v))
+}
+
+// Geocoded applies equality check predicate on the "geocoded" field. It's identical to GeocodedEQ.
+func Geocoded(v bool) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldGeocoded, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldEndDate, v))
+}
+
+// Quota applies equality check predicate on the "quota" field. It's identical to QuotaEQ.
+func Quota(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldQuota, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldEventID, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldAttempts, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ImportIDEQ applies the EQ predicate on the "import_id" field.
+func ImportIDEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldImportID, v))
+}
+
+// ImportIDNEQ applies the NEQ predicate on the "import_id" field.
+func ImportIDNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldImportID, v))
+}
+
+// ImportIDIn applies the In predicate on the "import_id" field.
+func ImportIDIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldImportID, vs...))
+}
+
+// ImportIDNotIn applies the NotIn predicate on the "import_id" field.
+func ImportIDNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldImportID, vs...))
+}
+
+// ImportIDGT applies the GT predicate on the "import_id" field.
+func ImportIDGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldImportID, v))
+}
+
+// ImportIDGTE applies the GTE predicate on the "import_id" field.
+func ImportIDGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldImportID, v))
+}
+
+// ImportIDLT applies the LT predicate on the "import_id" field.
+func ImportIDLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldImportID, v))
+}
+
+// ImportIDLTE applies the LTE predicate on the "import_id" field.
+func ImportIDLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldImportID, v))
+}
+
+// ImportIDContains applies the Contains predicate on the "import_id" field.
+func ImportIDContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldImportID, v))
+}
+
+// ImportIDHasPrefix applies the HasPrefix predicate on the "import_id" field.
+func ImportIDHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldImportID, v))
+}
+
+// ImportIDHasSuffix applies the HasSuffix predicate on the "import_id" field.
+func ImportIDHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldImportID, v))
+}
+
+// ImportIDEqualFold applies the EqualFold predicate on the "import_id" field.
+func ImportIDEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldImportID, v))
+}
+
+// ImportIDContainsFold applies the ContainsFold predicate on the "import_id" field.
+func ImportIDContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldImportID, v))
+}
+
+// HostAddressEQ applies the EQ predicate on the "host_address" field.
+func HostAddressEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldHostAddress, v))
+}
+
+// HostAddressNEQ applies the NEQ predicate on the "host_address" field.
+func HostAddressNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldHostAddress, v))
+}
+
+// HostAddressIn applies the In predicate on the "host_address" field.
+func HostAddressIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldHostAddress, vs...))
+}
+
+// HostAddressNotIn applies the NotIn predicate on the "host_address" field.
+func HostAddressNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldHostAddress, vs...))
+}
+
+// HostAddressGT applies the GT predicate on the "host_address" field.
+func HostAddressGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldHostAddress, v))
+}
+
+// HostAddressGTE applies the GTE predicate on the "host_address" field.
+func HostAddressGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldHostAddress, v))
+}
+
+// HostAddressLT applies the LT predicate on the "host_address" field.
+func HostAddressLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldHostAddress, v))
+}
+
+// HostAddressLTE applies the LTE predicate on the "host_address" field.
+func HostAddressLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldHostAddress, v))
+}
+
+// HostAddressContains applies the Contains predicate on the "host_address" field.
+func HostAddressContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldHostAddress, v))
+}
+
+// HostAddressHasPrefix applies the HasPrefix predicate on the "host_address" field.
+func HostAddressHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldHostAddress, v))
+}
+
+// HostAddressHasSuffix applies the HasSuffix predicate on the "host_address" field.
+func HostAddressHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldHostAddress, v))
+}
+
+// HostAddressEqualFold applies the EqualFold predicate on the "host_address" field.
+func HostAddressEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldHostAddress, v))
+}
+
+// HostAddressContainsFold applies the ContainsFold predicate on the "host_address" field.
+func HostAddressContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldHostAddress, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// RowEQ applies the EQ predicate on the "row" field.
+func RowEQ(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldRow, v))
+}
+
+// RowNEQ applies the NEQ predicate on the "row" field.
+func RowNEQ(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldRow, v))
+}
+
+// RowIn applies the In predicate on the "row" field.
+func RowIn(vs ...int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldRow, vs...))
+}
+
+// RowNotIn applies the NotIn predicate on the "row" field.
+func RowNotIn(vs ...int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldRow, vs...))
+}
+
+// RowGT applies the GT predicate on the "row" field.
+func RowGT(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldRow, v))
+}
+
+// RowGTE applies the GTE predicate on the "row" field.
+func RowGTE(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldRow, v))
+}
+
+// RowLT applies the LT predicate on the "row" field.
+func RowLT(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldRow, v))
+}
+
+// RowLTE applies the LTE predicate on the "row" field.
+func RowLTE(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldRow, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// EventPassImgEQ applies the EQ predicate on the "event_pass_img" field.
+func EventPassImgEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldEventPassImg, v))
+}
+
+// EventPassImgNEQ applies the NEQ predicate on the "event_pass_img" field.
+func EventPassImgNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldEventPassImg, v))
+}
+
+// EventPassImgIn applies the In predicate on the "event_pass_img" field.
+func EventPassImgIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldEventPassImg, vs...))
+}
+
+// EventPassImgNotIn applies the NotIn predicate on the "event_pass_img" field.
+func EventPassImgNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldEventPassImg, vs...))
+}
+
+// EventPassImgGT applies the GT predicate on the "event_pass_img" field.
+func EventPassImgGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldEventPassImg, v))
+}
+
+// EventPassImgGTE applies the GTE predicate on the "event_pass_img" field.
+func EventPassImgGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldEventPassImg, v))
+}
+
+// EventPassImgLT applies the LT predicate on the "event_pass_img" field.
+func EventPassImgLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldEventPassImg, v))
+}
+
+// EventPassImgLTE applies the LTE predicate on the "event_pass_img" field.
+func EventPassImgLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldEventPassImg, v))
+}
+
+// EventPassImgContains applies the Contains predicate on the "event_pass_img" field.
+func EventPassImgContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldEventPassImg, v))
+}
+
+// EventPassImgHasPrefix applies the HasPrefix predicate on the "event_pass_img" field.
+func EventPassImgHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldEventPassImg, v))
+}
+
+// EventPassImgHasSuffix applies the HasSuffix predicate on the "event_pass_img" field.
+func EventPassImgHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldEventPassImg, v))
+}
+
+// EventPassImgIsNil applies the IsNil predicate on the "event_pass_img" field.
+func EventPassImgIsNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIsNull(FieldEventPassImg))
+}
+
+// EventPassImgNotNil applies the NotNil predicate on the "event_pass_img" field.
+func EventPassImgNotNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotNull(FieldEventPassImg))
+}
+
+// EventPassImgEqualFold applies the EqualFold predicate on the "event_pass_img" field.
+func EventPassImgEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldEventPassImg, v))
+}
+
+// EventPassImgContainsFold applies the ContainsFold predicate on the "event_pass_img" field.
+func EventPassImgContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldEventPassImg, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v uint8) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldEventType, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// LatEQ applies the EQ predicate on the "lat" field.
+func LatEQ(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldLat, v))
+}
+
+// LatNEQ applies the NEQ predicate on the "lat" field.
+func LatNEQ(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldLat, v))
+}
+
+// LatIn applies the In predicate on the "lat" field.
+func LatIn(vs ...float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldLat, vs...))
+}
+
+// LatNotIn applies the NotIn predicate on the "lat" field.
+func LatNotIn(vs ...float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldLat, vs...))
+}
+
+// LatGT applies the GT predicate on the "lat" field.
+func LatGT(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldLat, v))
+}
+
+// LatGTE applies the GTE predicate on the "lat" field.
+func LatGTE(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldLat, v))
+}
+
+// LatLT applies the LT predicate on the "lat" field.
+func LatLT(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldLat, v))
+}
+
+// LatLTE applies the LTE predicate on the "lat" field.
+func LatLTE(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldLat, v))
+}
+
+// LatIsNil applies the IsNil predicate on the "lat" field.
+func LatIsNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIsNull(FieldLat))
+}
+
+// LatNotNil applies the NotNil predicate on the "lat" field.
+func LatNotNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotNull(FieldLat))
+}
+
+// LongEQ applies the EQ predicate on the "long" field.
+func LongEQ(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldLong, v))
+}
+
+// LongNEQ applies the NEQ predicate on the "long" field.
+func LongNEQ(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldLong, v))
+}
+
+// LongIn applies the In predicate on the "long" field.
+func LongIn(vs ...float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldLong, vs...))
+}
+
+// LongNotIn applies the NotIn predicate on the "long" field.
+func LongNotIn(vs ...float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldLong, vs...))
+}
+
+// LongGT applies the GT predicate on the "long" field.
+func LongGT(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldLong, v))
+}
+
+// LongGTE applies the GTE predicate on the "long" field.
+func LongGTE(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldLong, v))
+}
+
+// LongLT applies the LT predicate on the "long" field.
+func LongLT(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldLong, v))
+}
+
+// LongLTE applies the LTE predicate on the "long" field.
+func LongLTE(v float64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldLong, v))
+}
+
+// LongIsNil applies the IsNil predicate on the "long" field.
+func LongIsNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIsNull(FieldLong))
+}
+
+// LongNotNil applies the NotNil predicate on the "long" field.
+func LongNotNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotNull(FieldLong))
+}
+
+// GeocodedEQ applies the EQ predicate on the "geocoded" field.
+func GeocodedEQ(v bool) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldGeocoded, v))
+}
+
+// GeocodedNEQ applies the NEQ predicate on the "geocoded" field.
+func GeocodedNEQ(v bool) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldGeocoded, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldEndDate, v))
+}
+
+// QuotaEQ applies the EQ predicate on the "quota" field.
+func QuotaEQ(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldQuota, v))
+}
+
+// QuotaNEQ applies the NEQ predicate on the "quota" field.
+func QuotaNEQ(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldQuota, v))
+}
+
+// QuotaIn applies the In predicate on the "quota" field.
+func QuotaIn(vs ...uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldQuota, vs...))
+}
+
+// QuotaNotIn applies the NotIn predicate on the "quota" field.
+func QuotaNotIn(vs ...uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldQuota, vs...))
+}
+
+// QuotaGT applies the GT predicate on the "quota" field.
+func QuotaGT(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldQuota, v))
+}
+
+// QuotaGTE applies the GTE predicate on the "quota" field.
+func QuotaGTE(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldQuota, v))
+}
+
+// QuotaLT applies the LT predicate on the "quota" field.
+func QuotaLT(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldQuota, v))
+}
+
+// QuotaLTE applies the LTE predicate on the "quota" field.
+func QuotaLTE(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldQuota, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uint64) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDIsNil applies the IsNil predicate on the "event_id" field.
+func EventIDIsNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIsNull(FieldEventID))
+}
+
+// EventIDNotNil applies the NotNil predicate on the "event_id" field.
+func EventIDNotNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotNull(FieldEventID))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventImportRow) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventImportRow) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventImportRow) predicate.EventImportRow {
+	return predicate.EventImportRow(sql.NotPredicates(p))
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventimportrow"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventImportRowCreate is the builder for creating a EventImportRow entity.
+type EventImportRowCreate struct {
+	config
+	mutation *EventImportRowMutation
+	hooks    []Hook
+}
+
+// SetImportID sets the "import_id" field.
+func (_c *EventImportRowCreate) SetImportID(v string) *EventImportRowCreate {
+	_c.mutation.SetImportID(v)
+	return _c
+}
+
+// SetHostAddress sets the "host_address" field.
+func (_c *EventImportRowCreate) SetHostAddress(v string) *EventImportRowCreate {
+	_c.mutation.SetHostAddress(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *EventImportRowCreate) SetFormat(v string) *EventImportRowCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetRow sets the "row" field.
+func (_c *EventImportRowCreate) SetRow(v int) *EventImportRowCreate {
+	_c.mutation.SetRow(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *EventImportRowCreate) SetName(v string) *EventImportRowCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *EventImportRowCreate) SetDescription(v string) *EventImportRowCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableDescription(v *string) *EventImportRowCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_c *EventImportRowCreate) SetThumbnailURL(v string) *EventImportRowCreate {
+	_c.mutation.SetThumbnailURL(v)
+	return _c
+}
+
+// SetEventPassImg sets the "event_pass_img" field.
+func (_c *EventImportRowCreate) SetEventPassImg(v string) *EventImportRowCreate {
+	_c.mutation.SetEventPassImg(v)
+	return _c
+}
+
+// SetNillableEventPassImg sets the "event_pass_img" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableEventPassImg(v *string) *EventImportRowCreate {
+	if v != nil {
+		_c.SetEventPassImg(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *EventImportRowCreate) SetEventType(v uint8) *EventImportRowCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *EventImportRowCreate) SetLocation(v string) *EventImportRowCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetLat sets the "lat" field.
+func (_c *EventImportRowCreate) SetLat(v float64) *EventImportRowCreate {
+	_c.mutation.SetLat(v)
+	return _c
+}
+
+// SetNillableLat sets the "lat" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableLat(v *float64) *EventImportRowCreate {
+	if v != nil {
+		_c.SetLat(*v)
+	}
+	return _c
+}
+
+// SetLong sets the "long" field.
+func (_c *EventImportRowCreate) SetLong(v float64) *EventImportRowCreate {
+	_c.mutation.SetLong(v)
+	return _c
+}
+
+// SetNillableLong sets the "long" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableLong(v *float64) *EventImportRowCreate {
+	if v != nil {
+		_c.SetLong(*v)
+	}
+	return _c
+}
+
+// SetGeocoded sets the "geocoded" field.
+func (_c *EventImportRowCreate) SetGeocoded(v bool) *EventImportRowCreate {
+	_c.mutation.SetGeocoded(v)
+	return _c
+}
+
+// SetNillableGeocoded sets the "geocoded" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableGeocoded(v *bool) *EventImportRowCreate {
+	if v != nil {
+		_c.SetGeocoded(*v)
+	}
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *EventImportRowCreate) SetStartDate(v time.Time) *EventImportRowCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *EventImportRowCreate) SetEndDate(v time.Time) *EventImportRowCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetQuota sets the "quota" field.
+func (_c *EventImportRowCreate) SetQuota(v uint64) *EventImportRowCreate {
+	_c.mutation.SetQuota(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EventImportRowCreate) SetStatus(v eventimportrow.Status) *EventImportRowCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableStatus(v *eventimportrow.Status) *EventImportRowCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *EventImportRowCreate) SetEventID(v uint64) *EventImportRowCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableEventID(v *uint64) *EventImportRowCreate {
+	if v != nil {
+		_c.SetEventID(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *EventImportRowCreate) SetAttempts(v int) *EventImportRowCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableAttempts(v *int) *EventImportRowCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *EventImportRowCreate) SetError(v string) *EventImportRowCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableError(v *string) *EventImportRowCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventImportRowCreate) SetCreatedAt(v time.Time) *EventImportRowCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableCreatedAt(v *time.Time) *EventImportRowCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EventImportRowCreate) SetUpdatedAt(v time.Time) *EventImportRowCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EventImportRowCreate) SetNillableUpdatedAt(v *time.Time) *EventImportRowCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EventImportRowMutation object of the builder.
+func (_c *EventImportRowCreate) Mutation() *EventImportRowMutation {
+	return _c.mutation
+}
+
+// Save creates the EventImportRow in the database.
+func (_c *EventImportRowCreate) Save(ctx context.Context) (*EventImportRow, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventImportRowCreate) SaveX(ctx context.Context) *EventImportRow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventImportRowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventImportRowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventImportRowCreate) defaults() {
+	if _, ok := _c.mutation.Description(); !ok {
+		v := eventimportrow.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.Geocoded(); !ok {
+		v := eventimportrow.DefaultGeocoded
+		_c.mutation.SetGeocoded(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := eventimportrow.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := eventimportrow.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventimportrow.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := eventimportrow.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventImportRowCreate) check() error {
+	if _, ok := _c.mutation.ImportID(); !ok {
+		return &ValidationError{Name: "import_id", err: errors.New(`ent: missing required field "EventImportRow.import_id"`)}
+	}
+	if _, ok := _c.mutation.HostAddress(); !ok {
+		return &ValidationError{Name: "host_address", err: errors.New(`ent: missing required field "EventImportRow.host_address"`)}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "EventImportRow.format"`)}
+	}
+	if _, ok := _c.mutation.Row(); !ok {
+		return &ValidationError{Name: "row", err: errors.New(`ent: missing required field "EventImportRow.row"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EventImportRow.name"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "EventImportRow.description"`)}
+	}
+	if _, ok := _c.mutation.ThumbnailURL(); !ok {
+		return &ValidationError{Name: "thumbnail_url", err: errors.New(`ent: missing required field "EventImportRow.thumbnail_url"`)}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "EventImportRow.event_type"`)}
+	}
+	if _, ok := _c.mutation.Location(); !ok {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required field "EventImportRow.location"`)}
+	}
+	if _, ok := _c.mutation.Geocoded(); !ok {
+		return &ValidationError{Name: "geocoded", err: errors.New(`ent: missing required field "EventImportRow.geocoded"`)}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "EventImportRow.start_date"`)}
+	}
+	if _, ok := _c.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "EventImportRow.end_date"`)}
+	}
+	if _, ok := _c.mutation.Quota(); !ok {
+		return &ValidationError{Name: "quota", err: errors.New(`ent: missing required field "EventImportRow.quota"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EventImportRow.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := eventimportrow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventImportRow.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "EventImportRow.attempts"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventImportRow.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EventImportRow.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventImportRowCreate) sqlSave(ctx context.Context) (*EventImportRow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventImportRowCreate) createSpec() (*EventImportRow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventImportRow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventimportrow.Table, sqlgraph.NewFieldSpec(eventimportrow.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ImportID(); ok {
+		_spec.SetField(eventimportrow.FieldImportID, field.TypeString, value)
+		_node.ImportID = value
+	}
+	if value, ok := _c.mutation.HostAddress(); ok {
+		_spec.SetField(eventimportrow.FieldHostAddress, field.TypeString, value)
+		_node.HostAddress = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(eventimportrow.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Row(); ok {
+		_spec.SetField(eventimportrow.FieldRow, field.TypeInt, value)
+		_node.Row = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(eventimportrow.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(eventimportrow.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventimportrow.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := _c.mutation.EventPassImg(); ok {
+		_spec.SetField(eventimportrow.FieldEventPassImg, field.TypeString, value)
+		_node.EventPassImg = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(eventimportrow.FieldEventType, field.TypeUint8, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(eventimportrow.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := _c.mutation.Lat(); ok {
+		_spec.SetField(eventimportrow.FieldLat, field.TypeFloat64, value)
+		_node.Lat = &value
+	}
+	if value, ok := _c.mutation.Long(); ok {
+		_spec.SetField(eventimportrow.FieldLong, field.TypeFloat64, value)
+		_node.Long = &value
+	}
+	if value, ok := _c.mutation.Geocoded(); ok {
+		_spec.SetField(eventimportrow.FieldGeocoded, field.TypeBool, value)
+		_node.Geocoded = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(eventimportrow.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(eventimportrow.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := _c.mutation.Quota(); ok {
+		_spec.SetField(eventimportrow.FieldQuota, field.TypeUint64, value)
+		_node.Quota = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(eventimportrow.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(eventimportrow.FieldEventID, field.TypeUint64, value)
+		_node.EventID = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(eventimportrow.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(eventimportrow.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventimportrow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventimportrow.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EventImportRowCreateBulk is the builder for creating many EventImportRow entities in bulk.
+type EventImportRowCreateBulk struct {
+	config
+	err      error
+	builders []*EventImportRowCreate
+}
+
+// Save creates the EventImportRow entities in the database.
+func (_c *EventImportRowCreateBulk) Save(ctx context.Context) ([]*EventImportRow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventImportRow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventImportRowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventImportRowCreateBulk) SaveX(ctx context.Context) []*EventImportRow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventImportRowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventImportRowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventimportrow"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventImportRowUpdate is the builder for updating EventImportRow entities.
+type EventImportRowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventImportRowMutation
+}
+
+// Where appends a list predicates to the EventImportRowUpdate builder.
+func (_u *EventImportRowUpdate) Where(ps ...predicate.EventImportRow) *EventImportRowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetImportID sets the "import_id" field.
+func (_u *EventImportRowUpdate) SetImportID(v string) *EventImportRowUpdate {
+	_u.mutation.SetImportID(v)
+	return _u
+}
+
+// SetNillableImportID sets the "import_id" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableImportID(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetImportID(*v)
+	}
+	return _u
+}
+
+// SetHostAddress sets the "host_address" field.
+func (_u *EventImportRowUpdate) SetHostAddress(v string) *EventImportRowUpdate {
+	_u.mutation.SetHostAddress(v)
+	return _u
+}
+
+// SetNillableHostAddress sets the "host_address" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableHostAddress(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetHostAddress(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *EventImportRowUpdate) SetFormat(v string) *EventImportRowUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableFormat(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetRow sets the "row" field.
+func (_u *EventImportRowUpdate) SetRow(v int) *EventImportRowUpdate {
+	_u.mutation.ResetRow()
+	_u.mutation.SetRow(v)
+	return _u
+}
+
+// SetNillableRow sets the "row" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableRow(v *int) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetRow(*v)
+	}
+	return _u
+}
+
+// AddRow adds value to the "row" field.
+func (_u *EventImportRowUpdate) AddRow(v int) *EventImportRowUpdate {
+	_u.mutation.AddRow(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EventImportRowUpdate) SetName(v string) *EventImportRowUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableName(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventImportRowUpdate) SetDescription(v string) *EventImportRowUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableDescription(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *EventImportRowUpdate) SetThumbnailURL(v string) *EventImportRowUpdate {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableThumbnailURL(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// SetEventPassImg sets the "event_pass_img" field.
+func (_u *EventImportRowUpdate) SetEventPassImg(v string) *EventImportRowUpdate {
+	_u.mutation.SetEventPassImg(v)
+	return _u
+}
+
+// SetNillableEventPassImg sets the "event_pass_img" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableEventPassImg(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetEventPassImg(*v)
+	}
+	return _u
+}
+
+// ClearEventPassImg clears the value of the "event_pass_img" field.
+func (_u *EventImportRowUpdate) ClearEventPassImg() *EventImportRowUpdate {
+	_u.mutation.ClearEventPassImg()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *EventImportRowUpdate) SetEventType(v uint8) *EventImportRowUpdate {
+	_u.mutation.ResetEventType()
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableEventType(v *uint8) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// AddEventType adds value to the "event_type" field.
+func (_u *EventImportRowUpdate) AddEventType(v int8) *EventImportRowUpdate {
+	_u.mutation.AddEventType(v)
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventImportRowUpdate) SetLocation(v string) *EventImportRowUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableLocation(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// SetLat sets the "lat" field.
+func (_u *EventImportRowUpdate) SetLat(v float64) *EventImportRowUpdate {
+	_u.mutation.ResetLat()
+	_u.mutation.SetLat(v)
+	return _u
+}
+
+// SetNillableLat sets the "lat" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableLat(v *float64) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetLat(*v)
+	}
+	return _u
+}
+
+// AddLat adds value to the "lat" field.
+func (_u *EventImportRowUpdate) AddLat(v float64) *EventImportRowUpdate {
+	_u.mutation.AddLat(v)
+	return _u
+}
+
+// ClearLat clears the value of the "lat" field.
+func (_u *EventImportRowUpdate) ClearLat() *EventImportRowUpdate {
+	_u.mutation.ClearLat()
+	return _u
+}
+
+// SetLong sets the "long" field.
+func (_u *EventImportRowUpdate) SetLong(v float64) *EventImportRowUpdate {
+	_u.mutation.ResetLong()
+	_u.mutation.SetLong(v)
+	return _u
+}
+
+// SetNillableLong sets the "long" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableLong(v *float64) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetLong(*v)
+	}
+	return _u
+}
+
+// AddLong adds value to the "long" field.
+func (_u *EventImportRowUpdate) AddLong(v float64) *EventImportRowUpdate {
+	_u.mutation.AddLong(v)
+	return _u
+}
+
+// ClearLong clears the value of the "long" field.
+func (_u *EventImportRowUpdate) ClearLong() *EventImportRowUpdate {
+	_u.mutation.ClearLong()
+	return _u
+}
+
+// SetGeocoded sets the "geocoded" field.
+func (_u *EventImportRowUpdate) SetGeocoded(v bool) *EventImportRowUpdate {
+	_u.mutation.SetGeocoded(v)
+	return _u
+}
+
+// SetNillableGeocoded sets the "geocoded" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableGeocoded(v *bool) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetGeocoded(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *EventImportRowUpdate) SetStartDate(v time.Time) *EventImportRowUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableStartDate(v *time.Time) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *EventImportRowUpdate) SetEndDate(v time.Time) *EventImportRowUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableEndDate(v *time.Time) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetQuota sets the "quota" field.
+func (_u *EventImportRowUpdate) SetQuota(v uint64) *EventImportRowUpdate {
+	_u.mutation.ResetQuota()
+	_u.mutation.SetQuota(v)
+	return _u
+}
+
+// SetNillableQuota sets the "quota" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableQuota(v *uint64) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetQuota(*v)
+	}
+	return _u
+}
+
+// AddQuota adds value to the "quota" field.
+func (_u *EventImportRowUpdate) AddQuota(v int64) *EventImportRowUpdate {
+	_u.mutation.AddQuota(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EventImportRowUpdate) SetStatus(v eventimportrow.Status) *EventImportRowUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableStatus(v *eventimportrow.Status) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *EventImportRowUpdate) SetEventID(v uint64) *EventImportRowUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableEventID(v *uint64) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *EventImportRowUpdate) AddEventID(v int64) *EventImportRowUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// ClearEventID clears the value of the "event_id" field.
+func (_u *EventImportRowUpdate) ClearEventID() *EventImportRowUpdate {
+	_u.mutation.ClearEventID()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EventImportRowUpdate) SetAttempts(v int) *EventImportRowUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableAttempts(v *int) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EventImportRowUpdate) AddAttempts(v int) *EventImportRowUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *EventImportRowUpdate) SetError(v string) *EventImportRowUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *EventImportRowUpdate) SetNillableError(v *string) *EventImportRowUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *EventImportRowUpdate) ClearError() *EventImportRowUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventImportRowUpdate) SetUpdatedAt(v time.Time) *EventImportRowUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EventImportRowMutation object of the builder.
+func (_u *EventImportRowUpdate) Mutation() *EventImportRowMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventImportRowUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventImportRowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventImportRowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventImportRowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventImportRowUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := eventimportrow.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventImportRowUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := eventimportrow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventImportRow.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EventImportRowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventimportrow.Table, eventimportrow.Columns, sqlgraph.NewFieldSpec(eventimportrow.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ImportID(); ok {
+		_spec.SetField(eventimportrow.FieldImportID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HostAddress(); ok {
+		_spec.SetField(eventimportrow.FieldHostAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(eventimportrow.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Row(); ok {
+		_spec.SetField(eventimportrow.FieldRow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRow(); ok {
+		_spec.AddField(eventimportrow.FieldRow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(eventimportrow.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(eventimportrow.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventimportrow.FieldThumbnailURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventPassImg(); ok {
+		_spec.SetField(eventimportrow.FieldEventPassImg, field.TypeString, value)
+	}
+	if _u.mutation.EventPassImgCleared() {
+		_spec.ClearField(eventimportrow.FieldEventPassImg, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(eventimportrow.FieldEventType, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedEventType(); ok {
+		_spec.AddField(eventimportrow.FieldEventType, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(eventimportrow.FieldLocation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Lat(); ok {
+		_spec.SetField(eventimportrow.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLat(); ok {
+		_spec.AddField(eventimportrow.FieldLat, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatCleared() {
+		_spec.ClearField(eventimportrow.FieldLat, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Long(); ok {
+		_spec.SetField(eventimportrow.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLong(); ok {
+		_spec.AddField(eventimportrow.FieldLong, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongCleared() {
+		_spec.ClearField(eventimportrow.FieldLong, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Geocoded(); ok {
+		_spec.SetField(eventimportrow.FieldGeocoded, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(eventimportrow.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(eventimportrow.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Quota(); ok {
+		_spec.SetField(eventimportrow.FieldQuota, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedQuota(); ok {
+		_spec.AddField(eventimportrow.FieldQuota, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(eventimportrow.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(eventimportrow.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(eventimportrow.FieldEventID, field.TypeUint64, value)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(eventimportrow.FieldEventID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(eventimportrow.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(eventimportrow.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(eventimportrow.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(eventimportrow.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventimportrow.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventimportrow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventImportRowUpdateOne is the builder for updating a single EventImportRow entity.
+type EventImportRowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventImportRowMutation
+}
+
+// SetImportID sets the "import_id" field.
+func (_u *EventImportRowUpdateOne) SetImportID(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetImportID(v)
+	return _u
+}
+
+// SetNillableImportID sets the "import_id" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableImportID(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetImportID(*v)
+	}
+	return _u
+}
+
+// SetHostAddress sets the "host_address" field.
+func (_u *EventImportRowUpdateOne) SetHostAddress(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetHostAddress(v)
+	return _u
+}
+
+// SetNillableHostAddress sets the "host_address" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableHostAddress(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetHostAddress(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *EventImportRowUpdateOne) SetFormat(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableFormat(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetRow sets the "row" field.
+func (_u *EventImportRowUpdateOne) SetRow(v int) *EventImportRowUpdateOne {
+	_u.mutation.ResetRow()
+	_u.mutation.SetRow(v)
+	return _u
+}
+
+// SetNillableRow sets the "row" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableRow(v *int) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetRow(*v)
+	}
+	return _u
+}
+
+// AddRow adds value to the "row" field.
+func (_u *EventImportRowUpdateOne) AddRow(v int) *EventImportRowUpdateOne {
+	_u.mutation.AddRow(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EventImportRowUpdateOne) SetName(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableName(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventImportRowUpdateOne) SetDescription(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableDescription(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *EventImportRowUpdateOne) SetThumbnailURL(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableThumbnailURL(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// SetEventPassImg sets the "event_pass_img" field.
+func (_u *EventImportRowUpdateOne) SetEventPassImg(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetEventPassImg(v)
+	return _u
+}
+
+// SetNillableEventPassImg sets the "event_pass_img" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableEventPassImg(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetEventPassImg(*v)
+	}
+	return _u
+}
+
+// ClearEventPassImg clears the value of the "event_pass_img" field.
+func (_u *EventImportRowUpdateOne) ClearEventPassImg() *EventImportRowUpdateOne {
+	_u.mutation.ClearEventPassImg()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *EventImportRowUpdateOne) SetEventType(v uint8) *EventImportRowUpdateOne {
+	_u.mutation.ResetEventType()
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableEventType(v *uint8) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// AddEventType adds value to the "event_type" field.
+func (_u *EventImportRowUpdateOne) AddEventType(v int8) *EventImportRowUpdateOne {
+	_u.mutation.AddEventType(v)
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventImportRowUpdateOne) SetLocation(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableLocation(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// SetLat sets the "lat" field.
+func (_u *EventImportRowUpdateOne) SetLat(v float64) *EventImportRowUpdateOne {
+	_u.mutation.ResetLat()
+	_u.mutation.SetLat(v)
+	return _u
+}
+
+// SetNillableLat sets the "lat" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableLat(v *float64) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetLat(*v)
+	}
+	return _u
+}
+
+// AddLat adds value to the "lat" field.
+func (_u *EventImportRowUpdateOne) AddLat(v float64) *EventImportRowUpdateOne {
+	_u.mutation.AddLat(v)
+	return _u
+}
+
+// ClearLat clears the value of the "lat" field.
+func (_u *EventImportRowUpdateOne) ClearLat() *EventImportRowUpdateOne {
+	_u.mutation.ClearLat()
+	return _u
+}
+
+// SetLong sets the "long" field.
+func (_u *EventImportRowUpdateOne) SetLong(v float64) *EventImportRowUpdateOne {
+	_u.mutation.ResetLong()
+	_u.mutation.SetLong(v)
+	return _u
+}
+
+// SetNillableLong sets the "long" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableLong(v *float64) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetLong(*v)
+	}
+	return _u
+}
+
+// AddLong adds value to the "long" field.
+func (_u *EventImportRowUpdateOne) AddLong(v float64) *EventImportRowUpdateOne {
+	_u.mutation.AddLong(v)
+	return _u
+}
+
+// ClearLong clears the value of the "long" field.
+func (_u *EventImportRowUpdateOne) ClearLong() *EventImportRowUpdateOne {
+	_u.mutation.ClearLong()
+	return _u
+}
+
+// SetGeocoded sets the "geocoded" field.
+func (_u *EventImportRowUpdateOne) SetGeocoded(v bool) *EventImportRowUpdateOne {
+	_u.mutation.SetGeocoded(v)
+	return _u
+}
+
+// SetNillableGeocoded sets the "geocoded" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableGeocoded(v *bool) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetGeocoded(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *EventImportRowUpdateOne) SetStartDate(v time.Time) *EventImportRowUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableStartDate(v *time.Time) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *EventImportRowUpdateOne) SetEndDate(v time.Time) *EventImportRowUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableEndDate(v *time.Time) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetQuota sets the "quota" field.
+func (_u *EventImportRowUpdateOne) SetQuota(v uint64) *EventImportRowUpdateOne {
+	_u.mutation.ResetQuota()
+	_u.mutation.SetQuota(v)
+	return _u
+}
+
+// SetNillableQuota sets the "quota" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableQuota(v *uint64) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetQuota(*v)
+	}
+	return _u
+}
+
+// AddQuota adds value to the "quota" field.
+func (_u *EventImportRowUpdateOne) AddQuota(v int64) *EventImportRowUpdateOne {
+	_u.mutation.AddQuota(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EventImportRowUpdateOne) SetStatus(v eventimportrow.Status) *EventImportRowUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableStatus(v *eventimportrow.Status) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *EventImportRowUpdateOne) SetEventID(v uint64) *EventImportRowUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableEventID(v *uint64) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *EventImportRowUpdateOne) AddEventID(v int64) *EventImportRowUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// ClearEventID clears the value of the "event_id" field.
+func (_u *EventImportRowUpdateOne) ClearEventID() *EventImportRowUpdateOne {
+	_u.mutation.ClearEventID()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EventImportRowUpdateOne) SetAttempts(v int) *EventImportRowUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableAttempts(v *int) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EventImportRowUpdateOne) AddAttempts(v int) *EventImportRowUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *EventImportRowUpdateOne) SetError(v string) *EventImportRowUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *EventImportRowUpdateOne) SetNillableError(v *string) *EventImportRowUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *EventImportRowUpdateOne) ClearError() *EventImportRowUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventImportRowUpdateOne) SetUpdatedAt(v time.Time) *EventImportRowUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EventImportRowMutation object of the builder.
+func (_u *EventImportRowUpdateOne) Mutation() *EventImportRowMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventImportRowUpdate builder.
+func (_u *EventImportRowUpdateOne) Where(ps ...predicate.EventImportRow) *EventImportRowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventImportRowUpdateOne) Select(field string, fields ...string) *EventImportRowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventImportRow entity.
+func (_u *EventImportRowUpdateOne) Save(ctx context.Context) (*EventImportRow, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventImportRowUpdateOne) SaveX(ctx context.Context) *EventImportRow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventImportRowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventImportRowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventImportRowUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := eventimportrow.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventImportRowUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := eventimportrow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventImportRow.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EventImportRowUpdateOne) sqlSave(ctx context.Context) (_node *EventImportRow, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventimportrow.Table, eventimportrow.Columns, sqlgraph.NewFieldSpec(eventimportrow.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventImportRow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventimportrow.FieldID)
+		for _, f := range fields {
+			if !eventimportrow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventimportrow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ImportID(); ok {
+		_spec.SetField(eventimportrow.FieldImportID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HostAddress(); ok {
+		_spec.SetField(eventimportrow.FieldHostAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(eventimportrow.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Row(); ok {
+		_spec.SetField(eventimportrow.FieldRow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRow(); ok {
+		_spec.AddField(eventimportrow.FieldRow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(eventimportrow.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(eventimportrow.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventimportrow.FieldThumbnailURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventPassImg(); ok {
+		_spec.SetField(eventimportrow.FieldEventPassImg, field.TypeString, value)
+	}
+	if _u.mutation.EventPassImgCleared() {
+		_spec.ClearField(eventimportrow.FieldEventPassImg, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(eventimportrow.FieldEventType, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedEventType(); ok {
+		_spec.AddField(eventimportrow.FieldEventType, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(eventimportrow.FieldLocation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Lat(); ok {
+		_spec.SetField(eventimportrow.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLat(); ok {
+		_spec.AddField(eventimportrow.FieldLat, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatCleared() {
+		_spec.ClearField(eventimportrow.FieldLat, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Long(); ok {
+		_spec.SetField(eventimportrow.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLong(); ok {
+		_spec.AddField(eventimportrow.FieldLong, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongCleared() {
+		_spec.ClearField(eventimportrow.FieldLong, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Geocoded(); ok {
+		_spec.SetField(eventimportrow.FieldGeocoded, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(eventimportrow.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(eventimportrow.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Quota(); ok {
+		_spec.SetField(eventimportrow.FieldQuota, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedQuota(); ok {
+		_spec.AddField(eventimportrow.FieldQuota, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(eventimportrow.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(eventimportrow.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(eventimportrow.FieldEventID, field.TypeUint64, value)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(eventimportrow.FieldEventID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(eventimportrow.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(eventimportrow.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(eventimportrow.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(eventimportrow.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventimportrow.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EventImportRow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventimportrow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
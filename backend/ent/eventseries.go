@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventseries"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EventSeries is the model entity for the EventSeries schema.
+type EventSeries struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// HostAddress holds the value of the "host_address" field.
+	HostAddress string `json:"host_address,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// ThumbnailURL holds the value of the "thumbnail_url" field.
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// EventPassImg holds the value of the "event_pass_img" field.
+	EventPassImg string `json:"event_pass_img,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType uint8 `json:"event_type,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// Lat holds the value of the "lat" field.
+	Lat float64 `json:"lat,omitempty"`
+	// Long holds the value of the "long" field.
+	Long float64 `json:"long,omitempty"`
+	// Quota holds the value of the "quota" field.
+	Quota uint64 `json:"quota,omitempty"`
+	// Rrule holds the value of the "rrule" field.
+	Rrule string `json:"rrule,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate time.Time `json:"start_date,omitempty"`
+	// DurationSeconds holds the value of the "duration_seconds" field.
+	DurationSeconds int64 `json:"duration_seconds,omitempty"`
+	// LeadDays holds the value of the "lead_days" field.
+	LeadDays int `json:"lead_days,omitempty"`
+	// Status holds the value of the "status" field.
+	Status eventseries.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventSeries) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventseries.FieldLat, eventseries.FieldLong:
+			values[i] = new(sql.NullFloat64)
+		case eventseries.FieldID, eventseries.FieldEventType, eventseries.FieldQuota, eventseries.FieldDurationSeconds, eventseries.FieldLeadDays:
+			values[i] = new(sql.NullInt64)
+		case eventseries.FieldHostAddress, eventseries.FieldName, eventseries.FieldDescription, eventseries.FieldThumbnailURL, eventseries.FieldEventPassImg, eventseries.FieldLocation, eventseries.FieldRrule, eventseries.FieldTimezone, eventseries.FieldStatus:
+			values[i] = new(sql.NullString)
+		case eventseries.FieldStartDate, eventseries.FieldCreatedAt, eventseries.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventSeries fields.
+func (_m *EventSeries) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventseries.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case eventseries.FieldHostAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_address", values[i])
+			} else if value.Valid {
+				_m.HostAddress = value.String
+			}
+		case eventseries.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case eventseries.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case eventseries.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.ThumbnailURL = value.String
+			}
+		case eventseries.FieldEventPassImg:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_pass_img", values[i])
+			} else if value.Valid {
+				_m.EventPassImg = value.String
+			}
+		case eventseries.FieldEventType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = uint8(value.Int64)
+			}
+		case eventseries.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = value.String
+			}
+		case eventseries.FieldLat:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field lat", values[i])
+			} else if value.Valid {
+				_m.Lat = value.Float64
+			}
+		case eventseries.FieldLong:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field long", values[i])
+			} else if value.Valid {
+				_m.Long = value.Float64
+			}
+		case eventseries.FieldQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota", values[i])
+			} else if value.Valid {
+				_m.Quota = uint64(value.Int64)
+			}
+		case eventseries.FieldRrule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rrule", values[i])
+			} else if value.Valid {
+				_m.Rrule = value.String
+			}
+		case eventseries.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case eventseries.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				_m.StartDate = value.Time
+			}
+		case eventseries.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				_m.DurationSeconds = value.Int64
+			}
+		case eventseries.FieldLeadDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_days", values[i])
+			} else if value.Valid {
+				_m.LeadDays = int(value.Int64)
+			}
+		case eventseries.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = eventseries.Status(value.String)
+			}
+		case eventseries.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case eventseries.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventSeries.
+// This includes values selected through modifiers, order, etc.
+func (_m *EventSeries) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventSeries.
+// Note that you need to call EventSeries.Unwrap() before calling this method if this EventSeries
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EventSeries) Update() *EventSeriesUpdateOne {
+	return NewEventSeriesClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EventSeries entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EventSeries) Unwrap() *EventSeries {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventSeries is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EventSeries) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventSeries(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("host_address=")
+	builder.WriteString(_m.HostAddress)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(_m.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("event_pass_img=")
+	builder.WriteString(_m.EventPassImg)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventType))
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(_m.Location)
+	builder.WriteString(", ")
+	builder.WriteString("lat=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Lat))
+	builder.WriteString(", ")
+	builder.WriteString("long=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Long))
+	builder.WriteString(", ")
+	builder.WriteString("quota=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quota))
+	builder.WriteString(", ")
+	builder.WriteString("rrule=")
+	builder.WriteString(_m.Rrule)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(_m.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("lead_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeadDays))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventSeriesSlice is a parsable slice of EventSeries.
+type EventSeriesSlice []*EventSeries
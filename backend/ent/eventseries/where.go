@@ -0,0 +1,1065 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventseries
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldID, id))
+}
+
+// HostAddress applies equality check predicate on the "host_address" field. It's identical to HostAddressEQ.
+func HostAddress(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldHostAddress, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldDescription, v))
+}
+
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// EventPassImg applies equality check predicate on the "event_pass_img" field. It's identical to EventPassImgEQ.
+func EventPassImg(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldEventPassImg, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldEventType, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLocation, v))
+}
+
+// Lat applies equality check predicate on the "lat" field. It's identical to LatEQ.
+func Lat(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLat, v))
+}
+
+// Long applies equality check predicate on the "long" field. It's identical to LongEQ.
+func Long(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLong, v))
+}
+
+// Quota applies equality check predicate on the "quota" field. It's identical to QuotaEQ.
+func Quota(v uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldQuota, v))
+}
+
+// Rrule applies equality check predicate on the "rrule" field. It's identical to RruleEQ.
+func Rrule(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldRrule, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldTimezone, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldStartDate, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// LeadDays applies equality check predicate on the "lead_days" field. It's identical to LeadDaysEQ.
+func LeadDays(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLeadDays, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// HostAddressEQ applies the EQ predicate on the "host_address" field.
+func HostAddressEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldHostAddress, v))
+}
+
+// HostAddressNEQ applies the NEQ predicate on the "host_address" field.
+func HostAddressNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldHostAddress, v))
+}
+
+// HostAddressIn applies the In predicate on the "host_address" field.
+func HostAddressIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldHostAddress, vs...))
+}
+
+// HostAddressNotIn applies the NotIn predicate on the "host_address" field.
+func HostAddressNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldHostAddress, vs...))
+}
+
+// HostAddressGT applies the GT predicate on the "host_address" field.
+func HostAddressGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldHostAddress, v))
+}
+
+// HostAddressGTE applies the GTE predicate on the "host_address" field.
+func HostAddressGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldHostAddress, v))
+}
+
+// HostAddressLT applies the LT predicate on the "host_address" field.
+func HostAddressLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldHostAddress, v))
+}
+
+// HostAddressLTE applies the LTE predicate on the "host_address" field.
+func HostAddressLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldHostAddress, v))
+}
+
+// HostAddressContains applies the Contains predicate on the "host_address" field.
+func HostAddressContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldHostAddress, v))
+}
+
+// HostAddressHasPrefix applies the HasPrefix predicate on the "host_address" field.
+func HostAddressHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldHostAddress, v))
+}
+
+// HostAddressHasSuffix applies the HasSuffix predicate on the "host_address" field.
+func HostAddressHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldHostAddress, v))
+}
+
+// HostAddressEqualFold applies the EqualFold predicate on the "host_address" field.
+func HostAddressEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldHostAddress, v))
+}
+
+// HostAddressContainsFold applies the ContainsFold predicate on the "host_address" field.
+func HostAddressContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldHostAddress, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// EventPassImgEQ applies the EQ predicate on the "event_pass_img" field.
+func EventPassImgEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldEventPassImg, v))
+}
+
+// EventPassImgNEQ applies the NEQ predicate on the "event_pass_img" field.
+func EventPassImgNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldEventPassImg, v))
+}
+
+// EventPassImgIn applies the In predicate on the "event_pass_img" field.
+func EventPassImgIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldEventPassImg, vs...))
+}
+
+// EventPassImgNotIn applies the NotIn predicate on the "event_pass_img" field.
+func EventPassImgNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldEventPassImg, vs...))
+}
+
+// EventPassImgGT applies the GT predicate on the "event_pass_img" field.
+func EventPassImgGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldEventPassImg, v))
+}
+
+// EventPassImgGTE applies the GTE predicate on the "event_pass_img" field.
+func EventPassImgGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldEventPassImg, v))
+}
+
+// EventPassImgLT applies the LT predicate on the "event_pass_img" field.
+func EventPassImgLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldEventPassImg, v))
+}
+
+// EventPassImgLTE applies the LTE predicate on the "event_pass_img" field.
+func EventPassImgLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldEventPassImg, v))
+}
+
+// EventPassImgContains applies the Contains predicate on the "event_pass_img" field.
+func EventPassImgContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldEventPassImg, v))
+}
+
+// EventPassImgHasPrefix applies the HasPrefix predicate on the "event_pass_img" field.
+func EventPassImgHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldEventPassImg, v))
+}
+
+// EventPassImgHasSuffix applies the HasSuffix predicate on the "event_pass_img" field.
+func EventPassImgHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldEventPassImg, v))
+}
+
+// EventPassImgIsNil applies the IsNil predicate on the "event_pass_img" field.
+func EventPassImgIsNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIsNull(FieldEventPassImg))
+}
+
+// EventPassImgNotNil applies the NotNil predicate on the "event_pass_img" field.
+func EventPassImgNotNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotNull(FieldEventPassImg))
+}
+
+// EventPassImgEqualFold applies the EqualFold predicate on the "event_pass_img" field.
+func EventPassImgEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldEventPassImg, v))
+}
+
+// EventPassImgContainsFold applies the ContainsFold predicate on the "event_pass_img" field.
+func EventPassImgContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldEventPassImg, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v uint8) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldEventType, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// LatEQ applies the EQ predicate on the "lat" field.
+func LatEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLat, v))
+}
+
+// LatNEQ applies the NEQ predicate on the "lat" field.
+func LatNEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldLat, v))
+}
+
+// LatIn applies the In predicate on the "lat" field.
+func LatIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldLat, vs...))
+}
+
+// LatNotIn applies the NotIn predicate on the "lat" field.
+func LatNotIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldLat, vs...))
+}
+
+// LatGT applies the GT predicate on the "lat" field.
+func LatGT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldLat, v))
+}
+
+// LatGTE applies the GTE predicate on the "lat" field.
+func LatGTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldLat, v))
+}
+
+// LatLT applies the LT predicate on the "lat" field.
+func LatLT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldLat, v))
+}
+
+// LatLTE applies the LTE predicate on the "lat" field.
+func LatLTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldLat, v))
+}
+
+// LongEQ applies the EQ predicate on the "long" field.
+func LongEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLong, v))
+}
+
+// LongNEQ applies the NEQ predicate on the "long" field.
+func LongNEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldLong, v))
+}
+
+// LongIn applies the In predicate on the "long" field.
+func LongIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldLong, vs...))
+}
+
+// LongNotIn applies the NotIn predicate on the "long" field.
+func LongNotIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldLong, vs...))
+}
+
+// LongGT applies the GT predicate on the "long" field.
+func LongGT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldLong, v))
+}
+
+// LongGTE applies the GTE predicate on the "long" field.
+func LongGTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldLong, v))
+}
+
+// LongLT applies the LT predicate on the "long" field.
+func LongLT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldLong, v))
+}
+
+// LongLTE applies the LTE predicate on the "long" field.
+func LongLTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldLong, v))
+}
+
+// QuotaEQ applies the EQ predicate on the "quota" field.
+func QuotaEQ(v uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldQuota, v))
+}
+
+// QuotaNEQ applies the NEQ predicate on the "quota" field.
+func QuotaNEQ(v uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldQuota, v))
+}
+
+// QuotaIn applies the In predicate on the "quota" field.
+func QuotaIn(vs ...uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldQuota, vs...))
+}
+
+// QuotaNotIn applies the NotIn predicate on the "quota" field.
+func QuotaNotIn(vs ...uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldQuota, vs...))
+}
+
+// QuotaGT applies the GT predicate on the "quota" field.
+func QuotaGT(v uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldQuota, v))
+}
+
+// QuotaGTE applies the GTE predicate on the "quota" field.
+func QuotaGTE(v uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldQuota, v))
+}
+
+// QuotaLT applies the LT predicate on the "quota" field.
+func QuotaLT(v uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldQuota, v))
+}
+
+// QuotaLTE applies the LTE predicate on the "quota" field.
+func QuotaLTE(v uint64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldQuota, v))
+}
+
+// RruleEQ applies the EQ predicate on the "rrule" field.
+func RruleEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldRrule, v))
+}
+
+// RruleNEQ applies the NEQ predicate on the "rrule" field.
+func RruleNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldRrule, v))
+}
+
+// RruleIn applies the In predicate on the "rrule" field.
+func RruleIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldRrule, vs...))
+}
+
+// RruleNotIn applies the NotIn predicate on the "rrule" field.
+func RruleNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldRrule, vs...))
+}
+
+// RruleGT applies the GT predicate on the "rrule" field.
+func RruleGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldRrule, v))
+}
+
+// RruleGTE applies the GTE predicate on the "rrule" field.
+func RruleGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldRrule, v))
+}
+
+// RruleLT applies the LT predicate on the "rrule" field.
+func RruleLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldRrule, v))
+}
+
+// RruleLTE applies the LTE predicate on the "rrule" field.
+func RruleLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldRrule, v))
+}
+
+// RruleContains applies the Contains predicate on the "rrule" field.
+func RruleContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldRrule, v))
+}
+
+// RruleHasPrefix applies the HasPrefix predicate on the "rrule" field.
+func RruleHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldRrule, v))
+}
+
+// RruleHasSuffix applies the HasSuffix predicate on the "rrule" field.
+func RruleHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldRrule, v))
+}
+
+// RruleEqualFold applies the EqualFold predicate on the "rrule" field.
+func RruleEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldRrule, v))
+}
+
+// RruleContainsFold applies the ContainsFold predicate on the "rrule" field.
+func RruleContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldRrule, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldStartDate, v))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// LeadDaysEQ applies the EQ predicate on the "lead_days" field.
+func LeadDaysEQ(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLeadDays, v))
+}
+
+// LeadDaysNEQ applies the NEQ predicate on the "lead_days" field.
+func LeadDaysNEQ(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldLeadDays, v))
+}
+
+// LeadDaysIn applies the In predicate on the "lead_days" field.
+func LeadDaysIn(vs ...int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldLeadDays, vs...))
+}
+
+// LeadDaysNotIn applies the NotIn predicate on the "lead_days" field.
+func LeadDaysNotIn(vs ...int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldLeadDays, vs...))
+}
+
+// LeadDaysGT applies the GT predicate on the "lead_days" field.
+func LeadDaysGT(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldLeadDays, v))
+}
+
+// LeadDaysGTE applies the GTE predicate on the "lead_days" field.
+func LeadDaysGTE(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldLeadDays, v))
+}
+
+// LeadDaysLT applies the LT predicate on the "lead_days" field.
+func LeadDaysLT(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldLeadDays, v))
+}
+
+// LeadDaysLTE applies the LTE predicate on the "lead_days" field.
+func LeadDaysLTE(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldLeadDays, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventSeries) predicate.EventSeries {
+	return predicate.EventSeries(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventSeries) predicate.EventSeries {
+	return predicate.EventSeries(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventSeries) predicate.EventSeries {
+	return predicate.EventSeries(sql.NotPredicates(p))
+}
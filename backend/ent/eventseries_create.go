@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventseries"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventSeriesCreate is the builder for creating a EventSeries entity.
+type EventSeriesCreate struct {
+	config
+	mutation *EventSeriesMutation
+	hooks    []Hook
+}
+
+// SetHostAddress sets the "host_address" field.
+func (_c *EventSeriesCreate) SetHostAddress(v string) *EventSeriesCreate {
+	_c.mutation.SetHostAddress(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *EventSeriesCreate) SetName(v string) *EventSeriesCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *EventSeriesCreate) SetDescription(v string) *EventSeriesCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableDescription(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_c *EventSeriesCreate) SetThumbnailURL(v string) *EventSeriesCreate {
+	_c.mutation.SetThumbnailURL(v)
+	return _c
+}
+
+// SetEventPassImg sets the "event_pass_img" field.
+func (_c *EventSeriesCreate) SetEventPassImg(v string) *EventSeriesCreate {
+	_c.mutation.SetEventPassImg(v)
+	return _c
+}
+
+// SetNillableEventPassImg sets the "event_pass_img" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableEventPassImg(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetEventPassImg(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *EventSeriesCreate) SetEventType(v uint8) *EventSeriesCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *EventSeriesCreate) SetLocation(v string) *EventSeriesCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetLat sets the "lat" field.
+func (_c *EventSeriesCreate) SetLat(v float64) *EventSeriesCreate {
+	_c.mutation.SetLat(v)
+	return _c
+}
+
+// SetNillableLat sets the "lat" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableLat(v *float64) *EventSeriesCreate {
+	if v != nil {
+		_c.SetLat(*v)
+	}
+	return _c
+}
+
+// SetLong sets the "long" field.
+func (_c *EventSeriesCreate) SetLong(v float64) *EventSeriesCreate {
+	_c.mutation.SetLong(v)
+	return _c
+}
+
+// SetNillableLong sets the "long" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableLong(v *float64) *EventSeriesCreate {
+	if v != nil {
+		_c.SetLong(*v)
+	}
+	return _c
+}
+
+// SetQuota sets the "quota" field.
+func (_c *EventSeriesCreate) SetQuota(v uint64) *EventSeriesCreate {
+	_c.mutation.SetQuota(v)
+	return _c
+}
+
+// SetRrule sets the "rrule" field.
+func (_c *EventSeriesCreate) SetRrule(v string) *EventSeriesCreate {
+	_c.mutation.SetRrule(v)
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *EventSeriesCreate) SetTimezone(v string) *EventSeriesCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *EventSeriesCreate) SetStartDate(v time.Time) *EventSeriesCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (_c *EventSeriesCreate) SetDurationSeconds(v int64) *EventSeriesCreate {
+	_c.mutation.SetDurationSeconds(v)
+	return _c
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (_c *EventSeriesCreate) SetLeadDays(v int) *EventSeriesCreate {
+	_c.mutation.SetLeadDays(v)
+	return _c
+}
+
+// SetNillableLeadDays sets the "lead_days" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableLeadDays(v *int) *EventSeriesCreate {
+	if v != nil {
+		_c.SetLeadDays(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EventSeriesCreate) SetStatus(v eventseries.Status) *EventSeriesCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableStatus(v *eventseries.Status) *EventSeriesCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventSeriesCreate) SetCreatedAt(v time.Time) *EventSeriesCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableCreatedAt(v *time.Time) *EventSeriesCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EventSeriesCreate) SetUpdatedAt(v time.Time) *EventSeriesCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableUpdatedAt(v *time.Time) *EventSeriesCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EventSeriesMutation object of the builder.
+func (_c *EventSeriesCreate) Mutation() *EventSeriesMutation {
+	return _c.mutation
+}
+
+// Save creates the EventSeries in the database.
+func (_c *EventSeriesCreate) Save(ctx context.Context) (*EventSeries, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventSeriesCreate) SaveX(ctx context.Context) *EventSeries {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventSeriesCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventSeriesCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventSeriesCreate) defaults() {
+	if _, ok := _c.mutation.Description(); !ok {
+		v := eventseries.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.Lat(); !ok {
+		v := eventseries.DefaultLat
+		_c.mutation.SetLat(v)
+	}
+	if _, ok := _c.mutation.Long(); !ok {
+		v := eventseries.DefaultLong
+		_c.mutation.SetLong(v)
+	}
+	if _, ok := _c.mutation.LeadDays(); !ok {
+		v := eventseries.DefaultLeadDays
+		_c.mutation.SetLeadDays(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := eventseries.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventseries.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := eventseries.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventSeriesCreate) check() error {
+	if _, ok := _c.mutation.HostAddress(); !ok {
+		return &ValidationError{Name: "host_address", err: errors.New(`ent: missing required field "EventSeries.host_address"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EventSeries.name"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "EventSeries.description"`)}
+	}
+	if _, ok := _c.mutation.ThumbnailURL(); !ok {
+		return &ValidationError{Name: "thumbnail_url", err: errors.New(`ent: missing required field "EventSeries.thumbnail_url"`)}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "EventSeries.event_type"`)}
+	}
+	if _, ok := _c.mutation.Location(); !ok {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required field "EventSeries.location"`)}
+	}
+	if _, ok := _c.mutation.Lat(); !ok {
+		return &ValidationError{Name: "lat", err: errors.New(`ent: missing required field "EventSeries.lat"`)}
+	}
+	if _, ok := _c.mutation.Long(); !ok {
+		return &ValidationError{Name: "long", err: errors.New(`ent: missing required field "EventSeries.long"`)}
+	}
+	if _, ok := _c.mutation.Quota(); !ok {
+		return &ValidationError{Name: "quota", err: errors.New(`ent: missing required field "EventSeries.quota"`)}
+	}
+	if _, ok := _c.mutation.Rrule(); !ok {
+		return &ValidationError{Name: "rrule", err: errors.New(`ent: missing required field "EventSeries.rrule"`)}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "EventSeries.timezone"`)}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "EventSeries.start_date"`)}
+	}
+	if _, ok := _c.mutation.DurationSeconds(); !ok {
+		return &ValidationError{Name: "duration_seconds", err: errors.New(`ent: missing required field "EventSeries.duration_seconds"`)}
+	}
+	if _, ok := _c.mutation.LeadDays(); !ok {
+		return &ValidationError{Name: "lead_days", err: errors.New(`ent: missing required field "EventSeries.lead_days"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EventSeries.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := eventseries.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventSeries.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventSeries.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EventSeries.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventSeriesCreate) sqlSave(ctx context.Context) (*EventSeries, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventSeriesCreate) createSpec() (*EventSeries, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventSeries{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventseries.Table, sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.HostAddress(); ok {
+		_spec.SetField(eventseries.FieldHostAddress, field.TypeString, value)
+		_node.HostAddress = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(eventseries.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(eventseries.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventseries.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := _c.mutation.EventPassImg(); ok {
+		_spec.SetField(eventseries.FieldEventPassImg, field.TypeString, value)
+		_node.EventPassImg = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(eventseries.FieldEventType, field.TypeUint8, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(eventseries.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := _c.mutation.Lat(); ok {
+		_spec.SetField(eventseries.FieldLat, field.TypeFloat64, value)
+		_node.Lat = value
+	}
+	if value, ok := _c.mutation.Long(); ok {
+		_spec.SetField(eventseries.FieldLong, field.TypeFloat64, value)
+		_node.Long = value
+	}
+	if value, ok := _c.mutation.Quota(); ok {
+		_spec.SetField(eventseries.FieldQuota, field.TypeUint64, value)
+		_node.Quota = value
+	}
+	if value, ok := _c.mutation.Rrule(); ok {
+		_spec.SetField(eventseries.FieldRrule, field.TypeString, value)
+		_node.Rrule = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(eventseries.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(eventseries.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.DurationSeconds(); ok {
+		_spec.SetField(eventseries.FieldDurationSeconds, field.TypeInt64, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := _c.mutation.LeadDays(); ok {
+		_spec.SetField(eventseries.FieldLeadDays, field.TypeInt, value)
+		_node.LeadDays = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(eventseries.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventseries.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventseries.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EventSeriesCreateBulk is the builder for creating many EventSeries entities in bulk.
+type EventSeriesCreateBulk struct {
+	config
+	err      error
+	builders []*EventSeriesCreate
+}
+
+// Save creates the EventSeries entities in the database.
+func (_c *EventSeriesCreateBulk) Save(ctx context.Context) ([]*EventSeries, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventSeries, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventSeriesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventSeriesCreateBulk) SaveX(ctx context.Context) []*EventSeries {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventSeriesCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventSeriesCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,920 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventseries"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventSeriesUpdate is the builder for updating EventSeries entities.
+type EventSeriesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventSeriesMutation
+}
+
+// Where appends a list predicates to the EventSeriesUpdate builder.
+func (_u *EventSeriesUpdate) Where(ps ...predicate.EventSeries) *EventSeriesUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHostAddress sets the "host_address" field.
+func (_u *EventSeriesUpdate) SetHostAddress(v string) *EventSeriesUpdate {
+	_u.mutation.SetHostAddress(v)
+	return _u
+}
+
+// SetNillableHostAddress sets the "host_address" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableHostAddress(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetHostAddress(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EventSeriesUpdate) SetName(v string) *EventSeriesUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableName(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventSeriesUpdate) SetDescription(v string) *EventSeriesUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableDescription(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *EventSeriesUpdate) SetThumbnailURL(v string) *EventSeriesUpdate {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableThumbnailURL(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// SetEventPassImg sets the "event_pass_img" field.
+func (_u *EventSeriesUpdate) SetEventPassImg(v string) *EventSeriesUpdate {
+	_u.mutation.SetEventPassImg(v)
+	return _u
+}
+
+// SetNillableEventPassImg sets the "event_pass_img" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableEventPassImg(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetEventPassImg(*v)
+	}
+	return _u
+}
+
+// ClearEventPassImg clears the value of the "event_pass_img" field.
+func (_u *EventSeriesUpdate) ClearEventPassImg() *EventSeriesUpdate {
+	_u.mutation.ClearEventPassImg()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *EventSeriesUpdate) SetEventType(v uint8) *EventSeriesUpdate {
+	_u.mutation.ResetEventType()
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableEventType(v *uint8) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// AddEventType adds value to the "event_type" field.
+func (_u *EventSeriesUpdate) AddEventType(v int8) *EventSeriesUpdate {
+	_u.mutation.AddEventType(v)
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventSeriesUpdate) SetLocation(v string) *EventSeriesUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableLocation(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// SetLat sets the "lat" field.
+func (_u *EventSeriesUpdate) SetLat(v float64) *EventSeriesUpdate {
+	_u.mutation.ResetLat()
+	_u.mutation.SetLat(v)
+	return _u
+}
+
+// SetNillableLat sets the "lat" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableLat(v *float64) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetLat(*v)
+	}
+	return _u
+}
+
+// AddLat adds value to the "lat" field.
+func (_u *EventSeriesUpdate) AddLat(v float64) *EventSeriesUpdate {
+	_u.mutation.AddLat(v)
+	return _u
+}
+
+// SetLong sets the "long" field.
+func (_u *EventSeriesUpdate) SetLong(v float64) *EventSeriesUpdate {
+	_u.mutation.ResetLong()
+	_u.mutation.SetLong(v)
+	return _u
+}
+
+// SetNillableLong sets the "long" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableLong(v *float64) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetLong(*v)
+	}
+	return _u
+}
+
+// AddLong adds value to the "long" field.
+func (_u *EventSeriesUpdate) AddLong(v float64) *EventSeriesUpdate {
+	_u.mutation.AddLong(v)
+	return _u
+}
+
+// SetQuota sets the "quota" field.
+func (_u *EventSeriesUpdate) SetQuota(v uint64) *EventSeriesUpdate {
+	_u.mutation.ResetQuota()
+	_u.mutation.SetQuota(v)
+	return _u
+}
+
+// SetNillableQuota sets the "quota" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableQuota(v *uint64) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetQuota(*v)
+	}
+	return _u
+}
+
+// AddQuota adds value to the "quota" field.
+func (_u *EventSeriesUpdate) AddQuota(v int64) *EventSeriesUpdate {
+	_u.mutation.AddQuota(v)
+	return _u
+}
+
+// SetRrule sets the "rrule" field.
+func (_u *EventSeriesUpdate) SetRrule(v string) *EventSeriesUpdate {
+	_u.mutation.SetRrule(v)
+	return _u
+}
+
+// SetNillableRrule sets the "rrule" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableRrule(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetRrule(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *EventSeriesUpdate) SetTimezone(v string) *EventSeriesUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableTimezone(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *EventSeriesUpdate) SetStartDate(v time.Time) *EventSeriesUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableStartDate(v *time.Time) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (_u *EventSeriesUpdate) SetDurationSeconds(v int64) *EventSeriesUpdate {
+	_u.mutation.ResetDurationSeconds()
+	_u.mutation.SetDurationSeconds(v)
+	return _u
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableDurationSeconds(v *int64) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetDurationSeconds(*v)
+	}
+	return _u
+}
+
+// AddDurationSeconds adds value to the "duration_seconds" field.
+func (_u *EventSeriesUpdate) AddDurationSeconds(v int64) *EventSeriesUpdate {
+	_u.mutation.AddDurationSeconds(v)
+	return _u
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (_u *EventSeriesUpdate) SetLeadDays(v int) *EventSeriesUpdate {
+	_u.mutation.ResetLeadDays()
+	_u.mutation.SetLeadDays(v)
+	return _u
+}
+
+// SetNillableLeadDays sets the "lead_days" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableLeadDays(v *int) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetLeadDays(*v)
+	}
+	return _u
+}
+
+// AddLeadDays adds value to the "lead_days" field.
+func (_u *EventSeriesUpdate) AddLeadDays(v int) *EventSeriesUpdate {
+	_u.mutation.AddLeadDays(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EventSeriesUpdate) SetStatus(v eventseries.Status) *EventSeriesUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableStatus(v *eventseries.Status) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventSeriesUpdate) SetUpdatedAt(v time.Time) *EventSeriesUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EventSeriesMutation object of the builder.
+func (_u *EventSeriesUpdate) Mutation() *EventSeriesMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventSeriesUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventSeriesUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventSeriesUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventSeriesUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventSeriesUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := eventseries.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventSeriesUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := eventseries.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventSeries.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EventSeriesUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventseries.Table, eventseries.Columns, sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HostAddress(); ok {
+		_spec.SetField(eventseries.FieldHostAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(eventseries.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(eventseries.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventseries.FieldThumbnailURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventPassImg(); ok {
+		_spec.SetField(eventseries.FieldEventPassImg, field.TypeString, value)
+	}
+	if _u.mutation.EventPassImgCleared() {
+		_spec.ClearField(eventseries.FieldEventPassImg, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(eventseries.FieldEventType, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedEventType(); ok {
+		_spec.AddField(eventseries.FieldEventType, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(eventseries.FieldLocation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Lat(); ok {
+		_spec.SetField(eventseries.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLat(); ok {
+		_spec.AddField(eventseries.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Long(); ok {
+		_spec.SetField(eventseries.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLong(); ok {
+		_spec.AddField(eventseries.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Quota(); ok {
+		_spec.SetField(eventseries.FieldQuota, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedQuota(); ok {
+		_spec.AddField(eventseries.FieldQuota, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Rrule(); ok {
+		_spec.SetField(eventseries.FieldRrule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(eventseries.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(eventseries.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationSeconds(); ok {
+		_spec.SetField(eventseries.FieldDurationSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(eventseries.FieldDurationSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LeadDays(); ok {
+		_spec.SetField(eventseries.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadDays(); ok {
+		_spec.AddField(eventseries.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(eventseries.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventseries.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventseries.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventSeriesUpdateOne is the builder for updating a single EventSeries entity.
+type EventSeriesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventSeriesMutation
+}
+
+// SetHostAddress sets the "host_address" field.
+func (_u *EventSeriesUpdateOne) SetHostAddress(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetHostAddress(v)
+	return _u
+}
+
+// SetNillableHostAddress sets the "host_address" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableHostAddress(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetHostAddress(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EventSeriesUpdateOne) SetName(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableName(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventSeriesUpdateOne) SetDescription(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableDescription(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *EventSeriesUpdateOne) SetThumbnailURL(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableThumbnailURL(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// SetEventPassImg sets the "event_pass_img" field.
+func (_u *EventSeriesUpdateOne) SetEventPassImg(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetEventPassImg(v)
+	return _u
+}
+
+// SetNillableEventPassImg sets the "event_pass_img" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableEventPassImg(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetEventPassImg(*v)
+	}
+	return _u
+}
+
+// ClearEventPassImg clears the value of the "event_pass_img" field.
+func (_u *EventSeriesUpdateOne) ClearEventPassImg() *EventSeriesUpdateOne {
+	_u.mutation.ClearEventPassImg()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *EventSeriesUpdateOne) SetEventType(v uint8) *EventSeriesUpdateOne {
+	_u.mutation.ResetEventType()
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableEventType(v *uint8) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// AddEventType adds value to the "event_type" field.
+func (_u *EventSeriesUpdateOne) AddEventType(v int8) *EventSeriesUpdateOne {
+	_u.mutation.AddEventType(v)
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventSeriesUpdateOne) SetLocation(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableLocation(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// SetLat sets the "lat" field.
+func (_u *EventSeriesUpdateOne) SetLat(v float64) *EventSeriesUpdateOne {
+	_u.mutation.ResetLat()
+	_u.mutation.SetLat(v)
+	return _u
+}
+
+// SetNillableLat sets the "lat" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableLat(v *float64) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetLat(*v)
+	}
+	return _u
+}
+
+// AddLat adds value to the "lat" field.
+func (_u *EventSeriesUpdateOne) AddLat(v float64) *EventSeriesUpdateOne {
+	_u.mutation.AddLat(v)
+	return _u
+}
+
+// SetLong sets the "long" field.
+func (_u *EventSeriesUpdateOne) SetLong(v float64) *EventSeriesUpdateOne {
+	_u.mutation.ResetLong()
+	_u.mutation.SetLong(v)
+	return _u
+}
+
+// SetNillableLong sets the "long" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableLong(v *float64) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetLong(*v)
+	}
+	return _u
+}
+
+// AddLong adds value to the "long" field.
+func (_u *EventSeriesUpdateOne) AddLong(v float64) *EventSeriesUpdateOne {
+	_u.mutation.AddLong(v)
+	return _u
+}
+
+// SetQuota sets the "quota" field.
+func (_u *EventSeriesUpdateOne) SetQuota(v uint64) *EventSeriesUpdateOne {
+	_u.mutation.ResetQuota()
+	_u.mutation.SetQuota(v)
+	return _u
+}
+
+// SetNillableQuota sets the "quota" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableQuota(v *uint64) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetQuota(*v)
+	}
+	return _u
+}
+
+// AddQuota adds value to the "quota" field.
+func (_u *EventSeriesUpdateOne) AddQuota(v int64) *EventSeriesUpdateOne {
+	_u.mutation.AddQuota(v)
+	return _u
+}
+
+// SetRrule sets the "rrule" field.
+func (_u *EventSeriesUpdateOne) SetRrule(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetRrule(v)
+	return _u
+}
+
+// SetNillableRrule sets the "rrule" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableRrule(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetRrule(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *EventSeriesUpdateOne) SetTimezone(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableTimezone(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *EventSeriesUpdateOne) SetStartDate(v time.Time) *EventSeriesUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableStartDate(v *time.Time) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (_u *EventSeriesUpdateOne) SetDurationSeconds(v int64) *EventSeriesUpdateOne {
+	_u.mutation.ResetDurationSeconds()
+	_u.mutation.SetDurationSeconds(v)
+	return _u
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableDurationSeconds(v *int64) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetDurationSeconds(*v)
+	}
+	return _u
+}
+
+// AddDurationSeconds adds value to the "duration_seconds" field.
+func (_u *EventSeriesUpdateOne) AddDurationSeconds(v int64) *EventSeriesUpdateOne {
+	_u.mutation.AddDurationSeconds(v)
+	return _u
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (_u *EventSeriesUpdateOne) SetLeadDays(v int) *EventSeriesUpdateOne {
+	_u.mutation.ResetLeadDays()
+	_u.mutation.SetLeadDays(v)
+	return _u
+}
+
+// SetNillableLeadDays sets the "lead_days" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableLeadDays(v *int) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetLeadDays(*v)
+	}
+	return _u
+}
+
+// AddLeadDays adds value to the "lead_days" field.
+func (_u *EventSeriesUpdateOne) AddLeadDays(v int) *EventSeriesUpdateOne {
+	_u.mutation.AddLeadDays(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EventSeriesUpdateOne) SetStatus(v eventseries.Status) *EventSeriesUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableStatus(v *eventseries.Status) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventSeriesUpdateOne) SetUpdatedAt(v time.Time) *EventSeriesUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EventSeriesMutation object of the builder.
+func (_u *EventSeriesUpdateOne) Mutation() *EventSeriesMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventSeriesUpdate builder.
+func (_u *EventSeriesUpdateOne) Where(ps ...predicate.EventSeries) *EventSeriesUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventSeriesUpdateOne) Select(field string, fields ...string) *EventSeriesUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventSeries entity.
+func (_u *EventSeriesUpdateOne) Save(ctx context.Context) (*EventSeries, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventSeriesUpdateOne) SaveX(ctx context.Context) *EventSeries {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventSeriesUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventSeriesUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventSeriesUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := eventseries.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventSeriesUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := eventseries.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventSeries.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EventSeriesUpdateOne) sqlSave(ctx context.Context) (_node *EventSeries, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventseries.Table, eventseries.Columns, sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventSeries.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventseries.FieldID)
+		for _, f := range fields {
+			if !eventseries.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventseries.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HostAddress(); ok {
+		_spec.SetField(eventseries.FieldHostAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(eventseries.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(eventseries.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventseries.FieldThumbnailURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventPassImg(); ok {
+		_spec.SetField(eventseries.FieldEventPassImg, field.TypeString, value)
+	}
+	if _u.mutation.EventPassImgCleared() {
+		_spec.ClearField(eventseries.FieldEventPassImg, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(eventseries.FieldEventType, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedEventType(); ok {
+		_spec.AddField(eventseries.FieldEventType, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(eventseries.FieldLocation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Lat(); ok {
+		_spec.SetField(eventseries.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLat(); ok {
+		_spec.AddField(eventseries.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Long(); ok {
+		_spec.SetField(eventseries.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLong(); ok {
+		_spec.AddField(eventseries.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Quota(); ok {
+		_spec.SetField(eventseries.FieldQuota, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedQuota(); ok {
+		_spec.AddField(eventseries.FieldQuota, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Rrule(); ok {
+		_spec.SetField(eventseries.FieldRrule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(eventseries.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(eventseries.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationSeconds(); ok {
+		_spec.SetField(eventseries.FieldDurationSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(eventseries.FieldDurationSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LeadDays(); ok {
+		_spec.SetField(eventseries.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadDays(); ok {
+		_spec.AddField(eventseries.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(eventseries.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventseries.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EventSeries{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventseries.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
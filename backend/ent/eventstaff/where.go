@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventstaff
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLTE(FieldID, id))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldEventID, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddedBy applies equality check predicate on the "added_by" field. It's identical to AddedByEQ.
+func AddedBy(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldAddedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uint64) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLTE(FieldEventID, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// AddedByEQ applies the EQ predicate on the "added_by" field.
+func AddedByEQ(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldAddedBy, v))
+}
+
+// AddedByNEQ applies the NEQ predicate on the "added_by" field.
+func AddedByNEQ(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNEQ(FieldAddedBy, v))
+}
+
+// AddedByIn applies the In predicate on the "added_by" field.
+func AddedByIn(vs ...string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldIn(FieldAddedBy, vs...))
+}
+
+// AddedByNotIn applies the NotIn predicate on the "added_by" field.
+func AddedByNotIn(vs ...string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNotIn(FieldAddedBy, vs...))
+}
+
+// AddedByGT applies the GT predicate on the "added_by" field.
+func AddedByGT(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGT(FieldAddedBy, v))
+}
+
+// AddedByGTE applies the GTE predicate on the "added_by" field.
+func AddedByGTE(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGTE(FieldAddedBy, v))
+}
+
+// AddedByLT applies the LT predicate on the "added_by" field.
+func AddedByLT(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLT(FieldAddedBy, v))
+}
+
+// AddedByLTE applies the LTE predicate on the "added_by" field.
+func AddedByLTE(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLTE(FieldAddedBy, v))
+}
+
+// AddedByContains applies the Contains predicate on the "added_by" field.
+func AddedByContains(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldContains(FieldAddedBy, v))
+}
+
+// AddedByHasPrefix applies the HasPrefix predicate on the "added_by" field.
+func AddedByHasPrefix(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldHasPrefix(FieldAddedBy, v))
+}
+
+// AddedByHasSuffix applies the HasSuffix predicate on the "added_by" field.
+func AddedByHasSuffix(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldHasSuffix(FieldAddedBy, v))
+}
+
+// AddedByEqualFold applies the EqualFold predicate on the "added_by" field.
+func AddedByEqualFold(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEqualFold(FieldAddedBy, v))
+}
+
+// AddedByContainsFold applies the ContainsFold predicate on the "added_by" field.
+func AddedByContainsFold(v string) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldContainsFold(FieldAddedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EventStaff {
+	return predicate.EventStaff(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventStaff) predicate.EventStaff {
+	return predicate.EventStaff(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventStaff) predicate.EventStaff {
+	return predicate.EventStaff(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventStaff) predicate.EventStaff {
+	return predicate.EventStaff(sql.NotPredicates(p))
+}
@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventstaff"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventStaffCreate is the builder for creating a EventStaff entity.
+type EventStaffCreate struct {
+	config
+	mutation *EventStaffMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *EventStaffCreate) SetEventID(v uint64) *EventStaffCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetAddress sets the "address" field.
+func (_c *EventStaffCreate) SetAddress(v string) *EventStaffCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetAddedBy sets the "added_by" field.
+func (_c *EventStaffCreate) SetAddedBy(v string) *EventStaffCreate {
+	_c.mutation.SetAddedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventStaffCreate) SetCreatedAt(v time.Time) *EventStaffCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventStaffCreate) SetNillableCreatedAt(v *time.Time) *EventStaffCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EventStaffMutation object of the builder.
+func (_c *EventStaffCreate) Mutation() *EventStaffMutation {
+	return _c.mutation
+}
+
+// Save creates the EventStaff in the database.
+func (_c *EventStaffCreate) Save(ctx context.Context) (*EventStaff, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventStaffCreate) SaveX(ctx context.Context) *EventStaff {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventStaffCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventStaffCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventStaffCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventstaff.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventStaffCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "EventStaff.event_id"`)}
+	}
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "EventStaff.address"`)}
+	}
+	if _, ok := _c.mutation.AddedBy(); !ok {
+		return &ValidationError{Name: "added_by", err: errors.New(`ent: missing required field "EventStaff.added_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventStaff.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventStaffCreate) sqlSave(ctx context.Context) (*EventStaff, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventStaffCreate) createSpec() (*EventStaff, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventStaff{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventstaff.Table, sqlgraph.NewFieldSpec(eventstaff.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(eventstaff.FieldEventID, field.TypeUint64, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(eventstaff.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.AddedBy(); ok {
+		_spec.SetField(eventstaff.FieldAddedBy, field.TypeString, value)
+		_node.AddedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventstaff.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EventStaffCreateBulk is the builder for creating many EventStaff entities in bulk.
+type EventStaffCreateBulk struct {
+	config
+	err      error
+	builders []*EventStaffCreate
+}
+
+// Save creates the EventStaff entities in the database.
+func (_c *EventStaffCreateBulk) Save(ctx context.Context) ([]*EventStaff, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventStaff, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventStaffMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventStaffCreateBulk) SaveX(ctx context.Context) []*EventStaff {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventStaffCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventStaffCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventstaff"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventStaffUpdate is the builder for updating EventStaff entities.
+type EventStaffUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventStaffMutation
+}
+
+// Where appends a list predicates to the EventStaffUpdate builder.
+func (_u *EventStaffUpdate) Where(ps ...predicate.EventStaff) *EventStaffUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *EventStaffUpdate) SetEventID(v uint64) *EventStaffUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *EventStaffUpdate) SetNillableEventID(v *uint64) *EventStaffUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *EventStaffUpdate) AddEventID(v int64) *EventStaffUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *EventStaffUpdate) SetAddress(v string) *EventStaffUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *EventStaffUpdate) SetNillableAddress(v *string) *EventStaffUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetAddedBy sets the "added_by" field.
+func (_u *EventStaffUpdate) SetAddedBy(v string) *EventStaffUpdate {
+	_u.mutation.SetAddedBy(v)
+	return _u
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (_u *EventStaffUpdate) SetNillableAddedBy(v *string) *EventStaffUpdate {
+	if v != nil {
+		_u.SetAddedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EventStaffMutation object of the builder.
+func (_u *EventStaffUpdate) Mutation() *EventStaffMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventStaffUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventStaffUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventStaffUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventStaffUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EventStaffUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventstaff.Table, eventstaff.Columns, sqlgraph.NewFieldSpec(eventstaff.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(eventstaff.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(eventstaff.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(eventstaff.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddedBy(); ok {
+		_spec.SetField(eventstaff.FieldAddedBy, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventstaff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventStaffUpdateOne is the builder for updating a single EventStaff entity.
+type EventStaffUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventStaffMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *EventStaffUpdateOne) SetEventID(v uint64) *EventStaffUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *EventStaffUpdateOne) SetNillableEventID(v *uint64) *EventStaffUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *EventStaffUpdateOne) AddEventID(v int64) *EventStaffUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *EventStaffUpdateOne) SetAddress(v string) *EventStaffUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *EventStaffUpdateOne) SetNillableAddress(v *string) *EventStaffUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetAddedBy sets the "added_by" field.
+func (_u *EventStaffUpdateOne) SetAddedBy(v string) *EventStaffUpdateOne {
+	_u.mutation.SetAddedBy(v)
+	return _u
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (_u *EventStaffUpdateOne) SetNillableAddedBy(v *string) *EventStaffUpdateOne {
+	if v != nil {
+		_u.SetAddedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EventStaffMutation object of the builder.
+func (_u *EventStaffUpdateOne) Mutation() *EventStaffMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventStaffUpdate builder.
+func (_u *EventStaffUpdateOne) Where(ps ...predicate.EventStaff) *EventStaffUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventStaffUpdateOne) Select(field string, fields ...string) *EventStaffUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventStaff entity.
+func (_u *EventStaffUpdateOne) Save(ctx context.Context) (*EventStaff, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventStaffUpdateOne) SaveX(ctx context.Context) *EventStaff {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventStaffUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventStaffUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EventStaffUpdateOne) sqlSave(ctx context.Context) (_node *EventStaff, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventstaff.Table, eventstaff.Columns, sqlgraph.NewFieldSpec(eventstaff.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventStaff.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventstaff.FieldID)
+		for _, f := range fields {
+			if !eventstaff.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventstaff.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(eventstaff.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(eventstaff.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(eventstaff.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddedBy(); ok {
+		_spec.SetField(eventstaff.FieldAddedBy, field.TypeString, value)
+	}
+	_node = &EventStaff{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventstaff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
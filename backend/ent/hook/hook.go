@@ -8,6 +8,30 @@ import (
 	"fmt"
 )
 
+// The APIKeyFunc type is an adapter to allow the use of ordinary
+// function as APIKey mutator.
+type APIKeyFunc func(context.Context, *ent.APIKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyMutation", m)
+}
+
+// The APIKeyUsageFunc type is an adapter to allow the use of ordinary
+// function as APIKeyUsage mutator.
+type APIKeyUsageFunc func(context.Context, *ent.APIKeyUsageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeyUsageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIKeyUsageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyUsageMutation", m)
+}
+
 // The AttendanceFunc type is an adapter to allow the use of ordinary
 // function as Attendance mutator.
 type AttendanceFunc func(context.Context, *ent.AttendanceMutation) (ent.Value, error)
@@ -20,6 +44,78 @@ func (f AttendanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendanceMutation", m)
 }
 
+// The AuthNonceFunc type is an adapter to allow the use of ordinary
+// function as AuthNonce mutator.
+type AuthNonceFunc func(context.Context, *ent.AuthNonceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuthNonceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuthNonceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuthNonceMutation", m)
+}
+
+// The CalendarTokenFunc type is an adapter to allow the use of ordinary
+// function as CalendarToken mutator.
+type CalendarTokenFunc func(context.Context, *ent.CalendarTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CalendarTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CalendarTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CalendarTokenMutation", m)
+}
+
+// The CheckInIntentFunc type is an adapter to allow the use of ordinary
+// function as CheckInIntent mutator.
+type CheckInIntentFunc func(context.Context, *ent.CheckInIntentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CheckInIntentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CheckInIntentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CheckInIntentMutation", m)
+}
+
+// The CheckInTokenUseFunc type is an adapter to allow the use of ordinary
+// function as CheckInTokenUse mutator.
+type CheckInTokenUseFunc func(context.Context, *ent.CheckInTokenUseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CheckInTokenUseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CheckInTokenUseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CheckInTokenUseMutation", m)
+}
+
+// The ClaimFunc type is an adapter to allow the use of ordinary
+// function as Claim mutator.
+type ClaimFunc func(context.Context, *ent.ClaimMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClaimFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClaimMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClaimMutation", m)
+}
+
+// The ClaimQuotaFunc type is an adapter to allow the use of ordinary
+// function as ClaimQuota mutator.
+type ClaimQuotaFunc func(context.Context, *ent.ClaimQuotaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClaimQuotaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClaimQuotaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClaimQuotaMutation", m)
+}
+
 // The CommentFunc type is an adapter to allow the use of ordinary
 // function as Comment mutator.
 type CommentFunc func(context.Context, *ent.CommentMutation) (ent.Value, error)
@@ -32,6 +128,18 @@ func (f CommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentMutation", m)
 }
 
+// The DataVersionFunc type is an adapter to allow the use of ordinary
+// function as DataVersion mutator.
+type DataVersionFunc func(context.Context, *ent.DataVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DataVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DataVersionMutation", m)
+}
+
 // The EventFunc type is an adapter to allow the use of ordinary
 // function as Event mutator.
 type EventFunc func(context.Context, *ent.EventMutation) (ent.Value, error)
@@ -44,6 +152,30 @@ func (f EventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventMutation", m)
 }
 
+// The EventChangeFunc type is an adapter to allow the use of ordinary
+// function as EventChange mutator.
+type EventChangeFunc func(context.Context, *ent.EventChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EventChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventChangeMutation", m)
+}
+
+// The EventImportRowFunc type is an adapter to allow the use of ordinary
+// function as EventImportRow mutator.
+type EventImportRowFunc func(context.Context, *ent.EventImportRowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventImportRowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EventImportRowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventImportRowMutation", m)
+}
+
 // The EventPassFunc type is an adapter to allow the use of ordinary
 // function as EventPass mutator.
 type EventPassFunc func(context.Context, *ent.EventPassMutation) (ent.Value, error)
@@ -56,6 +188,78 @@ func (f EventPassFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventPassMutation", m)
 }
 
+// The EventSeriesFunc type is an adapter to allow the use of ordinary
+// function as EventSeries mutator.
+type EventSeriesFunc func(context.Context, *ent.EventSeriesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventSeriesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EventSeriesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventSeriesMutation", m)
+}
+
+// The EventStaffFunc type is an adapter to allow the use of ordinary
+// function as EventStaff mutator.
+type EventStaffFunc func(context.Context, *ent.EventStaffMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventStaffFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EventStaffMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventStaffMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *ent.IdempotencyKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyKeyMutation", m)
+}
+
+// The InviteCodeFunc type is an adapter to allow the use of ordinary
+// function as InviteCode mutator.
+type InviteCodeFunc func(context.Context, *ent.InviteCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InviteCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InviteCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InviteCodeMutation", m)
+}
+
+// The JoinLinkFunc type is an adapter to allow the use of ordinary
+// function as JoinLink mutator.
+type JoinLinkFunc func(context.Context, *ent.JoinLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JoinLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JoinLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JoinLinkMutation", m)
+}
+
+// The KioskDeviceFunc type is an adapter to allow the use of ordinary
+// function as KioskDevice mutator.
+type KioskDeviceFunc func(context.Context, *ent.KioskDeviceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KioskDeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KioskDeviceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KioskDeviceMutation", m)
+}
+
 // The LikeFunc type is an adapter to allow the use of ordinary
 // function as Like mutator.
 type LikeFunc func(context.Context, *ent.LikeMutation) (ent.Value, error)
@@ -80,6 +284,30 @@ func (f ListingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ListingMutation", m)
 }
 
+// The LocationFixFunc type is an adapter to allow the use of ordinary
+// function as LocationFix mutator.
+type LocationFixFunc func(context.Context, *ent.LocationFixMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LocationFixFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LocationFixMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LocationFixMutation", m)
+}
+
+// The MintCreditFunc type is an adapter to allow the use of ordinary
+// function as MintCredit mutator.
+type MintCreditFunc func(context.Context, *ent.MintCreditMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MintCreditFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MintCreditMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MintCreditMutation", m)
+}
+
 // The NFTAccessoryFunc type is an adapter to allow the use of ordinary
 // function as NFTAccessory mutator.
 type NFTAccessoryFunc func(context.Context, *ent.NFTAccessoryMutation) (ent.Value, error)
@@ -104,6 +332,54 @@ func (f NFTMomentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NFTMomentMutation", m)
 }
 
+// The NotificationFunc type is an adapter to allow the use of ordinary
+// function as Notification mutator.
+type NotificationFunc func(context.Context, *ent.NotificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationMutation", m)
+}
+
+// The ReferralFunc type is an adapter to allow the use of ordinary
+// function as Referral mutator.
+type ReferralFunc func(context.Context, *ent.ReferralMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReferralFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReferralMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReferralMutation", m)
+}
+
+// The SeriesOccurrenceFunc type is an adapter to allow the use of ordinary
+// function as SeriesOccurrence mutator.
+type SeriesOccurrenceFunc func(context.Context, *ent.SeriesOccurrenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SeriesOccurrenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SeriesOccurrenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SeriesOccurrenceMutation", m)
+}
+
+// The SessionFunc type is an adapter to allow the use of ordinary
+// function as Session mutator.
+type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -116,6 +392,18 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The WaitlistEntryFunc type is an adapter to allow the use of ordinary
+// function as WaitlistEntry mutator.
+type WaitlistEntryFunc func(context.Context, *ent.WaitlistEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaitlistEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WaitlistEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WaitlistEntryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invitecode
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldCode, v))
+}
+
+// OwnerAddress applies equality check predicate on the "owner_address" field. It's identical to OwnerAddressEQ.
+func OwnerAddress(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldOwnerAddress, v))
+}
+
+// MaxUses applies equality check predicate on the "max_uses" field. It's identical to MaxUsesEQ.
+func MaxUses(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// Uses applies equality check predicate on the "uses" field. It's identical to UsesEQ.
+func Uses(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldUses, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldContainsFold(FieldCode, v))
+}
+
+// OwnerAddressEQ applies the EQ predicate on the "owner_address" field.
+func OwnerAddressEQ(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldOwnerAddress, v))
+}
+
+// OwnerAddressNEQ applies the NEQ predicate on the "owner_address" field.
+func OwnerAddressNEQ(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNEQ(FieldOwnerAddress, v))
+}
+
+// OwnerAddressIn applies the In predicate on the "owner_address" field.
+func OwnerAddressIn(vs ...string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldIn(FieldOwnerAddress, vs...))
+}
+
+// OwnerAddressNotIn applies the NotIn predicate on the "owner_address" field.
+func OwnerAddressNotIn(vs ...string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNotIn(FieldOwnerAddress, vs...))
+}
+
+// OwnerAddressGT applies the GT predicate on the "owner_address" field.
+func OwnerAddressGT(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGT(FieldOwnerAddress, v))
+}
+
+// OwnerAddressGTE applies the GTE predicate on the "owner_address" field.
+func OwnerAddressGTE(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGTE(FieldOwnerAddress, v))
+}
+
+// OwnerAddressLT applies the LT predicate on the "owner_address" field.
+func OwnerAddressLT(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLT(FieldOwnerAddress, v))
+}
+
+// OwnerAddressLTE applies the LTE predicate on the "owner_address" field.
+func OwnerAddressLTE(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLTE(FieldOwnerAddress, v))
+}
+
+// OwnerAddressContains applies the Contains predicate on the "owner_address" field.
+func OwnerAddressContains(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldContains(FieldOwnerAddress, v))
+}
+
+// OwnerAddressHasPrefix applies the HasPrefix predicate on the "owner_address" field.
+func OwnerAddressHasPrefix(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldHasPrefix(FieldOwnerAddress, v))
+}
+
+// OwnerAddressHasSuffix applies the HasSuffix predicate on the "owner_address" field.
+func OwnerAddressHasSuffix(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldHasSuffix(FieldOwnerAddress, v))
+}
+
+// OwnerAddressEqualFold applies the EqualFold predicate on the "owner_address" field.
+func OwnerAddressEqualFold(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEqualFold(FieldOwnerAddress, v))
+}
+
+// OwnerAddressContainsFold applies the ContainsFold predicate on the "owner_address" field.
+func OwnerAddressContainsFold(v string) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldContainsFold(FieldOwnerAddress, v))
+}
+
+// MaxUsesEQ applies the EQ predicate on the "max_uses" field.
+func MaxUsesEQ(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// MaxUsesNEQ applies the NEQ predicate on the "max_uses" field.
+func MaxUsesNEQ(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNEQ(FieldMaxUses, v))
+}
+
+// MaxUsesIn applies the In predicate on the "max_uses" field.
+func MaxUsesIn(vs ...int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesNotIn applies the NotIn predicate on the "max_uses" field.
+func MaxUsesNotIn(vs ...int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNotIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesGT applies the GT predicate on the "max_uses" field.
+func MaxUsesGT(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGT(FieldMaxUses, v))
+}
+
+// MaxUsesGTE applies the GTE predicate on the "max_uses" field.
+func MaxUsesGTE(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGTE(FieldMaxUses, v))
+}
+
+// MaxUsesLT applies the LT predicate on the "max_uses" field.
+func MaxUsesLT(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLT(FieldMaxUses, v))
+}
+
+// MaxUsesLTE applies the LTE predicate on the "max_uses" field.
+func MaxUsesLTE(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLTE(FieldMaxUses, v))
+}
+
+// UsesEQ applies the EQ predicate on the "uses" field.
+func UsesEQ(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldUses, v))
+}
+
+// UsesNEQ applies the NEQ predicate on the "uses" field.
+func UsesNEQ(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNEQ(FieldUses, v))
+}
+
+// UsesIn applies the In predicate on the "uses" field.
+func UsesIn(vs ...int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldIn(FieldUses, vs...))
+}
+
+// UsesNotIn applies the NotIn predicate on the "uses" field.
+func UsesNotIn(vs ...int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNotIn(FieldUses, vs...))
+}
+
+// UsesGT applies the GT predicate on the "uses" field.
+func UsesGT(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGT(FieldUses, v))
+}
+
+// UsesGTE applies the GTE predicate on the "uses" field.
+func UsesGTE(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGTE(FieldUses, v))
+}
+
+// UsesLT applies the LT predicate on the "uses" field.
+func UsesLT(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLT(FieldUses, v))
+}
+
+// UsesLTE applies the LTE predicate on the "uses" field.
+func UsesLTE(v int) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLTE(FieldUses, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InviteCode {
+	return predicate.InviteCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InviteCode) predicate.InviteCode {
+	return predicate.InviteCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InviteCode) predicate.InviteCode {
+	return predicate.InviteCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InviteCode) predicate.InviteCode {
+	return predicate.InviteCode(sql.NotPredicates(p))
+}
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/invitecode"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InviteCodeCreate is the builder for creating a InviteCode entity.
+type InviteCodeCreate struct {
+	config
+	mutation *InviteCodeMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *InviteCodeCreate) SetCode(v string) *InviteCodeCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetOwnerAddress sets the "owner_address" field.
+func (_c *InviteCodeCreate) SetOwnerAddress(v string) *InviteCodeCreate {
+	_c.mutation.SetOwnerAddress(v)
+	return _c
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_c *InviteCodeCreate) SetMaxUses(v int) *InviteCodeCreate {
+	_c.mutation.SetMaxUses(v)
+	return _c
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_c *InviteCodeCreate) SetNillableMaxUses(v *int) *InviteCodeCreate {
+	if v != nil {
+		_c.SetMaxUses(*v)
+	}
+	return _c
+}
+
+// SetUses sets the "uses" field.
+func (_c *InviteCodeCreate) SetUses(v int) *InviteCodeCreate {
+	_c.mutation.SetUses(v)
+	return _c
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_c *InviteCodeCreate) SetNillableUses(v *int) *InviteCodeCreate {
+	if v != nil {
+		_c.SetUses(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InviteCodeCreate) SetCreatedAt(v time.Time) *InviteCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InviteCodeCreate) SetNillableCreatedAt(v *time.Time) *InviteCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InviteCodeMutation object of the builder.
+func (_c *InviteCodeCreate) Mutation() *InviteCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the InviteCode in the database.
+func (_c *InviteCodeCreate) Save(ctx context.Context) (*InviteCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InviteCodeCreate) SaveX(ctx context.Context) *InviteCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InviteCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InviteCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InviteCodeCreate) defaults() {
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		v := invitecode.DefaultMaxUses
+		_c.mutation.SetMaxUses(v)
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		v := invitecode.DefaultUses
+		_c.mutation.SetUses(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invitecode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InviteCodeCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "InviteCode.code"`)}
+	}
+	if _, ok := _c.mutation.OwnerAddress(); !ok {
+		return &ValidationError{Name: "owner_address", err: errors.New(`ent: missing required field "InviteCode.owner_address"`)}
+	}
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "InviteCode.max_uses"`)}
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "InviteCode.uses"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InviteCode.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *InviteCodeCreate) sqlSave(ctx context.Context) (*InviteCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InviteCodeCreate) createSpec() (*InviteCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InviteCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invitecode.Table, sqlgraph.NewFieldSpec(invitecode.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(invitecode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.OwnerAddress(); ok {
+		_spec.SetField(invitecode.FieldOwnerAddress, field.TypeString, value)
+		_node.OwnerAddress = value
+	}
+	if value, ok := _c.mutation.MaxUses(); ok {
+		_spec.SetField(invitecode.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := _c.mutation.Uses(); ok {
+		_spec.SetField(invitecode.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invitecode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// InviteCodeCreateBulk is the builder for creating many InviteCode entities in bulk.
+type InviteCodeCreateBulk struct {
+	config
+	err      error
+	builders []*InviteCodeCreate
+}
+
+// Save creates the InviteCode entities in the database.
+func (_c *InviteCodeCreateBulk) Save(ctx context.Context) ([]*InviteCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InviteCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InviteCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InviteCodeCreateBulk) SaveX(ctx context.Context) []*InviteCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InviteCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InviteCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/invitecode"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InviteCodeUpdate is the builder for updating InviteCode entities.
+type InviteCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InviteCodeMutation
+}
+
+// Where appends a list predicates to the InviteCodeUpdate builder.
+func (_u *InviteCodeUpdate) Where(ps ...predicate.InviteCode) *InviteCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *InviteCodeUpdate) SetCode(v string) *InviteCodeUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *InviteCodeUpdate) SetNillableCode(v *string) *InviteCodeUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetOwnerAddress sets the "owner_address" field.
+func (_u *InviteCodeUpdate) SetOwnerAddress(v string) *InviteCodeUpdate {
+	_u.mutation.SetOwnerAddress(v)
+	return _u
+}
+
+// SetNillableOwnerAddress sets the "owner_address" field if the given value is not nil.
+func (_u *InviteCodeUpdate) SetNillableOwnerAddress(v *string) *InviteCodeUpdate {
+	if v != nil {
+		_u.SetOwnerAddress(*v)
+	}
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *InviteCodeUpdate) SetMaxUses(v int) *InviteCodeUpdate {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *InviteCodeUpdate) SetNillableMaxUses(v *int) *InviteCodeUpdate {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *InviteCodeUpdate) AddMaxUses(v int) *InviteCodeUpdate {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *InviteCodeUpdate) SetUses(v int) *InviteCodeUpdate {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *InviteCodeUpdate) SetNillableUses(v *int) *InviteCodeUpdate {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *InviteCodeUpdate) AddUses(v int) *InviteCodeUpdate {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// Mutation returns the InviteCodeMutation object of the builder.
+func (_u *InviteCodeUpdate) Mutation() *InviteCodeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InviteCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InviteCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InviteCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InviteCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *InviteCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invitecode.Table, invitecode.Columns, sqlgraph.NewFieldSpec(invitecode.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(invitecode.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerAddress(); ok {
+		_spec.SetField(invitecode.FieldOwnerAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(invitecode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitecode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(invitecode.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(invitecode.FieldUses, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitecode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InviteCodeUpdateOne is the builder for updating a single InviteCode entity.
+type InviteCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InviteCodeMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *InviteCodeUpdateOne) SetCode(v string) *InviteCodeUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *InviteCodeUpdateOne) SetNillableCode(v *string) *InviteCodeUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetOwnerAddress sets the "owner_address" field.
+func (_u *InviteCodeUpdateOne) SetOwnerAddress(v string) *InviteCodeUpdateOne {
+	_u.mutation.SetOwnerAddress(v)
+	return _u
+}
+
+// SetNillableOwnerAddress sets the "owner_address" field if the given value is not nil.
+func (_u *InviteCodeUpdateOne) SetNillableOwnerAddress(v *string) *InviteCodeUpdateOne {
+	if v != nil {
+		_u.SetOwnerAddress(*v)
+	}
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *InviteCodeUpdateOne) SetMaxUses(v int) *InviteCodeUpdateOne {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *InviteCodeUpdateOne) SetNillableMaxUses(v *int) *InviteCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *InviteCodeUpdateOne) AddMaxUses(v int) *InviteCodeUpdateOne {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *InviteCodeUpdateOne) SetUses(v int) *InviteCodeUpdateOne {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *InviteCodeUpdateOne) SetNillableUses(v *int) *InviteCodeUpdateOne {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *InviteCodeUpdateOne) AddUses(v int) *InviteCodeUpdateOne {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// Mutation returns the InviteCodeMutation object of the builder.
+func (_u *InviteCodeUpdateOne) Mutation() *InviteCodeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InviteCodeUpdate builder.
+func (_u *InviteCodeUpdateOne) Where(ps ...predicate.InviteCode) *InviteCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InviteCodeUpdateOne) Select(field string, fields ...string) *InviteCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InviteCode entity.
+func (_u *InviteCodeUpdateOne) Save(ctx context.Context) (*InviteCode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InviteCodeUpdateOne) SaveX(ctx context.Context) *InviteCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InviteCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InviteCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *InviteCodeUpdateOne) sqlSave(ctx context.Context) (_node *InviteCode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invitecode.Table, invitecode.Columns, sqlgraph.NewFieldSpec(invitecode.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InviteCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitecode.FieldID)
+		for _, f := range fields {
+			if !invitecode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invitecode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(invitecode.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerAddress(); ok {
+		_spec.SetField(invitecode.FieldOwnerAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(invitecode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitecode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(invitecode.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(invitecode.FieldUses, field.TypeInt, value)
+	}
+	_node = &InviteCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitecode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
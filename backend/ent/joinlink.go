@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/joinlink"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// JoinLink is the model entity for the JoinLink schema.
+type JoinLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID uint64 `json:"event_id,omitempty"`
+	// UserAddress holds the value of the "user_address" field.
+	UserAddress string `json:"user_address,omitempty"`
+	// ClickedAt holds the value of the "clicked_at" field.
+	ClickedAt *time.Time `json:"clicked_at,omitempty"`
+	// LastHeartbeatAt holds the value of the "last_heartbeat_at" field.
+	LastHeartbeatAt *time.Time `json:"last_heartbeat_at,omitempty"`
+	// Qualified holds the value of the "qualified" field.
+	Qualified bool `json:"qualified,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JoinLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case joinlink.FieldQualified:
+			values[i] = new(sql.NullBool)
+		case joinlink.FieldID, joinlink.FieldEventID:
+			values[i] = new(sql.NullInt64)
+		case joinlink.FieldToken, joinlink.FieldUserAddress:
+			values[i] = new(sql.NullString)
+		case joinlink.FieldClickedAt, joinlink.FieldLastHeartbeatAt, joinlink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JoinLink fields.
+func (_m *JoinLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case joinlink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case joinlink.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case joinlink.FieldEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = uint64(value.Int64)
+			}
+		case joinlink.FieldUserAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_address", values[i])
+			} else if value.Valid {
+				_m.UserAddress = value.String
+			}
+		case joinlink.FieldClickedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field clicked_at", values[i])
+			} else if value.Valid {
+				_m.ClickedAt = new(time.Time)
+				*_m.ClickedAt = value.Time
+			}
+		case joinlink.FieldLastHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_heartbeat_at", values[i])
+			} else if value.Valid {
+				_m.LastHeartbeatAt = new(time.Time)
+				*_m.LastHeartbeatAt = value.Time
+			}
+		case joinlink.FieldQualified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field qualified", values[i])
+			} else if value.Valid {
+				_m.Qualified = value.Bool
+			}
+		case joinlink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JoinLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *JoinLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JoinLink.
+// Note that you need to call JoinLink.Unwrap() before calling this method if this JoinLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *JoinLink) Update() *JoinLinkUpdateOne {
+	return NewJoinLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the JoinLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *JoinLink) Unwrap() *JoinLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JoinLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *JoinLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("JoinLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("user_address=")
+	builder.WriteString(_m.UserAddress)
+	builder.WriteString(", ")
+	if v := _m.ClickedAt; v != nil {
+		builder.WriteString("clicked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastHeartbeatAt; v != nil {
+		builder.WriteString("last_heartbeat_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("qualified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Qualified))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JoinLinks is a parsable slice of JoinLink.
+type JoinLinks []*JoinLink
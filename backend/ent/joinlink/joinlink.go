@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package joinlink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the joinlink type in the database.
+	Label = "join_link"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldUserAddress holds the string denoting the user_address field in the database.
+	FieldUserAddress = "user_address"
+	// FieldClickedAt holds the string denoting the clicked_at field in the database.
+	FieldClickedAt = "clicked_at"
+	// FieldLastHeartbeatAt holds the string denoting the last_heartbeat_at field in the database.
+	FieldLastHeartbeatAt = "last_heartbeat_at"
+	// FieldQualified holds the string denoting the qualified field in the database.
+	FieldQualified = "qualified"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the joinlink in the database.
+	Table = "join_links"
+)
+
+// Columns holds all SQL columns for joinlink fields.
+var Columns = []string{
+	FieldID,
+	FieldToken,
+	FieldEventID,
+	FieldUserAddress,
+	FieldClickedAt,
+	FieldLastHeartbeatAt,
+	FieldQualified,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultQualified holds the default value on creation for the "qualified" field.
+	DefaultQualified bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the JoinLink queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByUserAddress orders the results by the user_address field.
+func ByUserAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAddress, opts...).ToFunc()
+}
+
+// ByClickedAt orders the results by the clicked_at field.
+func ByClickedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClickedAt, opts...).ToFunc()
+}
+
+// ByLastHeartbeatAt orders the results by the last_heartbeat_at field.
+func ByLastHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHeartbeatAt, opts...).ToFunc()
+}
+
+// ByQualified orders the results by the qualified field.
+func ByQualified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQualified, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
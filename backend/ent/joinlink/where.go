@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package joinlink
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLTE(FieldID, id))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldToken, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldEventID, v))
+}
+
+// UserAddress applies equality check predicate on the "user_address" field. It's identical to UserAddressEQ.
+func UserAddress(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldUserAddress, v))
+}
+
+// ClickedAt applies equality check predicate on the "clicked_at" field. It's identical to ClickedAtEQ.
+func ClickedAt(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldClickedAt, v))
+}
+
+// LastHeartbeatAt applies equality check predicate on the "last_heartbeat_at" field. It's identical to LastHeartbeatAtEQ.
+func LastHeartbeatAt(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldLastHeartbeatAt, v))
+}
+
+// Qualified applies equality check predicate on the "qualified" field. It's identical to QualifiedEQ.
+func Qualified(v bool) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldQualified, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldContainsFold(FieldToken, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uint64) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLTE(FieldEventID, v))
+}
+
+// UserAddressEQ applies the EQ predicate on the "user_address" field.
+func UserAddressEQ(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldUserAddress, v))
+}
+
+// UserAddressNEQ applies the NEQ predicate on the "user_address" field.
+func UserAddressNEQ(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNEQ(FieldUserAddress, v))
+}
+
+// UserAddressIn applies the In predicate on the "user_address" field.
+func UserAddressIn(vs ...string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIn(FieldUserAddress, vs...))
+}
+
+// UserAddressNotIn applies the NotIn predicate on the "user_address" field.
+func UserAddressNotIn(vs ...string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotIn(FieldUserAddress, vs...))
+}
+
+// UserAddressGT applies the GT predicate on the "user_address" field.
+func UserAddressGT(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGT(FieldUserAddress, v))
+}
+
+// UserAddressGTE applies the GTE predicate on the "user_address" field.
+func UserAddressGTE(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGTE(FieldUserAddress, v))
+}
+
+// UserAddressLT applies the LT predicate on the "user_address" field.
+func UserAddressLT(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLT(FieldUserAddress, v))
+}
+
+// UserAddressLTE applies the LTE predicate on the "user_address" field.
+func UserAddressLTE(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLTE(FieldUserAddress, v))
+}
+
+// UserAddressContains applies the Contains predicate on the "user_address" field.
+func UserAddressContains(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldContains(FieldUserAddress, v))
+}
+
+// UserAddressHasPrefix applies the HasPrefix predicate on the "user_address" field.
+func UserAddressHasPrefix(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldHasPrefix(FieldUserAddress, v))
+}
+
+// UserAddressHasSuffix applies the HasSuffix predicate on the "user_address" field.
+func UserAddressHasSuffix(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldHasSuffix(FieldUserAddress, v))
+}
+
+// UserAddressEqualFold applies the EqualFold predicate on the "user_address" field.
+func UserAddressEqualFold(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEqualFold(FieldUserAddress, v))
+}
+
+// UserAddressContainsFold applies the ContainsFold predicate on the "user_address" field.
+func UserAddressContainsFold(v string) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldContainsFold(FieldUserAddress, v))
+}
+
+// ClickedAtEQ applies the EQ predicate on the "clicked_at" field.
+func ClickedAtEQ(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldClickedAt, v))
+}
+
+// ClickedAtNEQ applies the NEQ predicate on the "clicked_at" field.
+func ClickedAtNEQ(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNEQ(FieldClickedAt, v))
+}
+
+// ClickedAtIn applies the In predicate on the "clicked_at" field.
+func ClickedAtIn(vs ...time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIn(FieldClickedAt, vs...))
+}
+
+// ClickedAtNotIn applies the NotIn predicate on the "clicked_at" field.
+func ClickedAtNotIn(vs ...time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotIn(FieldClickedAt, vs...))
+}
+
+// ClickedAtGT applies the GT predicate on the "clicked_at" field.
+func ClickedAtGT(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGT(FieldClickedAt, v))
+}
+
+// ClickedAtGTE applies the GTE predicate on the "clicked_at" field.
+func ClickedAtGTE(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGTE(FieldClickedAt, v))
+}
+
+// ClickedAtLT applies the LT predicate on the "clicked_at" field.
+func ClickedAtLT(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLT(FieldClickedAt, v))
+}
+
+// ClickedAtLTE applies the LTE predicate on the "clicked_at" field.
+func ClickedAtLTE(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLTE(FieldClickedAt, v))
+}
+
+// ClickedAtIsNil applies the IsNil predicate on the "clicked_at" field.
+func ClickedAtIsNil() predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIsNull(FieldClickedAt))
+}
+
+// ClickedAtNotNil applies the NotNil predicate on the "clicked_at" field.
+func ClickedAtNotNil() predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotNull(FieldClickedAt))
+}
+
+// LastHeartbeatAtEQ applies the EQ predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtEQ(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtNEQ applies the NEQ predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNEQ(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNEQ(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtIn applies the In predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtIn(vs ...time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIn(FieldLastHeartbeatAt, vs...))
+}
+
+// LastHeartbeatAtNotIn applies the NotIn predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNotIn(vs ...time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotIn(FieldLastHeartbeatAt, vs...))
+}
+
+// LastHeartbeatAtGT applies the GT predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtGT(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGT(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtGTE applies the GTE predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtGTE(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGTE(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtLT applies the LT predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtLT(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLT(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtLTE applies the LTE predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtLTE(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLTE(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtIsNil applies the IsNil predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtIsNil() predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIsNull(FieldLastHeartbeatAt))
+}
+
+// LastHeartbeatAtNotNil applies the NotNil predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNotNil() predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotNull(FieldLastHeartbeatAt))
+}
+
+// QualifiedEQ applies the EQ predicate on the "qualified" field.
+func QualifiedEQ(v bool) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldQualified, v))
+}
+
+// QualifiedNEQ applies the NEQ predicate on the "qualified" field.
+func QualifiedNEQ(v bool) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNEQ(FieldQualified, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.JoinLink {
+	return predicate.JoinLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JoinLink) predicate.JoinLink {
+	return predicate.JoinLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JoinLink) predicate.JoinLink {
+	return predicate.JoinLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JoinLink) predicate.JoinLink {
+	return predicate.JoinLink(sql.NotPredicates(p))
+}
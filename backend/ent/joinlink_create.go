@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/joinlink"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JoinLinkCreate is the builder for creating a JoinLink entity.
+type JoinLinkCreate struct {
+	config
+	mutation *JoinLinkMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (_c *JoinLinkCreate) SetToken(v string) *JoinLinkCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *JoinLinkCreate) SetEventID(v uint64) *JoinLinkCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_c *JoinLinkCreate) SetUserAddress(v string) *JoinLinkCreate {
+	_c.mutation.SetUserAddress(v)
+	return _c
+}
+
+// SetClickedAt sets the "clicked_at" field.
+func (_c *JoinLinkCreate) SetClickedAt(v time.Time) *JoinLinkCreate {
+	_c.mutation.SetClickedAt(v)
+	return _c
+}
+
+// SetNillableClickedAt sets the "clicked_at" field if the given value is not nil.
+func (_c *JoinLinkCreate) SetNillableClickedAt(v *time.Time) *JoinLinkCreate {
+	if v != nil {
+		_c.SetClickedAt(*v)
+	}
+	return _c
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_c *JoinLinkCreate) SetLastHeartbeatAt(v time.Time) *JoinLinkCreate {
+	_c.mutation.SetLastHeartbeatAt(v)
+	return _c
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_c *JoinLinkCreate) SetNillableLastHeartbeatAt(v *time.Time) *JoinLinkCreate {
+	if v != nil {
+		_c.SetLastHeartbeatAt(*v)
+	}
+	return _c
+}
+
+// SetQualified sets the "qualified" field.
+func (_c *JoinLinkCreate) SetQualified(v bool) *JoinLinkCreate {
+	_c.mutation.SetQualified(v)
+	return _c
+}
+
+// SetNillableQualified sets the "qualified" field if the given value is not nil.
+func (_c *JoinLinkCreate) SetNillableQualified(v *bool) *JoinLinkCreate {
+	if v != nil {
+		_c.SetQualified(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JoinLinkCreate) SetCreatedAt(v time.Time) *JoinLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JoinLinkCreate) SetNillableCreatedAt(v *time.Time) *JoinLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the JoinLinkMutation object of the builder.
+func (_c *JoinLinkCreate) Mutation() *JoinLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the JoinLink in the database.
+func (_c *JoinLinkCreate) Save(ctx context.Context) (*JoinLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JoinLinkCreate) SaveX(ctx context.Context) *JoinLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JoinLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JoinLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JoinLinkCreate) defaults() {
+	if _, ok := _c.mutation.Qualified(); !ok {
+		v := joinlink.DefaultQualified
+		_c.mutation.SetQualified(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := joinlink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JoinLinkCreate) check() error {
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "JoinLink.token"`)}
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "JoinLink.event_id"`)}
+	}
+	if _, ok := _c.mutation.UserAddress(); !ok {
+		return &ValidationError{Name: "user_address", err: errors.New(`ent: missing required field "JoinLink.user_address"`)}
+	}
+	if _, ok := _c.mutation.Qualified(); !ok {
+		return &ValidationError{Name: "qualified", err: errors.New(`ent: missing required field "JoinLink.qualified"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JoinLink.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *JoinLinkCreate) sqlSave(ctx context.Context) (*JoinLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JoinLinkCreate) createSpec() (*JoinLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JoinLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(joinlink.Table, sqlgraph.NewFieldSpec(joinlink.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(joinlink.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(joinlink.FieldEventID, field.TypeUint64, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.UserAddress(); ok {
+		_spec.SetField(joinlink.FieldUserAddress, field.TypeString, value)
+		_node.UserAddress = value
+	}
+	if value, ok := _c.mutation.ClickedAt(); ok {
+		_spec.SetField(joinlink.FieldClickedAt, field.TypeTime, value)
+		_node.ClickedAt = &value
+	}
+	if value, ok := _c.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(joinlink.FieldLastHeartbeatAt, field.TypeTime, value)
+		_node.LastHeartbeatAt = &value
+	}
+	if value, ok := _c.mutation.Qualified(); ok {
+		_spec.SetField(joinlink.FieldQualified, field.TypeBool, value)
+		_node.Qualified = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(joinlink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// JoinLinkCreateBulk is the builder for creating many JoinLink entities in bulk.
+type JoinLinkCreateBulk struct {
+	config
+	err      error
+	builders []*JoinLinkCreate
+}
+
+// Save creates the JoinLink entities in the database.
+func (_c *JoinLinkCreateBulk) Save(ctx context.Context) ([]*JoinLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JoinLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JoinLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JoinLinkCreateBulk) SaveX(ctx context.Context) []*JoinLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JoinLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JoinLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/joinlink"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JoinLinkUpdate is the builder for updating JoinLink entities.
+type JoinLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JoinLinkMutation
+}
+
+// Where appends a list predicates to the JoinLinkUpdate builder.
+func (_u *JoinLinkUpdate) Where(ps ...predicate.JoinLink) *JoinLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *JoinLinkUpdate) SetToken(v string) *JoinLinkUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *JoinLinkUpdate) SetNillableToken(v *string) *JoinLinkUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *JoinLinkUpdate) SetEventID(v uint64) *JoinLinkUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *JoinLinkUpdate) SetNillableEventID(v *uint64) *JoinLinkUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *JoinLinkUpdate) AddEventID(v int64) *JoinLinkUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_u *JoinLinkUpdate) SetUserAddress(v string) *JoinLinkUpdate {
+	_u.mutation.SetUserAddress(v)
+	return _u
+}
+
+// SetNillableUserAddress sets the "user_address" field if the given value is not nil.
+func (_u *JoinLinkUpdate) SetNillableUserAddress(v *string) *JoinLinkUpdate {
+	if v != nil {
+		_u.SetUserAddress(*v)
+	}
+	return _u
+}
+
+// SetClickedAt sets the "clicked_at" field.
+func (_u *JoinLinkUpdate) SetClickedAt(v time.Time) *JoinLinkUpdate {
+	_u.mutation.SetClickedAt(v)
+	return _u
+}
+
+// SetNillableClickedAt sets the "clicked_at" field if the given value is not nil.
+func (_u *JoinLinkUpdate) SetNillableClickedAt(v *time.Time) *JoinLinkUpdate {
+	if v != nil {
+		_u.SetClickedAt(*v)
+	}
+	return _u
+}
+
+// ClearClickedAt clears the value of the "clicked_at" field.
+func (_u *JoinLinkUpdate) ClearClickedAt() *JoinLinkUpdate {
+	_u.mutation.ClearClickedAt()
+	return _u
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_u *JoinLinkUpdate) SetLastHeartbeatAt(v time.Time) *JoinLinkUpdate {
+	_u.mutation.SetLastHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_u *JoinLinkUpdate) SetNillableLastHeartbeatAt(v *time.Time) *JoinLinkUpdate {
+	if v != nil {
+		_u.SetLastHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHeartbeatAt clears the value of the "last_heartbeat_at" field.
+func (_u *JoinLinkUpdate) ClearLastHeartbeatAt() *JoinLinkUpdate {
+	_u.mutation.ClearLastHeartbeatAt()
+	return _u
+}
+
+// SetQualified sets the "qualified" field.
+func (_u *JoinLinkUpdate) SetQualified(v bool) *JoinLinkUpdate {
+	_u.mutation.SetQualified(v)
+	return _u
+}
+
+// SetNillableQualified sets the "qualified" field if the given value is not nil.
+func (_u *JoinLinkUpdate) SetNillableQualified(v *bool) *JoinLinkUpdate {
+	if v != nil {
+		_u.SetQualified(*v)
+	}
+	return _u
+}
+
+// Mutation returns the JoinLinkMutation object of the builder.
+func (_u *JoinLinkUpdate) Mutation() *JoinLinkMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JoinLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JoinLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JoinLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JoinLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *JoinLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(joinlink.Table, joinlink.Columns, sqlgraph.NewFieldSpec(joinlink.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(joinlink.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(joinlink.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(joinlink.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.UserAddress(); ok {
+		_spec.SetField(joinlink.FieldUserAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClickedAt(); ok {
+		_spec.SetField(joinlink.FieldClickedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClickedAtCleared() {
+		_spec.ClearField(joinlink.FieldClickedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(joinlink.FieldLastHeartbeatAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHeartbeatAtCleared() {
+		_spec.ClearField(joinlink.FieldLastHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Qualified(); ok {
+		_spec.SetField(joinlink.FieldQualified, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{joinlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JoinLinkUpdateOne is the builder for updating a single JoinLink entity.
+type JoinLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JoinLinkMutation
+}
+
+// SetToken sets the "token" field.
+func (_u *JoinLinkUpdateOne) SetToken(v string) *JoinLinkUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *JoinLinkUpdateOne) SetNillableToken(v *string) *JoinLinkUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *JoinLinkUpdateOne) SetEventID(v uint64) *JoinLinkUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *JoinLinkUpdateOne) SetNillableEventID(v *uint64) *JoinLinkUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *JoinLinkUpdateOne) AddEventID(v int64) *JoinLinkUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_u *JoinLinkUpdateOne) SetUserAddress(v string) *JoinLinkUpdateOne {
+	_u.mutation.SetUserAddress(v)
+	return _u
+}
+
+// SetNillableUserAddress sets the "user_address" field if the given value is not nil.
+func (_u *JoinLinkUpdateOne) SetNillableUserAddress(v *string) *JoinLinkUpdateOne {
+	if v != nil {
+		_u.SetUserAddress(*v)
+	}
+	return _u
+}
+
+// SetClickedAt sets the "clicked_at" field.
+func (_u *JoinLinkUpdateOne) SetClickedAt(v time.Time) *JoinLinkUpdateOne {
+	_u.mutation.SetClickedAt(v)
+	return _u
+}
+
+// SetNillableClickedAt sets the "clicked_at" field if the given value is not nil.
+func (_u *JoinLinkUpdateOne) SetNillableClickedAt(v *time.Time) *JoinLinkUpdateOne {
+	if v != nil {
+		_u.SetClickedAt(*v)
+	}
+	return _u
+}
+
+// ClearClickedAt clears the value of the "clicked_at" field.
+func (_u *JoinLinkUpdateOne) ClearClickedAt() *JoinLinkUpdateOne {
+	_u.mutation.ClearClickedAt()
+	return _u
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_u *JoinLinkUpdateOne) SetLastHeartbeatAt(v time.Time) *JoinLinkUpdateOne {
+	_u.mutation.SetLastHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_u *JoinLinkUpdateOne) SetNillableLastHeartbeatAt(v *time.Time) *JoinLinkUpdateOne {
+	if v != nil {
+		_u.SetLastHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHeartbeatAt clears the value of the "last_heartbeat_at" field.
+func (_u *JoinLinkUpdateOne) ClearLastHeartbeatAt() *JoinLinkUpdateOne {
+	_u.mutation.ClearLastHeartbeatAt()
+	return _u
+}
+
+// SetQualified sets the "qualified" field.
+func (_u *JoinLinkUpdateOne) SetQualified(v bool) *JoinLinkUpdateOne {
+	_u.mutation.SetQualified(v)
+	return _u
+}
+
+// SetNillableQualified sets the "qualified" field if the given value is not nil.
+func (_u *JoinLinkUpdateOne) SetNillableQualified(v *bool) *JoinLinkUpdateOne {
+	if v != nil {
+		_u.SetQualified(*v)
+	}
+	return _u
+}
+
+// Mutation returns the JoinLinkMutation object of the builder.
+func (_u *JoinLinkUpdateOne) Mutation() *JoinLinkMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the JoinLinkUpdate builder.
+func (_u *JoinLinkUpdateOne) Where(ps ...predicate.JoinLink) *JoinLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JoinLinkUpdateOne) Select(field string, fields ...string) *JoinLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JoinLink entity.
+func (_u *JoinLinkUpdateOne) Save(ctx context.Context) (*JoinLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JoinLinkUpdateOne) SaveX(ctx context.Context) *JoinLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JoinLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JoinLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *JoinLinkUpdateOne) sqlSave(ctx context.Context) (_node *JoinLink, err error) {
+	_spec := sqlgraph.NewUpdateSpec(joinlink.Table, joinlink.Columns, sqlgraph.NewFieldSpec(joinlink.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JoinLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, joinlink.FieldID)
+		for _, f := range fields {
+			if !joinlink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != joinlink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(joinlink.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(joinlink.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(joinlink.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.UserAddress(); ok {
+		_spec.SetField(joinlink.FieldUserAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClickedAt(); ok {
+		_spec.SetField(joinlink.FieldClickedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClickedAtCleared() {
+		_spec.ClearField(joinlink.FieldClickedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(joinlink.FieldLastHeartbeatAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHeartbeatAtCleared() {
+		_spec.ClearField(joinlink.FieldLastHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Qualified(); ok {
+		_spec.SetField(joinlink.FieldQualified, field.TypeBool, value)
+	}
+	_node = &JoinLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{joinlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
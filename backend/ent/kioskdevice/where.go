@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kioskdevice
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLTE(FieldID, id))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldName, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldSecret, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldEventID, v))
+}
+
+// ProvisionedBy applies equality check predicate on the "provisioned_by" field. It's identical to ProvisionedByEQ.
+func ProvisionedBy(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldProvisionedBy, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldContainsFold(FieldName, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uint64) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLTE(FieldEventID, v))
+}
+
+// ProvisionedByEQ applies the EQ predicate on the "provisioned_by" field.
+func ProvisionedByEQ(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldProvisionedBy, v))
+}
+
+// ProvisionedByNEQ applies the NEQ predicate on the "provisioned_by" field.
+func ProvisionedByNEQ(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNEQ(FieldProvisionedBy, v))
+}
+
+// ProvisionedByIn applies the In predicate on the "provisioned_by" field.
+func ProvisionedByIn(vs ...string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIn(FieldProvisionedBy, vs...))
+}
+
+// ProvisionedByNotIn applies the NotIn predicate on the "provisioned_by" field.
+func ProvisionedByNotIn(vs ...string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotIn(FieldProvisionedBy, vs...))
+}
+
+// ProvisionedByGT applies the GT predicate on the "provisioned_by" field.
+func ProvisionedByGT(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGT(FieldProvisionedBy, v))
+}
+
+// ProvisionedByGTE applies the GTE predicate on the "provisioned_by" field.
+func ProvisionedByGTE(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGTE(FieldProvisionedBy, v))
+}
+
+// ProvisionedByLT applies the LT predicate on the "provisioned_by" field.
+func ProvisionedByLT(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLT(FieldProvisionedBy, v))
+}
+
+// ProvisionedByLTE applies the LTE predicate on the "provisioned_by" field.
+func ProvisionedByLTE(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLTE(FieldProvisionedBy, v))
+}
+
+// ProvisionedByContains applies the Contains predicate on the "provisioned_by" field.
+func ProvisionedByContains(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldContains(FieldProvisionedBy, v))
+}
+
+// ProvisionedByHasPrefix applies the HasPrefix predicate on the "provisioned_by" field.
+func ProvisionedByHasPrefix(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldHasPrefix(FieldProvisionedBy, v))
+}
+
+// ProvisionedByHasSuffix applies the HasSuffix predicate on the "provisioned_by" field.
+func ProvisionedByHasSuffix(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldHasSuffix(FieldProvisionedBy, v))
+}
+
+// ProvisionedByEqualFold applies the EqualFold predicate on the "provisioned_by" field.
+func ProvisionedByEqualFold(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEqualFold(FieldProvisionedBy, v))
+}
+
+// ProvisionedByContainsFold applies the ContainsFold predicate on the "provisioned_by" field.
+func ProvisionedByContainsFold(v string) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldContainsFold(FieldProvisionedBy, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KioskDevice) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KioskDevice) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KioskDevice) predicate.KioskDevice {
+	return predicate.KioskDevice(sql.NotPredicates(p))
+}
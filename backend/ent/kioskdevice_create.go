@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/kioskdevice"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KioskDeviceCreate is the builder for creating a KioskDevice entity.
+type KioskDeviceCreate struct {
+	config
+	mutation *KioskDeviceMutation
+	hooks    []Hook
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *KioskDeviceCreate) SetDeviceID(v string) *KioskDeviceCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *KioskDeviceCreate) SetName(v string) *KioskDeviceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *KioskDeviceCreate) SetNillableName(v *string) *KioskDeviceCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *KioskDeviceCreate) SetSecret(v string) *KioskDeviceCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *KioskDeviceCreate) SetEventID(v uint64) *KioskDeviceCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetProvisionedBy sets the "provisioned_by" field.
+func (_c *KioskDeviceCreate) SetProvisionedBy(v string) *KioskDeviceCreate {
+	_c.mutation.SetProvisionedBy(v)
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *KioskDeviceCreate) SetRevokedAt(v time.Time) *KioskDeviceCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *KioskDeviceCreate) SetNillableRevokedAt(v *time.Time) *KioskDeviceCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *KioskDeviceCreate) SetCreatedAt(v time.Time) *KioskDeviceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *KioskDeviceCreate) SetNillableCreatedAt(v *time.Time) *KioskDeviceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the KioskDeviceMutation object of the builder.
+func (_c *KioskDeviceCreate) Mutation() *KioskDeviceMutation {
+	return _c.mutation
+}
+
+// Save creates the KioskDevice in the database.
+func (_c *KioskDeviceCreate) Save(ctx context.Context) (*KioskDevice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *KioskDeviceCreate) SaveX(ctx context.Context) *KioskDevice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KioskDeviceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KioskDeviceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *KioskDeviceCreate) defaults() {
+	if _, ok := _c.mutation.Name(); !ok {
+		v := kioskdevice.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := kioskdevice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *KioskDeviceCreate) check() error {
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "KioskDevice.device_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "KioskDevice.name"`)}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "KioskDevice.secret"`)}
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "KioskDevice.event_id"`)}
+	}
+	if _, ok := _c.mutation.ProvisionedBy(); !ok {
+		return &ValidationError{Name: "provisioned_by", err: errors.New(`ent: missing required field "KioskDevice.provisioned_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KioskDevice.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *KioskDeviceCreate) sqlSave(ctx context.Context) (*KioskDevice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *KioskDeviceCreate) createSpec() (*KioskDevice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KioskDevice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(kioskdevice.Table, sqlgraph.NewFieldSpec(kioskdevice.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(kioskdevice.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(kioskdevice.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(kioskdevice.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(kioskdevice.FieldEventID, field.TypeUint64, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.ProvisionedBy(); ok {
+		_spec.SetField(kioskdevice.FieldProvisionedBy, field.TypeString, value)
+		_node.ProvisionedBy = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(kioskdevice.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(kioskdevice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// KioskDeviceCreateBulk is the builder for creating many KioskDevice entities in bulk.
+type KioskDeviceCreateBulk struct {
+	config
+	err      error
+	builders []*KioskDeviceCreate
+}
+
+// Save creates the KioskDevice entities in the database.
+func (_c *KioskDeviceCreateBulk) Save(ctx context.Context) ([]*KioskDevice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*KioskDevice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KioskDeviceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *KioskDeviceCreateBulk) SaveX(ctx context.Context) []*KioskDevice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KioskDeviceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KioskDeviceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
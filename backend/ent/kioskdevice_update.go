@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/kioskdevice"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KioskDeviceUpdate is the builder for updating KioskDevice entities.
+type KioskDeviceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KioskDeviceMutation
+}
+
+// Where appends a list predicates to the KioskDeviceUpdate builder.
+func (_u *KioskDeviceUpdate) Where(ps ...predicate.KioskDevice) *KioskDeviceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *KioskDeviceUpdate) SetName(v string) *KioskDeviceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *KioskDeviceUpdate) SetNillableName(v *string) *KioskDeviceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *KioskDeviceUpdate) SetSecret(v string) *KioskDeviceUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *KioskDeviceUpdate) SetNillableSecret(v *string) *KioskDeviceUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *KioskDeviceUpdate) SetEventID(v uint64) *KioskDeviceUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *KioskDeviceUpdate) SetNillableEventID(v *uint64) *KioskDeviceUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *KioskDeviceUpdate) AddEventID(v int64) *KioskDeviceUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetProvisionedBy sets the "provisioned_by" field.
+func (_u *KioskDeviceUpdate) SetProvisionedBy(v string) *KioskDeviceUpdate {
+	_u.mutation.SetProvisionedBy(v)
+	return _u
+}
+
+// SetNillableProvisionedBy sets the "provisioned_by" field if the given value is not nil.
+func (_u *KioskDeviceUpdate) SetNillableProvisionedBy(v *string) *KioskDeviceUpdate {
+	if v != nil {
+		_u.SetProvisionedBy(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *KioskDeviceUpdate) SetRevokedAt(v time.Time) *KioskDeviceUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *KioskDeviceUpdate) SetNillableRevokedAt(v *time.Time) *KioskDeviceUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *KioskDeviceUpdate) ClearRevokedAt() *KioskDeviceUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the KioskDeviceMutation object of the builder.
+func (_u *KioskDeviceUpdate) Mutation() *KioskDeviceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *KioskDeviceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KioskDeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *KioskDeviceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KioskDeviceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *KioskDeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(kioskdevice.Table, kioskdevice.Columns, sqlgraph.NewFieldSpec(kioskdevice.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(kioskdevice.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(kioskdevice.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(kioskdevice.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(kioskdevice.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.ProvisionedBy(); ok {
+		_spec.SetField(kioskdevice.FieldProvisionedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(kioskdevice.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(kioskdevice.FieldRevokedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kioskdevice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// KioskDeviceUpdateOne is the builder for updating a single KioskDevice entity.
+type KioskDeviceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KioskDeviceMutation
+}
+
+// SetName sets the "name" field.
+func (_u *KioskDeviceUpdateOne) SetName(v string) *KioskDeviceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *KioskDeviceUpdateOne) SetNillableName(v *string) *KioskDeviceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *KioskDeviceUpdateOne) SetSecret(v string) *KioskDeviceUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *KioskDeviceUpdateOne) SetNillableSecret(v *string) *KioskDeviceUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *KioskDeviceUpdateOne) SetEventID(v uint64) *KioskDeviceUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *KioskDeviceUpdateOne) SetNillableEventID(v *uint64) *KioskDeviceUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *KioskDeviceUpdateOne) AddEventID(v int64) *KioskDeviceUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetProvisionedBy sets the "provisioned_by" field.
+func (_u *KioskDeviceUpdateOne) SetProvisionedBy(v string) *KioskDeviceUpdateOne {
+	_u.mutation.SetProvisionedBy(v)
+	return _u
+}
+
+// SetNillableProvisionedBy sets the "provisioned_by" field if the given value is not nil.
+func (_u *KioskDeviceUpdateOne) SetNillableProvisionedBy(v *string) *KioskDeviceUpdateOne {
+	if v != nil {
+		_u.SetProvisionedBy(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *KioskDeviceUpdateOne) SetRevokedAt(v time.Time) *KioskDeviceUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *KioskDeviceUpdateOne) SetNillableRevokedAt(v *time.Time) *KioskDeviceUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *KioskDeviceUpdateOne) ClearRevokedAt() *KioskDeviceUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the KioskDeviceMutation object of the builder.
+func (_u *KioskDeviceUpdateOne) Mutation() *KioskDeviceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the KioskDeviceUpdate builder.
+func (_u *KioskDeviceUpdateOne) Where(ps ...predicate.KioskDevice) *KioskDeviceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *KioskDeviceUpdateOne) Select(field string, fields ...string) *KioskDeviceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated KioskDevice entity.
+func (_u *KioskDeviceUpdateOne) Save(ctx context.Context) (*KioskDevice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KioskDeviceUpdateOne) SaveX(ctx context.Context) *KioskDevice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *KioskDeviceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KioskDeviceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *KioskDeviceUpdateOne) sqlSave(ctx context.Context) (_node *KioskDevice, err error) {
+	_spec := sqlgraph.NewUpdateSpec(kioskdevice.Table, kioskdevice.Columns, sqlgraph.NewFieldSpec(kioskdevice.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KioskDevice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kioskdevice.FieldID)
+		for _, f := range fields {
+			if !kioskdevice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != kioskdevice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(kioskdevice.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(kioskdevice.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(kioskdevice.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(kioskdevice.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.ProvisionedBy(); ok {
+		_spec.SetField(kioskdevice.FieldProvisionedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(kioskdevice.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(kioskdevice.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &KioskDevice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kioskdevice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/locationfix"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LocationFix is the model entity for the LocationFix schema.
+type LocationFix struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID uint64 `json:"event_id,omitempty"`
+	// UserAddress holds the value of the "user_address" field.
+	UserAddress string `json:"user_address,omitempty"`
+	// Lat holds the value of the "lat" field.
+	Lat float64 `json:"lat,omitempty"`
+	// Long holds the value of the "long" field.
+	Long float64 `json:"long,omitempty"`
+	// AccuracyM holds the value of the "accuracy_m" field.
+	AccuracyM float64 `json:"accuracy_m,omitempty"`
+	// ReportedAt holds the value of the "reported_at" field.
+	ReportedAt time.Time `json:"reported_at,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// Accepted holds the value of the "accepted" field.
+	Accepted bool `json:"accepted,omitempty"`
+	// RejectReason holds the value of the "reject_reason" field.
+	RejectReason string `json:"reject_reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LocationFix) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case locationfix.FieldAccepted:
+			values[i] = new(sql.NullBool)
+		case locationfix.FieldLat, locationfix.FieldLong, locationfix.FieldAccuracyM:
+			values[i] = new(sql.NullFloat64)
+		case locationfix.FieldID, locationfix.FieldEventID:
+			values[i] = new(sql.NullInt64)
+		case locationfix.FieldUserAddress, locationfix.FieldRejectReason:
+			values[i] = new(sql.NullString)
+		case locationfix.FieldReportedAt, locationfix.FieldReceivedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LocationFix fields.
+func (_m *LocationFix) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case locationfix.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case locationfix.FieldEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = uint64(value.Int64)
+			}
+		case locationfix.FieldUserAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_address", values[i])
+			} else if value.Valid {
+				_m.UserAddress = value.String
+			}
+		case locationfix.FieldLat:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field lat", values[i])
+			} else if value.Valid {
+				_m.Lat = value.Float64
+			}
+		case locationfix.FieldLong:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field long", values[i])
+			} else if value.Valid {
+				_m.Long = value.Float64
+			}
+		case locationfix.FieldAccuracyM:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field accuracy_m", values[i])
+			} else if value.Valid {
+				_m.AccuracyM = value.Float64
+			}
+		case locationfix.FieldReportedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reported_at", values[i])
+			} else if value.Valid {
+				_m.ReportedAt = value.Time
+			}
+		case locationfix.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = value.Time
+			}
+		case locationfix.FieldAccepted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted", values[i])
+			} else if value.Valid {
+				_m.Accepted = value.Bool
+			}
+		case locationfix.FieldRejectReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reject_reason", values[i])
+			} else if value.Valid {
+				_m.RejectReason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LocationFix.
+// This includes values selected through modifiers, order, etc.
+func (_m *LocationFix) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LocationFix.
+// Note that you need to call LocationFix.Unwrap() before calling this method if this LocationFix
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LocationFix) Update() *LocationFixUpdateOne {
+	return NewLocationFixClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LocationFix entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LocationFix) Unwrap() *LocationFix {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LocationFix is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LocationFix) String() string {
+	var builder strings.Builder
+	builder.WriteString("LocationFix(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("user_address=")
+	builder.WriteString(_m.UserAddress)
+	builder.WriteString(", ")
+	builder.WriteString("lat=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Lat))
+	builder.WriteString(", ")
+	builder.WriteString("long=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Long))
+	builder.WriteString(", ")
+	builder.WriteString("accuracy_m=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccuracyM))
+	builder.WriteString(", ")
+	builder.WriteString("reported_at=")
+	builder.WriteString(_m.ReportedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(_m.ReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("accepted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Accepted))
+	builder.WriteString(", ")
+	builder.WriteString("reject_reason=")
+	builder.WriteString(_m.RejectReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LocationFixes is a parsable slice of LocationFix.
+type LocationFixes []*LocationFix
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package locationfix
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the locationfix type in the database.
+	Label = "location_fix"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldUserAddress holds the string denoting the user_address field in the database.
+	FieldUserAddress = "user_address"
+	// FieldLat holds the string denoting the lat field in the database.
+	FieldLat = "lat"
+	// FieldLong holds the string denoting the long field in the database.
+	FieldLong = "long"
+	// FieldAccuracyM holds the string denoting the accuracy_m field in the database.
+	FieldAccuracyM = "accuracy_m"
+	// FieldReportedAt holds the string denoting the reported_at field in the database.
+	FieldReportedAt = "reported_at"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldAccepted holds the string denoting the accepted field in the database.
+	FieldAccepted = "accepted"
+	// FieldRejectReason holds the string denoting the reject_reason field in the database.
+	FieldRejectReason = "reject_reason"
+	// Table holds the table name of the locationfix in the database.
+	Table = "location_fixes"
+)
+
+// Columns holds all SQL columns for locationfix fields.
+var Columns = []string{
+	FieldID,
+	FieldEventID,
+	FieldUserAddress,
+	FieldLat,
+	FieldLong,
+	FieldAccuracyM,
+	FieldReportedAt,
+	FieldReceivedAt,
+	FieldAccepted,
+	FieldRejectReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// DefaultAccepted holds the default value on creation for the "accepted" field.
+	DefaultAccepted bool
+)
+
+// OrderOption defines the ordering options for the LocationFix queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByUserAddress orders the results by the user_address field.
+func ByUserAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAddress, opts...).ToFunc()
+}
+
+// ByLat orders the results by the lat field.
+func ByLat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLat, opts...).ToFunc()
+}
+
+// ByLong orders the results by the long field.
+func ByLong(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLong, opts...).ToFunc()
+}
+
+// ByAccuracyM orders the results by the accuracy_m field.
+func ByAccuracyM(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccuracyM, opts...).ToFunc()
+}
+
+// ByReportedAt orders the results by the reported_at field.
+func ByReportedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportedAt, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByAccepted orders the results by the accepted field.
+func ByAccepted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccepted, opts...).ToFunc()
+}
+
+// ByRejectReason orders the results by the reject_reason field.
+func ByRejectReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectReason, opts...).ToFunc()
+}
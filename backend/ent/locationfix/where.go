@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package locationfix
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldID, id))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldEventID, v))
+}
+
+// UserAddress applies equality check predicate on the "user_address" field. It's identical to UserAddressEQ.
+func UserAddress(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldUserAddress, v))
+}
+
+// Lat applies equality check predicate on the "lat" field. It's identical to LatEQ.
+func Lat(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldLat, v))
+}
+
+// Long applies equality check predicate on the "long" field. It's identical to LongEQ.
+func Long(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldLong, v))
+}
+
+// AccuracyM applies equality check predicate on the "accuracy_m" field. It's identical to AccuracyMEQ.
+func AccuracyM(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldAccuracyM, v))
+}
+
+// ReportedAt applies equality check predicate on the "reported_at" field. It's identical to ReportedAtEQ.
+func ReportedAt(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldReportedAt, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// Accepted applies equality check predicate on the "accepted" field. It's identical to AcceptedEQ.
+func Accepted(v bool) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldAccepted, v))
+}
+
+// RejectReason applies equality check predicate on the "reject_reason" field. It's identical to RejectReasonEQ.
+func RejectReason(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldRejectReason, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uint64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldEventID, v))
+}
+
+// UserAddressEQ applies the EQ predicate on the "user_address" field.
+func UserAddressEQ(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldUserAddress, v))
+}
+
+// UserAddressNEQ applies the NEQ predicate on the "user_address" field.
+func UserAddressNEQ(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldUserAddress, v))
+}
+
+// UserAddressIn applies the In predicate on the "user_address" field.
+func UserAddressIn(vs ...string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldUserAddress, vs...))
+}
+
+// UserAddressNotIn applies the NotIn predicate on the "user_address" field.
+func UserAddressNotIn(vs ...string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldUserAddress, vs...))
+}
+
+// UserAddressGT applies the GT predicate on the "user_address" field.
+func UserAddressGT(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldUserAddress, v))
+}
+
+// UserAddressGTE applies the GTE predicate on the "user_address" field.
+func UserAddressGTE(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldUserAddress, v))
+}
+
+// UserAddressLT applies the LT predicate on the "user_address" field.
+func UserAddressLT(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldUserAddress, v))
+}
+
+// UserAddressLTE applies the LTE predicate on the "user_address" field.
+func UserAddressLTE(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldUserAddress, v))
+}
+
+// UserAddressContains applies the Contains predicate on the "user_address" field.
+func UserAddressContains(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldContains(FieldUserAddress, v))
+}
+
+// UserAddressHasPrefix applies the HasPrefix predicate on the "user_address" field.
+func UserAddressHasPrefix(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldHasPrefix(FieldUserAddress, v))
+}
+
+// UserAddressHasSuffix applies the HasSuffix predicate on the "user_address" field.
+func UserAddressHasSuffix(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldHasSuffix(FieldUserAddress, v))
+}
+
+// UserAddressEqualFold applies the EqualFold predicate on the "user_address" field.
+func UserAddressEqualFold(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEqualFold(FieldUserAddress, v))
+}
+
+// UserAddressContainsFold applies the ContainsFold predicate on the "user_address" field.
+func UserAddressContainsFold(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldContainsFold(FieldUserAddress, v))
+}
+
+// LatEQ applies the EQ predicate on the "lat" field.
+func LatEQ(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldLat, v))
+}
+
+// LatNEQ applies the NEQ predicate on the "lat" field.
+func LatNEQ(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldLat, v))
+}
+
+// LatIn applies the In predicate on the "lat" field.
+func LatIn(vs ...float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldLat, vs...))
+}
+
+// LatNotIn applies the NotIn predicate on the "lat" field.
+func LatNotIn(vs ...float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldLat, vs...))
+}
+
+// LatGT applies the GT predicate on the "lat" field.
+func LatGT(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldLat, v))
+}
+
+// LatGTE applies the GTE predicate on the "lat" field.
+func LatGTE(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldLat, v))
+}
+
+// LatLT applies the LT predicate on the "lat" field.
+func LatLT(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldLat, v))
+}
+
+// LatLTE applies the LTE predicate on the "lat" field.
+func LatLTE(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldLat, v))
+}
+
+// LongEQ applies the EQ predicate on the "long" field.
+func LongEQ(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldLong, v))
+}
+
+// LongNEQ applies the NEQ predicate on the "long" field.
+func LongNEQ(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldLong, v))
+}
+
+// LongIn applies the In predicate on the "long" field.
+func LongIn(vs ...float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldLong, vs...))
+}
+
+// LongNotIn applies the NotIn predicate on the "long" field.
+func LongNotIn(vs ...float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldLong, vs...))
+}
+
+// LongGT applies the GT predicate on the "long" field.
+func LongGT(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldLong, v))
+}
+
+// LongGTE applies the GTE predicate on the "long" field.
+func LongGTE(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldLong, v))
+}
+
+// LongLT applies the LT predicate on the "long" field.
+func LongLT(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldLong, v))
+}
+
+// LongLTE applies the LTE predicate on the "long" field.
+func LongLTE(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldLong, v))
+}
+
+// AccuracyMEQ applies the EQ predicate on the "accuracy_m" field.
+func AccuracyMEQ(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldAccuracyM, v))
+}
+
+// AccuracyMNEQ applies the NEQ predicate on the "accuracy_m" field.
+func AccuracyMNEQ(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldAccuracyM, v))
+}
+
+// AccuracyMIn applies the In predicate on the "accuracy_m" field.
+func AccuracyMIn(vs ...float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldAccuracyM, vs...))
+}
+
+// AccuracyMNotIn applies the NotIn predicate on the "accuracy_m" field.
+func AccuracyMNotIn(vs ...float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldAccuracyM, vs...))
+}
+
+// AccuracyMGT applies the GT predicate on the "accuracy_m" field.
+func AccuracyMGT(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldAccuracyM, v))
+}
+
+// AccuracyMGTE applies the GTE predicate on the "accuracy_m" field.
+func AccuracyMGTE(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldAccuracyM, v))
+}
+
+// AccuracyMLT applies the LT predicate on the "accuracy_m" field.
+func AccuracyMLT(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldAccuracyM, v))
+}
+
+// AccuracyMLTE applies the LTE predicate on the "accuracy_m" field.
+func AccuracyMLTE(v float64) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldAccuracyM, v))
+}
+
+// ReportedAtEQ applies the EQ predicate on the "reported_at" field.
+func ReportedAtEQ(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldReportedAt, v))
+}
+
+// ReportedAtNEQ applies the NEQ predicate on the "reported_at" field.
+func ReportedAtNEQ(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldReportedAt, v))
+}
+
+// ReportedAtIn applies the In predicate on the "reported_at" field.
+func ReportedAtIn(vs ...time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldReportedAt, vs...))
+}
+
+// ReportedAtNotIn applies the NotIn predicate on the "reported_at" field.
+func ReportedAtNotIn(vs ...time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldReportedAt, vs...))
+}
+
+// ReportedAtGT applies the GT predicate on the "reported_at" field.
+func ReportedAtGT(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldReportedAt, v))
+}
+
+// ReportedAtGTE applies the GTE predicate on the "reported_at" field.
+func ReportedAtGTE(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldReportedAt, v))
+}
+
+// ReportedAtLT applies the LT predicate on the "reported_at" field.
+func ReportedAtLT(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldReportedAt, v))
+}
+
+// ReportedAtLTE applies the LTE predicate on the "reported_at" field.
+func ReportedAtLTE(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldReportedAt, v))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// AcceptedEQ applies the EQ predicate on the "accepted" field.
+func AcceptedEQ(v bool) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldAccepted, v))
+}
+
+// AcceptedNEQ applies the NEQ predicate on the "accepted" field.
+func AcceptedNEQ(v bool) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldAccepted, v))
+}
+
+// RejectReasonEQ applies the EQ predicate on the "reject_reason" field.
+func RejectReasonEQ(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEQ(FieldRejectReason, v))
+}
+
+// RejectReasonNEQ applies the NEQ predicate on the "reject_reason" field.
+func RejectReasonNEQ(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNEQ(FieldRejectReason, v))
+}
+
+// RejectReasonIn applies the In predicate on the "reject_reason" field.
+func RejectReasonIn(vs ...string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIn(FieldRejectReason, vs...))
+}
+
+// RejectReasonNotIn applies the NotIn predicate on the "reject_reason" field.
+func RejectReasonNotIn(vs ...string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotIn(FieldRejectReason, vs...))
+}
+
+// RejectReasonGT applies the GT predicate on the "reject_reason" field.
+func RejectReasonGT(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGT(FieldRejectReason, v))
+}
+
+// RejectReasonGTE applies the GTE predicate on the "reject_reason" field.
+func RejectReasonGTE(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldGTE(FieldRejectReason, v))
+}
+
+// RejectReasonLT applies the LT predicate on the "reject_reason" field.
+func RejectReasonLT(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLT(FieldRejectReason, v))
+}
+
+// RejectReasonLTE applies the LTE predicate on the "reject_reason" field.
+func RejectReasonLTE(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldLTE(FieldRejectReason, v))
+}
+
+// RejectReasonContains applies the Contains predicate on the "reject_reason" field.
+func RejectReasonContains(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldContains(FieldRejectReason, v))
+}
+
+// RejectReasonHasPrefix applies the HasPrefix predicate on the "reject_reason" field.
+func RejectReasonHasPrefix(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldHasPrefix(FieldRejectReason, v))
+}
+
+// RejectReasonHasSuffix applies the HasSuffix predicate on the "reject_reason" field.
+func RejectReasonHasSuffix(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldHasSuffix(FieldRejectReason, v))
+}
+
+// RejectReasonIsNil applies the IsNil predicate on the "reject_reason" field.
+func RejectReasonIsNil() predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldIsNull(FieldRejectReason))
+}
+
+// RejectReasonNotNil applies the NotNil predicate on the "reject_reason" field.
+func RejectReasonNotNil() predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldNotNull(FieldRejectReason))
+}
+
+// RejectReasonEqualFold applies the EqualFold predicate on the "reject_reason" field.
+func RejectReasonEqualFold(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldEqualFold(FieldRejectReason, v))
+}
+
+// RejectReasonContainsFold applies the ContainsFold predicate on the "reject_reason" field.
+func RejectReasonContainsFold(v string) predicate.LocationFix {
+	return predicate.LocationFix(sql.FieldContainsFold(FieldRejectReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LocationFix) predicate.LocationFix {
+	return predicate.LocationFix(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LocationFix) predicate.LocationFix {
+	return predicate.LocationFix(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LocationFix) predicate.LocationFix {
+	return predicate.LocationFix(sql.NotPredicates(p))
+}
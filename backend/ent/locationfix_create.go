@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/locationfix"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationFixCreate is the builder for creating a LocationFix entity.
+type LocationFixCreate struct {
+	config
+	mutation *LocationFixMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *LocationFixCreate) SetEventID(v uint64) *LocationFixCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_c *LocationFixCreate) SetUserAddress(v string) *LocationFixCreate {
+	_c.mutation.SetUserAddress(v)
+	return _c
+}
+
+// SetLat sets the "lat" field.
+func (_c *LocationFixCreate) SetLat(v float64) *LocationFixCreate {
+	_c.mutation.SetLat(v)
+	return _c
+}
+
+// SetLong sets the "long" field.
+func (_c *LocationFixCreate) SetLong(v float64) *LocationFixCreate {
+	_c.mutation.SetLong(v)
+	return _c
+}
+
+// SetAccuracyM sets the "accuracy_m" field.
+func (_c *LocationFixCreate) SetAccuracyM(v float64) *LocationFixCreate {
+	_c.mutation.SetAccuracyM(v)
+	return _c
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_c *LocationFixCreate) SetReportedAt(v time.Time) *LocationFixCreate {
+	_c.mutation.SetReportedAt(v)
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *LocationFixCreate) SetReceivedAt(v time.Time) *LocationFixCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *LocationFixCreate) SetNillableReceivedAt(v *time.Time) *LocationFixCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetAccepted sets the "accepted" field.
+func (_c *LocationFixCreate) SetAccepted(v bool) *LocationFixCreate {
+	_c.mutation.SetAccepted(v)
+	return _c
+}
+
+// SetNillableAccepted sets the "accepted" field if the given value is not nil.
+func (_c *LocationFixCreate) SetNillableAccepted(v *bool) *LocationFixCreate {
+	if v != nil {
+		_c.SetAccepted(*v)
+	}
+	return _c
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (_c *LocationFixCreate) SetRejectReason(v string) *LocationFixCreate {
+	_c.mutation.SetRejectReason(v)
+	return _c
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (_c *LocationFixCreate) SetNillableRejectReason(v *string) *LocationFixCreate {
+	if v != nil {
+		_c.SetRejectReason(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LocationFixMutation object of the builder.
+func (_c *LocationFixCreate) Mutation() *LocationFixMutation {
+	return _c.mutation
+}
+
+// Save creates the LocationFix in the database.
+func (_c *LocationFixCreate) Save(ctx context.Context) (*LocationFix, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LocationFixCreate) SaveX(ctx context.Context) *LocationFix {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LocationFixCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LocationFixCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LocationFixCreate) defaults() {
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		v := locationfix.DefaultReceivedAt()
+		_c.mutation.SetReceivedAt(v)
+	}
+	if _, ok := _c.mutation.Accepted(); !ok {
+		v := locationfix.DefaultAccepted
+		_c.mutation.SetAccepted(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LocationFixCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "LocationFix.event_id"`)}
+	}
+	if _, ok := _c.mutation.UserAddress(); !ok {
+		return &ValidationError{Name: "user_address", err: errors.New(`ent: missing required field "LocationFix.user_address"`)}
+	}
+	if _, ok := _c.mutation.Lat(); !ok {
+		return &ValidationError{Name: "lat", err: errors.New(`ent: missing required field "LocationFix.lat"`)}
+	}
+	if _, ok := _c.mutation.Long(); !ok {
+		return &ValidationError{Name: "long", err: errors.New(`ent: missing required field "LocationFix.long"`)}
+	}
+	if _, ok := _c.mutation.AccuracyM(); !ok {
+		return &ValidationError{Name: "accuracy_m", err: errors.New(`ent: missing required field "LocationFix.accuracy_m"`)}
+	}
+	if _, ok := _c.mutation.ReportedAt(); !ok {
+		return &ValidationError{Name: "reported_at", err: errors.New(`ent: missing required field "LocationFix.reported_at"`)}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "LocationFix.received_at"`)}
+	}
+	if _, ok := _c.mutation.Accepted(); !ok {
+		return &ValidationError{Name: "accepted", err: errors.New(`ent: missing required field "LocationFix.accepted"`)}
+	}
+	return nil
+}
+
+func (_c *LocationFixCreate) sqlSave(ctx context.Context) (*LocationFix, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LocationFixCreate) createSpec() (*LocationFix, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LocationFix{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(locationfix.Table, sqlgraph.NewFieldSpec(locationfix.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(locationfix.FieldEventID, field.TypeUint64, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.UserAddress(); ok {
+		_spec.SetField(locationfix.FieldUserAddress, field.TypeString, value)
+		_node.UserAddress = value
+	}
+	if value, ok := _c.mutation.Lat(); ok {
+		_spec.SetField(locationfix.FieldLat, field.TypeFloat64, value)
+		_node.Lat = value
+	}
+	if value, ok := _c.mutation.Long(); ok {
+		_spec.SetField(locationfix.FieldLong, field.TypeFloat64, value)
+		_node.Long = value
+	}
+	if value, ok := _c.mutation.AccuracyM(); ok {
+		_spec.SetField(locationfix.FieldAccuracyM, field.TypeFloat64, value)
+		_node.AccuracyM = value
+	}
+	if value, ok := _c.mutation.ReportedAt(); ok {
+		_spec.SetField(locationfix.FieldReportedAt, field.TypeTime, value)
+		_node.ReportedAt = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(locationfix.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := _c.mutation.Accepted(); ok {
+		_spec.SetField(locationfix.FieldAccepted, field.TypeBool, value)
+		_node.Accepted = value
+	}
+	if value, ok := _c.mutation.RejectReason(); ok {
+		_spec.SetField(locationfix.FieldRejectReason, field.TypeString, value)
+		_node.RejectReason = value
+	}
+	return _node, _spec
+}
+
+// LocationFixCreateBulk is the builder for creating many LocationFix entities in bulk.
+type LocationFixCreateBulk struct {
+	config
+	err      error
+	builders []*LocationFixCreate
+}
+
+// Save creates the LocationFix entities in the database.
+func (_c *LocationFixCreateBulk) Save(ctx context.Context) ([]*LocationFix, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LocationFix, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LocationFixMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LocationFixCreateBulk) SaveX(ctx context.Context) []*LocationFix {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LocationFixCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LocationFixCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
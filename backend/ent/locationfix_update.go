@@ -0,0 +1,546 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/locationfix"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationFixUpdate is the builder for updating LocationFix entities.
+type LocationFixUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LocationFixMutation
+}
+
+// Where appends a list predicates to the LocationFixUpdate builder.
+func (_u *LocationFixUpdate) Where(ps ...predicate.LocationFix) *LocationFixUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *LocationFixUpdate) SetEventID(v uint64) *LocationFixUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *LocationFixUpdate) SetNillableEventID(v *uint64) *LocationFixUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *LocationFixUpdate) AddEventID(v int64) *LocationFixUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_u *LocationFixUpdate) SetUserAddress(v string) *LocationFixUpdate {
+	_u.mutation.SetUserAddress(v)
+	return _u
+}
+
+// SetNillableUserAddress sets the "user_address" field if the given value is not nil.
+func (_u *LocationFixUpdate) SetNillableUserAddress(v *string) *LocationFixUpdate {
+	if v != nil {
+		_u.SetUserAddress(*v)
+	}
+	return _u
+}
+
+// SetLat sets the "lat" field.
+func (_u *LocationFixUpdate) SetLat(v float64) *LocationFixUpdate {
+	_u.mutation.ResetLat()
+	_u.mutation.SetLat(v)
+	return _u
+}
+
+// SetNillableLat sets the "lat" field if the given value is not nil.
+func (_u *LocationFixUpdate) SetNillableLat(v *float64) *LocationFixUpdate {
+	if v != nil {
+		_u.SetLat(*v)
+	}
+	return _u
+}
+
+// AddLat adds value to the "lat" field.
+func (_u *LocationFixUpdate) AddLat(v float64) *LocationFixUpdate {
+	_u.mutation.AddLat(v)
+	return _u
+}
+
+// SetLong sets the "long" field.
+func (_u *LocationFixUpdate) SetLong(v float64) *LocationFixUpdate {
+	_u.mutation.ResetLong()
+	_u.mutation.SetLong(v)
+	return _u
+}
+
+// SetNillableLong sets the "long" field if the given value is not nil.
+func (_u *LocationFixUpdate) SetNillableLong(v *float64) *LocationFixUpdate {
+	if v != nil {
+		_u.SetLong(*v)
+	}
+	return _u
+}
+
+// AddLong adds value to the "long" field.
+func (_u *LocationFixUpdate) AddLong(v float64) *LocationFixUpdate {
+	_u.mutation.AddLong(v)
+	return _u
+}
+
+// SetAccuracyM sets the "accuracy_m" field.
+func (_u *LocationFixUpdate) SetAccuracyM(v float64) *LocationFixUpdate {
+	_u.mutation.ResetAccuracyM()
+	_u.mutation.SetAccuracyM(v)
+	return _u
+}
+
+// SetNillableAccuracyM sets the "accuracy_m" field if the given value is not nil.
+func (_u *LocationFixUpdate) SetNillableAccuracyM(v *float64) *LocationFixUpdate {
+	if v != nil {
+		_u.SetAccuracyM(*v)
+	}
+	return _u
+}
+
+// AddAccuracyM adds value to the "accuracy_m" field.
+func (_u *LocationFixUpdate) AddAccuracyM(v float64) *LocationFixUpdate {
+	_u.mutation.AddAccuracyM(v)
+	return _u
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_u *LocationFixUpdate) SetReportedAt(v time.Time) *LocationFixUpdate {
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *LocationFixUpdate) SetNillableReportedAt(v *time.Time) *LocationFixUpdate {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// SetAccepted sets the "accepted" field.
+func (_u *LocationFixUpdate) SetAccepted(v bool) *LocationFixUpdate {
+	_u.mutation.SetAccepted(v)
+	return _u
+}
+
+// SetNillableAccepted sets the "accepted" field if the given value is not nil.
+func (_u *LocationFixUpdate) SetNillableAccepted(v *bool) *LocationFixUpdate {
+	if v != nil {
+		_u.SetAccepted(*v)
+	}
+	return _u
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (_u *LocationFixUpdate) SetRejectReason(v string) *LocationFixUpdate {
+	_u.mutation.SetRejectReason(v)
+	return _u
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (_u *LocationFixUpdate) SetNillableRejectReason(v *string) *LocationFixUpdate {
+	if v != nil {
+		_u.SetRejectReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectReason clears the value of the "reject_reason" field.
+func (_u *LocationFixUpdate) ClearRejectReason() *LocationFixUpdate {
+	_u.mutation.ClearRejectReason()
+	return _u
+}
+
+// Mutation returns the LocationFixMutation object of the builder.
+func (_u *LocationFixUpdate) Mutation() *LocationFixMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LocationFixUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LocationFixUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LocationFixUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LocationFixUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LocationFixUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(locationfix.Table, locationfix.Columns, sqlgraph.NewFieldSpec(locationfix.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(locationfix.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(locationfix.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.UserAddress(); ok {
+		_spec.SetField(locationfix.FieldUserAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Lat(); ok {
+		_spec.SetField(locationfix.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLat(); ok {
+		_spec.AddField(locationfix.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Long(); ok {
+		_spec.SetField(locationfix.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLong(); ok {
+		_spec.AddField(locationfix.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AccuracyM(); ok {
+		_spec.SetField(locationfix.FieldAccuracyM, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAccuracyM(); ok {
+		_spec.AddField(locationfix.FieldAccuracyM, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(locationfix.FieldReportedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Accepted(); ok {
+		_spec.SetField(locationfix.FieldAccepted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RejectReason(); ok {
+		_spec.SetField(locationfix.FieldRejectReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectReasonCleared() {
+		_spec.ClearField(locationfix.FieldRejectReason, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{locationfix.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LocationFixUpdateOne is the builder for updating a single LocationFix entity.
+type LocationFixUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LocationFixMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *LocationFixUpdateOne) SetEventID(v uint64) *LocationFixUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *LocationFixUpdateOne) SetNillableEventID(v *uint64) *LocationFixUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *LocationFixUpdateOne) AddEventID(v int64) *LocationFixUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetUserAddress sets the "user_address" field.
+func (_u *LocationFixUpdateOne) SetUserAddress(v string) *LocationFixUpdateOne {
+	_u.mutation.SetUserAddress(v)
+	return _u
+}
+
+// SetNillableUserAddress sets the "user_address" field if the given value is not nil.
+func (_u *LocationFixUpdateOne) SetNillableUserAddress(v *string) *LocationFixUpdateOne {
+	if v != nil {
+		_u.SetUserAddress(*v)
+	}
+	return _u
+}
+
+// SetLat sets the "lat" field.
+func (_u *LocationFixUpdateOne) SetLat(v float64) *LocationFixUpdateOne {
+	_u.mutation.ResetLat()
+	_u.mutation.SetLat(v)
+	return _u
+}
+
+// SetNillableLat sets the "lat" field if the given value is not nil.
+func (_u *LocationFixUpdateOne) SetNillableLat(v *float64) *LocationFixUpdateOne {
+	if v != nil {
+		_u.SetLat(*v)
+	}
+	return _u
+}
+
+// AddLat adds value to the "lat" field.
+func (_u *LocationFixUpdateOne) AddLat(v float64) *LocationFixUpdateOne {
+	_u.mutation.AddLat(v)
+	return _u
+}
+
+// SetLong sets the "long" field.
+func (_u *LocationFixUpdateOne) SetLong(v float64) *LocationFixUpdateOne {
+	_u.mutation.ResetLong()
+	_u.mutation.SetLong(v)
+	return _u
+}
+
+// SetNillableLong sets the "long" field if the given value is not nil.
+func (_u *LocationFixUpdateOne) SetNillableLong(v *float64) *LocationFixUpdateOne {
+	if v != nil {
+		_u.SetLong(*v)
+	}
+	return _u
+}
+
+// AddLong adds value to the "long" field.
+func (_u *LocationFixUpdateOne) AddLong(v float64) *LocationFixUpdateOne {
+	_u.mutation.AddLong(v)
+	return _u
+}
+
+// SetAccuracyM sets the "accuracy_m" field.
+func (_u *LocationFixUpdateOne) SetAccuracyM(v float64) *LocationFixUpdateOne {
+	_u.mutation.ResetAccuracyM()
+	_u.mutation.SetAccuracyM(v)
+	return _u
+}
+
+// SetNillableAccuracyM sets the "accuracy_m" field if the given value is not nil.
+func (_u *LocationFixUpdateOne) SetNillableAccuracyM(v *float64) *LocationFixUpdateOne {
+	if v != nil {
+		_u.SetAccuracyM(*v)
+	}
+	return _u
+}
+
+// AddAccuracyM adds value to the "accuracy_m" field.
+func (_u *LocationFixUpdateOne) AddAccuracyM(v float64) *LocationFixUpdateOne {
+	_u.mutation.AddAccuracyM(v)
+	return _u
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_u *LocationFixUpdateOne) SetReportedAt(v time.Time) *LocationFixUpdateOne {
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *LocationFixUpdateOne) SetNillableReportedAt(v *time.Time) *LocationFixUpdateOne {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// SetAccepted sets the "accepted" field.
+func (_u *LocationFixUpdateOne) SetAccepted(v bool) *LocationFixUpdateOne {
+	_u.mutation.SetAccepted(v)
+	return _u
+}
+
+// SetNillableAccepted sets the "accepted" field if the given value is not nil.
+func (_u *LocationFixUpdateOne) SetNillableAccepted(v *bool) *LocationFixUpdateOne {
+	if v != nil {
+		_u.SetAccepted(*v)
+	}
+	return _u
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (_u *LocationFixUpdateOne) SetRejectReason(v string) *LocationFixUpdateOne {
+	_u.mutation.SetRejectReason(v)
+	return _u
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (_u *LocationFixUpdateOne) SetNillableRejectReason(v *string) *LocationFixUpdateOne {
+	if v != nil {
+		_u.SetRejectReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectReason clears the value of the "reject_reason" field.
+func (_u *LocationFixUpdateOne) ClearRejectReason() *LocationFixUpdateOne {
+	_u.mutation.ClearRejectReason()
+	return _u
+}
+
+// Mutation returns the LocationFixMutation object of the builder.
+func (_u *LocationFixUpdateOne) Mutation() *LocationFixMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LocationFixUpdate builder.
+func (_u *LocationFixUpdateOne) Where(ps ...predicate.LocationFix) *LocationFixUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LocationFixUpdateOne) Select(field string, fields ...string) *LocationFixUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LocationFix entity.
+func (_u *LocationFixUpdateOne) Save(ctx context.Context) (*LocationFix, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LocationFixUpdateOne) SaveX(ctx context.Context) *LocationFix {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LocationFixUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LocationFixUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LocationFixUpdateOne) sqlSave(ctx context.Context) (_node *LocationFix, err error) {
+	_spec := sqlgraph.NewUpdateSpec(locationfix.Table, locationfix.Columns, sqlgraph.NewFieldSpec(locationfix.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LocationFix.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, locationfix.FieldID)
+		for _, f := range fields {
+			if !locationfix.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != locationfix.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(locationfix.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(locationfix.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.UserAddress(); ok {
+		_spec.SetField(locationfix.FieldUserAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Lat(); ok {
+		_spec.SetField(locationfix.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLat(); ok {
+		_spec.AddField(locationfix.FieldLat, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Long(); ok {
+		_spec.SetField(locationfix.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLong(); ok {
+		_spec.AddField(locationfix.FieldLong, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AccuracyM(); ok {
+		_spec.SetField(locationfix.FieldAccuracyM, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAccuracyM(); ok {
+		_spec.AddField(locationfix.FieldAccuracyM, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(locationfix.FieldReportedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Accepted(); ok {
+		_spec.SetField(locationfix.FieldAccepted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RejectReason(); ok {
+		_spec.SetField(locationfix.FieldRejectReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectReasonCleared() {
+		_spec.ClearField(locationfix.FieldRejectReason, field.TypeString)
+	}
+	_node = &LocationFix{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{locationfix.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
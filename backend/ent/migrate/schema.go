@@ -8,11 +8,55 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "owner_address", Type: field.TypeString, Nullable: true},
+		{Name: "rate_per_minute", Type: field.TypeInt, Default: 60},
+		{Name: "daily_quota", Type: field.TypeInt, Default: 10000},
+		{Name: "total_requests", Type: field.TypeInt64, Default: 0},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+	}
+	// APIKeyUsagesColumns holds the columns for the "api_key_usages" table.
+	APIKeyUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key_id", Type: field.TypeInt},
+		{Name: "day", Type: field.TypeString},
+		{Name: "count", Type: field.TypeInt, Default: 0},
+	}
+	// APIKeyUsagesTable holds the schema information for the "api_key_usages" table.
+	APIKeyUsagesTable = &schema.Table{
+		Name:       "api_key_usages",
+		Columns:    APIKeyUsagesColumns,
+		PrimaryKey: []*schema.Column{APIKeyUsagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikeyusage_key_id_day",
+				Unique:  true,
+				Columns: []*schema.Column{APIKeyUsagesColumns[1], APIKeyUsagesColumns[2]},
+			},
+		},
+	}
 	// AttendancesColumns holds the columns for the "attendances" table.
 	AttendancesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "checked_in", Type: field.TypeBool, Default: false},
 		{Name: "registration_time", Type: field.TypeTime},
+		{Name: "check_in_method", Type: field.TypeEnum, Nullable: true, Enums: []string{"staff", "kiosk", "self", "join_link"}},
+		{Name: "verified_by", Type: field.TypeString, Nullable: true},
 		{Name: "event_attendances", Type: field.TypeInt},
 		{Name: "user_attendances", Type: field.TypeInt},
 	}
@@ -24,18 +68,148 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attendances_events_attendances",
-				Columns:    []*schema.Column{AttendancesColumns[3]},
+				Columns:    []*schema.Column{AttendancesColumns[5]},
 				RefColumns: []*schema.Column{EventsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "attendances_users_attendances",
-				Columns:    []*schema.Column{AttendancesColumns[4]},
+				Columns:    []*schema.Column{AttendancesColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// AuthNoncesColumns holds the columns for the "auth_nonces" table.
+	AuthNoncesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "nonce", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuthNoncesTable holds the schema information for the "auth_nonces" table.
+	AuthNoncesTable = &schema.Table{
+		Name:       "auth_nonces",
+		Columns:    AuthNoncesColumns,
+		PrimaryKey: []*schema.Column{AuthNoncesColumns[0]},
+	}
+	// CalendarTokensColumns holds the columns for the "calendar_tokens" table.
+	CalendarTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "address", Type: field.TypeString, Unique: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CalendarTokensTable holds the schema information for the "calendar_tokens" table.
+	CalendarTokensTable = &schema.Table{
+		Name:       "calendar_tokens",
+		Columns:    CalendarTokensColumns,
+		PrimaryKey: []*schema.Column{CalendarTokensColumns[0]},
+	}
+	// CheckInIntentsColumns holds the columns for the "check_in_intents" table.
+	CheckInIntentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeUint64},
+		{Name: "user_address", Type: field.TypeString},
+		{Name: "scanned_at", Type: field.TypeTime},
+		{Name: "device_id", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "done", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// CheckInIntentsTable holds the schema information for the "check_in_intents" table.
+	CheckInIntentsTable = &schema.Table{
+		Name:       "check_in_intents",
+		Columns:    CheckInIntentsColumns,
+		PrimaryKey: []*schema.Column{CheckInIntentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "checkinintent_event_id_user_address",
+				Unique:  true,
+				Columns: []*schema.Column{CheckInIntentsColumns[1], CheckInIntentsColumns[2]},
+			},
+			{
+				Name:    "checkinintent_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{CheckInIntentsColumns[5], CheckInIntentsColumns[8]},
+			},
+		},
+	}
+	// CheckInTokenUsesColumns holds the columns for the "check_in_token_uses" table.
+	CheckInTokenUsesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "nonce", Type: field.TypeString, Unique: true},
+		{Name: "event_id", Type: field.TypeUint64},
+		{Name: "user_address", Type: field.TypeString},
+		{Name: "used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// CheckInTokenUsesTable holds the schema information for the "check_in_token_uses" table.
+	CheckInTokenUsesTable = &schema.Table{
+		Name:       "check_in_token_uses",
+		Columns:    CheckInTokenUsesColumns,
+		PrimaryKey: []*schema.Column{CheckInTokenUsesColumns[0]},
+	}
+	// ClaimsColumns holds the columns for the "claims" table.
+	ClaimsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "slot", Type: field.TypeInt},
+		{Name: "pool_slot", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"reserved", "committed"}, Default: "reserved"},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "committed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ClaimsTable holds the schema information for the "claims" table.
+	ClaimsTable = &schema.Table{
+		Name:       "claims",
+		Columns:    ClaimsColumns,
+		PrimaryKey: []*schema.Column{ClaimsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "claim_kind_scope_subject_slot",
+				Unique:  true,
+				Columns: []*schema.Column{ClaimsColumns[1], ClaimsColumns[2], ClaimsColumns[3], ClaimsColumns[4]},
+			},
+			{
+				Name:    "claim_kind_scope_pool_slot",
+				Unique:  true,
+				Columns: []*schema.Column{ClaimsColumns[1], ClaimsColumns[2], ClaimsColumns[5]},
+			},
+		},
+	}
+	// ClaimQuotaColumns holds the columns for the "claim_quota" table.
+	ClaimQuotaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "per_subject_limit", Type: field.TypeInt, Default: 1},
+		{Name: "total_limit", Type: field.TypeInt, Default: 0},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ClaimQuotaTable holds the schema information for the "claim_quota" table.
+	ClaimQuotaTable = &schema.Table{
+		Name:       "claim_quota",
+		Columns:    ClaimQuotaColumns,
+		PrimaryKey: []*schema.Column{ClaimQuotaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "claimquota_kind_scope",
+				Unique:  true,
+				Columns: []*schema.Column{ClaimQuotaColumns[1], ClaimQuotaColumns[2]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -65,6 +239,19 @@ var (
 			},
 		},
 	}
+	// DataVersionsColumns holds the columns for the "data_versions" table.
+	DataVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "version", Type: field.TypeInt64, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DataVersionsTable holds the schema information for the "data_versions" table.
+	DataVersionsTable = &schema.Table{
+		Name:       "data_versions",
+		Columns:    DataVersionsColumns,
+		PrimaryKey: []*schema.Column{DataVersionsColumns[0]},
+	}
 	// EventsColumns holds the columns for the "events" table.
 	EventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -79,6 +266,11 @@ var (
 		{Name: "start_date", Type: field.TypeTime},
 		{Name: "end_date", Type: field.TypeTime},
 		{Name: "quota", Type: field.TypeUint64},
+		{Name: "checkin_radius_m", Type: field.TypeFloat64, Default: 0},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_reason", Type: field.TypeString, Nullable: true},
+		{Name: "revision", Type: field.TypeInt, Default: 0},
+		{Name: "attendee_visibility", Type: field.TypeEnum, Enums: []string{"public", "attendees", "host"}, Default: "public"},
 		{Name: "user_hosted_events", Type: field.TypeInt},
 	}
 	// EventsTable holds the schema information for the "events" table.
@@ -89,11 +281,86 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "events_users_hosted_events",
-				Columns:    []*schema.Column{EventsColumns[12]},
+				Columns:    []*schema.Column{EventsColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_lat_long",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[7], EventsColumns[8]},
+			},
+		},
+	}
+	// EventChangesColumns holds the columns for the "event_changes" table.
+	EventChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeUint64},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"updated", "cancelled"}},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "changed_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EventChangesTable holds the schema information for the "event_changes" table.
+	EventChangesTable = &schema.Table{
+		Name:       "event_changes",
+		Columns:    EventChangesColumns,
+		PrimaryKey: []*schema.Column{EventChangesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventchange_event_id_revision",
+				Unique:  false,
+				Columns: []*schema.Column{EventChangesColumns[1], EventChangesColumns[2]},
+			},
+		},
+	}
+	// EventImportRowsColumns holds the columns for the "event_import_rows" table.
+	EventImportRowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "import_id", Type: field.TypeString},
+		{Name: "host_address", Type: field.TypeString},
+		{Name: "format", Type: field.TypeString},
+		{Name: "row", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "thumbnail_url", Type: field.TypeString},
+		{Name: "event_pass_img", Type: field.TypeString, Nullable: true},
+		{Name: "event_type", Type: field.TypeUint8},
+		{Name: "location", Type: field.TypeString},
+		{Name: "lat", Type: field.TypeFloat64, Nullable: true},
+		{Name: "long", Type: field.TypeFloat64, Nullable: true},
+		{Name: "geocoded", Type: field.TypeBool, Default: false},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime},
+		{Name: "quota", Type: field.TypeUint64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "creating", "created", "failed"}, Default: "queued"},
+		{Name: "event_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EventImportRowsTable holds the schema information for the "event_import_rows" table.
+	EventImportRowsTable = &schema.Table{
+		Name:       "event_import_rows",
+		Columns:    EventImportRowsColumns,
+		PrimaryKey: []*schema.Column{EventImportRowsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventimportrow_import_id_row",
+				Unique:  true,
+				Columns: []*schema.Column{EventImportRowsColumns[1], EventImportRowsColumns[4]},
+			},
+			{
+				Name:    "eventimportrow_status",
+				Unique:  false,
+				Columns: []*schema.Column{EventImportRowsColumns[17]},
+			},
+		},
 	}
 	// EventPassesColumns holds the columns for the "event_passes" table.
 	EventPassesColumns = []*schema.Column{
@@ -127,6 +394,173 @@ var (
 			},
 		},
 	}
+	// EventSeriesColumns holds the columns for the "event_series" table.
+	EventSeriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "host_address", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "thumbnail_url", Type: field.TypeString},
+		{Name: "event_pass_img", Type: field.TypeString, Nullable: true},
+		{Name: "event_type", Type: field.TypeUint8},
+		{Name: "location", Type: field.TypeString},
+		{Name: "lat", Type: field.TypeFloat64, Default: 0},
+		{Name: "long", Type: field.TypeFloat64, Default: 0},
+		{Name: "quota", Type: field.TypeUint64},
+		{Name: "rrule", Type: field.TypeString},
+		{Name: "timezone", Type: field.TypeString},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "duration_seconds", Type: field.TypeInt64},
+		{Name: "lead_days", Type: field.TypeInt, Default: 28},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "paused", "completed", "stopped"}, Default: "active"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EventSeriesTable holds the schema information for the "event_series" table.
+	EventSeriesTable = &schema.Table{
+		Name:       "event_series",
+		Columns:    EventSeriesColumns,
+		PrimaryKey: []*schema.Column{EventSeriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventseries_host_address",
+				Unique:  false,
+				Columns: []*schema.Column{EventSeriesColumns[1]},
+			},
+			{
+				Name:    "eventseries_status",
+				Unique:  false,
+				Columns: []*schema.Column{EventSeriesColumns[16]},
+			},
+		},
+	}
+	// EventStaffsColumns holds the columns for the "event_staffs" table.
+	EventStaffsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeUint64},
+		{Name: "address", Type: field.TypeString},
+		{Name: "added_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EventStaffsTable holds the schema information for the "event_staffs" table.
+	EventStaffsTable = &schema.Table{
+		Name:       "event_staffs",
+		Columns:    EventStaffsColumns,
+		PrimaryKey: []*schema.Column{EventStaffsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventstaff_event_id_address",
+				Unique:  true,
+				Columns: []*schema.Column{EventStaffsColumns[1], EventStaffsColumns[2]},
+			},
+			{
+				Name:    "eventstaff_address",
+				Unique:  false,
+				Columns: []*schema.Column{EventStaffsColumns[2]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"in_progress", "completed"}, Default: "in_progress"},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_content_type", Type: field.TypeString, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_scope_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[9]},
+			},
+		},
+	}
+	// InviteCodesColumns holds the columns for the "invite_codes" table.
+	InviteCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "owner_address", Type: field.TypeString},
+		{Name: "max_uses", Type: field.TypeInt, Default: 10},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InviteCodesTable holds the schema information for the "invite_codes" table.
+	InviteCodesTable = &schema.Table{
+		Name:       "invite_codes",
+		Columns:    InviteCodesColumns,
+		PrimaryKey: []*schema.Column{InviteCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitecode_owner_address",
+				Unique:  false,
+				Columns: []*schema.Column{InviteCodesColumns[2]},
+			},
+		},
+	}
+	// JoinLinksColumns holds the columns for the "join_links" table.
+	JoinLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "event_id", Type: field.TypeUint64},
+		{Name: "user_address", Type: field.TypeString},
+		{Name: "clicked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_heartbeat_at", Type: field.TypeTime, Nullable: true},
+		{Name: "qualified", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// JoinLinksTable holds the schema information for the "join_links" table.
+	JoinLinksTable = &schema.Table{
+		Name:       "join_links",
+		Columns:    JoinLinksColumns,
+		PrimaryKey: []*schema.Column{JoinLinksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "joinlink_event_id_user_address",
+				Unique:  true,
+				Columns: []*schema.Column{JoinLinksColumns[2], JoinLinksColumns[3]},
+			},
+		},
+	}
+	// KioskDevicesColumns holds the columns for the "kiosk_devices" table.
+	KioskDevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "device_id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeUint64},
+		{Name: "provisioned_by", Type: field.TypeString},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// KioskDevicesTable holds the schema information for the "kiosk_devices" table.
+	KioskDevicesTable = &schema.Table{
+		Name:       "kiosk_devices",
+		Columns:    KioskDevicesColumns,
+		PrimaryKey: []*schema.Column{KioskDevicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "kioskdevice_event_id",
+				Unique:  false,
+				Columns: []*schema.Column{KioskDevicesColumns[4]},
+			},
+		},
+	}
 	// LikesColumns holds the columns for the "likes" table.
 	LikesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -186,6 +620,57 @@ var (
 			},
 		},
 	}
+	// LocationFixesColumns holds the columns for the "location_fixes" table.
+	LocationFixesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeUint64},
+		{Name: "user_address", Type: field.TypeString},
+		{Name: "lat", Type: field.TypeFloat64},
+		{Name: "long", Type: field.TypeFloat64},
+		{Name: "accuracy_m", Type: field.TypeFloat64},
+		{Name: "reported_at", Type: field.TypeTime},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "accepted", Type: field.TypeBool, Default: false},
+		{Name: "reject_reason", Type: field.TypeString, Nullable: true},
+	}
+	// LocationFixesTable holds the schema information for the "location_fixes" table.
+	LocationFixesTable = &schema.Table{
+		Name:       "location_fixes",
+		Columns:    LocationFixesColumns,
+		PrimaryKey: []*schema.Column{LocationFixesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "locationfix_user_address_reported_at",
+				Unique:  false,
+				Columns: []*schema.Column{LocationFixesColumns[2], LocationFixesColumns[6]},
+			},
+		},
+	}
+	// MintCreditsColumns holds the columns for the "mint_credits" table.
+	MintCreditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "address", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString},
+		{Name: "referral_id", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"available", "reserved", "spent"}, Default: "available"},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reserved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "spent_at", Type: field.TypeTime, Nullable: true},
+	}
+	// MintCreditsTable holds the schema information for the "mint_credits" table.
+	MintCreditsTable = &schema.Table{
+		Name:       "mint_credits",
+		Columns:    MintCreditsColumns,
+		PrimaryKey: []*schema.Column{MintCreditsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mintcredit_address_status",
+				Unique:  false,
+				Columns: []*schema.Column{MintCreditsColumns[1], MintCreditsColumns[4]},
+			},
+		},
+	}
 	// NftAccessoriesColumns holds the columns for the "nft_accessories" table.
 	NftAccessoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -256,6 +741,116 @@ var (
 			},
 		},
 	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "address", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_address_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[7]},
+			},
+		},
+	}
+	// ReferralsColumns holds the columns for the "referrals" table.
+	ReferralsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString},
+		{Name: "inviter_address", Type: field.TypeString},
+		{Name: "invitee_address", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "qualified"}, Default: "pending"},
+		{Name: "qualifying_event_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "qualified_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ReferralsTable holds the schema information for the "referrals" table.
+	ReferralsTable = &schema.Table{
+		Name:       "referrals",
+		Columns:    ReferralsColumns,
+		PrimaryKey: []*schema.Column{ReferralsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "referral_inviter_address",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[2]},
+			},
+			{
+				Name:    "referral_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[4]},
+			},
+		},
+	}
+	// SeriesOccurrencesColumns holds the columns for the "series_occurrences" table.
+	SeriesOccurrencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "series_id", Type: field.TypeInt},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "creating", "created", "failed", "skipped"}, Default: "pending"},
+		{Name: "event_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SeriesOccurrencesTable holds the schema information for the "series_occurrences" table.
+	SeriesOccurrencesTable = &schema.Table{
+		Name:       "series_occurrences",
+		Columns:    SeriesOccurrencesColumns,
+		PrimaryKey: []*schema.Column{SeriesOccurrencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "seriesoccurrence_series_id_start_date",
+				Unique:  true,
+				Columns: []*schema.Column{SeriesOccurrencesColumns[1], SeriesOccurrencesColumns[2]},
+			},
+			{
+				Name:    "seriesoccurrence_status",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesOccurrencesColumns[4]},
+			},
+			{
+				Name:    "seriesoccurrence_event_id",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesOccurrencesColumns[5]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "address", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_address",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[2]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -269,6 +864,7 @@ var (
 		{Name: "highlighted_moment_id", Type: field.TypeUint64, Nullable: true},
 		{Name: "socials", Type: field.TypeJSON, Nullable: true},
 		{Name: "is_free_minted", Type: field.TypeBool, Default: false},
+		{Name: "hide_from_attendee_lists", Type: field.TypeBool, Default: false},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -276,17 +872,76 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WaitlistEntriesColumns holds the columns for the "waitlist_entries" table.
+	WaitlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeUint64},
+		{Name: "address", Type: field.TypeString},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "offered", "claimed", "expired", "left"}, Default: "waiting"},
+		{Name: "offered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "offer_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WaitlistEntriesTable holds the schema information for the "waitlist_entries" table.
+	WaitlistEntriesTable = &schema.Table{
+		Name:       "waitlist_entries",
+		Columns:    WaitlistEntriesColumns,
+		PrimaryKey: []*schema.Column{WaitlistEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistentry_event_id_address",
+				Unique:  true,
+				Columns: []*schema.Column{WaitlistEntriesColumns[1], WaitlistEntriesColumns[2]},
+			},
+			{
+				Name:    "waitlistentry_event_id_status_position",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[1], WaitlistEntriesColumns[4], WaitlistEntriesColumns[3]},
+			},
+			{
+				Name:    "waitlistentry_status",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[4]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		APIKeyUsagesTable,
 		AttendancesTable,
+		AuthNoncesTable,
+		CalendarTokensTable,
+		CheckInIntentsTable,
+		CheckInTokenUsesTable,
+		ClaimsTable,
+		ClaimQuotaTable,
 		CommentsTable,
+		DataVersionsTable,
 		EventsTable,
+		EventChangesTable,
+		EventImportRowsTable,
 		EventPassesTable,
+		EventSeriesTable,
+		EventStaffsTable,
+		IdempotencyKeysTable,
+		InviteCodesTable,
+		JoinLinksTable,
+		KioskDevicesTable,
 		LikesTable,
 		ListingsTable,
+		LocationFixesTable,
+		MintCreditsTable,
 		NftAccessoriesTable,
 		NftMomentsTable,
+		NotificationsTable,
+		ReferralsTable,
+		SeriesOccurrencesTable,
+		SessionsTable,
 		UsersTable,
+		WaitlistEntriesTable,
 	}
 )
 
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/mintcredit"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MintCredit is the model entity for the MintCredit schema.
+type MintCredit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// ReferralID holds the value of the "referral_id" field.
+	ReferralID *int `json:"referral_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status mintcredit.Status `json:"status,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReservedAt holds the value of the "reserved_at" field.
+	ReservedAt *time.Time `json:"reserved_at,omitempty"`
+	// SpentAt holds the value of the "spent_at" field.
+	SpentAt      *time.Time `json:"spent_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MintCredit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mintcredit.FieldID, mintcredit.FieldReferralID:
+			values[i] = new(sql.NullInt64)
+		case mintcredit.FieldAddress, mintcredit.FieldSource, mintcredit.FieldStatus, mintcredit.FieldReference:
+			values[i] = new(sql.NullString)
+		case mintcredit.FieldCreatedAt, mintcredit.FieldReservedAt, mintcredit.FieldSpentAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MintCredit fields.
+func (_m *MintCredit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mintcredit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case mintcredit.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case mintcredit.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case mintcredit.FieldReferralID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field referral_id", values[i])
+			} else if value.Valid {
+				_m.ReferralID = new(int)
+				*_m.ReferralID = int(value.Int64)
+			}
+		case mintcredit.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = mintcredit.Status(value.String)
+			}
+		case mintcredit.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case mintcredit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case mintcredit.FieldReservedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved_at", values[i])
+			} else if value.Valid {
+				_m.ReservedAt = new(time.Time)
+				*_m.ReservedAt = value.Time
+			}
+		case mintcredit.FieldSpentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field spent_at", values[i])
+			} else if value.Valid {
+				_m.SpentAt = new(time.Time)
+				*_m.SpentAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MintCredit.
+// This includes values selected through modifiers, order, etc.
+func (_m *MintCredit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MintCredit.
+// Note that you need to call MintCredit.Unwrap() before calling this method if this MintCredit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MintCredit) Update() *MintCreditUpdateOne {
+	return NewMintCreditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MintCredit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MintCredit) Unwrap() *MintCredit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MintCredit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MintCredit) String() string {
+	var builder strings.Builder
+	builder.WriteString("MintCredit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	if v := _m.ReferralID; v != nil {
+		builder.WriteString("referral_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ReservedAt; v != nil {
+		builder.WriteString("reserved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SpentAt; v != nil {
+		builder.WriteString("spent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MintCredits is a parsable slice of MintCredit.
+type MintCredits []*MintCredit
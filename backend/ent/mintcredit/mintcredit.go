@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mintcredit
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the mintcredit type in the database.
+	Label = "mint_credit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldReferralID holds the string denoting the referral_id field in the database.
+	FieldReferralID = "referral_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldReservedAt holds the string denoting the reserved_at field in the database.
+	FieldReservedAt = "reserved_at"
+	// FieldSpentAt holds the string denoting the spent_at field in the database.
+	FieldSpentAt = "spent_at"
+	// Table holds the table name of the mintcredit in the database.
+	Table = "mint_credits"
+)
+
+// Columns holds all SQL columns for mintcredit fields.
+var Columns = []string{
+	FieldID,
+	FieldAddress,
+	FieldSource,
+	FieldReferralID,
+	FieldStatus,
+	FieldReference,
+	FieldCreatedAt,
+	FieldReservedAt,
+	FieldSpentAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusAvailable is the default value of the Status enum.
+const DefaultStatus = StatusAvailable
+
+// Status values.
+const (
+	StatusAvailable Status = "available"
+	StatusReserved  Status = "reserved"
+	StatusSpent     Status = "spent"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusAvailable, StatusReserved, StatusSpent:
+		return nil
+	default:
+		return fmt.Errorf("mintcredit: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the MintCredit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByReferralID orders the results by the referral_id field.
+func ByReferralID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferralID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReservedAt orders the results by the reserved_at field.
+func ByReservedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReservedAt, opts...).ToFunc()
+}
+
+// BySpentAt orders the results by the spent_at field.
+func BySpentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpentAt, opts...).ToFunc()
+}
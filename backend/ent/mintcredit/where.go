@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mintcredit
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLTE(FieldID, id))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldAddress, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldSource, v))
+}
+
+// ReferralID applies equality check predicate on the "referral_id" field. It's identical to ReferralIDEQ.
+func ReferralID(v int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldReferralID, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldReference, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReservedAt applies equality check predicate on the "reserved_at" field. It's identical to ReservedAtEQ.
+func ReservedAt(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldReservedAt, v))
+}
+
+// SpentAt applies equality check predicate on the "spent_at" field. It's identical to SpentAtEQ.
+func SpentAt(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldSpentAt, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ReferralIDEQ applies the EQ predicate on the "referral_id" field.
+func ReferralIDEQ(v int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldReferralID, v))
+}
+
+// ReferralIDNEQ applies the NEQ predicate on the "referral_id" field.
+func ReferralIDNEQ(v int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldReferralID, v))
+}
+
+// ReferralIDIn applies the In predicate on the "referral_id" field.
+func ReferralIDIn(vs ...int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldReferralID, vs...))
+}
+
+// ReferralIDNotIn applies the NotIn predicate on the "referral_id" field.
+func ReferralIDNotIn(vs ...int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldReferralID, vs...))
+}
+
+// ReferralIDGT applies the GT predicate on the "referral_id" field.
+func ReferralIDGT(v int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGT(FieldReferralID, v))
+}
+
+// ReferralIDGTE applies the GTE predicate on the "referral_id" field.
+func ReferralIDGTE(v int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGTE(FieldReferralID, v))
+}
+
+// ReferralIDLT applies the LT predicate on the "referral_id" field.
+func ReferralIDLT(v int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLT(FieldReferralID, v))
+}
+
+// ReferralIDLTE applies the LTE predicate on the "referral_id" field.
+func ReferralIDLTE(v int) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLTE(FieldReferralID, v))
+}
+
+// ReferralIDIsNil applies the IsNil predicate on the "referral_id" field.
+func ReferralIDIsNil() predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIsNull(FieldReferralID))
+}
+
+// ReferralIDNotNil applies the NotNil predicate on the "referral_id" field.
+func ReferralIDNotNil() predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotNull(FieldReferralID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldContainsFold(FieldReference, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReservedAtEQ applies the EQ predicate on the "reserved_at" field.
+func ReservedAtEQ(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldReservedAt, v))
+}
+
+// ReservedAtNEQ applies the NEQ predicate on the "reserved_at" field.
+func ReservedAtNEQ(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldReservedAt, v))
+}
+
+// ReservedAtIn applies the In predicate on the "reserved_at" field.
+func ReservedAtIn(vs ...time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldReservedAt, vs...))
+}
+
+// ReservedAtNotIn applies the NotIn predicate on the "reserved_at" field.
+func ReservedAtNotIn(vs ...time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldReservedAt, vs...))
+}
+
+// ReservedAtGT applies the GT predicate on the "reserved_at" field.
+func ReservedAtGT(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGT(FieldReservedAt, v))
+}
+
+// ReservedAtGTE applies the GTE predicate on the "reserved_at" field.
+func ReservedAtGTE(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGTE(FieldReservedAt, v))
+}
+
+// ReservedAtLT applies the LT predicate on the "reserved_at" field.
+func ReservedAtLT(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLT(FieldReservedAt, v))
+}
+
+// ReservedAtLTE applies the LTE predicate on the "reserved_at" field.
+func ReservedAtLTE(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLTE(FieldReservedAt, v))
+}
+
+// ReservedAtIsNil applies the IsNil predicate on the "reserved_at" field.
+func ReservedAtIsNil() predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIsNull(FieldReservedAt))
+}
+
+// ReservedAtNotNil applies the NotNil predicate on the "reserved_at" field.
+func ReservedAtNotNil() predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotNull(FieldReservedAt))
+}
+
+// SpentAtEQ applies the EQ predicate on the "spent_at" field.
+func SpentAtEQ(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldEQ(FieldSpentAt, v))
+}
+
+// SpentAtNEQ applies the NEQ predicate on the "spent_at" field.
+func SpentAtNEQ(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNEQ(FieldSpentAt, v))
+}
+
+// SpentAtIn applies the In predicate on the "spent_at" field.
+func SpentAtIn(vs ...time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIn(FieldSpentAt, vs...))
+}
+
+// SpentAtNotIn applies the NotIn predicate on the "spent_at" field.
+func SpentAtNotIn(vs ...time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotIn(FieldSpentAt, vs...))
+}
+
+// SpentAtGT applies the GT predicate on the "spent_at" field.
+func SpentAtGT(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGT(FieldSpentAt, v))
+}
+
+// SpentAtGTE applies the GTE predicate on the "spent_at" field.
+func SpentAtGTE(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldGTE(FieldSpentAt, v))
+}
+
+// SpentAtLT applies the LT predicate on the "spent_at" field.
+func SpentAtLT(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLT(FieldSpentAt, v))
+}
+
+// SpentAtLTE applies the LTE predicate on the "spent_at" field.
+func SpentAtLTE(v time.Time) predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldLTE(FieldSpentAt, v))
+}
+
+// SpentAtIsNil applies the IsNil predicate on the "spent_at" field.
+func SpentAtIsNil() predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldIsNull(FieldSpentAt))
+}
+
+// SpentAtNotNil applies the NotNil predicate on the "spent_at" field.
+func SpentAtNotNil() predicate.MintCredit {
+	return predicate.MintCredit(sql.FieldNotNull(FieldSpentAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MintCredit) predicate.MintCredit {
+	return predicate.MintCredit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MintCredit) predicate.MintCredit {
+	return predicate.MintCredit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MintCredit) predicate.MintCredit {
+	return predicate.MintCredit(sql.NotPredicates(p))
+}
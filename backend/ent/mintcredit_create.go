@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/mintcredit"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MintCreditCreate is the builder for creating a MintCredit entity.
+type MintCreditCreate struct {
+	config
+	mutation *MintCreditMutation
+	hooks    []Hook
+}
+
+// SetAddress sets the "address" field.
+func (_c *MintCreditCreate) SetAddress(v string) *MintCreditCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *MintCreditCreate) SetSource(v string) *MintCreditCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetReferralID sets the "referral_id" field.
+func (_c *MintCreditCreate) SetReferralID(v int) *MintCreditCreate {
+	_c.mutation.SetReferralID(v)
+	return _c
+}
+
+// SetNillableReferralID sets the "referral_id" field if the given value is not nil.
+func (_c *MintCreditCreate) SetNillableReferralID(v *int) *MintCreditCreate {
+	if v != nil {
+		_c.SetReferralID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *MintCreditCreate) SetStatus(v mintcredit.Status) *MintCreditCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *MintCreditCreate) SetNillableStatus(v *mintcredit.Status) *MintCreditCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *MintCreditCreate) SetReference(v string) *MintCreditCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *MintCreditCreate) SetNillableReference(v *string) *MintCreditCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MintCreditCreate) SetCreatedAt(v time.Time) *MintCreditCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MintCreditCreate) SetNillableCreatedAt(v *time.Time) *MintCreditCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetReservedAt sets the "reserved_at" field.
+func (_c *MintCreditCreate) SetReservedAt(v time.Time) *MintCreditCreate {
+	_c.mutation.SetReservedAt(v)
+	return _c
+}
+
+// SetNillableReservedAt sets the "reserved_at" field if the given value is not nil.
+func (_c *MintCreditCreate) SetNillableReservedAt(v *time.Time) *MintCreditCreate {
+	if v != nil {
+		_c.SetReservedAt(*v)
+	}
+	return _c
+}
+
+// SetSpentAt sets the "spent_at" field.
+func (_c *MintCreditCreate) SetSpentAt(v time.Time) *MintCreditCreate {
+	_c.mutation.SetSpentAt(v)
+	return _c
+}
+
+// SetNillableSpentAt sets the "spent_at" field if the given value is not nil.
+func (_c *MintCreditCreate) SetNillableSpentAt(v *time.Time) *MintCreditCreate {
+	if v != nil {
+		_c.SetSpentAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the MintCreditMutation object of the builder.
+func (_c *MintCreditCreate) Mutation() *MintCreditMutation {
+	return _c.mutation
+}
+
+// Save creates the MintCredit in the database.
+func (_c *MintCreditCreate) Save(ctx context.Context) (*MintCredit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MintCreditCreate) SaveX(ctx context.Context) *MintCredit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MintCreditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MintCreditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MintCreditCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := mintcredit.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := mintcredit.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MintCreditCreate) check() error {
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "MintCredit.address"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "MintCredit.source"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MintCredit.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := mintcredit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MintCredit.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MintCredit.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *MintCreditCreate) sqlSave(ctx context.Context) (*MintCredit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MintCreditCreate) createSpec() (*MintCredit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MintCredit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mintcredit.Table, sqlgraph.NewFieldSpec(mintcredit.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(mintcredit.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(mintcredit.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.ReferralID(); ok {
+		_spec.SetField(mintcredit.FieldReferralID, field.TypeInt, value)
+		_node.ReferralID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(mintcredit.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(mintcredit.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(mintcredit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ReservedAt(); ok {
+		_spec.SetField(mintcredit.FieldReservedAt, field.TypeTime, value)
+		_node.ReservedAt = &value
+	}
+	if value, ok := _c.mutation.SpentAt(); ok {
+		_spec.SetField(mintcredit.FieldSpentAt, field.TypeTime, value)
+		_node.SpentAt = &value
+	}
+	return _node, _spec
+}
+
+// MintCreditCreateBulk is the builder for creating many MintCredit entities in bulk.
+type MintCreditCreateBulk struct {
+	config
+	err      error
+	builders []*MintCreditCreate
+}
+
+// Save creates the MintCredit entities in the database.
+func (_c *MintCreditCreateBulk) Save(ctx context.Context) ([]*MintCredit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MintCredit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MintCreditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MintCreditCreateBulk) SaveX(ctx context.Context) []*MintCredit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MintCreditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MintCreditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,532 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/mintcredit"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MintCreditUpdate is the builder for updating MintCredit entities.
+type MintCreditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MintCreditMutation
+}
+
+// Where appends a list predicates to the MintCreditUpdate builder.
+func (_u *MintCreditUpdate) Where(ps ...predicate.MintCredit) *MintCreditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *MintCreditUpdate) SetAddress(v string) *MintCreditUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *MintCreditUpdate) SetNillableAddress(v *string) *MintCreditUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *MintCreditUpdate) SetSource(v string) *MintCreditUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *MintCreditUpdate) SetNillableSource(v *string) *MintCreditUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetReferralID sets the "referral_id" field.
+func (_u *MintCreditUpdate) SetReferralID(v int) *MintCreditUpdate {
+	_u.mutation.ResetReferralID()
+	_u.mutation.SetReferralID(v)
+	return _u
+}
+
+// SetNillableReferralID sets the "referral_id" field if the given value is not nil.
+func (_u *MintCreditUpdate) SetNillableReferralID(v *int) *MintCreditUpdate {
+	if v != nil {
+		_u.SetReferralID(*v)
+	}
+	return _u
+}
+
+// AddReferralID adds value to the "referral_id" field.
+func (_u *MintCreditUpdate) AddReferralID(v int) *MintCreditUpdate {
+	_u.mutation.AddReferralID(v)
+	return _u
+}
+
+// ClearReferralID clears the value of the "referral_id" field.
+func (_u *MintCreditUpdate) ClearReferralID() *MintCreditUpdate {
+	_u.mutation.ClearReferralID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MintCreditUpdate) SetStatus(v mintcredit.Status) *MintCreditUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MintCreditUpdate) SetNillableStatus(v *mintcredit.Status) *MintCreditUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *MintCreditUpdate) SetReference(v string) *MintCreditUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *MintCreditUpdate) SetNillableReference(v *string) *MintCreditUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *MintCreditUpdate) ClearReference() *MintCreditUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetReservedAt sets the "reserved_at" field.
+func (_u *MintCreditUpdate) SetReservedAt(v time.Time) *MintCreditUpdate {
+	_u.mutation.SetReservedAt(v)
+	return _u
+}
+
+// SetNillableReservedAt sets the "reserved_at" field if the given value is not nil.
+func (_u *MintCreditUpdate) SetNillableReservedAt(v *time.Time) *MintCreditUpdate {
+	if v != nil {
+		_u.SetReservedAt(*v)
+	}
+	return _u
+}
+
+// ClearReservedAt clears the value of the "reserved_at" field.
+func (_u *MintCreditUpdate) ClearReservedAt() *MintCreditUpdate {
+	_u.mutation.ClearReservedAt()
+	return _u
+}
+
+// SetSpentAt sets the "spent_at" field.
+func (_u *MintCreditUpdate) SetSpentAt(v time.Time) *MintCreditUpdate {
+	_u.mutation.SetSpentAt(v)
+	return _u
+}
+
+// SetNillableSpentAt sets the "spent_at" field if the given value is not nil.
+func (_u *MintCreditUpdate) SetNillableSpentAt(v *time.Time) *MintCreditUpdate {
+	if v != nil {
+		_u.SetSpentAt(*v)
+	}
+	return _u
+}
+
+// ClearSpentAt clears the value of the "spent_at" field.
+func (_u *MintCreditUpdate) ClearSpentAt() *MintCreditUpdate {
+	_u.mutation.ClearSpentAt()
+	return _u
+}
+
+// Mutation returns the MintCreditMutation object of the builder.
+func (_u *MintCreditUpdate) Mutation() *MintCreditMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MintCreditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MintCreditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MintCreditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MintCreditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MintCreditUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := mintcredit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MintCredit.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MintCreditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mintcredit.Table, mintcredit.Columns, sqlgraph.NewFieldSpec(mintcredit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(mintcredit.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(mintcredit.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReferralID(); ok {
+		_spec.SetField(mintcredit.FieldReferralID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReferralID(); ok {
+		_spec.AddField(mintcredit.FieldReferralID, field.TypeInt, value)
+	}
+	if _u.mutation.ReferralIDCleared() {
+		_spec.ClearField(mintcredit.FieldReferralID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(mintcredit.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(mintcredit.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(mintcredit.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReservedAt(); ok {
+		_spec.SetField(mintcredit.FieldReservedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReservedAtCleared() {
+		_spec.ClearField(mintcredit.FieldReservedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SpentAt(); ok {
+		_spec.SetField(mintcredit.FieldSpentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SpentAtCleared() {
+		_spec.ClearField(mintcredit.FieldSpentAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mintcredit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MintCreditUpdateOne is the builder for updating a single MintCredit entity.
+type MintCreditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MintCreditMutation
+}
+
+// SetAddress sets the "address" field.
+func (_u *MintCreditUpdateOne) SetAddress(v string) *MintCreditUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *MintCreditUpdateOne) SetNillableAddress(v *string) *MintCreditUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *MintCreditUpdateOne) SetSource(v string) *MintCreditUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *MintCreditUpdateOne) SetNillableSource(v *string) *MintCreditUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetReferralID sets the "referral_id" field.
+func (_u *MintCreditUpdateOne) SetReferralID(v int) *MintCreditUpdateOne {
+	_u.mutation.ResetReferralID()
+	_u.mutation.SetReferralID(v)
+	return _u
+}
+
+// SetNillableReferralID sets the "referral_id" field if the given value is not nil.
+func (_u *MintCreditUpdateOne) SetNillableReferralID(v *int) *MintCreditUpdateOne {
+	if v != nil {
+		_u.SetReferralID(*v)
+	}
+	return _u
+}
+
+// AddReferralID adds value to the "referral_id" field.
+func (_u *MintCreditUpdateOne) AddReferralID(v int) *MintCreditUpdateOne {
+	_u.mutation.AddReferralID(v)
+	return _u
+}
+
+// ClearReferralID clears the value of the "referral_id" field.
+func (_u *MintCreditUpdateOne) ClearReferralID() *MintCreditUpdateOne {
+	_u.mutation.ClearReferralID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MintCreditUpdateOne) SetStatus(v mintcredit.Status) *MintCreditUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MintCreditUpdateOne) SetNillableStatus(v *mintcredit.Status) *MintCreditUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *MintCreditUpdateOne) SetReference(v string) *MintCreditUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *MintCreditUpdateOne) SetNillableReference(v *string) *MintCreditUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *MintCreditUpdateOne) ClearReference() *MintCreditUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetReservedAt sets the "reserved_at" field.
+func (_u *MintCreditUpdateOne) SetReservedAt(v time.Time) *MintCreditUpdateOne {
+	_u.mutation.SetReservedAt(v)
+	return _u
+}
+
+// SetNillableReservedAt sets the "reserved_at" field if the given value is not nil.
+func (_u *MintCreditUpdateOne) SetNillableReservedAt(v *time.Time) *MintCreditUpdateOne {
+	if v != nil {
+		_u.SetReservedAt(*v)
+	}
+	return _u
+}
+
+// ClearReservedAt clears the value of the "reserved_at" field.
+func (_u *MintCreditUpdateOne) ClearReservedAt() *MintCreditUpdateOne {
+	_u.mutation.ClearReservedAt()
+	return _u
+}
+
+// SetSpentAt sets the "spent_at" field.
+func (_u *MintCreditUpdateOne) SetSpentAt(v time.Time) *MintCreditUpdateOne {
+	_u.mutation.SetSpentAt(v)
+	return _u
+}
+
+// SetNillableSpentAt sets the "spent_at" field if the given value is not nil.
+func (_u *MintCreditUpdateOne) SetNillableSpentAt(v *time.Time) *MintCreditUpdateOne {
+	if v != nil {
+		_u.SetSpentAt(*v)
+	}
+	return _u
+}
+
+// ClearSpentAt clears the value of the "spent_at" field.
+func (_u *MintCreditUpdateOne) ClearSpentAt() *MintCreditUpdateOne {
+	_u.mutation.ClearSpentAt()
+	return _u
+}
+
+// Mutation returns the MintCreditMutation object of the builder.
+func (_u *MintCreditUpdateOne) Mutation() *MintCreditMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MintCreditUpdate builder.
+func (_u *MintCreditUpdateOne) Where(ps ...predicate.MintCredit) *MintCreditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MintCreditUpdateOne) Select(field string, fields ...string) *MintCreditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MintCredit entity.
+func (_u *MintCreditUpdateOne) Save(ctx context.Context) (*MintCredit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MintCreditUpdateOne) SaveX(ctx context.Context) *MintCredit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MintCreditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MintCreditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MintCreditUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := mintcredit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MintCredit.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MintCreditUpdateOne) sqlSave(ctx context.Context) (_node *MintCredit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mintcredit.Table, mintcredit.Columns, sqlgraph.NewFieldSpec(mintcredit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MintCredit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mintcredit.FieldID)
+		for _, f := range fields {
+			if !mintcredit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mintcredit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(mintcredit.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(mintcredit.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReferralID(); ok {
+		_spec.SetField(mintcredit.FieldReferralID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReferralID(); ok {
+		_spec.AddField(mintcredit.FieldReferralID, field.TypeInt, value)
+	}
+	if _u.mutation.ReferralIDCleared() {
+		_spec.ClearField(mintcredit.FieldReferralID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(mintcredit.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(mintcredit.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(mintcredit.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReservedAt(); ok {
+		_spec.SetField(mintcredit.FieldReservedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReservedAtCleared() {
+		_spec.ClearField(mintcredit.FieldReservedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SpentAt(); ok {
+		_spec.SetField(mintcredit.FieldSpentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SpentAtCleared() {
+		_spec.ClearField(mintcredit.FieldSpentAt, field.TypeTime)
+	}
+	_node = &MintCredit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mintcredit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
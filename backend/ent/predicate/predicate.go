@@ -6,29 +6,101 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// APIKeyUsage is the predicate function for apikeyusage builders.
+type APIKeyUsage func(*sql.Selector)
+
 // Attendance is the predicate function for attendance builders.
 type Attendance func(*sql.Selector)
 
+// AuthNonce is the predicate function for authnonce builders.
+type AuthNonce func(*sql.Selector)
+
+// CalendarToken is the predicate function for calendartoken builders.
+type CalendarToken func(*sql.Selector)
+
+// CheckInIntent is the predicate function for checkinintent builders.
+type CheckInIntent func(*sql.Selector)
+
+// CheckInTokenUse is the predicate function for checkintokenuse builders.
+type CheckInTokenUse func(*sql.Selector)
+
+// Claim is the predicate function for claim builders.
+type Claim func(*sql.Selector)
+
+// ClaimQuota is the predicate function for claimquota builders.
+type ClaimQuota func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
+// DataVersion is the predicate function for dataversion builders.
+type DataVersion func(*sql.Selector)
+
 // Event is the predicate function for event builders.
 type Event func(*sql.Selector)
 
+// EventChange is the predicate function for eventchange builders.
+type EventChange func(*sql.Selector)
+
+// EventImportRow is the predicate function for eventimportrow builders.
+type EventImportRow func(*sql.Selector)
+
 // EventPass is the predicate function for eventpass builders.
 type EventPass func(*sql.Selector)
 
+// EventSeries is the predicate function for eventseries builders.
+type EventSeries func(*sql.Selector)
+
+// EventStaff is the predicate function for eventstaff builders.
+type EventStaff func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// InviteCode is the predicate function for invitecode builders.
+type InviteCode func(*sql.Selector)
+
+// JoinLink is the predicate function for joinlink builders.
+type JoinLink func(*sql.Selector)
+
+// KioskDevice is the predicate function for kioskdevice builders.
+type KioskDevice func(*sql.Selector)
+
 // Like is the predicate function for like builders.
 type Like func(*sql.Selector)
 
 // Listing is the predicate function for listing builders.
 type Listing func(*sql.Selector)
 
+// LocationFix is the predicate function for locationfix builders.
+type LocationFix func(*sql.Selector)
+
+// MintCredit is the predicate function for mintcredit builders.
+type MintCredit func(*sql.Selector)
+
 // NFTAccessory is the predicate function for nftaccessory builders.
 type NFTAccessory func(*sql.Selector)
 
 // NFTMoment is the predicate function for nftmoment builders.
 type NFTMoment func(*sql.Selector)
 
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// Referral is the predicate function for referral builders.
+type Referral func(*sql.Selector)
+
+// SeriesOccurrence is the predicate function for seriesoccurrence builders.
+type SeriesOccurrence func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WaitlistEntry is the predicate function for waitlistentry builders.
+type WaitlistEntry func(*sql.Selector)
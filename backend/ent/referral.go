@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/referral"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Referral is the model entity for the Referral schema.
+type Referral struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// InviterAddress holds the value of the "inviter_address" field.
+	InviterAddress string `json:"inviter_address,omitempty"`
+	// InviteeAddress holds the value of the "invitee_address" field.
+	InviteeAddress string `json:"invitee_address,omitempty"`
+	// Status holds the value of the "status" field.
+	Status referral.Status `json:"status,omitempty"`
+	// QualifyingEventID holds the value of the "qualifying_event_id" field.
+	QualifyingEventID *uint64 `json:"qualifying_event_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// QualifiedAt holds the value of the "qualified_at" field.
+	QualifiedAt  *time.Time `json:"qualified_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Referral) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldID, referral.FieldQualifyingEventID:
+			values[i] = new(sql.NullInt64)
+		case referral.FieldCode, referral.FieldInviterAddress, referral.FieldInviteeAddress, referral.FieldStatus:
+			values[i] = new(sql.NullString)
+		case referral.FieldCreatedAt, referral.FieldQualifiedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Referral fields.
+func (_m *Referral) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case referral.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case referral.FieldInviterAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field inviter_address", values[i])
+			} else if value.Valid {
+				_m.InviterAddress = value.String
+			}
+		case referral.FieldInviteeAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invitee_address", values[i])
+			} else if value.Valid {
+				_m.InviteeAddress = value.String
+			}
+		case referral.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = referral.Status(value.String)
+			}
+		case referral.FieldQualifyingEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field qualifying_event_id", values[i])
+			} else if value.Valid {
+				_m.QualifyingEventID = new(uint64)
+				*_m.QualifyingEventID = uint64(value.Int64)
+			}
+		case referral.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case referral.FieldQualifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field qualified_at", values[i])
+			} else if value.Valid {
+				_m.QualifiedAt = new(time.Time)
+				*_m.QualifiedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Referral.
+// This includes values selected through modifiers, order, etc.
+func (_m *Referral) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Referral.
+// Note that you need to call Referral.Unwrap() before calling this method if this Referral
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Referral) Update() *ReferralUpdateOne {
+	return NewReferralClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Referral entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Referral) Unwrap() *Referral {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Referral is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Referral) String() string {
+	var builder strings.Builder
+	builder.WriteString("Referral(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("inviter_address=")
+	builder.WriteString(_m.InviterAddress)
+	builder.WriteString(", ")
+	builder.WriteString("invitee_address=")
+	builder.WriteString(_m.InviteeAddress)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.QualifyingEventID; v != nil {
+		builder.WriteString("qualifying_event_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.QualifiedAt; v != nil {
+		builder.WriteString("qualified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Referrals is a parsable slice of Referral.
+type Referrals []*Referral
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the referral type in the database.
+	Label = "referral"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldInviterAddress holds the string denoting the inviter_address field in the database.
+	FieldInviterAddress = "inviter_address"
+	// FieldInviteeAddress holds the string denoting the invitee_address field in the database.
+	FieldInviteeAddress = "invitee_address"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldQualifyingEventID holds the string denoting the qualifying_event_id field in the database.
+	FieldQualifyingEventID = "qualifying_event_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldQualifiedAt holds the string denoting the qualified_at field in the database.
+	FieldQualifiedAt = "qualified_at"
+	// Table holds the table name of the referral in the database.
+	Table = "referrals"
+)
+
+// Columns holds all SQL columns for referral fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldInviterAddress,
+	FieldInviteeAddress,
+	FieldStatus,
+	FieldQualifyingEventID,
+	FieldCreatedAt,
+	FieldQualifiedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusQualified Status = "qualified"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusQualified:
+		return nil
+	default:
+		return fmt.Errorf("referral: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Referral queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByInviterAddress orders the results by the inviter_address field.
+func ByInviterAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInviterAddress, opts...).ToFunc()
+}
+
+// ByInviteeAddress orders the results by the invitee_address field.
+func ByInviteeAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInviteeAddress, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByQualifyingEventID orders the results by the qualifying_event_id field.
+func ByQualifyingEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQualifyingEventID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByQualifiedAt orders the results by the qualified_at field.
+func ByQualifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQualifiedAt, opts...).ToFunc()
+}
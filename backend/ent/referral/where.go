@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCode, v))
+}
+
+// InviterAddress applies equality check predicate on the "inviter_address" field. It's identical to InviterAddressEQ.
+func InviterAddress(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldInviterAddress, v))
+}
+
+// InviteeAddress applies equality check predicate on the "invitee_address" field. It's identical to InviteeAddressEQ.
+func InviteeAddress(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldInviteeAddress, v))
+}
+
+// QualifyingEventID applies equality check predicate on the "qualifying_event_id" field. It's identical to QualifyingEventIDEQ.
+func QualifyingEventID(v uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldQualifyingEventID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// QualifiedAt applies equality check predicate on the "qualified_at" field. It's identical to QualifiedAtEQ.
+func QualifiedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldQualifiedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldCode, v))
+}
+
+// InviterAddressEQ applies the EQ predicate on the "inviter_address" field.
+func InviterAddressEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldInviterAddress, v))
+}
+
+// InviterAddressNEQ applies the NEQ predicate on the "inviter_address" field.
+func InviterAddressNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldInviterAddress, v))
+}
+
+// InviterAddressIn applies the In predicate on the "inviter_address" field.
+func InviterAddressIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldInviterAddress, vs...))
+}
+
+// InviterAddressNotIn applies the NotIn predicate on the "inviter_address" field.
+func InviterAddressNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldInviterAddress, vs...))
+}
+
+// InviterAddressGT applies the GT predicate on the "inviter_address" field.
+func InviterAddressGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldInviterAddress, v))
+}
+
+// InviterAddressGTE applies the GTE predicate on the "inviter_address" field.
+func InviterAddressGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldInviterAddress, v))
+}
+
+// InviterAddressLT applies the LT predicate on the "inviter_address" field.
+func InviterAddressLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldInviterAddress, v))
+}
+
+// InviterAddressLTE applies the LTE predicate on the "inviter_address" field.
+func InviterAddressLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldInviterAddress, v))
+}
+
+// InviterAddressContains applies the Contains predicate on the "inviter_address" field.
+func InviterAddressContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldInviterAddress, v))
+}
+
+// InviterAddressHasPrefix applies the HasPrefix predicate on the "inviter_address" field.
+func InviterAddressHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldInviterAddress, v))
+}
+
+// InviterAddressHasSuffix applies the HasSuffix predicate on the "inviter_address" field.
+func InviterAddressHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldInviterAddress, v))
+}
+
+// InviterAddressEqualFold applies the EqualFold predicate on the "inviter_address" field.
+func InviterAddressEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldInviterAddress, v))
+}
+
+// InviterAddressContainsFold applies the ContainsFold predicate on the "inviter_address" field.
+func InviterAddressContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldInviterAddress, v))
+}
+
+// InviteeAddressEQ applies the EQ predicate on the "invitee_address" field.
+func InviteeAddressEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldInviteeAddress, v))
+}
+
+// InviteeAddressNEQ applies the NEQ predicate on the "invitee_address" field.
+func InviteeAddressNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldInviteeAddress, v))
+}
+
+// InviteeAddressIn applies the In predicate on the "invitee_address" field.
+func InviteeAddressIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldInviteeAddress, vs...))
+}
+
+// InviteeAddressNotIn applies the NotIn predicate on the "invitee_address" field.
+func InviteeAddressNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldInviteeAddress, vs...))
+}
+
+// InviteeAddressGT applies the GT predicate on the "invitee_address" field.
+func InviteeAddressGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldInviteeAddress, v))
+}
+
+// InviteeAddressGTE applies the GTE predicate on the "invitee_address" field.
+func InviteeAddressGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldInviteeAddress, v))
+}
+
+// InviteeAddressLT applies the LT predicate on the "invitee_address" field.
+func InviteeAddressLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldInviteeAddress, v))
+}
+
+// InviteeAddressLTE applies the LTE predicate on the "invitee_address" field.
+func InviteeAddressLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldInviteeAddress, v))
+}
+
+// InviteeAddressContains applies the Contains predicate on the "invitee_address" field.
+func InviteeAddressContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldInviteeAddress, v))
+}
+
+// InviteeAddressHasPrefix applies the HasPrefix predicate on the "invitee_address" field.
+func InviteeAddressHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldInviteeAddress, v))
+}
+
+// InviteeAddressHasSuffix applies the HasSuffix predicate on the "invitee_address" field.
+func InviteeAddressHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldInviteeAddress, v))
+}
+
+// InviteeAddressEqualFold applies the EqualFold predicate on the "invitee_address" field.
+func InviteeAddressEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldInviteeAddress, v))
+}
+
+// InviteeAddressContainsFold applies the ContainsFold predicate on the "invitee_address" field.
+func InviteeAddressContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldInviteeAddress, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// QualifyingEventIDEQ applies the EQ predicate on the "qualifying_event_id" field.
+func QualifyingEventIDEQ(v uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldQualifyingEventID, v))
+}
+
+// QualifyingEventIDNEQ applies the NEQ predicate on the "qualifying_event_id" field.
+func QualifyingEventIDNEQ(v uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldQualifyingEventID, v))
+}
+
+// QualifyingEventIDIn applies the In predicate on the "qualifying_event_id" field.
+func QualifyingEventIDIn(vs ...uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldQualifyingEventID, vs...))
+}
+
+// QualifyingEventIDNotIn applies the NotIn predicate on the "qualifying_event_id" field.
+func QualifyingEventIDNotIn(vs ...uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldQualifyingEventID, vs...))
+}
+
+// QualifyingEventIDGT applies the GT predicate on the "qualifying_event_id" field.
+func QualifyingEventIDGT(v uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldQualifyingEventID, v))
+}
+
+// QualifyingEventIDGTE applies the GTE predicate on the "qualifying_event_id" field.
+func QualifyingEventIDGTE(v uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldQualifyingEventID, v))
+}
+
+// QualifyingEventIDLT applies the LT predicate on the "qualifying_event_id" field.
+func QualifyingEventIDLT(v uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldQualifyingEventID, v))
+}
+
+// QualifyingEventIDLTE applies the LTE predicate on the "qualifying_event_id" field.
+func QualifyingEventIDLTE(v uint64) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldQualifyingEventID, v))
+}
+
+// QualifyingEventIDIsNil applies the IsNil predicate on the "qualifying_event_id" field.
+func QualifyingEventIDIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldQualifyingEventID))
+}
+
+// QualifyingEventIDNotNil applies the NotNil predicate on the "qualifying_event_id" field.
+func QualifyingEventIDNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldQualifyingEventID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// QualifiedAtEQ applies the EQ predicate on the "qualified_at" field.
+func QualifiedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldQualifiedAt, v))
+}
+
+// QualifiedAtNEQ applies the NEQ predicate on the "qualified_at" field.
+func QualifiedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldQualifiedAt, v))
+}
+
+// QualifiedAtIn applies the In predicate on the "qualified_at" field.
+func QualifiedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldQualifiedAt, vs...))
+}
+
+// QualifiedAtNotIn applies the NotIn predicate on the "qualified_at" field.
+func QualifiedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldQualifiedAt, vs...))
+}
+
+// QualifiedAtGT applies the GT predicate on the "qualified_at" field.
+func QualifiedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldQualifiedAt, v))
+}
+
+// QualifiedAtGTE applies the GTE predicate on the "qualified_at" field.
+func QualifiedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldQualifiedAt, v))
+}
+
+// QualifiedAtLT applies the LT predicate on the "qualified_at" field.
+func QualifiedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldQualifiedAt, v))
+}
+
+// QualifiedAtLTE applies the LTE predicate on the "qualified_at" field.
+func QualifiedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldQualifiedAt, v))
+}
+
+// QualifiedAtIsNil applies the IsNil predicate on the "qualified_at" field.
+func QualifiedAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldQualifiedAt))
+}
+
+// QualifiedAtNotNil applies the NotNil predicate on the "qualified_at" field.
+func QualifiedAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldQualifiedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.NotPredicates(p))
+}
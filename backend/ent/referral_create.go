@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/referral"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReferralCreate is the builder for creating a Referral entity.
+type ReferralCreate struct {
+	config
+	mutation *ReferralMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *ReferralCreate) SetCode(v string) *ReferralCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetInviterAddress sets the "inviter_address" field.
+func (_c *ReferralCreate) SetInviterAddress(v string) *ReferralCreate {
+	_c.mutation.SetInviterAddress(v)
+	return _c
+}
+
+// SetInviteeAddress sets the "invitee_address" field.
+func (_c *ReferralCreate) SetInviteeAddress(v string) *ReferralCreate {
+	_c.mutation.SetInviteeAddress(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReferralCreate) SetStatus(v referral.Status) *ReferralCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableStatus(v *referral.Status) *ReferralCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetQualifyingEventID sets the "qualifying_event_id" field.
+func (_c *ReferralCreate) SetQualifyingEventID(v uint64) *ReferralCreate {
+	_c.mutation.SetQualifyingEventID(v)
+	return _c
+}
+
+// SetNillableQualifyingEventID sets the "qualifying_event_id" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableQualifyingEventID(v *uint64) *ReferralCreate {
+	if v != nil {
+		_c.SetQualifyingEventID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReferralCreate) SetCreatedAt(v time.Time) *ReferralCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableCreatedAt(v *time.Time) *ReferralCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (_c *ReferralCreate) SetQualifiedAt(v time.Time) *ReferralCreate {
+	_c.mutation.SetQualifiedAt(v)
+	return _c
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableQualifiedAt(v *time.Time) *ReferralCreate {
+	if v != nil {
+		_c.SetQualifiedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_c *ReferralCreate) Mutation() *ReferralMutation {
+	return _c.mutation
+}
+
+// Save creates the Referral in the database.
+func (_c *ReferralCreate) Save(ctx context.Context) (*Referral, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReferralCreate) SaveX(ctx context.Context) *Referral {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReferralCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReferralCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReferralCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := referral.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := referral.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReferralCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Referral.code"`)}
+	}
+	if _, ok := _c.mutation.InviterAddress(); !ok {
+		return &ValidationError{Name: "inviter_address", err: errors.New(`ent: missing required field "Referral.inviter_address"`)}
+	}
+	if _, ok := _c.mutation.InviteeAddress(); !ok {
+		return &ValidationError{Name: "invitee_address", err: errors.New(`ent: missing required field "Referral.invitee_address"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Referral.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := referral.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Referral.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Referral.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReferralCreate) sqlSave(ctx context.Context) (*Referral, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReferralCreate) createSpec() (*Referral, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Referral{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(referral.Table, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(referral.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.InviterAddress(); ok {
+		_spec.SetField(referral.FieldInviterAddress, field.TypeString, value)
+		_node.InviterAddress = value
+	}
+	if value, ok := _c.mutation.InviteeAddress(); ok {
+		_spec.SetField(referral.FieldInviteeAddress, field.TypeString, value)
+		_node.InviteeAddress = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.QualifyingEventID(); ok {
+		_spec.SetField(referral.FieldQualifyingEventID, field.TypeUint64, value)
+		_node.QualifyingEventID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(referral.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+		_node.QualifiedAt = &value
+	}
+	return _node, _spec
+}
+
+// ReferralCreateBulk is the builder for creating many Referral entities in bulk.
+type ReferralCreateBulk struct {
+	config
+	err      error
+	builders []*ReferralCreate
+}
+
+// Save creates the Referral entities in the database.
+func (_c *ReferralCreateBulk) Save(ctx context.Context) ([]*Referral, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Referral, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReferralMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReferralCreateBulk) SaveX(ctx context.Context) []*Referral {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReferralCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReferralCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
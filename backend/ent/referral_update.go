@@ -0,0 +1,462 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/referral"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReferralUpdate is the builder for updating Referral entities.
+type ReferralUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (_u *ReferralUpdate) Where(ps ...predicate.Referral) *ReferralUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ReferralUpdate) SetCode(v string) *ReferralUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableCode(v *string) *ReferralUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetInviterAddress sets the "inviter_address" field.
+func (_u *ReferralUpdate) SetInviterAddress(v string) *ReferralUpdate {
+	_u.mutation.SetInviterAddress(v)
+	return _u
+}
+
+// SetNillableInviterAddress sets the "inviter_address" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableInviterAddress(v *string) *ReferralUpdate {
+	if v != nil {
+		_u.SetInviterAddress(*v)
+	}
+	return _u
+}
+
+// SetInviteeAddress sets the "invitee_address" field.
+func (_u *ReferralUpdate) SetInviteeAddress(v string) *ReferralUpdate {
+	_u.mutation.SetInviteeAddress(v)
+	return _u
+}
+
+// SetNillableInviteeAddress sets the "invitee_address" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableInviteeAddress(v *string) *ReferralUpdate {
+	if v != nil {
+		_u.SetInviteeAddress(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReferralUpdate) SetStatus(v referral.Status) *ReferralUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableStatus(v *referral.Status) *ReferralUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetQualifyingEventID sets the "qualifying_event_id" field.
+func (_u *ReferralUpdate) SetQualifyingEventID(v uint64) *ReferralUpdate {
+	_u.mutation.ResetQualifyingEventID()
+	_u.mutation.SetQualifyingEventID(v)
+	return _u
+}
+
+// SetNillableQualifyingEventID sets the "qualifying_event_id" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableQualifyingEventID(v *uint64) *ReferralUpdate {
+	if v != nil {
+		_u.SetQualifyingEventID(*v)
+	}
+	return _u
+}
+
+// AddQualifyingEventID adds value to the "qualifying_event_id" field.
+func (_u *ReferralUpdate) AddQualifyingEventID(v int64) *ReferralUpdate {
+	_u.mutation.AddQualifyingEventID(v)
+	return _u
+}
+
+// ClearQualifyingEventID clears the value of the "qualifying_event_id" field.
+func (_u *ReferralUpdate) ClearQualifyingEventID() *ReferralUpdate {
+	_u.mutation.ClearQualifyingEventID()
+	return _u
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (_u *ReferralUpdate) SetQualifiedAt(v time.Time) *ReferralUpdate {
+	_u.mutation.SetQualifiedAt(v)
+	return _u
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (_u *ReferralUpdate) SetNillableQualifiedAt(v *time.Time) *ReferralUpdate {
+	if v != nil {
+		_u.SetQualifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearQualifiedAt clears the value of the "qualified_at" field.
+func (_u *ReferralUpdate) ClearQualifiedAt() *ReferralUpdate {
+	_u.mutation.ClearQualifiedAt()
+	return _u
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_u *ReferralUpdate) Mutation() *ReferralMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReferralUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReferralUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReferralUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReferralUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReferralUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := referral.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Referral.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReferralUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(referral.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InviterAddress(); ok {
+		_spec.SetField(referral.FieldInviterAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InviteeAddress(); ok {
+		_spec.SetField(referral.FieldInviteeAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QualifyingEventID(); ok {
+		_spec.SetField(referral.FieldQualifyingEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedQualifyingEventID(); ok {
+		_spec.AddField(referral.FieldQualifyingEventID, field.TypeUint64, value)
+	}
+	if _u.mutation.QualifyingEventIDCleared() {
+		_spec.ClearField(referral.FieldQualifyingEventID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.QualifiedAtCleared() {
+		_spec.ClearField(referral.FieldQualifiedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReferralUpdateOne is the builder for updating a single Referral entity.
+type ReferralUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *ReferralUpdateOne) SetCode(v string) *ReferralUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableCode(v *string) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetInviterAddress sets the "inviter_address" field.
+func (_u *ReferralUpdateOne) SetInviterAddress(v string) *ReferralUpdateOne {
+	_u.mutation.SetInviterAddress(v)
+	return _u
+}
+
+// SetNillableInviterAddress sets the "inviter_address" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableInviterAddress(v *string) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetInviterAddress(*v)
+	}
+	return _u
+}
+
+// SetInviteeAddress sets the "invitee_address" field.
+func (_u *ReferralUpdateOne) SetInviteeAddress(v string) *ReferralUpdateOne {
+	_u.mutation.SetInviteeAddress(v)
+	return _u
+}
+
+// SetNillableInviteeAddress sets the "invitee_address" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableInviteeAddress(v *string) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetInviteeAddress(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReferralUpdateOne) SetStatus(v referral.Status) *ReferralUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableStatus(v *referral.Status) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetQualifyingEventID sets the "qualifying_event_id" field.
+func (_u *ReferralUpdateOne) SetQualifyingEventID(v uint64) *ReferralUpdateOne {
+	_u.mutation.ResetQualifyingEventID()
+	_u.mutation.SetQualifyingEventID(v)
+	return _u
+}
+
+// SetNillableQualifyingEventID sets the "qualifying_event_id" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableQualifyingEventID(v *uint64) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetQualifyingEventID(*v)
+	}
+	return _u
+}
+
+// AddQualifyingEventID adds value to the "qualifying_event_id" field.
+func (_u *ReferralUpdateOne) AddQualifyingEventID(v int64) *ReferralUpdateOne {
+	_u.mutation.AddQualifyingEventID(v)
+	return _u
+}
+
+// ClearQualifyingEventID clears the value of the "qualifying_event_id" field.
+func (_u *ReferralUpdateOne) ClearQualifyingEventID() *ReferralUpdateOne {
+	_u.mutation.ClearQualifyingEventID()
+	return _u
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (_u *ReferralUpdateOne) SetQualifiedAt(v time.Time) *ReferralUpdateOne {
+	_u.mutation.SetQualifiedAt(v)
+	return _u
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (_u *ReferralUpdateOne) SetNillableQualifiedAt(v *time.Time) *ReferralUpdateOne {
+	if v != nil {
+		_u.SetQualifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearQualifiedAt clears the value of the "qualified_at" field.
+func (_u *ReferralUpdateOne) ClearQualifiedAt() *ReferralUpdateOne {
+	_u.mutation.ClearQualifiedAt()
+	return _u
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_u *ReferralUpdateOne) Mutation() *ReferralMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (_u *ReferralUpdateOne) Where(ps ...predicate.Referral) *ReferralUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReferralUpdateOne) Select(field string, fields ...string) *ReferralUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Referral entity.
+func (_u *ReferralUpdateOne) Save(ctx context.Context) (*Referral, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReferralUpdateOne) SaveX(ctx context.Context) *Referral {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReferralUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReferralUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReferralUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := referral.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Referral.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReferralUpdateOne) sqlSave(ctx context.Context) (_node *Referral, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Referral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referral.FieldID)
+		for _, f := range fields {
+			if !referral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != referral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(referral.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InviterAddress(); ok {
+		_spec.SetField(referral.FieldInviterAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InviteeAddress(); ok {
+		_spec.SetField(referral.FieldInviteeAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QualifyingEventID(); ok {
+		_spec.SetField(referral.FieldQualifyingEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedQualifyingEventID(); ok {
+		_spec.AddField(referral.FieldQualifyingEventID, field.TypeUint64, value)
+	}
+	if _u.mutation.QualifyingEventIDCleared() {
+		_spec.ClearField(referral.FieldQualifyingEventID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.QualifiedAtCleared() {
+		_spec.ClearField(referral.FieldQualifiedAt, field.TypeTime)
+	}
+	_node = &Referral{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,13 +3,38 @@
 package ent
 
 import (
+	"backend/ent/apikey"
+	"backend/ent/apikeyusage"
 	"backend/ent/attendance"
+	"backend/ent/authnonce"
+	"backend/ent/calendartoken"
+	"backend/ent/checkinintent"
+	"backend/ent/checkintokenuse"
+	"backend/ent/claim"
+	"backend/ent/claimquota"
 	"backend/ent/comment"
+	"backend/ent/dataversion"
+	"backend/ent/event"
+	"backend/ent/eventchange"
+	"backend/ent/eventimportrow"
 	"backend/ent/eventpass"
+	"backend/ent/eventseries"
+	"backend/ent/eventstaff"
+	"backend/ent/idempotencykey"
+	"backend/ent/invitecode"
+	"backend/ent/joinlink"
+	"backend/ent/kioskdevice"
 	"backend/ent/like"
+	"backend/ent/locationfix"
+	"backend/ent/mintcredit"
 	"backend/ent/nftmoment"
+	"backend/ent/notification"
+	"backend/ent/referral"
 	"backend/ent/schema"
+	"backend/ent/seriesoccurrence"
+	"backend/ent/session"
 	"backend/ent/user"
+	"backend/ent/waitlistentry"
 	"time"
 )
 
@@ -17,6 +42,34 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescRatePerMinute is the schema descriptor for rate_per_minute field.
+	apikeyDescRatePerMinute := apikeyFields[5].Descriptor()
+	// apikey.DefaultRatePerMinute holds the default value on creation for the rate_per_minute field.
+	apikey.DefaultRatePerMinute = apikeyDescRatePerMinute.Default.(int)
+	// apikeyDescDailyQuota is the schema descriptor for daily_quota field.
+	apikeyDescDailyQuota := apikeyFields[6].Descriptor()
+	// apikey.DefaultDailyQuota holds the default value on creation for the daily_quota field.
+	apikey.DefaultDailyQuota = apikeyDescDailyQuota.Default.(int)
+	// apikeyDescTotalRequests is the schema descriptor for total_requests field.
+	apikeyDescTotalRequests := apikeyFields[7].Descriptor()
+	// apikey.DefaultTotalRequests holds the default value on creation for the total_requests field.
+	apikey.DefaultTotalRequests = apikeyDescTotalRequests.Default.(int64)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[9].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	apikeyusageFields := schema.APIKeyUsage{}.Fields()
+	_ = apikeyusageFields
+	// apikeyusageDescCount is the schema descriptor for count field.
+	apikeyusageDescCount := apikeyusageFields[2].Descriptor()
+	// apikeyusage.DefaultCount holds the default value on creation for the count field.
+	apikeyusage.DefaultCount = apikeyusageDescCount.Default.(int)
 	attendanceFields := schema.Attendance{}.Fields()
 	_ = attendanceFields
 	// attendanceDescCheckedIn is the schema descriptor for checked_in field.
@@ -27,6 +80,66 @@ func init() {
 	attendanceDescRegistrationTime := attendanceFields[1].Descriptor()
 	// attendance.DefaultRegistrationTime holds the default value on creation for the registration_time field.
 	attendance.DefaultRegistrationTime = attendanceDescRegistrationTime.Default.(func() time.Time)
+	authnonceFields := schema.AuthNonce{}.Fields()
+	_ = authnonceFields
+	// authnonceDescCreatedAt is the schema descriptor for created_at field.
+	authnonceDescCreatedAt := authnonceFields[2].Descriptor()
+	// authnonce.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authnonce.DefaultCreatedAt = authnonceDescCreatedAt.Default.(func() time.Time)
+	calendartokenFields := schema.CalendarToken{}.Fields()
+	_ = calendartokenFields
+	// calendartokenDescCreatedAt is the schema descriptor for created_at field.
+	calendartokenDescCreatedAt := calendartokenFields[2].Descriptor()
+	// calendartoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	calendartoken.DefaultCreatedAt = calendartokenDescCreatedAt.Default.(func() time.Time)
+	checkinintentFields := schema.CheckInIntent{}.Fields()
+	_ = checkinintentFields
+	// checkinintentDescAttempts is the schema descriptor for attempts field.
+	checkinintentDescAttempts := checkinintentFields[5].Descriptor()
+	// checkinintent.DefaultAttempts holds the default value on creation for the attempts field.
+	checkinintent.DefaultAttempts = checkinintentDescAttempts.Default.(int)
+	// checkinintentDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	checkinintentDescNextAttemptAt := checkinintentFields[7].Descriptor()
+	// checkinintent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	checkinintent.DefaultNextAttemptAt = checkinintentDescNextAttemptAt.Default.(func() time.Time)
+	// checkinintentDescCreatedAt is the schema descriptor for created_at field.
+	checkinintentDescCreatedAt := checkinintentFields[8].Descriptor()
+	// checkinintent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	checkinintent.DefaultCreatedAt = checkinintentDescCreatedAt.Default.(func() time.Time)
+	// checkinintentDescUpdatedAt is the schema descriptor for updated_at field.
+	checkinintentDescUpdatedAt := checkinintentFields[9].Descriptor()
+	// checkinintent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	checkinintent.DefaultUpdatedAt = checkinintentDescUpdatedAt.Default.(func() time.Time)
+	// checkinintent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	checkinintent.UpdateDefaultUpdatedAt = checkinintentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	checkintokenuseFields := schema.CheckInTokenUse{}.Fields()
+	_ = checkintokenuseFields
+	// checkintokenuseDescUsedAt is the schema descriptor for used_at field.
+	checkintokenuseDescUsedAt := checkintokenuseFields[3].Descriptor()
+	// checkintokenuse.DefaultUsedAt holds the default value on creation for the used_at field.
+	checkintokenuse.DefaultUsedAt = checkintokenuseDescUsedAt.Default.(func() time.Time)
+	claimFields := schema.Claim{}.Fields()
+	_ = claimFields
+	// claimDescCreatedAt is the schema descriptor for created_at field.
+	claimDescCreatedAt := claimFields[7].Descriptor()
+	// claim.DefaultCreatedAt holds the default value on creation for the created_at field.
+	claim.DefaultCreatedAt = claimDescCreatedAt.Default.(func() time.Time)
+	claimquotaFields := schema.ClaimQuota{}.Fields()
+	_ = claimquotaFields
+	// claimquotaDescPerSubjectLimit is the schema descriptor for per_subject_limit field.
+	claimquotaDescPerSubjectLimit := claimquotaFields[2].Descriptor()
+	// claimquota.DefaultPerSubjectLimit holds the default value on creation for the per_subject_limit field.
+	claimquota.DefaultPerSubjectLimit = claimquotaDescPerSubjectLimit.Default.(int)
+	// claimquotaDescTotalLimit is the schema descriptor for total_limit field.
+	claimquotaDescTotalLimit := claimquotaFields[3].Descriptor()
+	// claimquota.DefaultTotalLimit holds the default value on creation for the total_limit field.
+	claimquota.DefaultTotalLimit = claimquotaDescTotalLimit.Default.(int)
+	// claimquotaDescUpdatedAt is the schema descriptor for updated_at field.
+	claimquotaDescUpdatedAt := claimquotaFields[6].Descriptor()
+	// claimquota.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	claimquota.DefaultUpdatedAt = claimquotaDescUpdatedAt.Default.(func() time.Time)
+	// claimquota.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	claimquota.UpdateDefaultUpdatedAt = claimquotaDescUpdatedAt.UpdateDefault.(func() time.Time)
 	commentFields := schema.Comment{}.Fields()
 	_ = commentFields
 	// commentDescContent is the schema descriptor for content field.
@@ -43,18 +156,160 @@ func init() {
 	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
 	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	dataversionFields := schema.DataVersion{}.Fields()
+	_ = dataversionFields
+	// dataversionDescVersion is the schema descriptor for version field.
+	dataversionDescVersion := dataversionFields[1].Descriptor()
+	// dataversion.DefaultVersion holds the default value on creation for the version field.
+	dataversion.DefaultVersion = dataversionDescVersion.Default.(int64)
+	// dataversionDescUpdatedAt is the schema descriptor for updated_at field.
+	dataversionDescUpdatedAt := dataversionFields[2].Descriptor()
+	// dataversion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dataversion.DefaultUpdatedAt = dataversionDescUpdatedAt.Default.(func() time.Time)
+	// dataversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dataversion.UpdateDefaultUpdatedAt = dataversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescCheckinRadiusM is the schema descriptor for checkin_radius_m field.
+	eventDescCheckinRadiusM := eventFields[11].Descriptor()
+	// event.DefaultCheckinRadiusM holds the default value on creation for the checkin_radius_m field.
+	event.DefaultCheckinRadiusM = eventDescCheckinRadiusM.Default.(float64)
+	// eventDescRevision is the schema descriptor for revision field.
+	eventDescRevision := eventFields[14].Descriptor()
+	// event.DefaultRevision holds the default value on creation for the revision field.
+	event.DefaultRevision = eventDescRevision.Default.(int)
+	eventchangeFields := schema.EventChange{}.Fields()
+	_ = eventchangeFields
+	// eventchangeDescCreatedAt is the schema descriptor for created_at field.
+	eventchangeDescCreatedAt := eventchangeFields[6].Descriptor()
+	// eventchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventchange.DefaultCreatedAt = eventchangeDescCreatedAt.Default.(func() time.Time)
+	eventimportrowFields := schema.EventImportRow{}.Fields()
+	_ = eventimportrowFields
+	// eventimportrowDescDescription is the schema descriptor for description field.
+	eventimportrowDescDescription := eventimportrowFields[5].Descriptor()
+	// eventimportrow.DefaultDescription holds the default value on creation for the description field.
+	eventimportrow.DefaultDescription = eventimportrowDescDescription.Default.(string)
+	// eventimportrowDescGeocoded is the schema descriptor for geocoded field.
+	eventimportrowDescGeocoded := eventimportrowFields[12].Descriptor()
+	// eventimportrow.DefaultGeocoded holds the default value on creation for the geocoded field.
+	eventimportrow.DefaultGeocoded = eventimportrowDescGeocoded.Default.(bool)
+	// eventimportrowDescAttempts is the schema descriptor for attempts field.
+	eventimportrowDescAttempts := eventimportrowFields[18].Descriptor()
+	// eventimportrow.DefaultAttempts holds the default value on creation for the attempts field.
+	eventimportrow.DefaultAttempts = eventimportrowDescAttempts.Default.(int)
+	// eventimportrowDescCreatedAt is the schema descriptor for created_at field.
+	eventimportrowDescCreatedAt := eventimportrowFields[20].Descriptor()
+	// eventimportrow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventimportrow.DefaultCreatedAt = eventimportrowDescCreatedAt.Default.(func() time.Time)
+	// eventimportrowDescUpdatedAt is the schema descriptor for updated_at field.
+	eventimportrowDescUpdatedAt := eventimportrowFields[21].Descriptor()
+	// eventimportrow.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventimportrow.DefaultUpdatedAt = eventimportrowDescUpdatedAt.Default.(func() time.Time)
+	// eventimportrow.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventimportrow.UpdateDefaultUpdatedAt = eventimportrowDescUpdatedAt.UpdateDefault.(func() time.Time)
 	eventpassFields := schema.EventPass{}.Fields()
 	_ = eventpassFields
 	// eventpassDescIsUsed is the schema descriptor for is_used field.
 	eventpassDescIsUsed := eventpassFields[5].Descriptor()
 	// eventpass.DefaultIsUsed holds the default value on creation for the is_used field.
 	eventpass.DefaultIsUsed = eventpassDescIsUsed.Default.(bool)
+	eventseriesFields := schema.EventSeries{}.Fields()
+	_ = eventseriesFields
+	// eventseriesDescDescription is the schema descriptor for description field.
+	eventseriesDescDescription := eventseriesFields[2].Descriptor()
+	// eventseries.DefaultDescription holds the default value on creation for the description field.
+	eventseries.DefaultDescription = eventseriesDescDescription.Default.(string)
+	// eventseriesDescLat is the schema descriptor for lat field.
+	eventseriesDescLat := eventseriesFields[7].Descriptor()
+	// eventseries.DefaultLat holds the default value on creation for the lat field.
+	eventseries.DefaultLat = eventseriesDescLat.Default.(float64)
+	// eventseriesDescLong is the schema descriptor for long field.
+	eventseriesDescLong := eventseriesFields[8].Descriptor()
+	// eventseries.DefaultLong holds the default value on creation for the long field.
+	eventseries.DefaultLong = eventseriesDescLong.Default.(float64)
+	// eventseriesDescLeadDays is the schema descriptor for lead_days field.
+	eventseriesDescLeadDays := eventseriesFields[14].Descriptor()
+	// eventseries.DefaultLeadDays holds the default value on creation for the lead_days field.
+	eventseries.DefaultLeadDays = eventseriesDescLeadDays.Default.(int)
+	// eventseriesDescCreatedAt is the schema descriptor for created_at field.
+	eventseriesDescCreatedAt := eventseriesFields[16].Descriptor()
+	// eventseries.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventseries.DefaultCreatedAt = eventseriesDescCreatedAt.Default.(func() time.Time)
+	// eventseriesDescUpdatedAt is the schema descriptor for updated_at field.
+	eventseriesDescUpdatedAt := eventseriesFields[17].Descriptor()
+	// eventseries.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventseries.DefaultUpdatedAt = eventseriesDescUpdatedAt.Default.(func() time.Time)
+	// eventseries.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventseries.UpdateDefaultUpdatedAt = eventseriesDescUpdatedAt.UpdateDefault.(func() time.Time)
+	eventstaffFields := schema.EventStaff{}.Fields()
+	_ = eventstaffFields
+	// eventstaffDescCreatedAt is the schema descriptor for created_at field.
+	eventstaffDescCreatedAt := eventstaffFields[3].Descriptor()
+	// eventstaff.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventstaff.DefaultCreatedAt = eventstaffDescCreatedAt.Default.(func() time.Time)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[7].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	invitecodeFields := schema.InviteCode{}.Fields()
+	_ = invitecodeFields
+	// invitecodeDescMaxUses is the schema descriptor for max_uses field.
+	invitecodeDescMaxUses := invitecodeFields[2].Descriptor()
+	// invitecode.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invitecode.DefaultMaxUses = invitecodeDescMaxUses.Default.(int)
+	// invitecodeDescUses is the schema descriptor for uses field.
+	invitecodeDescUses := invitecodeFields[3].Descriptor()
+	// invitecode.DefaultUses holds the default value on creation for the uses field.
+	invitecode.DefaultUses = invitecodeDescUses.Default.(int)
+	// invitecodeDescCreatedAt is the schema descriptor for created_at field.
+	invitecodeDescCreatedAt := invitecodeFields[4].Descriptor()
+	// invitecode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitecode.DefaultCreatedAt = invitecodeDescCreatedAt.Default.(func() time.Time)
+	joinlinkFields := schema.JoinLink{}.Fields()
+	_ = joinlinkFields
+	// joinlinkDescQualified is the schema descriptor for qualified field.
+	joinlinkDescQualified := joinlinkFields[5].Descriptor()
+	// joinlink.DefaultQualified holds the default value on creation for the qualified field.
+	joinlink.DefaultQualified = joinlinkDescQualified.Default.(bool)
+	// joinlinkDescCreatedAt is the schema descriptor for created_at field.
+	joinlinkDescCreatedAt := joinlinkFields[6].Descriptor()
+	// joinlink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	joinlink.DefaultCreatedAt = joinlinkDescCreatedAt.Default.(func() time.Time)
+	kioskdeviceFields := schema.KioskDevice{}.Fields()
+	_ = kioskdeviceFields
+	// kioskdeviceDescName is the schema descriptor for name field.
+	kioskdeviceDescName := kioskdeviceFields[1].Descriptor()
+	// kioskdevice.DefaultName holds the default value on creation for the name field.
+	kioskdevice.DefaultName = kioskdeviceDescName.Default.(string)
+	// kioskdeviceDescCreatedAt is the schema descriptor for created_at field.
+	kioskdeviceDescCreatedAt := kioskdeviceFields[6].Descriptor()
+	// kioskdevice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	kioskdevice.DefaultCreatedAt = kioskdeviceDescCreatedAt.Default.(func() time.Time)
 	likeFields := schema.Like{}.Fields()
 	_ = likeFields
 	// likeDescCreatedAt is the schema descriptor for created_at field.
 	likeDescCreatedAt := likeFields[0].Descriptor()
 	// like.DefaultCreatedAt holds the default value on creation for the created_at field.
 	like.DefaultCreatedAt = likeDescCreatedAt.Default.(func() time.Time)
+	locationfixFields := schema.LocationFix{}.Fields()
+	_ = locationfixFields
+	// locationfixDescReceivedAt is the schema descriptor for received_at field.
+	locationfixDescReceivedAt := locationfixFields[6].Descriptor()
+	// locationfix.DefaultReceivedAt holds the default value on creation for the received_at field.
+	locationfix.DefaultReceivedAt = locationfixDescReceivedAt.Default.(func() time.Time)
+	// locationfixDescAccepted is the schema descriptor for accepted field.
+	locationfixDescAccepted := locationfixFields[7].Descriptor()
+	// locationfix.DefaultAccepted holds the default value on creation for the accepted field.
+	locationfix.DefaultAccepted = locationfixDescAccepted.Default.(bool)
+	mintcreditFields := schema.MintCredit{}.Fields()
+	_ = mintcreditFields
+	// mintcreditDescCreatedAt is the schema descriptor for created_at field.
+	mintcreditDescCreatedAt := mintcreditFields[5].Descriptor()
+	// mintcredit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mintcredit.DefaultCreatedAt = mintcreditDescCreatedAt.Default.(func() time.Time)
 	nftmomentFields := schema.NFTMoment{}.Fields()
 	_ = nftmomentFields
 	// nftmomentDescLikeCount is the schema descriptor for like_count field.
@@ -65,10 +320,60 @@ func init() {
 	nftmomentDescCommentCount := nftmomentFields[5].Descriptor()
 	// nftmoment.DefaultCommentCount holds the default value on creation for the comment_count field.
 	nftmoment.DefaultCommentCount = nftmomentDescCommentCount.Default.(int)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[6].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	referralFields := schema.Referral{}.Fields()
+	_ = referralFields
+	// referralDescCreatedAt is the schema descriptor for created_at field.
+	referralDescCreatedAt := referralFields[5].Descriptor()
+	// referral.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referral.DefaultCreatedAt = referralDescCreatedAt.Default.(func() time.Time)
+	seriesoccurrenceFields := schema.SeriesOccurrence{}.Fields()
+	_ = seriesoccurrenceFields
+	// seriesoccurrenceDescAttempts is the schema descriptor for attempts field.
+	seriesoccurrenceDescAttempts := seriesoccurrenceFields[5].Descriptor()
+	// seriesoccurrence.DefaultAttempts holds the default value on creation for the attempts field.
+	seriesoccurrence.DefaultAttempts = seriesoccurrenceDescAttempts.Default.(int)
+	// seriesoccurrenceDescCreatedAt is the schema descriptor for created_at field.
+	seriesoccurrenceDescCreatedAt := seriesoccurrenceFields[7].Descriptor()
+	// seriesoccurrence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	seriesoccurrence.DefaultCreatedAt = seriesoccurrenceDescCreatedAt.Default.(func() time.Time)
+	// seriesoccurrenceDescUpdatedAt is the schema descriptor for updated_at field.
+	seriesoccurrenceDescUpdatedAt := seriesoccurrenceFields[8].Descriptor()
+	// seriesoccurrence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	seriesoccurrence.DefaultUpdatedAt = seriesoccurrenceDescUpdatedAt.Default.(func() time.Time)
+	// seriesoccurrence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	seriesoccurrence.UpdateDefaultUpdatedAt = seriesoccurrenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[3].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescIsFreeMinted is the schema descriptor for is_free_minted field.
 	userDescIsFreeMinted := userFields[9].Descriptor()
 	// user.DefaultIsFreeMinted holds the default value on creation for the is_free_minted field.
 	user.DefaultIsFreeMinted = userDescIsFreeMinted.Default.(bool)
+	// userDescHideFromAttendeeLists is the schema descriptor for hide_from_attendee_lists field.
+	userDescHideFromAttendeeLists := userFields[10].Descriptor()
+	// user.DefaultHideFromAttendeeLists holds the default value on creation for the hide_from_attendee_lists field.
+	user.DefaultHideFromAttendeeLists = userDescHideFromAttendeeLists.Default.(bool)
+	waitlistentryFields := schema.WaitlistEntry{}.Fields()
+	_ = waitlistentryFields
+	// waitlistentryDescCreatedAt is the schema descriptor for created_at field.
+	waitlistentryDescCreatedAt := waitlistentryFields[6].Descriptor()
+	// waitlistentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistentry.DefaultCreatedAt = waitlistentryDescCreatedAt.Default.(func() time.Time)
+	// waitlistentryDescUpdatedAt is the schema descriptor for updated_at field.
+	waitlistentryDescUpdatedAt := waitlistentryFields[7].Descriptor()
+	// waitlistentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	waitlistentry.DefaultUpdatedAt = waitlistentryDescUpdatedAt.Default.(func() time.Time)
+	// waitlistentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	waitlistentry.UpdateDefaultUpdatedAt = waitlistentryDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKey adalah kunci akses untuk integrator pihak ketiga (partner).
+// Hanya hash dari kunci yang disimpan; kunci mentah hanya ditampilkan sekali saat dibuat.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields dari APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		// Beberapa karakter awal kunci, untuk ditampilkan di UI admin (misal: "capt_1a2b3c")
+		field.String("prefix"),
+		field.String("key_hash").
+			Unique().
+			Sensitive(),
+
+		// "read" = semua route GET, "*" = semua route,
+		// atau route spesifik dengan format "METHOD /path" (misal: "GET /events/:id")
+		field.JSON("scopes", []string{}),
+		// Alamat yang diwakili key (misal: host yang memakai integrasi partner).
+		// Route partner (lihat 'apiKeyCredentialRoutes') diotorisasi sebagai alamat ini;
+		// tanpa owner, key hanya menaikkan batas pemakaian route publik.
+		field.String("owner_address").
+			Optional(),
+		// Batas request per menit dan per hari (UTC)
+		field.Int("rate_per_minute").
+			Default(60),
+		field.Int("daily_quota").
+			Default(10000),
+
+		field.Int64("total_requests").
+			Default(0),
+		field.String("created_by"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
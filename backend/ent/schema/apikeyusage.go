@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// APIKeyUsage adalah counter harian pemakaian sebuah API key (untuk kuota harian).
+type APIKeyUsage struct {
+	ent.Schema
+}
+
+// Fields dari APIKeyUsage.
+func (APIKeyUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("key_id"),
+		// Tanggal UTC, format "2006-01-02"
+		field.String("day"),
+		field.Int("count").
+			Default(0),
+	}
+}
+
+// Indexes dari APIKeyUsage.
+func (APIKeyUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key_id", "day").Unique(),
+	}
+}
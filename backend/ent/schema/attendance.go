@@ -25,6 +25,17 @@ func (Attendance) Fields() []ent.Field {
 		// Kita simpan kapan mereka mendaftar
 		field.Time("registration_time").
 			Default(time.Now),
+
+		// Cara check-in diverifikasi (diisi oleh API, 'checked_in' tetap diisi indexer):
+		// staff = host/staff men-scan/menginput, kiosk = antrian kiosk,
+		// self = geofence, join_link = link join dari host
+		field.Enum("check_in_method").
+			Values("staff", "kiosk", "self", "join_link").
+			Optional().
+			Nillable(),
+		// Alamat host/staff yang memverifikasi (untuk kiosk: yang mendaftarkan kiosk)
+		field.String("verified_by").
+			Optional(),
 	}
 }
 
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthNonce adalah nonce sekali pakai untuk FCL account-proof.
+// Nonce dibuat oleh server, ditandatangani wallet, lalu dihapus saat login.
+type AuthNonce struct {
+	ent.Schema
+}
+
+// Fields dari AuthNonce.
+func (AuthNonce) Fields() []ent.Field {
+	return []ent.Field{
+		// Hex string (minimal 32 byte, sesuai syarat FCL)
+		field.String("nonce").
+			Unique(),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
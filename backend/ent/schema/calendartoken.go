@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// CalendarToken adalah token rahasia di URL feed kalender (iCalendar) milik seorang user.
+// Satu token per user; membuat token baru otomatis membatalkan token lama.
+// Database hanya menyimpan hash SHA-256-nya.
+type CalendarToken struct {
+	ent.Schema
+}
+
+// Fields dari CalendarToken.
+func (CalendarToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("address").
+			Unique(),
+		field.String("token_hash").
+			Unique().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
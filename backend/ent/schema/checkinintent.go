@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CheckInIntent adalah antrian check-in dari kiosk (mode offline).
+// Kiosk menyimpan hasil scan secara lokal, lalu mengirimnya ke backend
+// saat koneksi tersedia. Worker di backend kemudian mengirim transaksi
+// check-in on-chain satu per satu.
+type CheckInIntent struct {
+	ent.Schema
+}
+
+// Fields dari CheckInIntent.
+func (CheckInIntent) Fields() []ent.Field {
+	return []ent.Field{
+		// ID event on-chain (sama dengan Event.event_id)
+		field.Uint64("event_id"),
+		field.String("user_address"),
+
+		// Kapan QR di-scan di kiosk (bukan kapan diterima backend)
+		field.Time("scanned_at"),
+		field.String("device_id"),
+
+		field.Enum("status").
+			Values("pending", "processing", "done", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.String("last_error").
+			Optional(),
+		// Intent 'pending' baru diambil worker setelah waktu ini (backoff antar percobaan)
+		field.Time("next_attempt_at").
+			Default(time.Now),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		// Diperbarui setiap perubahan status; intent 'processing' yang terlalu lama
+		// tidak berubah dianggap ditinggal worker yang mati (lihat 'checkInIntentStaleAfter')
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("processed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes dari CheckInIntent.
+func (CheckInIntent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Deduplikasi: satu user hanya perlu satu intent per event,
+		// walaupun di-scan berkali-kali atau oleh kiosk yang berbeda.
+		index.Fields("event_id", "user_address").Unique(),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
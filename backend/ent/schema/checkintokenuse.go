@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckInTokenUse mencatat token QR check-in yang sudah dipakai,
+// agar token yang sama tidak bisa dipakai ulang (replay).
+type CheckInTokenUse struct {
+	ent.Schema
+}
+
+// Fields dari CheckInTokenUse.
+func (CheckInTokenUse) Fields() []ent.Field {
+	return []ent.Field{
+		// Nonce acak dari token. HARUS unik.
+		field.String("nonce").
+			Unique(),
+		field.Uint64("event_id"),
+		field.String("user_address"),
+		field.Time("used_at").
+			Default(time.Now).
+			Immutable(),
+
+		// Setelah lewat waktu ini, baris boleh dihapus
+		// (token yang kedaluwarsa sudah ditolak oleh verifikasi).
+		field.Time("expires_at"),
+	}
+}
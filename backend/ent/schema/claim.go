@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Claim adalah pemakaian satu unit kuota (misal: 1x free mint) oleh seorang user.
+// Alurnya: 'reserved' sebelum transaksi dikirim -> 'committed' setelah seal.
+// Jika transaksi gagal, klaim di-release (baris dihapus) sehingga kuota kembali.
+//
+// Atomisitas dijamin oleh unique index pada slot: user dengan kuota N hanya
+// bisa menempati slot 0..N-1, sehingga request paralel tidak bisa melebihi kuota.
+type Claim struct {
+	ent.Schema
+}
+
+// Fields dari Claim.
+func (Claim) Fields() []ent.Field {
+	return []ent.Field{
+		// Jenis kuota, misal: "free_mint"
+		field.String("kind"),
+		// Cakupan kuota: "global", "event:<eventID>", atau "campaign:<nama>"
+		field.String("scope"),
+		// Alamat user pemilik klaim
+		field.String("subject"),
+
+		// Slot kuota per user (0..per_subject_limit-1)
+		field.Int("slot"),
+		// Slot kuota total untuk semua user (0..total_limit-1), nil jika tanpa batas total
+		field.Int("pool_slot").
+			Optional().
+			Nillable(),
+
+		field.Enum("status").
+			Values("reserved", "committed").
+			Default("reserved"),
+		// Referensi hasil (misal: nama moment / TX ID)
+		field.String("reference").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		// Reservasi yang tidak di-commit sampai waktu ini dianggap gagal dan boleh diambil ulang
+		field.Time("expires_at"),
+		field.Time("committed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes dari Claim.
+func (Claim) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "scope", "subject", "slot").Unique(),
+		index.Fields("kind", "scope", "pool_slot").Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ClaimQuota adalah konfigurasi kuota untuk satu jenis klaim di satu cakupan
+// (misal: 3x free mint per user untuk "campaign:launch", maksimal 500 total).
+type ClaimQuota struct {
+	ent.Schema
+}
+
+// Fields dari ClaimQuota.
+func (ClaimQuota) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kind"),
+		field.String("scope"),
+
+		// Jumlah klaim maksimal per user
+		field.Int("per_subject_limit").
+			Default(1),
+		// Jumlah klaim maksimal untuk semua user (0 = tanpa batas)
+		field.Int("total_limit").
+			Default(0),
+
+		// Periode berlaku (opsional)
+		field.Time("starts_at").
+			Optional().
+			Nillable(),
+		field.Time("ends_at").
+			Optional().
+			Nillable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes dari ClaimQuota.
+func (ClaimQuota) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "scope").Unique(),
+	}
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// DataVersion adalah penghitung versi sebuah kumpulan data (misal: "events").
+// Dinaikkan oleh penulis data (indexer & handler API) agar cache di proses lain
+// cukup membaca satu baris untuk tahu datanya berubah.
+type DataVersion struct {
+	ent.Schema
+}
+
+// Fields dari DataVersion.
+func (DataVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Unique().
+			Immutable(),
+		field.Int64("version").
+			Default(0),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Event memegang skema untuk tipe Event.
@@ -27,6 +28,33 @@ func (Event) Fields() []ent.Field {
 		field.Time("start_date"),
 		field.Time("end_date"),
 		field.Uint64("quota"),
+
+		// Radius (meter) untuk self check-in berbasis lokasi (event offline).
+		// 0 = self check-in dinonaktifkan. Diatur oleh host.
+		field.Float("checkin_radius_m").
+			Default(0),
+
+		// Pembatalan oleh host (off-chain). nil = event tidak dibatalkan.
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.String("cancel_reason").
+			Optional(),
+
+		// Naik setiap kali host mengubah/membatalkan event (riwayat di tabel EventChange).
+		// Dipakai sebagai SEQUENCE di iCalendar agar aplikasi kalender memperbarui event.
+		field.Int("revision").
+			Default(0),
+
+		// Siapa yang boleh melihat daftar peserta (diatur host):
+		// public    = semua user yang login
+		// attendees = hanya peserta event
+		// host      = hanya host/staff
+		// Pengunjung anonim hanya mendapat jumlah peserta; user yang memilih
+		// 'hide_from_attendee_lists' tidak ditampilkan kecuali ke host/staff.
+		field.Enum("attendee_visibility").
+			Values("public", "attendees", "host").
+			Default("public"),
 	}
 }
 
@@ -46,3 +74,11 @@ func (Event) Edges() []ent.Edge {
 		edge.To("attendances", Attendance.Type),
 	}
 }
+
+// Indexes dari Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		// Pencarian berbasis lokasi (radius/bbox) memfilter bounding box lat/long lebih dulu
+		index.Fields("lat", "long"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventFieldChange adalah perubahan satu field event (nilai lama -> baru).
+type EventFieldChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// EventChange adalah riwayat perubahan/pembatalan event oleh host.
+type EventChange struct {
+	ent.Schema
+}
+
+// Fields dari EventChange.
+func (EventChange) Fields() []ent.Field {
+	return []ent.Field{
+		// ID event on-chain (sama dengan Event.event_id)
+		field.Uint64("event_id"),
+		// Nilai 'Event.revision' setelah perubahan ini
+		field.Int("revision"),
+
+		field.Enum("kind").
+			Values("updated", "cancelled"),
+		field.JSON("changes", []EventFieldChange{}),
+		field.String("reason").
+			Optional(),
+
+		// Alamat host/admin yang melakukan perubahan
+		field.String("changed_by"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes dari EventChange.
+func (EventChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id", "revision"),
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventImportRow adalah satu baris valid dari import event (POST /events/import, dryRun=false)
+// yang menunggu dibuat on-chain oleh worker import.
+type EventImportRow struct {
+	ent.Schema
+}
+
+// Fields dari EventImportRow.
+func (EventImportRow) Fields() []ent.Field {
+	return []ent.Field{
+		// ID import (dikembalikan ke host untuk cek status)
+		field.String("import_id"),
+		field.String("host_address"),
+		field.String("format"),
+		// Nomor baris CSV / urutan VEVENT
+		field.Int("row"),
+
+		// Argumen 'create_event.cdc' (sudah divalidasi saat import)
+		field.String("name"),
+		field.String("description").
+			Default(""),
+		field.String("thumbnail_url"),
+		field.String("event_pass_img").
+			Optional(),
+		field.Uint8("event_type"),
+		field.String("location"),
+		field.Float("lat").
+			Optional().
+			Nillable(),
+		field.Float("long").
+			Optional().
+			Nillable(),
+		field.Bool("geocoded").
+			Default(false),
+		field.Time("start_date"),
+		field.Time("end_date"),
+		field.Uint64("quota"),
+
+		// queued   = menunggu worker
+		// creating = transaksi sedang dikirim worker
+		// created  = event on-chain sudah dibuat ('event_id' terisi)
+		// failed   = transaksi gagal setelah beberapa percobaan
+		field.Enum("status").
+			Values("queued", "creating", "created", "failed").
+			Default("queued"),
+
+		// ID event on-chain (sama dengan Event.event_id)
+		field.Uint64("event_id").
+			Optional().
+			Nillable(),
+
+		field.Int("attempts").
+			Default(0),
+		field.String("error").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes dari EventImportRow.
+func (EventImportRow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("import_id", "row").Unique(),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventSeries adalah event berulang milik host (misal: meetup mingguan).
+// Berisi template event + aturan pengulangan (subset RRULE); scheduler membuat
+// occurrence on-chain (EventCreated) beberapa hari sebelum jadwalnya.
+type EventSeries struct {
+	ent.Schema
+}
+
+// Fields dari EventSeries.
+func (EventSeries) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("host_address"),
+
+		// Template untuk setiap occurrence (sama dengan argumen 'create_event.cdc')
+		field.String("name"),
+		field.String("description").
+			Default(""),
+		field.String("thumbnail_url"),
+		field.String("event_pass_img").
+			Optional(),
+		field.Uint8("event_type"),
+		field.String("location"),
+		field.Float("lat").
+			Default(0),
+		field.Float("long").
+			Default(0),
+		field.Uint64("quota"),
+
+		// Aturan pengulangan, misal: "FREQ=WEEKLY;BYDAY=TU;COUNT=10"
+		field.String("rrule"),
+		// Zona waktu IANA tempat aturan dihitung (jam lokal tetap walau ada DST)
+		field.String("timezone"),
+		// Waktu mulai occurrence pertama (DTSTART) dan durasi setiap occurrence
+		field.Time("start_date"),
+		field.Int64("duration_seconds"),
+		// Berapa hari sebelum jadwal occurrence dibuat on-chain
+		field.Int("lead_days").
+			Default(28),
+
+		// active    = scheduler membuat occurrence baru
+		// paused    = tidak ada occurrence baru (yang sudah dibuat tetap ada)
+		// completed = aturan sudah habis (COUNT/UNTIL) dan semua occurrence sudah dibuat
+		// stopped   = dihentikan host
+		field.Enum("status").
+			Values("active", "paused", "completed", "stopped").
+			Default("active"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes dari EventSeries.
+func (EventSeries) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("host_address"),
+		index.Fields("status"),
+	}
+}
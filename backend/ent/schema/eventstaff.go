@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventStaff adalah user yang didelegasikan oleh host untuk membantu
+// mengelola event (check-in, export attendee, moderasi).
+type EventStaff struct {
+	ent.Schema
+}
+
+// Fields dari EventStaff.
+func (EventStaff) Fields() []ent.Field {
+	return []ent.Field{
+		// ID event on-chain (sama dengan Event.event_id)
+		field.Uint64("event_id"),
+		field.String("address"),
+
+		// Alamat host/admin yang menambahkan staff ini
+		field.String("added_by"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes dari EventStaff.
+func (EventStaff) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id", "address").Unique(),
+		index.Fields("address"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey menyimpan respon dari request pertama dengan header 'Idempotency-Key',
+// agar retry dari client (misal: mobile yang timeout saat minting) tidak
+// menghasilkan upload/transaksi ganda.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields dari IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		// Pemilik key: alamat user yang login (atau "ip:<ip>")
+		field.String("scope"),
+		field.String("key"),
+		// sha256 dari method, route, dan isi request
+		field.String("fingerprint"),
+
+		field.Enum("status").
+			Values("in_progress", "completed").
+			Default("in_progress"),
+		field.Int("response_status").
+			Optional(),
+		field.String("response_content_type").
+			Optional(),
+		field.Bytes("response_body").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+// Indexes dari IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InviteCode adalah kode referral yang dibuat user untuk dibagikan.
+type InviteCode struct {
+	ent.Schema
+}
+
+// Fields dari InviteCode.
+func (InviteCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").Unique(),
+		field.String("owner_address"),
+
+		// Berapa kali kode boleh dipakai (membatasi sybil dari satu kode)
+		field.Int("max_uses").
+			Default(10),
+		field.Int("uses").
+			Default(0),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes dari InviteCode.
+func (InviteCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_address"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JoinLink adalah link unik per registrant untuk event online.
+// Link ini me-redirect ke URL meeting host sambil mencatat kehadiran.
+type JoinLink struct {
+	ent.Schema
+}
+
+// Fields dari JoinLink.
+func (JoinLink) Fields() []ent.Field {
+	return []ent.Field{
+		// Token acak di URL (/join/:token). HARUS unik.
+		field.String("token").
+			Unique(),
+		field.Uint64("event_id"),
+		field.String("user_address"),
+
+		// Klik pertama di dalam jendela waktu event
+		field.Time("clicked_at").
+			Optional().
+			Nillable(),
+		// Heartbeat terakhir dari client
+		field.Time("last_heartbeat_at").
+			Optional().
+			Nillable(),
+		// true jika sudah memenuhi syarat kehadiran dan check-in sudah diantrikan
+		field.Bool("qualified").
+			Default(false),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes dari JoinLink.
+func (JoinLink) Indexes() []ent.Index {
+	return []ent.Index{
+		// Satu link per registrant per event
+		index.Fields("event_id", "user_address").Unique(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// KioskDevice adalah perangkat kiosk check-in (mode offline) yang didaftarkan
+// host/staff untuk satu event. Intent dari kiosk hanya diterima untuk event tersebut,
+// dan hanya selama pendaftarnya masih punya izin check-in di event itu.
+type KioskDevice struct {
+	ent.Schema
+}
+
+// Fields dari KioskDevice.
+func (KioskDevice) Fields() []ent.Field {
+	return []ent.Field{
+		// ID perangkat (dibuat server, dikirim kiosk di setiap intent)
+		field.String("device_id").
+			Unique().
+			Immutable(),
+		// Label bebas dari host (misal: "Pintu Utara")
+		field.String("name").
+			Default(""),
+		// Secret HMAC-SHA256 untuk menandatangani intent. Hanya ditampilkan sekali saat dibuat.
+		field.String("secret").
+			Sensitive(),
+
+		// ID event on-chain (sama dengan Event.event_id)
+		field.Uint64("event_id"),
+		// Alamat host/staff yang mendaftarkan kiosk
+		field.String("provisioned_by"),
+
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes dari KioskDevice.
+func (KioskDevice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LocationFix menyimpan setiap lokasi yang dikirim user saat self check-in.
+// Riwayat ini dipakai untuk heuristik anti-spoofing (misal: kecepatan antar lokasi).
+type LocationFix struct {
+	ent.Schema
+}
+
+// Fields dari LocationFix.
+func (LocationFix) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("event_id"),
+		field.String("user_address"),
+		field.Float("lat"),
+		field.Float("long"),
+		// Akurasi yang dilaporkan perangkat (meter)
+		field.Float("accuracy_m"),
+		// Waktu lokasi menurut perangkat
+		field.Time("reported_at"),
+		field.Time("received_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Bool("accepted").
+			Default(false),
+		field.String("reject_reason").
+			Optional(),
+	}
+}
+
+// Indexes dari LocationFix.
+func (LocationFix) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_address", "reported_at"),
+	}
+}
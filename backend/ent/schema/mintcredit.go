@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MintCredit adalah 1 jatah free mint tambahan (misal: hadiah referral).
+// Dipakai di '/moment/free' jika kuota free mint global user sudah habis.
+type MintCredit struct {
+	ent.Schema
+}
+
+// Fields dari MintCredit.
+func (MintCredit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("address"),
+		// Asal credit, misal: "referral:inviter" / "referral:invitee"
+		field.String("source"),
+		field.Int("referral_id").
+			Optional().
+			Nillable(),
+
+		// available -> reserved (transaksi berjalan) -> spent, atau kembali ke available jika gagal
+		field.Enum("status").
+			Values("available", "reserved", "spent").
+			Default("available"),
+		field.String("reference").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("reserved_at").
+			Optional().
+			Nillable(),
+		field.Time("spent_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes dari MintCredit.
+func (MintCredit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address", "status"),
+	}
+}
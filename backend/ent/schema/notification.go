@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification adalah notifikasi in-app untuk user (misal: slot waitlist tersedia).
+type Notification struct {
+	ent.Schema
+}
+
+// Fields dari Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		// Penerima notifikasi
+		field.String("address"),
+
+		// Jenis notifikasi, misal: "waitlist_offer", "waitlist_expired"
+		field.String("type"),
+		field.String("title"),
+		field.String("body"),
+
+		// Data tambahan untuk frontend (misal: event_id, batas waktu klaim)
+		field.JSON("data", map[string]any{}).
+			Optional(),
+
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes dari Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address", "created_at"),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Referral mencatat user baru (invitee) yang onboarding dengan kode milik inviter.
+// Referral baru 'qualified' (dan mint credit diberikan) setelah check-in invitee
+// diverifikasi host/staff di sebuah event yang tidak terkait dengan inviter.
+type Referral struct {
+	ent.Schema
+}
+
+// Fields dari Referral.
+func (Referral) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code"),
+		field.String("inviter_address"),
+		// Satu alamat hanya bisa di-refer sekali
+		field.String("invitee_address").Unique(),
+
+		field.Enum("status").
+			Values("pending", "qualified").
+			Default("pending"),
+		// Event tempat invitee check-in (syarat qualified)
+		field.Uint64("qualifying_event_id").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("qualified_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes dari Referral.
+func (Referral) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("inviter_address"),
+		index.Fields("status"),
+	}
+}
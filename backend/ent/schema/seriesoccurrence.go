@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SeriesOccurrence adalah satu jadwal dari EventSeries beserta event on-chain-nya.
+type SeriesOccurrence struct {
+	ent.Schema
+}
+
+// Fields dari SeriesOccurrence.
+func (SeriesOccurrence) Fields() []ent.Field {
+	return []ent.Field{
+		// ID EventSeries
+		field.Int("series_id"),
+		field.Time("start_date"),
+		field.Time("end_date"),
+
+		// pending  = terjadwal, transaksi belum dikirim
+		// creating = transaksi sedang dikirim scheduler
+		// created  = event on-chain sudah dibuat ('event_id' terisi)
+		// failed   = transaksi gagal setelah beberapa percobaan
+		// skipped  = tidak dibuat (seri dihentikan sebelum jadwalnya)
+		field.Enum("status").
+			Values("pending", "creating", "created", "failed", "skipped").
+			Default("pending"),
+
+		// ID event on-chain (sama dengan Event.event_id)
+		field.Uint64("event_id").
+			Optional().
+			Nillable(),
+
+		field.Int("attempts").
+			Default(0),
+		field.String("error").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes dari SeriesOccurrence.
+func (SeriesOccurrence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("series_id", "start_date").Unique(),
+		index.Fields("status"),
+		index.Fields("event_id"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Session adalah sesi login setelah user membuktikan kepemilikan wallet.
+// Token aslinya hanya diberikan ke client; database menyimpan hash SHA-256-nya.
+type Session struct {
+	ent.Schema
+}
+
+// Fields dari Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Unique().
+			Sensitive(),
+		// Alamat Flow yang sudah terverifikasi (misal: 0x1bb6b1e0a5170088)
+		field.String("address"),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes dari Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address"),
+	}
+}
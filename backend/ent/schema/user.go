@@ -29,7 +29,11 @@ func (User) Fields() []ent.Field {
 		field.JSON("highlighted_eventPass_ids", []uint64{}).Optional(),
 		field.Uint64("highlighted_moment_id").Optional(),
 		field.JSON("socials", map[string]string{}).Optional(),
+		// Legacy: cermin dari tabel Claim ("free_mint" scope "global").
+		// Bernilai true jika kuota free mint global user sudah habis.
 		field.Bool("is_free_minted").Default(false),
+		// true = tidak ditampilkan di daftar peserta event & event pass publik (host/staff tetap bisa melihat)
+		field.Bool("hide_from_attendee_lists").Default(false),
 	}
 }
 
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WaitlistEntry adalah antrian user untuk event yang kuotanya penuh.
+// Saat ada slot kosong (registrasi dibatalkan / kuota dinaikkan), user teratas
+// mendapat tawaran ('offered') dan harus register on-chain sebelum 'offer_expires_at'.
+type WaitlistEntry struct {
+	ent.Schema
+}
+
+// Fields dari WaitlistEntry.
+func (WaitlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		// ID event on-chain (sama dengan Event.event_id)
+		field.Uint64("event_id"),
+		field.String("address"),
+
+		// Urutan antrian (kecil = lebih dulu). Bisa diatur ulang oleh host.
+		field.Int("position"),
+
+		// waiting  = masih mengantri
+		// offered  = mendapat slot, menunggu user register on-chain
+		// claimed  = user sudah register (Attendance ter-indeks)
+		// expired  = jendela klaim habis tanpa register
+		// left     = user keluar dari waitlist
+		field.Enum("status").
+			Values("waiting", "offered", "claimed", "expired", "left").
+			Default("waiting"),
+
+		field.Time("offered_at").
+			Optional().
+			Nillable(),
+		field.Time("offer_expires_at").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes dari WaitlistEntry.
+func (WaitlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id", "address").Unique(),
+		index.Fields("event_id", "status", "position"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/seriesoccurrence"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeriesOccurrenceCreate is the builder for creating a SeriesOccurrence entity.
+type SeriesOccurrenceCreate struct {
+	config
+	mutation *SeriesOccurrenceMutation
+	hooks    []Hook
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *SeriesOccurrenceCreate) SetSeriesID(v int) *SeriesOccurrenceCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *SeriesOccurrenceCreate) SetStartDate(v time.Time) *SeriesOccurrenceCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *SeriesOccurrenceCreate) SetEndDate(v time.Time) *SeriesOccurrenceCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SeriesOccurrenceCreate) SetStatus(v seriesoccurrence.Status) *SeriesOccurrenceCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SeriesOccurrenceCreate) SetNillableStatus(v *seriesoccurrence.Status) *SeriesOccurrenceCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *SeriesOccurrenceCreate) SetEventID(v uint64) *SeriesOccurrenceCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_c *SeriesOccurrenceCreate) SetNillableEventID(v *uint64) *SeriesOccurrenceCreate {
+	if v != nil {
+		_c.SetEventID(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *SeriesOccurrenceCreate) SetAttempts(v int) *SeriesOccurrenceCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *SeriesOccurrenceCreate) SetNillableAttempts(v *int) *SeriesOccurrenceCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *SeriesOccurrenceCreate) SetError(v string) *SeriesOccurrenceCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *SeriesOccurrenceCreate) SetNillableError(v *string) *SeriesOccurrenceCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SeriesOccurrenceCreate) SetCreatedAt(v time.Time) *SeriesOccurrenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SeriesOccurrenceCreate) SetNillableCreatedAt(v *time.Time) *SeriesOccurrenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SeriesOccurrenceCreate) SetUpdatedAt(v time.Time) *SeriesOccurrenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SeriesOccurrenceCreate) SetNillableUpdatedAt(v *time.Time) *SeriesOccurrenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SeriesOccurrenceMutation object of the builder.
+func (_c *SeriesOccurrenceCreate) Mutation() *SeriesOccurrenceMutation {
+	return _c.mutation
+}
+
+// Save creates the SeriesOccurrence in the database.
+func (_c *SeriesOccurrenceCreate) Save(ctx context.Context) (*SeriesOccurrence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SeriesOccurrenceCreate) SaveX(ctx context.Context) *SeriesOccurrence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SeriesOccurrenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SeriesOccurrenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SeriesOccurrenceCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := seriesoccurrence.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := seriesoccurrence.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := seriesoccurrence.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := seriesoccurrence.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SeriesOccurrenceCreate) check() error {
+	if _, ok := _c.mutation.SeriesID(); !ok {
+		return &ValidationError{Name: "series_id", err: errors.New(`ent: missing required field "SeriesOccurrence.series_id"`)}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "SeriesOccurrence.start_date"`)}
+	}
+	if _, ok := _c.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "SeriesOccurrence.end_date"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SeriesOccurrence.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := seriesoccurrence.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SeriesOccurrence.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "SeriesOccurrence.attempts"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SeriesOccurrence.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SeriesOccurrence.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SeriesOccurrenceCreate) sqlSave(ctx context.Context) (*SeriesOccurrence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SeriesOccurrenceCreate) createSpec() (*SeriesOccurrence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SeriesOccurrence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(seriesoccurrence.Table, sqlgraph.NewFieldSpec(seriesoccurrence.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SeriesID(); ok {
+		_spec.SetField(seriesoccurrence.FieldSeriesID, field.TypeInt, value)
+		_node.SeriesID = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(seriesoccurrence.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(seriesoccurrence.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(seriesoccurrence.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(seriesoccurrence.FieldEventID, field.TypeUint64, value)
+		_node.EventID = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(seriesoccurrence.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(seriesoccurrence.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(seriesoccurrence.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(seriesoccurrence.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SeriesOccurrenceCreateBulk is the builder for creating many SeriesOccurrence entities in bulk.
+type SeriesOccurrenceCreateBulk struct {
+	config
+	err      error
+	builders []*SeriesOccurrenceCreate
+}
+
+// Save creates the SeriesOccurrence entities in the database.
+func (_c *SeriesOccurrenceCreateBulk) Save(ctx context.Context) ([]*SeriesOccurrence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SeriesOccurrence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SeriesOccurrenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SeriesOccurrenceCreateBulk) SaveX(ctx context.Context) []*SeriesOccurrence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SeriesOccurrenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SeriesOccurrenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/seriesoccurrence"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeriesOccurrenceDelete is the builder for deleting a SeriesOccurrence entity.
+type SeriesOccurrenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *SeriesOccurrenceMutation
+}
+
+// Where appends a list predicates to the SeriesOccurrenceDelete builder.
+func (_d *SeriesOccurrenceDelete) Where(ps ...predicate.SeriesOccurrence) *SeriesOccurrenceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SeriesOccurrenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SeriesOccurrenceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SeriesOccurrenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(seriesoccurrence.Table, sqlgraph.NewFieldSpec(seriesoccurrence.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SeriesOccurrenceDeleteOne is the builder for deleting a single SeriesOccurrence entity.
+type SeriesOccurrenceDeleteOne struct {
+	_d *SeriesOccurrenceDelete
+}
+
+// Where appends a list predicates to the SeriesOccurrenceDelete builder.
+func (_d *SeriesOccurrenceDeleteOne) Where(ps ...predicate.SeriesOccurrence) *SeriesOccurrenceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SeriesOccurrenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{seriesoccurrence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SeriesOccurrenceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/seriesoccurrence"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeriesOccurrenceUpdate is the builder for updating SeriesOccurrence entities.
+type SeriesOccurrenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SeriesOccurrenceMutation
+}
+
+// Where appends a list predicates to the SeriesOccurrenceUpdate builder.
+func (_u *SeriesOccurrenceUpdate) Where(ps ...predicate.SeriesOccurrence) *SeriesOccurrenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *SeriesOccurrenceUpdate) SetSeriesID(v int) *SeriesOccurrenceUpdate {
+	_u.mutation.ResetSeriesID()
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdate) SetNillableSeriesID(v *int) *SeriesOccurrenceUpdate {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// AddSeriesID adds value to the "series_id" field.
+func (_u *SeriesOccurrenceUpdate) AddSeriesID(v int) *SeriesOccurrenceUpdate {
+	_u.mutation.AddSeriesID(v)
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *SeriesOccurrenceUpdate) SetStartDate(v time.Time) *SeriesOccurrenceUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdate) SetNillableStartDate(v *time.Time) *SeriesOccurrenceUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *SeriesOccurrenceUpdate) SetEndDate(v time.Time) *SeriesOccurrenceUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdate) SetNillableEndDate(v *time.Time) *SeriesOccurrenceUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SeriesOccurrenceUpdate) SetStatus(v seriesoccurrence.Status) *SeriesOccurrenceUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdate) SetNillableStatus(v *seriesoccurrence.Status) *SeriesOccurrenceUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *SeriesOccurrenceUpdate) SetEventID(v uint64) *SeriesOccurrenceUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdate) SetNillableEventID(v *uint64) *SeriesOccurrenceUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *SeriesOccurrenceUpdate) AddEventID(v int64) *SeriesOccurrenceUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// ClearEventID clears the value of the "event_id" field.
+func (_u *SeriesOccurrenceUpdate) ClearEventID() *SeriesOccurrenceUpdate {
+	_u.mutation.ClearEventID()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *SeriesOccurrenceUpdate) SetAttempts(v int) *SeriesOccurrenceUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdate) SetNillableAttempts(v *int) *SeriesOccurrenceUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *SeriesOccurrenceUpdate) AddAttempts(v int) *SeriesOccurrenceUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *SeriesOccurrenceUpdate) SetError(v string) *SeriesOccurrenceUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdate) SetNillableError(v *string) *SeriesOccurrenceUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *SeriesOccurrenceUpdate) ClearError() *SeriesOccurrenceUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SeriesOccurrenceUpdate) SetUpdatedAt(v time.Time) *SeriesOccurrenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SeriesOccurrenceMutation object of the builder.
+func (_u *SeriesOccurrenceUpdate) Mutation() *SeriesOccurrenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SeriesOccurrenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SeriesOccurrenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SeriesOccurrenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SeriesOccurrenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SeriesOccurrenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := seriesoccurrence.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SeriesOccurrenceUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := seriesoccurrence.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SeriesOccurrence.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SeriesOccurrenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seriesoccurrence.Table, seriesoccurrence.Columns, sqlgraph.NewFieldSpec(seriesoccurrence.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(seriesoccurrence.FieldSeriesID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeriesID(); ok {
+		_spec.AddField(seriesoccurrence.FieldSeriesID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(seriesoccurrence.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(seriesoccurrence.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(seriesoccurrence.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(seriesoccurrence.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(seriesoccurrence.FieldEventID, field.TypeUint64, value)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(seriesoccurrence.FieldEventID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(seriesoccurrence.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(seriesoccurrence.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(seriesoccurrence.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(seriesoccurrence.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(seriesoccurrence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seriesoccurrence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SeriesOccurrenceUpdateOne is the builder for updating a single SeriesOccurrence entity.
+type SeriesOccurrenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SeriesOccurrenceMutation
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *SeriesOccurrenceUpdateOne) SetSeriesID(v int) *SeriesOccurrenceUpdateOne {
+	_u.mutation.ResetSeriesID()
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdateOne) SetNillableSeriesID(v *int) *SeriesOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// AddSeriesID adds value to the "series_id" field.
+func (_u *SeriesOccurrenceUpdateOne) AddSeriesID(v int) *SeriesOccurrenceUpdateOne {
+	_u.mutation.AddSeriesID(v)
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *SeriesOccurrenceUpdateOne) SetStartDate(v time.Time) *SeriesOccurrenceUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdateOne) SetNillableStartDate(v *time.Time) *SeriesOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *SeriesOccurrenceUpdateOne) SetEndDate(v time.Time) *SeriesOccurrenceUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdateOne) SetNillableEndDate(v *time.Time) *SeriesOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SeriesOccurrenceUpdateOne) SetStatus(v seriesoccurrence.Status) *SeriesOccurrenceUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdateOne) SetNillableStatus(v *seriesoccurrence.Status) *SeriesOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *SeriesOccurrenceUpdateOne) SetEventID(v uint64) *SeriesOccurrenceUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdateOne) SetNillableEventID(v *uint64) *SeriesOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *SeriesOccurrenceUpdateOne) AddEventID(v int64) *SeriesOccurrenceUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// ClearEventID clears the value of the "event_id" field.
+func (_u *SeriesOccurrenceUpdateOne) ClearEventID() *SeriesOccurrenceUpdateOne {
+	_u.mutation.ClearEventID()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *SeriesOccurrenceUpdateOne) SetAttempts(v int) *SeriesOccurrenceUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdateOne) SetNillableAttempts(v *int) *SeriesOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *SeriesOccurrenceUpdateOne) AddAttempts(v int) *SeriesOccurrenceUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *SeriesOccurrenceUpdateOne) SetError(v string) *SeriesOccurrenceUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *SeriesOccurrenceUpdateOne) SetNillableError(v *string) *SeriesOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *SeriesOccurrenceUpdateOne) ClearError() *SeriesOccurrenceUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SeriesOccurrenceUpdateOne) SetUpdatedAt(v time.Time) *SeriesOccurrenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SeriesOccurrenceMutation object of the builder.
+func (_u *SeriesOccurrenceUpdateOne) Mutation() *SeriesOccurrenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SeriesOccurrenceUpdate builder.
+func (_u *SeriesOccurrenceUpdateOne) Where(ps ...predicate.SeriesOccurrence) *SeriesOccurrenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SeriesOccurrenceUpdateOne) Select(field string, fields ...string) *SeriesOccurrenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SeriesOccurrence entity.
+func (_u *SeriesOccurrenceUpdateOne) Save(ctx context.Context) (*SeriesOccurrence, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SeriesOccurrenceUpdateOne) SaveX(ctx context.Context) *SeriesOccurrence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SeriesOccurrenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SeriesOccurrenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SeriesOccurrenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := seriesoccurrence.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SeriesOccurrenceUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := seriesoccurrence.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SeriesOccurrence.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SeriesOccurrenceUpdateOne) sqlSave(ctx context.Context) (_node *SeriesOccurrence, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(seriesoccurrence.Table, seriesoccurrence.Columns, sqlgraph.NewFieldSpec(seriesoccurrence.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SeriesOccurrence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, seriesoccurrence.FieldID)
+		for _, f := range fields {
+			if !seriesoccurrence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != seriesoccurrence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(seriesoccurrence.FieldSeriesID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeriesID(); ok {
+		_spec.AddField(seriesoccurrence.FieldSeriesID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(seriesoccurrence.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(seriesoccurrence.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(seriesoccurrence.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(seriesoccurrence.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(seriesoccurrence.FieldEventID, field.TypeUint64, value)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(seriesoccurrence.FieldEventID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(seriesoccurrence.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(seriesoccurrence.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(seriesoccurrence.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(seriesoccurrence.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(seriesoccurrence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SeriesOccurrence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{seriesoccurrence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
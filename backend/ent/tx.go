@@ -12,24 +12,72 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// APIKeyUsage is the client for interacting with the APIKeyUsage builders.
+	APIKeyUsage *APIKeyUsageClient
 	// Attendance is the client for interacting with the Attendance builders.
 	Attendance *AttendanceClient
+	// AuthNonce is the client for interacting with the AuthNonce builders.
+	AuthNonce *AuthNonceClient
+	// CalendarToken is the client for interacting with the CalendarToken builders.
+	CalendarToken *CalendarTokenClient
+	// CheckInIntent is the client for interacting with the CheckInIntent builders.
+	CheckInIntent *CheckInIntentClient
+	// CheckInTokenUse is the client for interacting with the CheckInTokenUse builders.
+	CheckInTokenUse *CheckInTokenUseClient
+	// Claim is the client for interacting with the Claim builders.
+	Claim *ClaimClient
+	// ClaimQuota is the client for interacting with the ClaimQuota builders.
+	ClaimQuota *ClaimQuotaClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// DataVersion is the client for interacting with the DataVersion builders.
+	DataVersion *DataVersionClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
+	// EventChange is the client for interacting with the EventChange builders.
+	EventChange *EventChangeClient
+	// EventImportRow is the client for interacting with the EventImportRow builders.
+	EventImportRow *EventImportRowClient
 	// EventPass is the client for interacting with the EventPass builders.
 	EventPass *EventPassClient
+	// EventSeries is the client for interacting with the EventSeries builders.
+	EventSeries *EventSeriesClient
+	// EventStaff is the client for interacting with the EventStaff builders.
+	EventStaff *EventStaffClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// InviteCode is the client for interacting with the InviteCode builders.
+	InviteCode *InviteCodeClient
+	// JoinLink is the client for interacting with the JoinLink builders.
+	JoinLink *JoinLinkClient
+	// KioskDevice is the client for interacting with the KioskDevice builders.
+	KioskDevice *KioskDeviceClient
 	// Like is the client for interacting with the Like builders.
 	Like *LikeClient
 	// Listing is the client for interacting with the Listing builders.
 	Listing *ListingClient
+	// LocationFix is the client for interacting with the LocationFix builders.
+	LocationFix *LocationFixClient
+	// MintCredit is the client for interacting with the MintCredit builders.
+	MintCredit *MintCreditClient
 	// NFTAccessory is the client for interacting with the NFTAccessory builders.
 	NFTAccessory *NFTAccessoryClient
 	// NFTMoment is the client for interacting with the NFTMoment builders.
 	NFTMoment *NFTMomentClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// SeriesOccurrence is the client for interacting with the SeriesOccurrence builders.
+	SeriesOccurrence *SeriesOccurrenceClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
 
 	// lazily loaded.
 	client     *Client
@@ -161,15 +209,39 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.APIKeyUsage = NewAPIKeyUsageClient(tx.config)
 	tx.Attendance = NewAttendanceClient(tx.config)
+	tx.AuthNonce = NewAuthNonceClient(tx.config)
+	tx.CalendarToken = NewCalendarTokenClient(tx.config)
+	tx.CheckInIntent = NewCheckInIntentClient(tx.config)
+	tx.CheckInTokenUse = NewCheckInTokenUseClient(tx.config)
+	tx.Claim = NewClaimClient(tx.config)
+	tx.ClaimQuota = NewClaimQuotaClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
+	tx.DataVersion = NewDataVersionClient(tx.config)
 	tx.Event = NewEventClient(tx.config)
+	tx.EventChange = NewEventChangeClient(tx.config)
+	tx.EventImportRow = NewEventImportRowClient(tx.config)
 	tx.EventPass = NewEventPassClient(tx.config)
+	tx.EventSeries = NewEventSeriesClient(tx.config)
+	tx.EventStaff = NewEventStaffClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.InviteCode = NewInviteCodeClient(tx.config)
+	tx.JoinLink = NewJoinLinkClient(tx.config)
+	tx.KioskDevice = NewKioskDeviceClient(tx.config)
 	tx.Like = NewLikeClient(tx.config)
 	tx.Listing = NewListingClient(tx.config)
+	tx.LocationFix = NewLocationFixClient(tx.config)
+	tx.MintCredit = NewMintCreditClient(tx.config)
 	tx.NFTAccessory = NewNFTAccessoryClient(tx.config)
 	tx.NFTMoment = NewNFTMomentClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.Referral = NewReferralClient(tx.config)
+	tx.SeriesOccurrence = NewSeriesOccurrenceClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WaitlistEntry = NewWaitlistEntryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -179,7 +251,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Attendance.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -37,6 +37,8 @@ type User struct {
 	Socials map[string]string `json:"socials,omitempty"`
 	// IsFreeMinted holds the value of the "is_free_minted" field.
 	IsFreeMinted bool `json:"is_free_minted,omitempty"`
+	// HideFromAttendeeLists holds the value of the "hide_from_attendee_lists" field.
+	HideFromAttendeeLists bool `json:"hide_from_attendee_lists,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -145,7 +147,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldHighlightedEventPassIds, user.FieldSocials:
 			values[i] = new([]byte)
-		case user.FieldIsFreeMinted:
+		case user.FieldIsFreeMinted, user.FieldHideFromAttendeeLists:
 			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldHighlightedMomentID:
 			values[i] = new(sql.NullInt64)
@@ -236,6 +238,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsFreeMinted = value.Bool
 			}
+		case user.FieldHideFromAttendeeLists:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_from_attendee_lists", values[i])
+			} else if value.Valid {
+				_m.HideFromAttendeeLists = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -341,6 +349,9 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_free_minted=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsFreeMinted))
+	builder.WriteString(", ")
+	builder.WriteString("hide_from_attendee_lists=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HideFromAttendeeLists))
 	builder.WriteByte(')')
 	return builder.String()
 }
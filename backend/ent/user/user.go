@@ -32,6 +32,8 @@ const (
 	FieldSocials = "socials"
 	// FieldIsFreeMinted holds the string denoting the is_free_minted field in the database.
 	FieldIsFreeMinted = "is_free_minted"
+	// FieldHideFromAttendeeLists holds the string denoting the hide_from_attendee_lists field in the database.
+	FieldHideFromAttendeeLists = "hide_from_attendee_lists"
 	// EdgeEventPasses holds the string denoting the event_passes edge name in mutations.
 	EdgeEventPasses = "event_passes"
 	// EdgeHostedEvents holds the string denoting the hosted_events edge name in mutations.
@@ -121,6 +123,7 @@ var Columns = []string{
 	FieldHighlightedMomentID,
 	FieldSocials,
 	FieldIsFreeMinted,
+	FieldHideFromAttendeeLists,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -136,6 +139,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultIsFreeMinted holds the default value on creation for the "is_free_minted" field.
 	DefaultIsFreeMinted bool
+	// DefaultHideFromAttendeeLists holds the default value on creation for the "hide_from_attendee_lists" field.
+	DefaultHideFromAttendeeLists bool
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -186,6 +191,11 @@ func ByIsFreeMinted(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsFreeMinted, opts...).ToFunc()
 }
 
+// ByHideFromAttendeeLists orders the results by the hide_from_attendee_lists field.
+func ByHideFromAttendeeLists(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHideFromAttendeeLists, opts...).ToFunc()
+}
+
 // ByEventPassesCount orders the results by event_passes count.
 func ByEventPassesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -94,6 +94,11 @@ func IsFreeMinted(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldIsFreeMinted, v))
 }
 
+// HideFromAttendeeLists applies equality check predicate on the "hide_from_attendee_lists" field. It's identical to HideFromAttendeeListsEQ.
+func HideFromAttendeeLists(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHideFromAttendeeLists, v))
+}
+
 // AddressEQ applies the EQ predicate on the "address" field.
 func AddressEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAddress, v))
@@ -614,6 +619,16 @@ func IsFreeMintedNEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldNEQ(FieldIsFreeMinted, v))
 }
 
+// HideFromAttendeeListsEQ applies the EQ predicate on the "hide_from_attendee_lists" field.
+func HideFromAttendeeListsEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHideFromAttendeeLists, v))
+}
+
+// HideFromAttendeeListsNEQ applies the NEQ predicate on the "hide_from_attendee_lists" field.
+func HideFromAttendeeListsNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldHideFromAttendeeLists, v))
+}
+
 // HasEventPasses applies the HasEdge predicate on the "event_passes" edge.
 func HasEventPasses() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -143,6 +143,20 @@ func (_c *UserCreate) SetNillableIsFreeMinted(v *bool) *UserCreate {
 	return _c
 }
 
+// SetHideFromAttendeeLists sets the "hide_from_attendee_lists" field.
+func (_c *UserCreate) SetHideFromAttendeeLists(v bool) *UserCreate {
+	_c.mutation.SetHideFromAttendeeLists(v)
+	return _c
+}
+
+// SetNillableHideFromAttendeeLists sets the "hide_from_attendee_lists" field if the given value is not nil.
+func (_c *UserCreate) SetNillableHideFromAttendeeLists(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetHideFromAttendeeLists(*v)
+	}
+	return _c
+}
+
 // AddEventPassIDs adds the "event_passes" edge to the EventPass entity by IDs.
 func (_c *UserCreate) AddEventPassIDs(ids ...int) *UserCreate {
 	_c.mutation.AddEventPassIDs(ids...)
@@ -302,6 +316,10 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultIsFreeMinted
 		_c.mutation.SetIsFreeMinted(v)
 	}
+	if _, ok := _c.mutation.HideFromAttendeeLists(); !ok {
+		v := user.DefaultHideFromAttendeeLists
+		_c.mutation.SetHideFromAttendeeLists(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -312,6 +330,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.IsFreeMinted(); !ok {
 		return &ValidationError{Name: "is_free_minted", err: errors.New(`ent: missing required field "User.is_free_minted"`)}
 	}
+	if _, ok := _c.mutation.HideFromAttendeeLists(); !ok {
+		return &ValidationError{Name: "hide_from_attendee_lists", err: errors.New(`ent: missing required field "User.hide_from_attendee_lists"`)}
+	}
 	return nil
 }
 
@@ -378,6 +399,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldIsFreeMinted, field.TypeBool, value)
 		_node.IsFreeMinted = value
 	}
+	if value, ok := _c.mutation.HideFromAttendeeLists(); ok {
+		_spec.SetField(user.FieldHideFromAttendeeLists, field.TypeBool, value)
+		_node.HideFromAttendeeLists = value
+	}
 	if nodes := _c.mutation.EventPassesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -221,6 +221,20 @@ func (_u *UserUpdate) SetNillableIsFreeMinted(v *bool) *UserUpdate {
 	return _u
 }
 
+// SetHideFromAttendeeLists sets the "hide_from_attendee_lists" field.
+func (_u *UserUpdate) SetHideFromAttendeeLists(v bool) *UserUpdate {
+	_u.mutation.SetHideFromAttendeeLists(v)
+	return _u
+}
+
+// SetNillableHideFromAttendeeLists sets the "hide_from_attendee_lists" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableHideFromAttendeeLists(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetHideFromAttendeeLists(*v)
+	}
+	return _u
+}
+
 // AddEventPassIDs adds the "event_passes" edge to the EventPass entity by IDs.
 func (_u *UserUpdate) AddEventPassIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddEventPassIDs(ids...)
@@ -612,6 +626,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsFreeMinted(); ok {
 		_spec.SetField(user.FieldIsFreeMinted, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.HideFromAttendeeLists(); ok {
+		_spec.SetField(user.FieldHideFromAttendeeLists, field.TypeBool, value)
+	}
 	if _u.mutation.EventPassesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1177,6 +1194,20 @@ func (_u *UserUpdateOne) SetNillableIsFreeMinted(v *bool) *UserUpdateOne {
 	return _u
 }
 
+// SetHideFromAttendeeLists sets the "hide_from_attendee_lists" field.
+func (_u *UserUpdateOne) SetHideFromAttendeeLists(v bool) *UserUpdateOne {
+	_u.mutation.SetHideFromAttendeeLists(v)
+	return _u
+}
+
+// SetNillableHideFromAttendeeLists sets the "hide_from_attendee_lists" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableHideFromAttendeeLists(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetHideFromAttendeeLists(*v)
+	}
+	return _u
+}
+
 // AddEventPassIDs adds the "event_passes" edge to the EventPass entity by IDs.
 func (_u *UserUpdateOne) AddEventPassIDs(ids ...int) *UserUpdateOne {
 	_u.mutation.AddEventPassIDs(ids...)
@@ -1598,6 +1629,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.IsFreeMinted(); ok {
 		_spec.SetField(user.FieldIsFreeMinted, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.HideFromAttendeeLists(); ok {
+		_spec.SetField(user.FieldHideFromAttendeeLists, field.TypeBool, value)
+	}
 	if _u.mutation.EventPassesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package waitlistentry
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldID, id))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldEventID, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldAddress, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldPosition, v))
+}
+
+// OfferedAt applies equality check predicate on the "offered_at" field. It's identical to OfferedAtEQ.
+func OfferedAt(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldOfferedAt, v))
+}
+
+// OfferExpiresAt applies equality check predicate on the "offer_expires_at" field. It's identical to OfferExpiresAtEQ.
+func OfferExpiresAt(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldOfferExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uint64) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldEventID, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldPosition, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// OfferedAtEQ applies the EQ predicate on the "offered_at" field.
+func OfferedAtEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldOfferedAt, v))
+}
+
+// OfferedAtNEQ applies the NEQ predicate on the "offered_at" field.
+func OfferedAtNEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldOfferedAt, v))
+}
+
+// OfferedAtIn applies the In predicate on the "offered_at" field.
+func OfferedAtIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldOfferedAt, vs...))
+}
+
+// OfferedAtNotIn applies the NotIn predicate on the "offered_at" field.
+func OfferedAtNotIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldOfferedAt, vs...))
+}
+
+// OfferedAtGT applies the GT predicate on the "offered_at" field.
+func OfferedAtGT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldOfferedAt, v))
+}
+
+// OfferedAtGTE applies the GTE predicate on the "offered_at" field.
+func OfferedAtGTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldOfferedAt, v))
+}
+
+// OfferedAtLT applies the LT predicate on the "offered_at" field.
+func OfferedAtLT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldOfferedAt, v))
+}
+
+// OfferedAtLTE applies the LTE predicate on the "offered_at" field.
+func OfferedAtLTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldOfferedAt, v))
+}
+
+// OfferedAtIsNil applies the IsNil predicate on the "offered_at" field.
+func OfferedAtIsNil() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIsNull(FieldOfferedAt))
+}
+
+// OfferedAtNotNil applies the NotNil predicate on the "offered_at" field.
+func OfferedAtNotNil() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotNull(FieldOfferedAt))
+}
+
+// OfferExpiresAtEQ applies the EQ predicate on the "offer_expires_at" field.
+func OfferExpiresAtEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtNEQ applies the NEQ predicate on the "offer_expires_at" field.
+func OfferExpiresAtNEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtIn applies the In predicate on the "offer_expires_at" field.
+func OfferExpiresAtIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldOfferExpiresAt, vs...))
+}
+
+// OfferExpiresAtNotIn applies the NotIn predicate on the "offer_expires_at" field.
+func OfferExpiresAtNotIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldOfferExpiresAt, vs...))
+}
+
+// OfferExpiresAtGT applies the GT predicate on the "offer_expires_at" field.
+func OfferExpiresAtGT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtGTE applies the GTE predicate on the "offer_expires_at" field.
+func OfferExpiresAtGTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtLT applies the LT predicate on the "offer_expires_at" field.
+func OfferExpiresAtLT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtLTE applies the LTE predicate on the "offer_expires_at" field.
+func OfferExpiresAtLTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtIsNil applies the IsNil predicate on the "offer_expires_at" field.
+func OfferExpiresAtIsNil() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIsNull(FieldOfferExpiresAt))
+}
+
+// OfferExpiresAtNotNil applies the NotNil predicate on the "offer_expires_at" field.
+func OfferExpiresAtNotNil() predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotNull(FieldOfferExpiresAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WaitlistEntry) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WaitlistEntry) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WaitlistEntry) predicate.WaitlistEntry {
+	return predicate.WaitlistEntry(sql.NotPredicates(p))
+}
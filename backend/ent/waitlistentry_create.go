@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/waitlistentry"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryCreate is the builder for creating a WaitlistEntry entity.
+type WaitlistEntryCreate struct {
+	config
+	mutation *WaitlistEntryMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *WaitlistEntryCreate) SetEventID(v uint64) *WaitlistEntryCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetAddress sets the "address" field.
+func (_c *WaitlistEntryCreate) SetAddress(v string) *WaitlistEntryCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *WaitlistEntryCreate) SetPosition(v int) *WaitlistEntryCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WaitlistEntryCreate) SetStatus(v waitlistentry.Status) *WaitlistEntryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableStatus(v *waitlistentry.Status) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (_c *WaitlistEntryCreate) SetOfferedAt(v time.Time) *WaitlistEntryCreate {
+	_c.mutation.SetOfferedAt(v)
+	return _c
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableOfferedAt(v *time.Time) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetOfferedAt(*v)
+	}
+	return _c
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (_c *WaitlistEntryCreate) SetOfferExpiresAt(v time.Time) *WaitlistEntryCreate {
+	_c.mutation.SetOfferExpiresAt(v)
+	return _c
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableOfferExpiresAt(v *time.Time) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetOfferExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WaitlistEntryCreate) SetCreatedAt(v time.Time) *WaitlistEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableCreatedAt(v *time.Time) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WaitlistEntryCreate) SetUpdatedAt(v time.Time) *WaitlistEntryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WaitlistEntryCreate) SetNillableUpdatedAt(v *time.Time) *WaitlistEntryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_c *WaitlistEntryCreate) Mutation() *WaitlistEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the WaitlistEntry in the database.
+func (_c *WaitlistEntryCreate) Save(ctx context.Context) (*WaitlistEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WaitlistEntryCreate) SaveX(ctx context.Context) *WaitlistEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaitlistEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaitlistEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WaitlistEntryCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := waitlistentry.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := waitlistentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := waitlistentry.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WaitlistEntryCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "WaitlistEntry.event_id"`)}
+	}
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "WaitlistEntry.address"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "WaitlistEntry.position"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WaitlistEntry.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WaitlistEntry.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WaitlistEntry.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *WaitlistEntryCreate) sqlSave(ctx context.Context) (*WaitlistEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WaitlistEntryCreate) createSpec() (*WaitlistEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaitlistEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(waitlistentry.Table, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(waitlistentry.FieldEventID, field.TypeUint64, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(waitlistentry.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(waitlistentry.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+		_node.OfferedAt = &value
+	}
+	if value, ok := _c.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+		_node.OfferExpiresAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// WaitlistEntryCreateBulk is the builder for creating many WaitlistEntry entities in bulk.
+type WaitlistEntryCreateBulk struct {
+	config
+	err      error
+	builders []*WaitlistEntryCreate
+}
+
+// Save creates the WaitlistEntry entities in the database.
+func (_c *WaitlistEntryCreateBulk) Save(ctx context.Context) ([]*WaitlistEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WaitlistEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaitlistEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WaitlistEntryCreateBulk) SaveX(ctx context.Context) []*WaitlistEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaitlistEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaitlistEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/waitlistentry"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryUpdate is the builder for updating WaitlistEntry entities.
+type WaitlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (_u *WaitlistEntryUpdate) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *WaitlistEntryUpdate) SetEventID(v uint64) *WaitlistEntryUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableEventID(v *uint64) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *WaitlistEntryUpdate) AddEventID(v int64) *WaitlistEntryUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *WaitlistEntryUpdate) SetAddress(v string) *WaitlistEntryUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableAddress(v *string) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *WaitlistEntryUpdate) SetPosition(v int) *WaitlistEntryUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillablePosition(v *int) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *WaitlistEntryUpdate) AddPosition(v int) *WaitlistEntryUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaitlistEntryUpdate) SetStatus(v waitlistentry.Status) *WaitlistEntryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableStatus(v *waitlistentry.Status) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (_u *WaitlistEntryUpdate) SetOfferedAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetOfferedAt(v)
+	return _u
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableOfferedAt(v *time.Time) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetOfferedAt(*v)
+	}
+	return _u
+}
+
+// ClearOfferedAt clears the value of the "offered_at" field.
+func (_u *WaitlistEntryUpdate) ClearOfferedAt() *WaitlistEntryUpdate {
+	_u.mutation.ClearOfferedAt()
+	return _u
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (_u *WaitlistEntryUpdate) SetOfferExpiresAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetOfferExpiresAt(v)
+	return _u
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableOfferExpiresAt(v *time.Time) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetOfferExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (_u *WaitlistEntryUpdate) ClearOfferExpiresAt() *WaitlistEntryUpdate {
+	_u.mutation.ClearOfferExpiresAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WaitlistEntryUpdate) SetUpdatedAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_u *WaitlistEntryUpdate) Mutation() *WaitlistEntryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WaitlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WaitlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WaitlistEntryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := waitlistentry.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaitlistEntryUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WaitlistEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(waitlistentry.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(waitlistentry.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(waitlistentry.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(waitlistentry.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(waitlistentry.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfferedAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WaitlistEntryUpdateOne is the builder for updating a single WaitlistEntry entity.
+type WaitlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *WaitlistEntryUpdateOne) SetEventID(v uint64) *WaitlistEntryUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableEventID(v *uint64) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *WaitlistEntryUpdateOne) AddEventID(v int64) *WaitlistEntryUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *WaitlistEntryUpdateOne) SetAddress(v string) *WaitlistEntryUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableAddress(v *string) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *WaitlistEntryUpdateOne) SetPosition(v int) *WaitlistEntryUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillablePosition(v *int) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *WaitlistEntryUpdateOne) AddPosition(v int) *WaitlistEntryUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaitlistEntryUpdateOne) SetStatus(v waitlistentry.Status) *WaitlistEntryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableStatus(v *waitlistentry.Status) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetOfferedAt sets the "offered_at" field.
+func (_u *WaitlistEntryUpdateOne) SetOfferedAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetOfferedAt(v)
+	return _u
+}
+
+// SetNillableOfferedAt sets the "offered_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableOfferedAt(v *time.Time) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetOfferedAt(*v)
+	}
+	return _u
+}
+
+// ClearOfferedAt clears the value of the "offered_at" field.
+func (_u *WaitlistEntryUpdateOne) ClearOfferedAt() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearOfferedAt()
+	return _u
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (_u *WaitlistEntryUpdateOne) SetOfferExpiresAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetOfferExpiresAt(v)
+	return _u
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableOfferExpiresAt(v *time.Time) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetOfferExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (_u *WaitlistEntryUpdateOne) ClearOfferExpiresAt() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearOfferExpiresAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WaitlistEntryUpdateOne) SetUpdatedAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_u *WaitlistEntryUpdateOne) Mutation() *WaitlistEntryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (_u *WaitlistEntryUpdateOne) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WaitlistEntryUpdateOne) Select(field string, fields ...string) *WaitlistEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WaitlistEntry entity.
+func (_u *WaitlistEntryUpdateOne) Save(ctx context.Context) (*WaitlistEntry, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistEntryUpdateOne) SaveX(ctx context.Context) *WaitlistEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WaitlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WaitlistEntryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := waitlistentry.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaitlistEntryUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WaitlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaitlistEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for _, f := range fields {
+			if !waitlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(waitlistentry.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(waitlistentry.FieldEventID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(waitlistentry.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(waitlistentry.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(waitlistentry.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OfferedAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfferedAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &WaitlistEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
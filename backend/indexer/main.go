@@ -31,6 +31,8 @@ var (
 	ProfileUpdated                 = fmt.Sprintf("A.%s.UserProfile.ProfileUpdated", config.ContractAddress)
 	UserRegisteredEvent            = fmt.Sprintf("A.%s.EventManager.UserRegistered", config.ContractAddress)
 	UserCheckedInEvent             = fmt.Sprintf("A.%s.EventManager.UserCheckedIn", config.ContractAddress)
+	UserUnregisteredEvent          = fmt.Sprintf("A.%s.EventManager.UserUnregistered", config.ContractAddress)
+	EventQuotaUpdatedEvent         = fmt.Sprintf("A.%s.EventManager.EventQuotaUpdated", config.ContractAddress)
 	EventPassMinted                = fmt.Sprintf("A.%s.EventPass.Minted", config.ContractAddress)
 	ListingAvailable               = "A.2d55b98eb200daef.NFTStorefrontV2.ListingAvailable"
 	ListingCompleted               = "A.2d55b98eb200daef.NFTStorefrontV2.ListingCompleted"
@@ -76,7 +78,7 @@ func main() {
 				NFTMomentMinted, NFTAccessoryMinted, NFTMomentEquipAccessory, NFTMomentUnequipAccessory,
 				FlowCapabilityControllerIssued, EventCreated, UserRegisteredEvent, UserCheckedInEvent,
 				EventPassMinted, ProfileUpdated, ListingAvailable, NFTDeposited, ListingCompleted,
-				NFTMomentMintedWithEventPass, ListingDestroyed,
+				NFTMomentMintedWithEventPass, ListingDestroyed, UserUnregisteredEvent, EventQuotaUpdatedEvent,
 			},
 		},
 	)
@@ -114,6 +116,10 @@ func main() {
 					utils.UserRegistered(ctx, ev, client)
 				case UserCheckedInEvent:
 					utils.UserCheckedIn(ctx, ev, client)
+				case UserUnregisteredEvent:
+					utils.UserUnregistered(ctx, ev, client)
+				case EventQuotaUpdatedEvent:
+					utils.EventQuotaUpdated(ctx, ev, client)
 				case EventPassMinted:
 					utils.EventPassMinted(ctx, ev, client)
 				case ProfileUpdated:
@@ -10,10 +10,12 @@ import (
 
 // Definisikan ulang 'Pagination' agar 'swag' mengerti
 type Pagination struct {
-	TotalItems  int `json:"totalItems"`
-	TotalPages  int `json:"totalPages"`
-	CurrentPage int `json:"currentPage"`
-	PageSize    int `json:"pageSize"`
+	TotalItems  int    `json:"totalItems"`
+	TotalPages  int    `json:"totalPages"`
+	CurrentPage int    `json:"currentPage"`
+	PageSize    int    `json:"pageSize"`
+	NextCursor  string `json:"nextCursor,omitempty"` // Mode cursor: cursor halaman berikutnya
+	PrevCursor  string `json:"prevCursor,omitempty"` // Mode cursor: cursor halaman sebelumnya
 }
 
 // --- Ini adalah 'struct' DTO bersih yang Anda minta ---
@@ -32,18 +34,30 @@ type EventEdges struct {
 
 // EventResponse bersih (sesuai JSON Anda)
 type EventResponse struct {
-	ID           int        `json:"id"`
-	EventID      uint64     `json:"event_id"`
-	Name         string     `json:"name"`
-	Description  string     `json:"description"`
-	Thumbnail    string     `json:"thumbnail"`
-	Location     string     `json:"location"`
-	StartDate    time.Time  `json:"start_date"`
-	EndDate      time.Time  `json:"end_date"`
-	Quota        uint64     `json:"quota"`
-	IsRegistered bool       `json:"is_registered"`
-	IsCheckedIn  bool       `json:"is_checked_in"`
-	Edges        EventEdges `json:"edges"` // <-- Menggunakan struct 'edges' bersih
+	ID                 int                    `json:"id"`
+	EventID            uint64                 `json:"event_id"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Thumbnail          string                 `json:"thumbnail"`
+	Location           string                 `json:"location"`
+	StartDate          time.Time              `json:"start_date"`
+	EndDate            time.Time              `json:"end_date"`
+	Quota              uint64                 `json:"quota"`
+	CheckinRadiusM     float64                `json:"checkin_radius_m"`                             // Radius self check-in (meter), 0 = nonaktif
+	Status             string                 `json:"status,omitempty" example:"upcoming"`          // Terhitung: upcoming | ongoing | ended | cancelled
+	RegistrationStatus string                 `json:"registration_status,omitempty" example:"open"` // Terhitung: open | full | closed
+	RegisteredCount    int                    `json:"registered_count" example:"12"`
+	CheckedInCount     int                    `json:"checked_in_count" example:"4"`
+	IsRegistered       bool                   `json:"is_registered"`
+	IsCheckedIn        bool                   `json:"is_checked_in"`
+	DistanceKm         *float64               `json:"distance_km,omitempty"` // Hanya untuk pencarian lokasi (near/bbox)
+	CancelledAt        *time.Time             `json:"cancelled_at,omitempty"`
+	CancelReason       string                 `json:"cancel_reason,omitempty"`
+	Revision           int                    `json:"revision"`                                       // Jumlah perubahan oleh host
+	Changes            []*EventChangeResponse `json:"changes"`                                        // Riwayat perubahan, terbaru lebih dulu
+	SeriesID           *int                   `json:"series_id,omitempty"`                            // Jika event adalah occurrence dari EventSeries
+	AttendeeVisibility string                 `json:"attendee_visibility,omitempty" example:"public"` // public | attendees | host
+	Edges              EventEdges             `json:"edges"`                                          // <-- Menggunakan struct 'edges' bersih
 }
 
 // GetEventsResponse bersih (pembungkus utama)
@@ -238,3 +252,401 @@ type GetUsersResponse struct {
 	Data       []*DTOUserProfile `json:"data"`
 	Pagination *Pagination       `json:"pagination"`
 }
+
+// CreateEventResponse (Data respons pembuatan event oleh backend)
+type CreateEventResponse struct {
+	Message      string `json:"message"        example:"Event created successfully!"`
+	EventID      uint64 `json:"event_id"       example:"12"`
+	Indexed      bool   `json:"indexed"`
+	Thumbnail    string `json:"thumbnail"      example:"https://.../ipfs/bafy..."`
+	EventPassImg string `json:"event_pass_img,omitempty"`
+}
+
+// BatchCheckInResult (Hasil check-in per alamat)
+// Status: "checked_in", "already_checked_in", "not_registered", "invalid_address", "failed"
+type BatchCheckInResult struct {
+	UserAddress string `json:"userAddress" example:"0x1bb6b1e0a5170088"`
+	Status      string `json:"status"      example:"checked_in"`
+	Error       string `json:"error,omitempty"`
+}
+
+// BatchCheckInResponse (Data respons batch check-in)
+type BatchCheckInResponse struct {
+	EventID      string                `json:"eventID" example:"1"`
+	CheckedIn    int                   `json:"checkedIn"`
+	Skipped      int                   `json:"skipped"`
+	Failed       int                   `json:"failed"`
+	Transactions int                   `json:"transactions"`
+	Results      []*BatchCheckInResult `json:"results"`
+}
+
+// QueuedCheckInResult (Hasil penerimaan intent per item)
+// Status: "queued", "requeued" (intent 'failed' di-scan ulang), "duplicate", "invalid"
+type QueuedCheckInResult struct {
+	EventID     string `json:"eventID"`
+	UserAddress string `json:"userAddress"`
+	Status      string `json:"status" example:"queued"`
+	Error       string `json:"error,omitempty"`
+}
+
+// CheckInQueueProgress (Progres antrian check-in untuk satu event)
+type CheckInQueueProgress struct {
+	EventID    uint64                 `json:"eventID"`
+	Pending    int                    `json:"pending"`
+	Processing int                    `json:"processing"`
+	Done       int                    `json:"done"`
+	Failed     int                    `json:"failed"`
+	Failures   []*CheckInQueueFailure `json:"failures,omitempty"`
+}
+
+type CheckInQueueFailure struct {
+	UserAddress string    `json:"userAddress"`
+	DeviceID    string    `json:"deviceID"`
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"lastError"`
+	ScannedAt   time.Time `json:"scannedAt"`
+}
+
+// CheckInTokenResponse (Token QR check-in untuk registrant)
+type CheckInTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// SelfCheckInResponse (Hasil self check-in berbasis lokasi)
+type SelfCheckInResponse struct {
+	Message     string  `json:"message"`
+	UserAddress string  `json:"userAddress"`
+	EventID     uint64  `json:"eventID"`
+	DistanceM   float64 `json:"distanceM"`
+}
+
+// JoinLinkResponse (Link join unik untuk event online)
+type JoinLinkResponse struct {
+	EventID     uint64 `json:"eventID"`
+	UserAddress string `json:"userAddress"`
+	URL         string `json:"url"       example:"https://api.capt.today/join/3f9a..."`
+	Heartbeat   string `json:"heartbeat" example:"https://api.capt.today/join/3f9a.../heartbeat"`
+}
+
+// JoinHeartbeatResponse (Status kehadiran setelah heartbeat)
+type JoinHeartbeatResponse struct {
+	PresentMinutes  int  `json:"presentMinutes"`
+	RequiredMinutes int  `json:"requiredMinutes"`
+	Qualified       bool `json:"qualified"`
+}
+
+// AuthNonceResponse (Nonce untuk FCL account-proof)
+type AuthNonceResponse struct {
+	AppIdentifier string    `json:"appIdentifier" example:"Capt.today"`
+	Nonce         string    `json:"nonce"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
+
+// SessionResponse (Token sesi setelah login)
+type SessionResponse struct {
+	Token     string    `json:"token"`
+	Address   string    `json:"address" example:"0x1bb6b1e0a5170088"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// EventStaffResponse (Staff yang didelegasikan host)
+type EventStaffResponse struct {
+	Address   string    `json:"address" example:"0x1bb6b1e0a5170088"`
+	AddedBy   string    `json:"addedBy" example:"0xe03daebed8ca0615"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// KioskDeviceResponse (Kiosk check-in offline yang terdaftar untuk satu event)
+// 'secret' hanya diisi sekali, saat kiosk didaftarkan.
+type KioskDeviceResponse struct {
+	DeviceID      string     `json:"deviceID" example:"kiosk_4f1c2a9b7e3d5f60a1b2c3d4e5f60718"`
+	Name          string     `json:"name" example:"Pintu Utara"`
+	EventID       uint64     `json:"eventID"`
+	ProvisionedBy string     `json:"provisionedBy" example:"0x1bb6b1e0a5170088"`
+	Secret        string     `json:"secret,omitempty"`
+	RevokedAt     *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+// APIKeyResponse (API key partner, tanpa kunci mentah)
+type APIKeyResponse struct {
+	ID            int        `json:"id"`
+	Name          string     `json:"name" example:"Partner Ticketing"`
+	Prefix        string     `json:"prefix" example:"capt_1a2b3c"`
+	Scopes        []string   `json:"scopes" example:"read"`
+	OwnerAddress  string     `json:"ownerAddress,omitempty" example:"0x1234567890abcdef"`
+	RatePerMinute int        `json:"ratePerMinute" example:"60"`
+	DailyQuota    int        `json:"dailyQuota" example:"10000"`
+	UsedToday     int        `json:"usedToday" example:"120"`
+	TotalRequests int64      `json:"totalRequests" example:"5230"`
+	CreatedBy     string     `json:"createdBy"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	LastUsedAt    *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt     *time.Time `json:"revokedAt,omitempty"`
+}
+
+// CreateAPIKeyResponse (Kunci mentah hanya dikembalikan sekali)
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key" example:"capt_1a2b3c..."`
+}
+
+// ClaimQuotaResponse (Konfigurasi kuota klaim)
+type ClaimQuotaResponse struct {
+	Kind            string     `json:"kind" example:"free_mint"`
+	Scope           string     `json:"scope" example:"global"`
+	PerSubjectLimit int        `json:"perSubjectLimit" example:"1"`
+	TotalLimit      int        `json:"totalLimit" example:"0"`
+	StartsAt        *time.Time `json:"startsAt,omitempty"`
+	EndsAt          *time.Time `json:"endsAt,omitempty"`
+}
+
+// ClaimRemainingResponse (Sisa kuota klaim user)
+type ClaimRemainingResponse struct {
+	Kind      string `json:"kind" example:"free_mint"`
+	Scope     string `json:"scope" example:"global"`
+	Limit     int    `json:"limit" example:"1"`
+	Remaining int    `json:"remaining" example:"1"`
+}
+
+// InviteCodeResponse (Kode undangan referral)
+type InviteCodeResponse struct {
+	Code      string    `json:"code" example:"K3QZ7MNA"`
+	MaxUses   int       `json:"maxUses" example:"10"`
+	Uses      int       `json:"uses" example:"2"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ReferralResponse (Referral yang tercatat)
+type ReferralResponse struct {
+	Code           string    `json:"code" example:"K3QZ7MNA"`
+	InviterAddress string    `json:"inviterAddress" example:"0xe03daebed8ca0615"`
+	InviteeAddress string    `json:"inviteeAddress" example:"0x1bb6b1e0a5170088"`
+	Status         string    `json:"status" example:"pending"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// ReferralStatsResponse (Statistik referral user)
+type ReferralStatsResponse struct {
+	Codes            []*InviteCodeResponse `json:"codes"`
+	Invited          int                   `json:"invited" example:"3"`
+	Pending          int                   `json:"pending" example:"1"`
+	Qualified        int                   `json:"qualified" example:"2"`
+	CreditsEarned    int                   `json:"creditsEarned" example:"2"`
+	CreditsAvailable int                   `json:"creditsAvailable" example:"1"`
+	CreditsSpent     int                   `json:"creditsSpent" example:"1"`
+}
+
+// ReferralInviterStat (Inviter teratas)
+type ReferralInviterStat struct {
+	Address   string `json:"address" example:"0xe03daebed8ca0615"`
+	Qualified int    `json:"qualified" example:"12"`
+}
+
+// AdminReferralStatsResponse (Statistik referral seluruh platform)
+type AdminReferralStatsResponse struct {
+	TotalReferrals int                    `json:"totalReferrals"`
+	Pending        int                    `json:"pending"`
+	Qualified      int                    `json:"qualified"`
+	CreditsIssued  int                    `json:"creditsIssued"`
+	CreditsSpent   int                    `json:"creditsSpent"`
+	TopInviters    []*ReferralInviterStat `json:"topInviters"`
+}
+
+// SearchResult (Satu hasil pencarian full-text)
+type SearchResult struct {
+	Type    string  `json:"type" example:"event"` // event | moment | accessory | user
+	ID      string  `json:"id" example:"12"`      // event_id, nft_id, atau address (user)
+	Title   string  `json:"title" example:"Flow Jakarta Meetup"`
+	Snippet string  `json:"snippet" example:"... <b>meetup</b> komunitas di Jakarta ..."` // Kata yang cocok dibungkus <b>
+	Rank    float64 `json:"rank" example:"0.42"`
+}
+
+// SearchFacet (Jumlah hasil per tipe entity)
+type SearchFacet struct {
+	Type  string `json:"type" example:"event"`
+	Count int    `json:"count" example:"4"`
+}
+
+// SearchResponse (Hasil pencarian gabungan)
+type SearchResponse struct {
+	Query   string          `json:"query" example:"meetup jakarta"`
+	Results []*SearchResult `json:"results"`
+	Facets  []*SearchFacet  `json:"facets"`
+}
+
+// EventClusterResponse (Satu cluster event di peta)
+type EventClusterResponse struct {
+	Count    int      `json:"count" example:"12"`
+	Lat      float64  `json:"lat" example:"-6.2"`    // Centroid
+	Long     float64  `json:"long" example:"106.8"`  // Centroid
+	MinLat   float64  `json:"minLat" example:"-6.3"` // Batas cluster (untuk zoom-in)
+	MaxLat   float64  `json:"maxLat" example:"-6.1"`
+	MinLong  float64  `json:"minLong" example:"106.7"`
+	MaxLong  float64  `json:"maxLong" example:"106.9"`
+	EventIDs []uint64 `json:"eventIds"` // Contoh event ID (maks. 5, yang paling baru)
+}
+
+// EventClustersResponse (Hasil clustering event)
+type EventClustersResponse struct {
+	Zoom        int                     `json:"zoom" example:"10"`
+	TotalEvents int                     `json:"totalEvents" example:"42"`
+	Clusters    []*EventClusterResponse `json:"clusters"`
+}
+
+// CalendarTokenResponse (Token feed kalender, hanya ditampilkan sekali)
+type CalendarTokenResponse struct {
+	Token string `json:"token" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	URL   string `json:"url" example:"https://api.capt.today/users/0x1bb6b1e0a5170088/calendar.ics?token=9f86d081884c7d659a2feaa0c55ad015"`
+}
+
+// EventImportPreview (Data event hasil parsing satu baris import)
+type EventImportPreview struct {
+	Name         string   `json:"name" example:"Flow Meetup Jakarta"`
+	Description  string   `json:"description"`
+	EventType    uint8    `json:"eventType" example:"1"`
+	Location     string   `json:"location" example:"Senayan, Jakarta"`
+	Lat          *float64 `json:"lat,omitempty" example:"-6.2183"`
+	Long         *float64 `json:"long,omitempty" example:"106.8027"`
+	Geocoded     bool     `json:"geocoded"` // true = lat/long hasil geocoding alamat
+	StartDate    string   `json:"startDate" example:"2026-11-01T10:00:00Z"`
+	EndDate      string   `json:"endDate" example:"2026-11-01T12:00:00Z"`
+	Quota        uint64   `json:"quota" example:"100"`
+	Thumbnail    string   `json:"thumbnail"` // Kosong = memakai file 'thumbnail' yang di-upload
+	EventPassImg string   `json:"eventPassImg,omitempty"`
+}
+
+// EventImportRowResult (Hasil import per baris)
+// Status: "valid" (dry run), "invalid", "skipped", "queued", lalu (dari worker) "creating", "created", "failed"
+type EventImportRowResult struct {
+	Row     int                 `json:"row" example:"2"` // Nomor baris CSV / urutan VEVENT
+	Status  string              `json:"status" example:"valid"`
+	Reason  string              `json:"reason,omitempty"`
+	Errors  []string            `json:"errors,omitempty"`
+	EventID *uint64             `json:"eventID,omitempty" example:"12"`
+	Event   *EventImportPreview `json:"event"`
+}
+
+// EventImportResponse (Ringkasan import event)
+type EventImportResponse struct {
+	ImportID string                  `json:"importID,omitempty" example:"3f2a9c1e8b7d4a60b5e1c2d3f4a5b6c7"` // Untuk GET /events/import/{id} (dryRun=false)
+	Format   string                  `json:"format" example:"csv"`
+	DryRun   bool                    `json:"dryRun" example:"true"`
+	Total    int                     `json:"total"`
+	Valid    int                     `json:"valid"`
+	Invalid  int                     `json:"invalid"`
+	Skipped  int                     `json:"skipped"`
+	Queued   int                     `json:"queued"` // Masih antri / sedang dikirim
+	Created  int                     `json:"created"`
+	Failed   int                     `json:"failed"`
+	Rows     []*EventImportRowResult `json:"rows"`
+}
+
+// WaitlistEntryResponse (Posisi user di waitlist event)
+// Status: "waiting", "offered", "claimed", "expired", "left"
+type WaitlistEntryResponse struct {
+	EventID        uint64     `json:"eventID" example:"1"`
+	Address        string     `json:"address" example:"0x1bb6b1e0a5170088"`
+	Status         string     `json:"status" example:"waiting"`
+	Rank           int        `json:"rank,omitempty" example:"3"` // Urutan di antrian (hanya status 'waiting')
+	OfferedAt      *time.Time `json:"offeredAt,omitempty"`
+	OfferExpiresAt *time.Time `json:"offerExpiresAt,omitempty"` // Batas waktu register on-chain (slot ditahan on-chain sampai saat ini)
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// NotificationResponse (Notifikasi in-app)
+type NotificationResponse struct {
+	ID        int            `json:"id" example:"1"`
+	Type      string         `json:"type" example:"waitlist_offer"`
+	Title     string         `json:"title" example:"Slot event tersedia!"`
+	Body      string         `json:"body"`
+	Data      map[string]any `json:"data,omitempty"`
+	ReadAt    *time.Time     `json:"readAt,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+// EventFieldChangeResponse (Perubahan satu field event)
+type EventFieldChangeResponse struct {
+	Field string `json:"field" example:"start_date"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// EventChangeResponse (Riwayat perubahan event oleh host)
+// Kind: "updated", "cancelled"
+type EventChangeResponse struct {
+	Revision  int                        `json:"revision" example:"1"`
+	Kind      string                     `json:"kind" example:"updated"`
+	Changes   []EventFieldChangeResponse `json:"changes"`
+	Reason    string                     `json:"reason,omitempty"`
+	ChangedBy string                     `json:"changedBy" example:"0x1bb6b1e0a5170088"`
+	CreatedAt time.Time                  `json:"createdAt"`
+}
+
+// EventSeriesResponse (Event berulang milik host)
+// Status: "active", "paused", "completed", "stopped"
+type EventSeriesResponse struct {
+	ID              int                         `json:"id" example:"1"`
+	HostAddress     string                      `json:"hostAddress" example:"0x1bb6b1e0a5170088"`
+	Name            string                      `json:"name" example:"Flow Builders Meetup"`
+	Description     string                      `json:"description"`
+	Thumbnail       string                      `json:"thumbnail"`
+	EventPassImg    string                      `json:"eventPassImg,omitempty"`
+	EventType       uint8                       `json:"eventType" example:"1"`
+	Location        string                      `json:"location" example:"Senayan, Jakarta"`
+	Lat             float64                     `json:"lat"`
+	Long            float64                     `json:"long"`
+	Quota           uint64                      `json:"quota" example:"50"`
+	RRule           string                      `json:"rrule" example:"FREQ=WEEKLY;BYDAY=TU;COUNT=10"`
+	Timezone        string                      `json:"timezone" example:"Asia/Jakarta"`
+	StartDate       time.Time                   `json:"startDate"` // Occurrence pertama (DTSTART)
+	DurationMinutes int64                       `json:"durationMinutes" example:"120"`
+	LeadDays        int                         `json:"leadDays" example:"28"` // Occurrence dibuat on-chain N hari sebelumnya
+	Status          string                      `json:"status" example:"active"`
+	NextOccurrences []time.Time                 `json:"nextOccurrences"` // Jadwal berikutnya menurut aturan (pratinjau)
+	Occurrences     []*SeriesOccurrenceResponse `json:"occurrences,omitempty"`
+	CreatedAt       time.Time                   `json:"createdAt"`
+}
+
+// SeriesOccurrenceResponse (Satu jadwal dari EventSeries)
+// Status: "pending", "creating", "created", "failed", "skipped"
+type SeriesOccurrenceResponse struct {
+	ID        int       `json:"id" example:"1"`
+	StartDate time.Time `json:"startDate"`
+	EndDate   time.Time `json:"endDate"`
+	Status    string    `json:"status" example:"created"`
+	EventID   *uint64   `json:"eventID,omitempty" example:"42"` // Event on-chain (jika sudah dibuat)
+	Attempts  int       `json:"attempts" example:"1"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// SeriesAttendeeResponse (Kehadiran satu user di seluruh sesi series)
+type SeriesAttendeeResponse struct {
+	Address        string  `json:"address" example:"0x1bb6b1e0a5170088"`
+	Registered     int     `json:"registered" example:"9"`       // Jumlah sesi selesai yang didaftari
+	Attended       int     `json:"attended" example:"8"`         // Jumlah sesi selesai yang dihadiri (check-in)
+	SessionsHeld   int     `json:"sessionsHeld" example:"10"`    // "hadir 8 dari 10 sesi"
+	AttendanceRate float64 `json:"attendanceRate" example:"0.8"` // attended / sessionsHeld
+}
+
+// SeriesAttendanceResponse (Rekap kehadiran series)
+type SeriesAttendanceResponse struct {
+	SeriesID          int                       `json:"seriesID" example:"1"`
+	SessionsTotal     int                       `json:"sessionsTotal" example:"12"`    // Sesi yang sudah dibuat on-chain (tidak termasuk yang dibatalkan)
+	SessionsHeld      int                       `json:"sessionsHeld" example:"10"`     // Sesi yang sudah selesai
+	SessionsCancelled int                       `json:"sessionsCancelled" example:"1"` // Sesi yang dibatalkan host
+	Attendees         []*SeriesAttendeeResponse `json:"attendees,omitempty"`
+	Me                *SeriesAttendeeResponse   `json:"me,omitempty"`
+}
+
+// EventPrivacyResponse (Pengaturan privasi daftar peserta event)
+type EventPrivacyResponse struct {
+	EventID            uint64 `json:"eventID" example:"1"`
+	AttendeeVisibility string `json:"attendeeVisibility" example:"attendees"` // public | attendees | host
+}
+
+// UserPrivacyResponse (Pengaturan privasi user)
+type UserPrivacyResponse struct {
+	HideFromAttendeeLists bool `json:"hideFromAttendeeLists" example:"true"`
+}
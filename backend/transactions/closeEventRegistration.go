@@ -0,0 +1,31 @@
+package transactions
+
+import "github.com/onflow/cadence"
+
+// Skrip transaksi tutup registrasi event yang dibatalkan (dijalankan oleh ADMIN/BACKEND)
+const closeEventRegistrationScriptTemplate = `
+import EventManager from 0x%s
+
+transaction(eventID: UInt64) {
+
+    let adminRef: &EventManager.Admin
+
+    prepare(signer: auth(BorrowValue) &Account) {
+        self.adminRef = signer.storage.borrow<&EventManager.Admin>(
+            from: EventManager.eventManagerStoragePath
+        ) ?? panic("cant borrow resource Admin EventManager")
+    }
+
+    execute {
+        self.adminRef.closeEventRegistration(eventID: eventID)
+    }
+}
+`
+
+// CloseEventRegistration menutup registrasi on-chain event 'eventID' secara permanen.
+// Setelah ini 'registerEvent' ditolak kontrak. Aman dipanggil ulang (idempoten).
+func CloseEventRegistration(eventID uint64) error {
+	return sendEventAdminTransaction("close_event_registration", closeEventRegistrationScriptTemplate,
+		cadence.NewUInt64(eventID),
+	)
+}
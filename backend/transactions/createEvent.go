@@ -0,0 +1,249 @@
+package transactions
+
+import (
+	"backend/utils"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/http" // Menggunakan klien HTTP
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// Versi backend dari 'create_event.cdc'.
+// Bedanya: transaksi ini ditandatangani oleh ADMIN/BACKEND, sehingga
+// 'hostAddress' dikirim sebagai argumen (bukan 'signer.address').
+const createEventScriptTemplate = `
+import EventManager from 0x%s
+
+transaction(
+    hostAddress: Address,
+    eventName: String,
+    description: String,
+    thumbnailURL: String,
+    eventPassImg: String?,
+    eventType: UInt8, // 0 = online, 1 = offline
+    location: String,
+    lat: Fix64,
+    long: Fix64,
+    startDate: UFix64,
+    endDate: UFix64,
+    quota: UInt64
+) {
+
+    prepare(signer: auth(BorrowValue) &Account) {
+        let newID = EventManager.createEvent(
+            hostAddress: hostAddress,
+            eventName: eventName,
+            description: description,
+            thumbnailURL: thumbnailURL,
+            eventPassImg: eventPassImg,
+            eventType: eventType,
+            location: location,
+            lat: lat,
+            long: long,
+            startDate: startDate,
+            endDate: endDate,
+            quota: quota
+        )
+
+        log("Event created successfully with ID: ".concat(newID.toString()))
+    }
+}
+`
+
+// CreateEventParams menampung semua field yang dibawa oleh event 'EventCreated'.
+// 'EventPassImg' boleh kosong (akan dikirim sebagai 'nil').
+type CreateEventParams struct {
+	HostAddress  string
+	Name         string
+	Description  string
+	ThumbnailURL string
+	EventPassImg string
+	EventType    uint8
+	Location     string
+	Lat          float64
+	Long         float64
+	StartDate    time.Time
+	EndDate      time.Time
+	Quota        uint64
+}
+
+// CreateEvent mengirim transaksi pembuatan event atas nama host,
+// lalu mengembalikan 'eventID' on-chain dari event 'EventCreated'.
+// Error '*SentTxError' berarti transaksi sudah terkirim dan event mungkin sudah dibuat:
+// jangan langsung mengirim ulang.
+func CreateEvent(params CreateEventParams) (uint64, error) {
+
+	// Muat .env
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("Peringatan: Error loading .env file:", err)
+	}
+
+	ctx := context.Background()
+	var flowClient access.Client
+
+	// Koneksi Flow ke Emulator HTTP port
+	flowClient, err = http.NewClient(http.TestnetHost)
+	if err != nil {
+		return 0, fmt.Errorf("gagal membuat flow client: %w", err)
+	}
+
+	// 1. SIAPKAN SIGNER (ADMIN)
+	privateKeyHex := os.Getenv("PRIVATE_KEY") // Ambil dari .env
+	if privateKeyHex == "" {
+		return 0, fmt.Errorf("PRIVATE_KEY tidak ditemukan di environment variables")
+	}
+
+	adminFlowAddress := flow.HexToAddress(deployerAddress)
+	platformKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, privateKeyHex)
+	if err != nil {
+		return 0, fmt.Errorf("gagal decode private key: %w", err)
+	}
+
+	// Proposal key dikunci sampai transaksi terkirim (lihat 'acquireProposalKey')
+	key, release, err := acquireProposalKey(ctx, flowClient, adminFlowAddress)
+	if err != nil {
+		return 0, fmt.Errorf("gagal mendapatkan akun admin %s: %w", adminFlowAddress.String(), err)
+	}
+	defer release(false)
+
+	signer, err := crypto.NewInMemorySigner(platformKey, key.HashAlgo)
+	if err != nil {
+		return 0, fmt.Errorf("gagal memuat signer: %w", err)
+	}
+
+	// 2. BUAT SKRIP TRANSAKSI
+	script := []byte(fmt.Sprintf(createEventScriptTemplate, deployerAddress))
+
+	// 3. SIAPKAN ARGUMEN (12 Argumen)
+	hostAddressArg := cadence.NewAddress(flow.HexToAddress(params.HostAddress))
+
+	nameArg, err := MakeStrArg(params.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	descriptionArg, err := MakeStrArg(params.Description)
+	if err != nil {
+		return 0, err
+	}
+
+	thumbnailArg, err := MakeStrArg(params.ThumbnailURL)
+	if err != nil {
+		return 0, err
+	}
+
+	// 'eventPassImg' adalah String? di Cadence
+	eventPassImgArg := cadence.NewOptional(nil)
+	if params.EventPassImg != "" {
+		imgArg, err := MakeStrArg(params.EventPassImg)
+		if err != nil {
+			return 0, err
+		}
+		eventPassImgArg = cadence.NewOptional(imgArg)
+	}
+
+	locationArg, err := MakeStrArg(params.Location)
+	if err != nil {
+		return 0, err
+	}
+
+	// Fix64 menerima string desimal (misal: "-6.2000")
+	latArg, err := cadence.NewFix64(strconv.FormatFloat(params.Lat, 'f', 4, 64))
+	if err != nil {
+		return 0, fmt.Errorf("gagal membuat argumen lat: %w", err)
+	}
+	longArg, err := cadence.NewFix64(strconv.FormatFloat(params.Long, 'f', 4, 64))
+	if err != nil {
+		return 0, fmt.Errorf("gagal membuat argumen long: %w", err)
+	}
+
+	// Timestamp dalam detik (UFix64 format "123.0")
+	startDateArg, err := cadence.NewUFix64(fmt.Sprintf("%d.0", params.StartDate.Unix()))
+	if err != nil {
+		return 0, fmt.Errorf("gagal membuat argumen startDate: %w", err)
+	}
+	endDateArg, err := cadence.NewUFix64(fmt.Sprintf("%d.0", params.EndDate.Unix()))
+	if err != nil {
+		return 0, fmt.Errorf("gagal membuat argumen endDate: %w", err)
+	}
+
+	// 4. BUAT TRANSAKSI
+	latestBlock, err := flowClient.GetLatestBlock(ctx, true)
+	if err != nil {
+		return 0, fmt.Errorf("gagal mendapatkan block terbaru: %w", err)
+	}
+
+	tx := flow.NewTransaction().
+		SetScript(script).
+		SetReferenceBlockID(latestBlock.ID).
+		SetPayer(adminFlowAddress). // Admin adalah 'Payer'
+		SetProposalKey(adminFlowAddress, key.Index, key.SequenceNumber).
+		AddAuthorizer(adminFlowAddress) // Admin adalah 'Authorizer'
+
+	// 5. TAMBAHKAN ARGUMEN (urutan harus sama dengan skrip)
+	_ = tx.AddArgument(hostAddressArg)
+	_ = tx.AddArgument(nameArg)
+	_ = tx.AddArgument(descriptionArg)
+	_ = tx.AddArgument(thumbnailArg)
+	_ = tx.AddArgument(eventPassImgArg)
+	_ = tx.AddArgument(cadence.NewUInt8(params.EventType))
+	_ = tx.AddArgument(locationArg)
+	_ = tx.AddArgument(latArg)
+	_ = tx.AddArgument(longArg)
+	_ = tx.AddArgument(startDateArg)
+	_ = tx.AddArgument(endDateArg)
+	_ = tx.AddArgument(cadence.NewUInt64(params.Quota))
+
+	// 6. TANDA TANGANI TRANSAKSI
+	err = tx.SignEnvelope(adminFlowAddress, key.Index, signer)
+	if err != nil {
+		return 0, fmt.Errorf("gagal menandatangani transaksi: %w", err)
+	}
+
+	// 7. KIRIM TRANSAKSI
+	log.Println("Mengirim transaksi 'create_event'...")
+	err = flowClient.SendTransaction(ctx, *tx)
+	release(err == nil)
+	if err != nil {
+		return 0, fmt.Errorf("gagal mengirim transaksi: %w", err)
+	}
+
+	// 8. TUNGGU HASILNYA (SEAL)
+	// Mulai dari sini transaksi sudah terkirim: error yang hasilnya tidak pasti dibungkus 'SentTxError'
+	result, err := utils.WaitForSeal(ctx, flowClient, tx.ID())
+	if err != nil {
+		log.Printf("Transaksi %s gagal: %v\n", tx.ID(), err)
+		if result != nil {
+			// Sudah di-seal dengan error Cadence: pasti tidak ada event yang dibuat
+			return 0, fmt.Errorf("transaksi %s gagal: %w", tx.ID(), err)
+		}
+		// Timeout/context: transaksi bisa saja tetap dieksekusi nanti
+		return 0, &SentTxError{TxID: tx.ID().String(), Err: err}
+	}
+
+	// 9. AMBIL 'eventID' DARI EVENT 'EventCreated'
+	eventCreatedType := fmt.Sprintf("A.%s.EventManager.EventCreated", deployerAddress)
+	for _, ev := range result.Events {
+		if ev.Type != eventCreatedType {
+			continue
+		}
+		eventID, ok := ev.Value.SearchFieldByName("eventID").(cadence.UInt64)
+		if !ok {
+			return 0, fmt.Errorf("field 'eventID' tidak ditemukan di event %s", ev.Type)
+		}
+		log.Printf("Transaksi Create Event Berhasil! 🔥 Event ID: %d. TX ID: %s", uint64(eventID), tx.ID())
+		return uint64(eventID), nil
+	}
+
+	return 0, &SentTxError{TxID: tx.ID().String(), Err: fmt.Errorf("transaksi sukses tapi event 'EventCreated' tidak ditemukan")}
+}
@@ -0,0 +1,170 @@
+package transactions
+
+import (
+	"backend/utils"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/http" // Menggunakan klien HTTP
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// Skrip transaksi tahan slot event untuk user dari waitlist (dijalankan oleh ADMIN/BACKEND)
+const reserveEventSlotScriptTemplate = `
+import EventManager from 0x%s
+
+transaction(
+    eventID: UInt64,
+    userAddress: Address,
+    expiresAt: UFix64
+) {
+
+    let adminRef: &EventManager.Admin
+
+    prepare(signer: auth(BorrowValue) &Account) {
+        self.adminRef = signer.storage.borrow<&EventManager.Admin>(
+            from: EventManager.eventManagerStoragePath
+        ) ?? panic("cant borrow resource Admin EventManager")
+    }
+
+    execute {
+        self.adminRef.reserveEventSlot(eventID: eventID, userAddress: userAddress, expiresAt: expiresAt)
+    }
+}
+`
+
+// Skrip transaksi lepas slot yang ditahan (dijalankan oleh ADMIN/BACKEND)
+const releaseEventSlotScriptTemplate = `
+import EventManager from 0x%s
+
+transaction(
+    eventID: UInt64,
+    userAddress: Address
+) {
+
+    let adminRef: &EventManager.Admin
+
+    prepare(signer: auth(BorrowValue) &Account) {
+        self.adminRef = signer.storage.borrow<&EventManager.Admin>(
+            from: EventManager.eventManagerStoragePath
+        ) ?? panic("cant borrow resource Admin EventManager")
+    }
+
+    execute {
+        self.adminRef.releaseEventSlot(eventID: eventID, userAddress: userAddress)
+    }
+}
+`
+
+// ReserveEventSlot menahan satu slot event on-chain untuk 'userAddress' sampai 'expiresAt'.
+// Selama ditahan, 'registerEvent' user lain menghitung slot ini terpakai.
+// Kontrak menolak jika user sudah register atau tidak ada slot kosong.
+func ReserveEventSlot(eventID uint64, userAddress string, expiresAt time.Time) error {
+	expiresAtArg, err := cadence.NewUFix64(fmt.Sprintf("%d.0", expiresAt.Unix()))
+	if err != nil {
+		return fmt.Errorf("gagal membuat argumen expiresAt: %w", err)
+	}
+	return sendEventAdminTransaction("reserve_event_slot", reserveEventSlotScriptTemplate,
+		cadence.NewUInt64(eventID),
+		cadence.NewAddress(flow.HexToAddress(userAddress)),
+		expiresAtArg,
+	)
+}
+
+// ReleaseEventSlot melepas slot yang ditahan untuk 'userAddress' sebelum kedaluwarsa.
+func ReleaseEventSlot(eventID uint64, userAddress string) error {
+	return sendEventAdminTransaction("release_event_slot", releaseEventSlotScriptTemplate,
+		cadence.NewUInt64(eventID),
+		cadence.NewAddress(flow.HexToAddress(userAddress)),
+	)
+}
+
+func sendEventAdminTransaction(name string, scriptTemplate string, args ...cadence.Value) error {
+
+	// Muat .env
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("Peringatan: Error loading .env file:", err)
+	}
+
+	ctx := context.Background()
+	var flowClient access.Client
+
+	flowClient, err = http.NewClient(http.TestnetHost)
+	if err != nil {
+		return fmt.Errorf("gagal membuat flow client: %w", err)
+	}
+
+	// 1. SIAPKAN SIGNER (ADMIN)
+	privateKeyHex := os.Getenv("PRIVATE_KEY")
+	if privateKeyHex == "" {
+		return fmt.Errorf("PRIVATE_KEY tidak ditemukan di environment variables")
+	}
+
+	adminFlowAddress := flow.HexToAddress(deployerAddress)
+	platformKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, privateKeyHex)
+	if err != nil {
+		return fmt.Errorf("gagal decode private key: %w", err)
+	}
+
+	// Proposal key dikunci sampai transaksi terkirim (lihat 'acquireProposalKey')
+	key, release, err := acquireProposalKey(ctx, flowClient, adminFlowAddress)
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan akun admin %s: %w", adminFlowAddress.String(), err)
+	}
+	defer release(false)
+
+	signer, err := crypto.NewInMemorySigner(platformKey, key.HashAlgo)
+	if err != nil {
+		return fmt.Errorf("gagal memuat signer: %w", err)
+	}
+
+	// 2. BUAT TRANSAKSI
+	script := []byte(fmt.Sprintf(scriptTemplate, deployerAddress))
+
+	latestBlock, err := flowClient.GetLatestBlock(ctx, true)
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan block terbaru: %w", err)
+	}
+
+	tx := flow.NewTransaction().
+		SetScript(script).
+		SetReferenceBlockID(latestBlock.ID).
+		SetPayer(adminFlowAddress).
+		SetProposalKey(adminFlowAddress, key.Index, key.SequenceNumber).
+		AddAuthorizer(adminFlowAddress)
+
+	for _, arg := range args {
+		_ = tx.AddArgument(arg)
+	}
+
+	// 3. TANDA TANGANI & KIRIM
+	err = tx.SignEnvelope(adminFlowAddress, key.Index, signer)
+	if err != nil {
+		return fmt.Errorf("gagal menandatangani transaksi: %w", err)
+	}
+
+	log.Printf("Mengirim transaksi '%s'...", name)
+	err = flowClient.SendTransaction(ctx, *tx)
+	release(err == nil)
+	if err != nil {
+		return fmt.Errorf("gagal mengirim transaksi: %w", err)
+	}
+
+	// 4. TUNGGU HASILNYA (SEAL)
+	result, err := utils.WaitForSeal(ctx, flowClient, tx.ID())
+	if err != nil {
+		log.Printf("Transaksi %s gagal: %v\n", tx.ID(), err)
+		return fmt.Errorf("transaksi %s gagal: %w", tx.ID(), err)
+	}
+
+	log.Printf("Transaksi '%s' berhasil. Status: %s. TX ID: %s", name, result.Status, tx.ID())
+	return nil
+}
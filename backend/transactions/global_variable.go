@@ -1,8 +1,63 @@
 package transactions
 
-import "backend/config"
+import (
+	"backend/config"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
 
 const (
 	deployerAddress   = config.ContractAddress
 	NFTTestnetAddress = "631e88ae7f1d7c20"
 )
+
+// Semua transaksi backend ditandatangani akun deployer dengan proposal key yang sama (index 0).
+// Sequence number key itu baru naik on-chain setelah transaksi dieksekusi, jadi transaksi yang
+// dikirim berdekatan tidak boleh sekadar membaca nilai on-chain (keduanya akan memakai nilai yang sama
+// dan salah satunya ditolak). Sequence number berikutnya dicatat secara lokal; lock hanya dipegang
+// saat membaca sequence number dan mengirim transaksi, menunggu seal dilakukan di luar lock.
+
+// pendingSequenceTTL: jika sequence number on-chain belum menyusul dalam waktu ini, transaksi
+// terakhir dianggap tidak pernah masuk block dan nilai on-chain dipakai lagi.
+const pendingSequenceTTL = time.Minute
+
+var proposalKey struct {
+	mu     sync.Mutex
+	next   uint64    // Sequence number setelah transaksi terakhir yang terkirim
+	sentAt time.Time // Waktu transaksi terakhir terkirim
+}
+
+// acquireProposalKey mengunci proposal key akun 'address' dan mengembalikan key (index 0) dengan
+// sequence number yang belum dipakai. Panggil 'release(sent)' tepat setelah SendTransaction
+// (sent = transaksi terkirim). 'release' aman dipanggil lebih dari sekali, jadi bisa juga di-defer
+// untuk jalur error sebelum transaksi dikirim.
+func acquireProposalKey(ctx context.Context, flowClient access.Client, address flow.Address) (*flow.AccountKey, func(sent bool), error) {
+	proposalKey.mu.Lock()
+
+	account, err := flowClient.GetAccount(ctx, address)
+	if err != nil {
+		proposalKey.mu.Unlock()
+		return nil, nil, err
+	}
+
+	key := *account.Keys[0]
+	if proposalKey.next > key.SequenceNumber && time.Since(proposalKey.sentAt) < pendingSequenceTTL {
+		key.SequenceNumber = proposalKey.next
+	}
+
+	var once sync.Once
+	release := func(sent bool) {
+		once.Do(func() {
+			if sent {
+				proposalKey.next = key.SequenceNumber + 1
+				proposalKey.sentAt = time.Now()
+			}
+			proposalKey.mu.Unlock()
+		})
+	}
+	return &key, release, nil
+}
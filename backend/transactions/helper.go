@@ -17,6 +17,20 @@ import (
 	// "github.com/onflow/flow-go-sdk/crypto"
 )
 
+// SentTxError adalah error yang terjadi SETELAH transaksi terkirim ke chain
+// (misal: timeout menunggu seal). Transaksinya bisa saja tetap dieksekusi,
+// jadi pemanggil tidak boleh mengirim ulang transaksi yang sama begitu saja.
+type SentTxError struct {
+	TxID string
+	Err  error
+}
+
+func (e *SentTxError) Error() string {
+	return fmt.Sprintf("transaksi %s terkirim tapi hasilnya tidak diketahui: %v", e.TxID, e.Err)
+}
+
+func (e *SentTxError) Unwrap() error { return e.Err }
+
 func MakeStrArg(s string) (cadence.String, error) {
 	val, err := cadence.NewString(s)
 	if err != nil {
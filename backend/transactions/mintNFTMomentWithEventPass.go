@@ -121,13 +121,13 @@ func MintNFTMomentWithEventPass(
 		return fmt.Errorf("gagal decode private key: %w", err)
 	}
 
-	platformAccount, err := flowClient.GetAccount(ctx, minterFlowAddress)
+	// Proposal key dikunci sampai transaksi terkirim (lihat 'acquireProposalKey')
+	key, release, err := acquireProposalKey(ctx, flowClient, minterFlowAddress)
 	if err != nil {
 		return fmt.Errorf("gagal mendapatkan akun minter %s: %w", minterFlowAddress.String(), err)
 	}
+	defer release(false)
 
-	// Asumsi kita menggunakan key pertama (index 0)
-	key := platformAccount.Keys[0]
 	signer, err := crypto.NewInMemorySigner(platformKey, key.HashAlgo)
 	if err != nil {
 		return fmt.Errorf("gagal memuat signer: %w", err)
@@ -200,6 +200,7 @@ func MintNFTMomentWithEventPass(
 	// 7. KIRIM TRANSAKSI
 	log.Println("Mengirim transaksi 'mint_nft_moment'...")
 	err = flowClient.SendTransaction(ctx, *tx)
+	release(err == nil)
 	if err != nil {
 		return fmt.Errorf("gagal mengirim transaksi: %w", err)
 	}
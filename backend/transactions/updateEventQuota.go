@@ -0,0 +1,123 @@
+package transactions
+
+import (
+	"backend/utils"
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/http" // Menggunakan klien HTTP
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// Skrip transaksi ubah kuota event (dijalankan oleh ADMIN/BACKEND atas permintaan host)
+const updateEventQuotaScriptTemplate = `
+import EventManager from 0x%s
+
+transaction(
+    eventID: UInt64,
+    quota: UInt64
+) {
+
+    let adminRef: &EventManager.Admin
+
+    prepare(signer: auth(BorrowValue) &Account) {
+        self.adminRef = signer.storage.borrow<&EventManager.Admin>(
+            from: EventManager.eventManagerStoragePath
+        ) ?? panic("cant borrow resource Admin EventManager")
+    }
+
+    execute {
+        self.adminRef.updateEventQuota(eventID: eventID, quota: quota)
+    }
+}
+`
+
+// UpdateEventQuota mengubah kuota event on-chain. Kontrak menolak kuota
+// yang lebih kecil dari jumlah attendee yang sudah register.
+func UpdateEventQuota(eventID uint64, quota uint64) error {
+
+	// Muat .env
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("Peringatan: Error loading .env file:", err)
+	}
+
+	ctx := context.Background()
+	var flowClient access.Client
+
+	flowClient, err = http.NewClient(http.TestnetHost)
+	if err != nil {
+		return fmt.Errorf("gagal membuat flow client: %w", err)
+	}
+
+	// 1. SIAPKAN SIGNER (ADMIN)
+	privateKeyHex := os.Getenv("PRIVATE_KEY")
+	if privateKeyHex == "" {
+		return fmt.Errorf("PRIVATE_KEY tidak ditemukan di environment variables")
+	}
+
+	adminFlowAddress := flow.HexToAddress(deployerAddress)
+	platformKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, privateKeyHex)
+	if err != nil {
+		return fmt.Errorf("gagal decode private key: %w", err)
+	}
+
+	// Proposal key dikunci sampai transaksi terkirim (lihat 'acquireProposalKey')
+	key, release, err := acquireProposalKey(ctx, flowClient, adminFlowAddress)
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan akun admin %s: %w", adminFlowAddress.String(), err)
+	}
+	defer release(false)
+
+	signer, err := crypto.NewInMemorySigner(platformKey, key.HashAlgo)
+	if err != nil {
+		return fmt.Errorf("gagal memuat signer: %w", err)
+	}
+
+	// 2. BUAT TRANSAKSI
+	script := []byte(fmt.Sprintf(updateEventQuotaScriptTemplate, deployerAddress))
+
+	latestBlock, err := flowClient.GetLatestBlock(ctx, true)
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan block terbaru: %w", err)
+	}
+
+	tx := flow.NewTransaction().
+		SetScript(script).
+		SetReferenceBlockID(latestBlock.ID).
+		SetPayer(adminFlowAddress).
+		SetProposalKey(adminFlowAddress, key.Index, key.SequenceNumber).
+		AddAuthorizer(adminFlowAddress)
+
+	_ = tx.AddArgument(cadence.NewUInt64(eventID))
+	_ = tx.AddArgument(cadence.NewUInt64(quota))
+
+	// 3. TANDA TANGANI & KIRIM
+	err = tx.SignEnvelope(adminFlowAddress, key.Index, signer)
+	if err != nil {
+		return fmt.Errorf("gagal menandatangani transaksi: %w", err)
+	}
+
+	log.Println("Mengirim transaksi 'update_event_quota'...")
+	err = flowClient.SendTransaction(ctx, *tx)
+	release(err == nil)
+	if err != nil {
+		return fmt.Errorf("gagal mengirim transaksi: %w", err)
+	}
+
+	// 4. TUNGGU HASILNYA (SEAL)
+	result, err := utils.WaitForSeal(ctx, flowClient, tx.ID())
+	if err != nil {
+		log.Printf("Transaksi %s gagal: %v\n", tx.ID(), err)
+		return fmt.Errorf("transaksi %s gagal: %w", tx.ID(), err)
+	}
+
+	log.Printf("Transaksi update kuota event %d berhasil. Status: %s. TX ID: %s", eventID, result.Status, tx.ID())
+	return nil
+}
@@ -0,0 +1,168 @@
+package transactions
+
+import (
+	"backend/utils"
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/http" // Menggunakan klien HTTP
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// Versi batch dari 'userCheckinScriptTemplate'.
+// Satu transaksi melakukan check-in + mint EventPass untuk banyak alamat sekaligus.
+const userCheckinBatchScriptTemplate = `
+import EventPass from 0x%s
+import EventManager from 0x%s
+
+transaction(
+    eventID: UInt64,
+    userAddresses: [Address]
+) {
+
+    let adminRef: &EventManager.Admin
+    let eventRef: &EventManager.Event
+    let eventPassMinterRef: &EventPass.NFTMinter
+
+    prepare(signer: auth(BorrowValue) &Account) {
+        self.eventRef = EventManager.events[eventID] as! &EventManager.Event
+        self.adminRef = signer.storage.borrow<&EventManager.Admin>(
+            from: EventManager.eventManagerStoragePath
+        ) ?? panic("cant borrow resource Admin EventManager")
+        self.eventPassMinterRef = signer.storage.borrow<&EventPass.NFTMinter>(
+          from: EventPass.MinterStoragePath
+        ) ?? panic("cant borrow ressource minter EventPass")
+    }
+
+    execute {
+        let thumbnail = self.eventRef.eventPassImg != nil ?
+          self.eventRef.eventPassImg?.uri() :
+          "https://white-lazy-marten-351.mypinata.cloud/ipfs/bafybeibv7mz4yvpuw5ejbovka3h2zhrzyf7jptikz7fzsuprlgw3h6qtnq"
+
+        for userAddress in userAddresses {
+            let recipient = getAccount(userAddress).capabilities.borrow<&EventPass.Collection>(
+              EventPass.CollectionPublicPath
+            ) ?? panic("cant borrow ressource recipient collection EventPass for ".concat(userAddress.toString()))
+
+            self.adminRef.checkInUserToEvent(eventID: eventID, userAddress: userAddress)
+            self.eventPassMinterRef.mintNFT(
+              recipient: recipient,
+              name: self.eventRef.eventName,
+              description: self.eventRef.description,
+              thumbnail: thumbnail!,
+              eventType: self.eventRef.eventType.rawValue,
+              eventID: self.eventRef.eventID
+            )
+        }
+
+        log("batch checkIn success for ".concat(userAddresses.length.toString()).concat(" users"))
+    }
+}
+`
+
+// UserCheckinBatch melakukan check-in untuk banyak alamat dalam SATU transaksi.
+// Transaksi bersifat atomik: jika satu alamat gagal, seluruh batch dibatalkan.
+func UserCheckinBatch(
+	eventID uint64,
+	userAddresses []string,
+) error {
+	if len(userAddresses) == 0 {
+		return nil
+	}
+
+	// Muat .env
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("Peringatan: Error loading .env file:", err)
+	}
+
+	ctx := context.Background()
+	var flowClient access.Client
+
+	// Koneksi Flow ke Emulator HTTP port
+	flowClient, err = http.NewClient(http.TestnetHost)
+	if err != nil {
+		return fmt.Errorf("gagal membuat flow client: %w", err)
+	}
+
+	// 1. SIAPKAN SIGNER (ADMIN/MINTER)
+	privateKeyHex := os.Getenv("PRIVATE_KEY") // Ambil dari .env
+	if privateKeyHex == "" {
+		return fmt.Errorf("PRIVATE_KEY tidak ditemukan di environment variables")
+	}
+
+	minterFlowAddress := flow.HexToAddress(deployerAddress)
+	platformKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, privateKeyHex)
+	if err != nil {
+		return fmt.Errorf("gagal decode private key: %w", err)
+	}
+
+	// Proposal key dikunci sampai transaksi terkirim (lihat 'acquireProposalKey')
+	key, release, err := acquireProposalKey(ctx, flowClient, minterFlowAddress)
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan akun minter %s: %w", minterFlowAddress.String(), err)
+	}
+	defer release(false)
+
+	signer, err := crypto.NewInMemorySigner(platformKey, key.HashAlgo)
+	if err != nil {
+		return fmt.Errorf("gagal memuat signer: %w", err)
+	}
+
+	// 2. BUAT SKRIP TRANSAKSI
+	script := []byte(fmt.Sprintf(userCheckinBatchScriptTemplate, deployerAddress, deployerAddress))
+
+	// 3. SIAPKAN ARGUMEN ([Address])
+	addressValues := make([]cadence.Value, len(userAddresses))
+	for i, addr := range userAddresses {
+		addressValues[i] = cadence.NewAddress(flow.HexToAddress(addr))
+	}
+	userAddressesArg := cadence.NewArray(addressValues)
+
+	// 4. BUAT TRANSAKSI
+	latestBlock, err := flowClient.GetLatestBlock(ctx, true)
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan block terbaru: %w", err)
+	}
+
+	tx := flow.NewTransaction().
+		SetScript(script).
+		SetReferenceBlockID(latestBlock.ID).
+		SetPayer(minterFlowAddress). // Admin adalah 'Payer'
+		SetProposalKey(minterFlowAddress, key.Index, key.SequenceNumber).
+		AddAuthorizer(minterFlowAddress) // Admin adalah 'Authorizer'
+
+	// 5. TAMBAHKAN ARGUMEN
+	_ = tx.AddArgument(cadence.NewUInt64(eventID))
+	_ = tx.AddArgument(userAddressesArg)
+
+	// 6. TANDA TANGANI TRANSAKSI
+	err = tx.SignEnvelope(minterFlowAddress, key.Index, signer)
+	if err != nil {
+		return fmt.Errorf("gagal menandatangani transaksi: %w", err)
+	}
+
+	// 7. KIRIM TRANSAKSI
+	log.Printf("Mengirim transaksi 'user_checkin_batch' (%d alamat)...", len(userAddresses))
+	err = flowClient.SendTransaction(ctx, *tx)
+	release(err == nil)
+	if err != nil {
+		return fmt.Errorf("gagal mengirim transaksi: %w", err)
+	}
+
+	// 8. TUNGGU HASILNYA (SEAL)
+	result, err := utils.WaitForSeal(ctx, flowClient, tx.ID())
+	if err != nil {
+		log.Printf("Transaksi %s gagal: %v\n", tx.ID(), err)
+		return fmt.Errorf("transaksi %s gagal: %w", tx.ID(), err)
+	}
+
+	log.Printf("Transaksi Batch Check-in Berhasil! 🔥 Status: %s. TX ID: %s", result.Status, tx.ID())
+	return nil // Sukses
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/dataversion"
+	"context"
+)
+
+// Nama versi data yang dipakai bersama oleh API dan indexer
+const EventsDataVersion = "events"
+
+// BumpDataVersion menaikkan versi data 'name' satu langkah (baris dibuat saat pertama kali dipakai).
+// 'client' boleh berasal dari transaksi ('tx.Client()') agar ikut di-commit/rollback.
+func BumpDataVersion(ctx context.Context, client *ent.Client, name string) error {
+	n, err := client.DataVersion.Update().
+		Where(dataversion.NameEQ(name)).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+	err = client.DataVersion.Create().SetName(name).SetVersion(1).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// Dibuat proses lain di saat yang sama; naikkan baris miliknya
+		return client.DataVersion.Update().Where(dataversion.NameEQ(name)).AddVersion(1).Exec(ctx)
+	}
+	return err
+}
+
+// DataVersionOf mengembalikan versi data 'name' saat ini (0 jika belum pernah dinaikkan).
+func DataVersionOf(ctx context.Context, client *ent.Client, name string) (int64, error) {
+	v, err := client.DataVersion.Query().Where(dataversion.NameEQ(name)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return v.Version, nil
+}
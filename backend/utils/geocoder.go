@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrAddressNotFound dikembalikan jika geocoder tidak mengenali alamat.
+var ErrAddressNotFound = errors.New("alamat tidak ditemukan")
+
+// Geocoder mengubah alamat teks menjadi koordinat.
+// Implementasi bisa diganti (misal: layanan eksternal) tanpa mengubah pemanggilnya.
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (lat, long float64, err error)
+}
+
+// LookupGeocoder adalah geocoder sederhana berbasis tabel (nama tempat -> koordinat).
+// Alamat dicocokkan jika mengandung salah satu nama tempat (case-insensitive);
+// nama terpanjang yang cocok yang dipakai, jadi "Senayan, Jakarta" cocok ke "senayan".
+type LookupGeocoder struct {
+	places map[string][2]float64
+}
+
+// defaultGeocodePlaces adalah tabel bawaan (kota-kota besar di Indonesia).
+var defaultGeocodePlaces = map[string][2]float64{
+	"jakarta":    {-6.2088, 106.8456},
+	"senayan":    {-6.2183, 106.8027},
+	"bandung":    {-6.9175, 107.6191},
+	"surabaya":   {-7.2575, 112.7521},
+	"yogyakarta": {-7.7956, 110.3695},
+	"jogja":      {-7.7956, 110.3695},
+	"semarang":   {-6.9667, 110.4167},
+	"medan":      {3.5952, 98.6722},
+	"makassar":   {-5.1477, 119.4327},
+	"denpasar":   {-8.6705, 115.2126},
+	"bali":       {-8.4095, 115.1889},
+	"malang":     {-7.9666, 112.6326},
+	"tangerang":  {-6.1783, 106.6319},
+	"bekasi":     {-6.2383, 106.9756},
+	"depok":      {-6.4025, 106.7942},
+	"bogor":      {-6.5971, 106.8060},
+	"palembang":  {-2.9761, 104.7754},
+	"balikpapan": {-1.2379, 116.8529},
+	"singapore":  {1.3521, 103.8198},
+}
+
+// NewLookupGeocoder membuat geocoder dari tabel bawaan, ditambah isi file JSON
+// (format: {"nama tempat": [lat, long]}) jika 'path' tidak kosong.
+func NewLookupGeocoder(path string) (*LookupGeocoder, error) {
+	g := &LookupGeocoder{places: map[string][2]float64{}}
+	for name, coord := range defaultGeocodePlaces {
+		g.places[name] = coord
+	}
+	if path == "" {
+		return g, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca tabel geocoder: %w", err)
+	}
+	var extra map[string][2]float64
+	if err := json.Unmarshal(b, &extra); err != nil {
+		return nil, fmt.Errorf("format tabel geocoder tidak valid: %w", err)
+	}
+	for name, coord := range extra {
+		g.places[strings.ToLower(strings.TrimSpace(name))] = coord
+	}
+	return g, nil
+}
+
+func (g *LookupGeocoder) Geocode(_ context.Context, address string) (float64, float64, error) {
+	address = strings.ToLower(address)
+	best := ""
+	for name := range g.places {
+		if len(name) > len(best) && strings.Contains(address, name) {
+			best = name
+		}
+	}
+	if best == "" {
+		return 0, 0, ErrAddressNotFound
+	}
+	coord := g.places[best]
+	return coord[0], coord[1], nil
+}
+
+// NewGeocoderFromEnv memilih geocoder dari environment:
+// GEOCODER_TABLE = path file JSON tambahan untuk LookupGeocoder (opsional).
+func NewGeocoderFromEnv() (Geocoder, error) {
+	return NewLookupGeocoder(os.Getenv("GEOCODER_TABLE"))
+}
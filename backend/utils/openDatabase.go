@@ -13,6 +13,13 @@ import (
 )
 
 func Open(databaseUrl string) *ent.Client {
+	client, _ := OpenWithDB(databaseUrl)
+	return client
+}
+
+// OpenWithDB sama seperti Open, tetapi juga mengembalikan koneksi '*sql.DB' mentah
+// untuk query yang tidak bisa diekspresikan lewat ent (misal: full-text search Postgres).
+func OpenWithDB(databaseUrl string) (*ent.Client, *sql.DB) {
 	db, err := sql.Open("pgx", databaseUrl)
 	if err != nil {
 		log.Fatal(err)
@@ -20,5 +27,5 @@ func Open(databaseUrl string) *ent.Client {
 
 	// Create an ent.Driver from `db`.
 	drv := entsql.OpenDB(dialect.Postgres, db)
-	return ent.NewClient(ent.Driver(drv))
-}
\ No newline at end of file
+	return ent.NewClient(ent.Driver(drv)), db
+}
@@ -165,13 +165,8 @@ func NFTMomentMinted(ctx context.Context, ev flow.Event, client *ent.Client) {
 	} else {
 		log.Println("User found", isUserFound)
 
-		// Update status free mint user
-		_, errUpdate := isUserFound.Update().SetIsFreeMinted(true).Save(ctx)
-		if errUpdate != nil {
-			log.Printf("Gagal update status free mint user %s: %v", isUserFound.Address, errUpdate)
-		} else {
-			log.Printf("User %s marked as free minted.", isUserFound.Address)
-		}
+		// Flag 'is_free_minted' dikelola backend dari kuota klaim (lihat 'syncLegacyFreeMintFlag'),
+		// bukan dari event mint (event ini juga muncul untuk mint dengan Event Pass)
 
 		nftMinted, err := client.NFTMoment.Create().
 			SetName(name).
@@ -381,6 +376,9 @@ func EventCreated(ctx context.Context, ev flow.Event, client *ent.Client) {
 				log.Printf("Gagal menyimpan event baru ID %d: %v", eventID, createErr)
 			} else {
 				log.Printf("Event baru berhasil di-indeks: %s (ID: %d)", newEvent.Name, newEvent.EventID)
+				if err := BumpDataVersion(ctx, client, EventsDataVersion); err != nil {
+					log.Printf("Gagal menaikkan versi data event: %v", err)
+				}
 			}
 
 		} else {
@@ -495,6 +493,65 @@ func UserCheckedIn(ctx context.Context, ev flow.Event, client *ent.Client) {
 	}
 }
 
+// (Handler untuk event 'UserUnregistered')
+// Registrasi dibatalkan on-chain: hapus 'Attendance' agar slot terhitung kosong (untuk waitlist).
+func UserUnregistered(ctx context.Context, ev flow.Event, client *ent.Client) {
+	var Fields = ev.Value.FieldsMappedByName()
+	userAddressCadence, err := getCadenceField[cadence.Address](Fields, "userAddress")
+	if err != nil {
+		log.Println("gagal parsing user address:", err)
+		return
+	}
+	eventIDCadence, err := getCadenceField[cadence.UInt64](Fields, "eventID")
+	if err != nil {
+		log.Println("gagal parsing eventID:", err)
+		return
+	}
+	userAddress := userAddressCadence.String()
+	eventID := uint64(eventIDCadence)
+
+	deleted, err := client.Attendance.Delete().
+		Where(
+			attendance.HasEventWith(event.EventIDEQ(eventID)),
+			attendance.HasUserWith(user.AddressEQ(userAddress)),
+			attendance.CheckedIn(false),
+		).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Gagal menghapus 'Attendance' user %s di event %d: %v", userAddress, eventID, err)
+		return
+	}
+	log.Printf("User %s membatalkan registrasi event %d (%d record dihapus)", userAddress, eventID, deleted)
+}
+
+// (Handler untuk event 'EventQuotaUpdated')
+func EventQuotaUpdated(ctx context.Context, ev flow.Event, client *ent.Client) {
+	var Fields = ev.Value.FieldsMappedByName()
+	eventIDCadence, err := getCadenceField[cadence.UInt64](Fields, "eventID")
+	if err != nil {
+		log.Println("gagal parsing eventID:", err)
+		return
+	}
+	quotaCadence, err := getCadenceField[cadence.UInt64](Fields, "quota")
+	if err != nil {
+		log.Println("gagal parsing quota:", err)
+		return
+	}
+	eventID := uint64(eventIDCadence)
+
+	if err := client.Event.Update().
+		Where(event.EventIDEQ(eventID)).
+		SetQuota(uint64(quotaCadence)).
+		Exec(ctx); err != nil {
+		log.Printf("Gagal mengupdate kuota event %d: %v", eventID, err)
+		return
+	}
+	log.Printf("Kuota event %d diubah menjadi %d", eventID, uint64(quotaCadence))
+	if err := BumpDataVersion(ctx, client, EventsDataVersion); err != nil {
+		log.Printf("Gagal menaikkan versi data event: %v", err)
+	}
+}
+
 func EventPassMinted(ctx context.Context, ev flow.Event, client *ent.Client) {
 
 	// --- 1. Parsing Event ---
@@ -925,7 +982,7 @@ func NFTDeposited(ctx context.Context, ev flow.Event, client *ent.Client) {
 	newOwner, err := client.User.Query().Where(user.AddressEQ(newOwnerAddress)).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			log.Printf("Pemilik baru %s tidak ditemukan")
+			log.Printf("Pemilik baru %s tidak ditemukan", newOwnerAddress)
 			return
 		} else {
 			log.Printf("Error query user %s: %v", newOwnerAddress, err)
@@ -0,0 +1,214 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RRule adalah subset aturan pengulangan iCalendar (RFC 5545 RRULE) untuk seri event:
+//
+//	FREQ=DAILY|WEEKLY|MONTHLY   (wajib)
+//	INTERVAL=n                  (default 1)
+//	BYDAY=MO,WE,...             (hanya WEEKLY, tanpa angka urutan)
+//	BYMONTHDAY=1,15,...         (hanya MONTHLY, 1-31; bulan tanpa tanggal tsb. dilewati)
+//	COUNT=n / UNTIL=YYYYMMDD[THHMMSSZ]  (opsional, tidak boleh keduanya)
+//
+// Occurrence dihitung dalam zona waktu DTSTART, sehingga jam lokal tetap sama walaupun ada DST.
+// Minggu dimulai hari Senin (WKST=MO).
+type RRule struct {
+	Freq       string
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay []int
+	Count      int        // 0 = tanpa batas jumlah
+	Until      *time.Time // nil = tanpa batas waktu
+}
+
+const (
+	RRuleDaily   = "DAILY"
+	RRuleWeekly  = "WEEKLY"
+	RRuleMonthly = "MONTHLY"
+
+	// Batas iterasi periode agar aturan yang tidak pernah cocok tidak berputar tanpa akhir
+	maxRRulePeriods = 10000
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+// ParseRRule membaca string RRULE (boleh diawali "RRULE:").
+func ParseRRule(s string) (*RRule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, errors.New("rrule kosong")
+	}
+
+	r := &RRule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("bagian rrule tidak valid: '%s'", part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%s muncul lebih dari sekali", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			r.Freq = strings.ToUpper(value)
+			if r.Freq != RRuleDaily && r.Freq != RRuleWeekly && r.Freq != RRuleMonthly {
+				return nil, errors.New("FREQ harus DAILY, WEEKLY, atau MONTHLY")
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > 365 {
+				return nil, errors.New("INTERVAL harus angka 1-365")
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, errors.New("COUNT harus angka lebih dari 0")
+			}
+			r.Count = n
+		case "UNTIL":
+			t, err := time.Parse("20060102T150405Z", value)
+			if err != nil {
+				// Tanggal saja: sampai akhir hari tsb. (UTC)
+				d, derr := time.Parse("20060102", value)
+				if derr != nil {
+					return nil, errors.New("UNTIL harus format YYYYMMDD atau YYYYMMDDTHHMMSSZ")
+				}
+				t = d.Add(24*time.Hour - time.Second)
+			}
+			r.Until = &t
+		case "BYDAY":
+			for _, d := range strings.Split(strings.ToUpper(value), ",") {
+				wd, ok := rruleWeekdays[strings.TrimSpace(d)]
+				if !ok {
+					return nil, fmt.Errorf("BYDAY tidak didukung: '%s' (gunakan MO,TU,WE,TH,FR,SA,SU)", d)
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(d))
+				if err != nil || n < 1 || n > 31 {
+					return nil, errors.New("BYMONTHDAY harus angka 1-31")
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+		case "WKST":
+			if strings.ToUpper(value) != "MO" {
+				return nil, errors.New("hanya WKST=MO yang didukung")
+			}
+		default:
+			return nil, fmt.Errorf("%s belum didukung", key)
+		}
+	}
+
+	switch {
+	case r.Freq == "":
+		return nil, errors.New("FREQ wajib ada")
+	case len(r.ByDay) > 0 && r.Freq != RRuleWeekly:
+		return nil, errors.New("BYDAY hanya didukung untuk FREQ=WEEKLY")
+	case len(r.ByMonthDay) > 0 && r.Freq != RRuleMonthly:
+		return nil, errors.New("BYMONTHDAY hanya didukung untuk FREQ=MONTHLY")
+	case r.Count > 0 && r.Until != nil:
+		return nil, errors.New("COUNT dan UNTIL tidak boleh dipakai bersamaan")
+	}
+
+	// Urutkan agar occurrence dalam satu periode keluar berurutan (minggu dimulai Senin)
+	sort.Slice(r.ByDay, func(i, j int) bool { return mondayIndex(r.ByDay[i]) < mondayIndex(r.ByDay[j]) })
+	sort.Ints(r.ByMonthDay)
+	return r, nil
+}
+
+func mondayIndex(d time.Weekday) int {
+	return (int(d) + 6) % 7
+}
+
+// Finite mengembalikan true jika aturan punya akhir (COUNT atau UNTIL).
+func (r *RRule) Finite() bool {
+	return r.Count > 0 || r.Until != nil
+}
+
+// Occurrences mengembalikan waktu mulai occurrence (urut, dimulai dari dtstart) yang <= 'end'.
+// 'done' bernilai true jika aturan sudah habis (COUNT/UNTIL) sebelum 'end'.
+func (r *RRule) Occurrences(dtstart, end time.Time) (occurrences []time.Time, done bool) {
+	hour, min, sec := dtstart.Clock()
+	loc := dtstart.Location()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hour, min, sec, 0, loc)
+	}
+
+	// emit menambahkan satu kandidat; mengembalikan false jika iterasi harus berhenti.
+	emit := func(t time.Time) bool {
+		if t.Before(dtstart) {
+			return true
+		}
+		if r.Until != nil && t.After(*r.Until) {
+			done = true
+			return false
+		}
+		if t.After(end) {
+			return false
+		}
+		occurrences = append(occurrences, t)
+		if r.Count > 0 && len(occurrences) >= r.Count {
+			done = true
+			return false
+		}
+		return true
+	}
+
+	y, m, d := dtstart.Date()
+	for k := 0; k < maxRRulePeriods; k++ {
+		n := k * r.Interval
+		switch r.Freq {
+		case RRuleDaily:
+			if !emit(at(y, m, d+n)) {
+				return occurrences, done
+			}
+
+		case RRuleWeekly:
+			// Senin di minggu periode ke-k
+			weekStart := d - mondayIndex(dtstart.Weekday()) + 7*n
+			days := r.ByDay
+			if len(days) == 0 {
+				days = []time.Weekday{dtstart.Weekday()}
+			}
+			for _, wd := range days {
+				if !emit(at(y, m, weekStart+mondayIndex(wd))) {
+					return occurrences, done
+				}
+			}
+
+		case RRuleMonthly:
+			first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, loc)
+			days := r.ByMonthDay
+			if len(days) == 0 {
+				days = []int{d}
+			}
+			for _, md := range days {
+				t := at(first.Year(), first.Month(), md)
+				if t.Month() != first.Month() {
+					continue // Misal tanggal 31 di bulan 30 hari
+				}
+				if !emit(t) {
+					return occurrences, done
+				}
+			}
+		}
+	}
+	return occurrences, done
+}
@@ -0,0 +1,225 @@
+package utils
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // Zona waktu DST tetap tersedia walaupun OS tidak punya zoneinfo
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q): %v", name, err)
+	}
+	return loc
+}
+
+func TestParseRRuleErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+	}{
+		{"kosong", ""},
+		{"tanpa FREQ", "INTERVAL=2"},
+		{"FREQ tidak didukung", "FREQ=YEARLY"},
+		{"bagian tanpa nilai", "FREQ=DAILY;COUNT"},
+		{"key ganda", "FREQ=DAILY;FREQ=WEEKLY"},
+		{"INTERVAL nol", "FREQ=DAILY;INTERVAL=0"},
+		{"COUNT nol", "FREQ=DAILY;COUNT=0"},
+		{"UNTIL tidak valid", "FREQ=DAILY;UNTIL=2026-01-01"},
+		{"BYDAY dengan urutan", "FREQ=WEEKLY;BYDAY=1MO"},
+		{"BYDAY bukan WEEKLY", "FREQ=DAILY;BYDAY=MO"},
+		{"BYMONTHDAY di luar rentang", "FREQ=MONTHLY;BYMONTHDAY=32"},
+		{"BYMONTHDAY bukan MONTHLY", "FREQ=WEEKLY;BYMONTHDAY=1"},
+		{"COUNT dan UNTIL", "FREQ=DAILY;COUNT=2;UNTIL=20260101"},
+		{"WKST selain MO", "FREQ=WEEKLY;WKST=SU"},
+		{"key tidak didukung", "FREQ=MONTHLY;BYSETPOS=-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if r, err := ParseRRule(tt.rule); err == nil {
+				t.Fatalf("ParseRRule(%q) = %+v, ingin error", tt.rule, r)
+			}
+		})
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	r, err := ParseRRule("RRULE:freq=weekly;BYDAY=FR,MO,WE;INTERVAL=2;UNTIL=20260131")
+	if err != nil {
+		t.Fatalf("ParseRRule: %v", err)
+	}
+	if r.Freq != RRuleWeekly || r.Interval != 2 {
+		t.Errorf("Freq/Interval = %s/%d, ingin WEEKLY/2", r.Freq, r.Interval)
+	}
+	// BYDAY diurutkan dari Senin
+	want := []time.Weekday{time.Monday, time.Wednesday, time.Friday}
+	if len(r.ByDay) != len(want) {
+		t.Fatalf("ByDay = %v, ingin %v", r.ByDay, want)
+	}
+	for i := range want {
+		if r.ByDay[i] != want[i] {
+			t.Errorf("ByDay = %v, ingin %v", r.ByDay, want)
+		}
+	}
+	// UNTIL tanggal saja = akhir hari (UTC)
+	if wantUntil := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC); r.Until == nil || !r.Until.Equal(wantUntil) {
+		t.Errorf("Until = %v, ingin %v", r.Until, wantUntil)
+	}
+	if !r.Finite() {
+		t.Error("Finite() = false, ingin true")
+	}
+}
+
+func TestRRuleOccurrences(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	jakarta := mustLoadLocation(t, "Asia/Jakarta")
+	utc := func(y int, m time.Month, d, h, min int) time.Time {
+		return time.Date(y, m, d, h, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		dtstart  time.Time
+		end      time.Time
+		want     []time.Time
+		wantDone bool
+	}{
+		{
+			// DST mulai 8 Maret 2026 di New York: jam lokal tetap 10:00, offset UTC berubah
+			name:    "WEEKLY melewati DST",
+			rule:    "FREQ=WEEKLY;COUNT=3",
+			dtstart: time.Date(2026, 3, 1, 10, 0, 0, 0, newYork),
+			end:     utc(2026, 12, 31, 0, 0),
+			want: []time.Time{
+				utc(2026, 3, 1, 15, 0),
+				utc(2026, 3, 8, 14, 0),
+				utc(2026, 3, 15, 14, 0),
+			},
+			wantDone: true,
+		},
+		{
+			// DST berakhir 1 November 2026
+			name:    "DAILY saat DST berakhir",
+			rule:    "FREQ=DAILY;COUNT=2",
+			dtstart: time.Date(2026, 10, 31, 9, 30, 0, 0, newYork),
+			end:     utc(2026, 12, 31, 0, 0),
+			want: []time.Time{
+				utc(2026, 10, 31, 13, 30),
+				utc(2026, 11, 1, 14, 30),
+			},
+			wantDone: true,
+		},
+		{
+			name:    "BYMONTHDAY=31 melewati bulan tanpa tanggal 31",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=4",
+			dtstart: utc(2026, 1, 31, 19, 0),
+			end:     utc(2027, 12, 31, 0, 0),
+			want: []time.Time{
+				utc(2026, 1, 31, 19, 0),
+				utc(2026, 3, 31, 19, 0),
+				utc(2026, 5, 31, 19, 0),
+				utc(2026, 7, 31, 19, 0),
+			},
+			wantDone: true,
+		},
+		{
+			name:    "MONTHLY tanpa BYMONTHDAY memakai tanggal DTSTART",
+			rule:    "FREQ=MONTHLY;COUNT=3",
+			dtstart: utc(2026, 8, 31, 8, 0),
+			end:     utc(2027, 12, 31, 0, 0),
+			want: []time.Time{
+				utc(2026, 8, 31, 8, 0),
+				utc(2026, 10, 31, 8, 0),
+				utc(2026, 12, 31, 8, 0),
+			},
+			wantDone: true,
+		},
+		{
+			name:    "DAILY dengan INTERVAL dan COUNT",
+			rule:    "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			dtstart: time.Date(2026, 1, 30, 19, 0, 0, 0, jakarta),
+			end:     utc(2026, 12, 31, 0, 0),
+			want: []time.Time{
+				utc(2026, 1, 30, 12, 0),
+				utc(2026, 2, 1, 12, 0),
+				utc(2026, 2, 3, 12, 0),
+			},
+			wantDone: true,
+		},
+		{
+			name:    "UNTIL tanggal saja termasuk hari terakhir",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20260114",
+			dtstart: utc(2026, 1, 5, 9, 0),
+			end:     utc(2026, 12, 31, 0, 0),
+			want: []time.Time{
+				utc(2026, 1, 5, 9, 0),
+				utc(2026, 1, 7, 9, 0),
+				utc(2026, 1, 12, 9, 0),
+				utc(2026, 1, 14, 9, 0),
+			},
+			wantDone: true,
+		},
+		{
+			name:    "UNTIL dengan jam",
+			rule:    "FREQ=DAILY;UNTIL=20260103T085959Z",
+			dtstart: utc(2026, 1, 1, 9, 0),
+			end:     utc(2026, 12, 31, 0, 0),
+			want: []time.Time{
+				utc(2026, 1, 1, 9, 0),
+				utc(2026, 1, 2, 9, 0),
+			},
+			wantDone: true,
+		},
+		{
+			name:    "BYDAY sebelum DTSTART di minggu pertama dilewati",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,FR;COUNT=3",
+			dtstart: utc(2026, 1, 7, 18, 0), // Rabu
+			end:     utc(2026, 12, 31, 0, 0),
+			want: []time.Time{
+				utc(2026, 1, 9, 18, 0),
+				utc(2026, 1, 12, 18, 0),
+				utc(2026, 1, 16, 18, 0),
+			},
+			wantDone: true,
+		},
+		{
+			name:    "berhenti di 'end' sebelum aturan habis",
+			rule:    "FREQ=WEEKLY;COUNT=10",
+			dtstart: utc(2026, 1, 1, 10, 0),
+			end:     utc(2026, 1, 15, 10, 0),
+			want: []time.Time{
+				utc(2026, 1, 1, 10, 0),
+				utc(2026, 1, 8, 10, 0),
+				utc(2026, 1, 15, 10, 0),
+			},
+			wantDone: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRRule(%q): %v", tt.rule, err)
+			}
+			got, done := r.Occurrences(tt.dtstart, tt.end)
+			if done != tt.wantDone {
+				t.Errorf("done = %v, ingin %v", done, tt.wantDone)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Occurrences = %v, ingin %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %v, ingin %v", i, got[i].UTC(), tt.want[i])
+				}
+				if got[i].Location() != tt.dtstart.Location() {
+					t.Errorf("occurrence %d di zona %v, ingin %v", i, got[i].Location(), tt.dtstart.Location())
+				}
+			}
+		})
+	}
+}
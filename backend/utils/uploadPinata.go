@@ -83,3 +83,28 @@ func UploadToPinata(filePath string) (*PinataResponse, error) {
 
 	return &pinataResp, nil
 }
+
+// UnpinFromPinata melepas pin file (misal: gambar yang sudah di-upload tapi tidak jadi dipakai).
+func UnpinFromPinata(ipfsHash string) error {
+	pinataJWT := os.Getenv("PINATA_JWT_KEY")
+	if pinataJWT == "" {
+		return fmt.Errorf("PINATA_JWT_KEY tidak ditemukan")
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, "https://api.pinata.cloud/pinning/unpin/"+ipfsHash, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+pinataJWT)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unpin gagal, status: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// accountProofDomainTag adalah domain tag yang dipakai FCL untuk account-proof
+// ("FCL-ACCOUNT-PROOF-V0.0", di-padding 0 sampai 32 byte).
+var accountProofDomainTag = func() []byte {
+	tag := make([]byte, 32)
+	copy(tag, "FCL-ACCOUNT-PROOF-V0.0")
+	return tag
+}()
+
+// AccountProofSignature adalah satu 'composite signature' dari FCL.
+type AccountProofSignature struct {
+	Address   string `json:"addr"`
+	KeyID     uint32 `json:"keyId"`
+	Signature string `json:"signature"` // hex
+}
+
+// VerifyAccountProof memverifikasi FCL account-proof terhadap key on-chain akun tersebut
+// (diambil dari access node). Total 'weight' key yang valid harus >= 1000.
+func VerifyAccountProof(
+	ctx context.Context,
+	flowClient access.Client,
+	address string,
+	appIdentifier string,
+	nonce string,
+	signatures []AccountProofSignature,
+) error {
+	if len(signatures) == 0 {
+		return fmt.Errorf("signature kosong")
+	}
+
+	// 1. Susun pesan yang ditandatangani wallet
+	flowAddress := flow.HexToAddress(address)
+	encoded, err := flow.EncodeAccountProofMessage(flowAddress, appIdentifier, nonce)
+	if err != nil {
+		return err
+	}
+	message := append(append([]byte{}, accountProofDomainTag...), encoded...)
+
+	// 2. Ambil key on-chain akun
+	account, err := flowClient.GetAccount(ctx, flowAddress)
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan akun %s: %w", flowAddress.String(), err)
+	}
+
+	keys := make(map[uint32]*flow.AccountKey, len(account.Keys))
+	for _, key := range account.Keys {
+		keys[key.Index] = key
+	}
+
+	// 3. Verifikasi setiap signature, jumlahkan weight dari key yang valid.
+	// Setiap key hanya dihitung sekali.
+	totalWeight := 0
+	counted := make(map[uint32]bool)
+	for _, sig := range signatures {
+		if flow.HexToAddress(sig.Address) != flowAddress {
+			return fmt.Errorf("signature untuk alamat %s tidak cocok", sig.Address)
+		}
+
+		key, ok := keys[sig.KeyID]
+		if !ok || key.Revoked || counted[sig.KeyID] {
+			continue
+		}
+
+		sigBytes, err := hex.DecodeString(strings.TrimPrefix(sig.Signature, "0x"))
+		if err != nil {
+			return fmt.Errorf("signature bukan hex yang valid")
+		}
+
+		hasher, err := crypto.NewHasher(key.HashAlgo)
+		if err != nil {
+			return fmt.Errorf("hash algorithm key %d tidak didukung: %w", sig.KeyID, err)
+		}
+
+		valid, err := key.PublicKey.Verify(sigBytes, message, hasher)
+		if err != nil || !valid {
+			continue
+		}
+
+		counted[sig.KeyID] = true
+		totalWeight += key.Weight
+	}
+
+	if totalWeight < 1000 {
+		return fmt.Errorf("account-proof tidak valid (weight %d < 1000)", totalWeight)
+	}
+	return nil
+}
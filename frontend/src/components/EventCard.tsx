@@ -107,7 +107,7 @@ export function EventCard({ event }: EventCardProps) {
               <div className="flex items-center gap-1.5">
                 <Users className="size-3 text-rpn-blue" />
                 <span>
-                  <span className="text-white font-bold">{event.attendees?.length || 0}</span> / {event.quota}
+                  <span className="text-white font-bold">{event.registeredCount ?? event.attendees?.length ?? 0}</span> / {event.quota}
                 </span>
               </div>
             </div>
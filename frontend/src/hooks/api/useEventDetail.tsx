@@ -15,6 +15,7 @@ interface GetEventDetailResponse {
     location: string;
     start_date: string;
     quota: number;
+    registered_count: number;
     is_registered: boolean;
     is_checked_in: boolean;
     attendees?: [{
@@ -59,7 +60,8 @@ const fetchEventById = async (id: string, userAddress?: string) => {
       isRegistered: ev.is_registered,
       isCheckedIn: ev.is_checked_in,
       price: 0,
-      quota: ev.quota
+      quota: ev.quota,
+      registeredCount: ev.registered_count
     } as UIEvent;
 
   } catch (error: any) {
@@ -15,6 +15,7 @@ export interface BackendEvent {
   start_date: string; // ISO String dari Go
   end_date: string;
   quota: number;
+  registered_count: number; // Jumlah peserta (daftar peserta bisa disembunyikan oleh privasi)
   edges?: {
     host?: {
       address: string;
@@ -54,6 +55,7 @@ export interface UIEvent {
   organizer: string;
   price: number;    // (Sementara hardcode atau ambil dari logic lain)
   quota: number;
+  registeredCount: number;
   isRegistered: boolean;
   isCheckedIn: boolean;
   attendees?: [{
@@ -94,6 +96,7 @@ export function useEventList(page: number = 1) {
         organizer: ev.edges?.host?.address || "Unknown Host",
         price: 0, // (Backend belum kirim harga tiket, asumsi gratis/0 untuk MVP)
         quota: ev.quota,
+        registeredCount: ev.registered_count,
         isRegistered: false,
         isCheckedIn: false,
         attendees: ev.edges?.attendances,
@@ -9,6 +9,7 @@ export interface Event {
   category: string;
   image?: string;
   attendees: number;
+  registeredCount?: number;
   maxAttendees: number;
   price: number;
   organizer: string;
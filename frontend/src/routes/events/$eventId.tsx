@@ -182,7 +182,7 @@ function EventDetailPage() {
                 <div className="space-y-4 mb-8">
                   {/* 1. LOGIKA HITUNG */}
                   {(() => {
-                    const attendeesCount = event.attendees?.length || 0;
+                    const attendeesCount = event.registeredCount ?? event.attendees?.length ?? 0;
                     const percentage = Math.min((attendeesCount / event.quota) * 100, 100);
                     const seatsLeft = event.quota - attendeesCount;
 